@@ -0,0 +1,91 @@
+package blockchain
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// saveForkChoiceSnapshots snapshots the fork choice store to the DB once every epoch, so a node
+// which did not shut down cleanly still resumes fork choice from a recent snapshot.
+func (s *Service) saveForkChoiceSnapshots() {
+	ticker := time.NewTicker(time.Duration(params.BeaconConfig().SecondsPerSlot*params.BeaconConfig().SlotsPerEpoch) * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := s.saveForkChoiceSnapshot(s.ctx); err != nil {
+				log.WithError(err).Error("Could not save fork choice snapshot")
+			}
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// saveForkChoiceSnapshot saves a snapshot of the fork choice store to the DB.
+func (s *Service) saveForkChoiceSnapshot(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "blockchain.saveForkChoiceSnapshot")
+	defer span.End()
+
+	if s.forkChoiceStore == nil {
+		return nil
+	}
+	snapshot := s.forkChoiceStore.Snapshot()
+	if len(snapshot.Nodes) == 0 {
+		return nil
+	}
+	return s.beaconDB.SaveForkChoiceSnapshot(ctx, snapshot)
+}
+
+// restoreForkChoice returns the fork choice store of the snapshot saved to the DB. Nil is returned
+// if there is no snapshot, or if it does not match the finalized checkpoint or references blocks
+// missing from the DB, such as initial sync blocks which were not yet saved when the node stopped.
+func (s *Service) restoreForkChoice(ctx context.Context, finalizedCheckpoint *ethpb.Checkpoint) (*protoarray.ForkChoice, error) {
+	snapshot, err := s.beaconDB.ForkChoiceSnapshot(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get fork choice snapshot")
+	}
+	if snapshot == nil {
+		return nil, nil
+	}
+	// The snapshot may predate the latest finalized checkpoint by up to an epoch, but must still
+	// contain the finalized block for fork choice to be rooted at it.
+	finalizedRoot := s.ensureRootNotZeros(bytesutil.ToBytes32(finalizedCheckpoint.Root))
+	hasFinalizedRoot := false
+	for _, n := range snapshot.Nodes {
+		root := bytesutil.ToBytes32(n.Root)
+		if root == finalizedRoot {
+			hasFinalizedRoot = true
+		}
+		if !s.beaconDB.HasBlock(ctx, root) {
+			log.WithField("blockRoot", fmt.Sprintf("%#x", root)).Warn("Fork choice snapshot references a missing block, rebuilding fork choice")
+			return nil, nil
+		}
+	}
+	if !hasFinalizedRoot || snapshot.FinalizedEpoch > finalizedCheckpoint.Epoch {
+		log.WithFields(logrus.Fields{
+			"snapshotFinalizedEpoch": snapshot.FinalizedEpoch,
+			"finalizedEpoch":         finalizedCheckpoint.Epoch,
+		}).Warn("Fork choice snapshot does not match the finalized checkpoint, rebuilding fork choice")
+		return nil, nil
+	}
+	// Root the restored store at the finalized checkpoint, which may be newer than the snapshot.
+	snapshot.FinalizedEpoch = finalizedCheckpoint.Epoch
+	snapshot.FinalizedRoot = finalizedCheckpoint.Root
+	store, err := protoarray.NewFromSnapshot(snapshot)
+	if err != nil {
+		log.WithError(err).Warn("Invalid fork choice snapshot, rebuilding fork choice")
+		return nil, nil
+	}
+	log.WithField("nodes", len(snapshot.Nodes)).Info("Restored fork choice from snapshot")
+	return store, nil
+}
@@ -0,0 +1,84 @@
+package blockchain
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestRestoreForkChoice_FromSnapshot(t *testing.T) {
+	ctx := context.Background()
+	db, _ := testDB.SetupDB(t)
+
+	genesis := testutil.NewBeaconBlock()
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, genesis))
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = 1
+	blk.Block.ParentRoot = genesisRoot[:]
+	root, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, blk))
+
+	store := protoarray.New(0, 0, [32]byte{})
+	require.NoError(t, store.ProcessBlock(ctx, 0, genesisRoot, [32]byte{}, [32]byte{}, 0, 0))
+	require.NoError(t, store.ProcessBlock(ctx, 1, root, genesisRoot, [32]byte{}, 0, 0))
+	store.ProcessAttestation(ctx, []uint64{0}, root, 0)
+	_, err = store.Head(ctx, 0, genesisRoot, []uint64{1}, 0)
+	require.NoError(t, err)
+
+	s := &Service{ctx: ctx, beaconDB: db, forkChoiceStore: store, genesisRoot: genesisRoot}
+	restored, err := s.restoreForkChoice(ctx, &ethpb.Checkpoint{Root: make([]byte, 32)})
+	require.NoError(t, err)
+	assert.Equal(t, (*protoarray.ForkChoice)(nil), restored, "Restored fork choice without a snapshot")
+
+	require.NoError(t, s.saveForkChoiceSnapshot(ctx))
+	restored, err = s.restoreForkChoice(ctx, &ethpb.Checkpoint{Root: make([]byte, 32)})
+	require.NoError(t, err)
+	require.NotNil(t, restored)
+	assert.Equal(t, true, restored.HasNode(root))
+	assert.Equal(t, uint64(1), restored.Node(root).Weight())
+	assert.Equal(t, uint64(1), restored.Node(genesisRoot).Weight())
+	assert.Equal(t, uint64(0), restored.Store().FinalizedEpoch())
+
+	// A checkpoint finalized after the snapshot was taken replaces the snapshot finalized epoch.
+	restored, err = s.restoreForkChoice(ctx, &ethpb.Checkpoint{Epoch: 1, Root: genesisRoot[:]})
+	require.NoError(t, err)
+	require.NotNil(t, restored)
+	assert.Equal(t, uint64(1), restored.Store().FinalizedEpoch())
+}
+
+func TestRestoreForkChoice_RebuildsOnMismatch(t *testing.T) {
+	ctx := context.Background()
+	db, _ := testDB.SetupDB(t)
+
+	genesis := testutil.NewBeaconBlock()
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, genesis))
+
+	store := protoarray.New(0, 0, [32]byte{})
+	require.NoError(t, store.ProcessBlock(ctx, 0, genesisRoot, [32]byte{}, [32]byte{}, 0, 0))
+	s := &Service{ctx: ctx, beaconDB: db, forkChoiceStore: store, genesisRoot: genesisRoot}
+	require.NoError(t, s.saveForkChoiceSnapshot(ctx))
+
+	// The snapshot does not contain the finalized block.
+	restored, err := s.restoreForkChoice(ctx, &ethpb.Checkpoint{Epoch: 1, Root: bytesutil.PadTo([]byte{'b'}, 32)})
+	require.NoError(t, err)
+	assert.Equal(t, (*protoarray.ForkChoice)(nil), restored, "Restored a snapshot not matching the finalized checkpoint")
+
+	// The child block is not in the DB, as if it were an unsaved initial sync block.
+	require.NoError(t, store.ProcessBlock(ctx, 1, [32]byte{'a'}, genesisRoot, [32]byte{}, 0, 0))
+	require.NoError(t, s.saveForkChoiceSnapshot(ctx))
+	restored, err = s.restoreForkChoice(ctx, &ethpb.Checkpoint{Root: make([]byte, 32)})
+	require.NoError(t, err)
+	assert.Equal(t, (*protoarray.ForkChoice)(nil), restored, "Restored a snapshot referencing a missing block")
+}
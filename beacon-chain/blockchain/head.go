@@ -62,7 +62,12 @@ func (s *Service) updateHead(ctx context.Context, balances []uint64) error {
 	}
 
 	// Save head to the local service cache.
-	return s.saveHead(ctx, headRoot)
+	if err := s.saveHead(ctx, headRoot); err != nil {
+		return err
+	}
+
+	s.notifyCheckpoints()
+	return nil
 }
 
 // This saves head info to the local service cache, it also saves the
@@ -100,18 +105,32 @@ func (s *Service) saveHead(ctx context.Context, headRoot [32]byte) error {
 		return errors.New("cannot save nil head state")
 	}
 
+	oldHeadRoot := s.headRoot()
+	oldHeadSlot := s.headSlot()
+
 	// A chain re-org occurred, so we fire an event notifying the rest of the services.
-	if bytesutil.ToBytes32(newHeadBlock.Block.ParentRoot) != s.headRoot() {
+	if bytesutil.ToBytes32(newHeadBlock.Block.ParentRoot) != oldHeadRoot {
 		log.WithFields(logrus.Fields{
 			"newSlot": fmt.Sprintf("%d", newHeadBlock.Block.Slot),
-			"oldSlot": fmt.Sprintf("%d", s.headSlot()),
+			"oldSlot": fmt.Sprintf("%d", oldHeadSlot),
 		}).Debug("Chain reorg occurred")
+		reorg := &statefeed.ReorgData{
+			NewSlot:     newHeadBlock.Block.Slot,
+			OldSlot:     oldHeadSlot,
+			OldHeadRoot: oldHeadRoot,
+			NewHeadRoot: headRoot,
+		}
+		ancestorRoot, ancestorSlot, depth, err := s.commonAncestor(ctx, oldHeadRoot, headRoot)
+		if err != nil {
+			log.WithError(err).Debug("Could not determine common ancestor of reorg")
+		} else {
+			reorg.CommonAncestorRoot = ancestorRoot
+			reorg.CommonAncestorSlot = ancestorSlot
+			reorg.Depth = depth
+		}
 		s.stateNotifier.StateFeed().Send(&feed.Event{
 			Type: statefeed.Reorg,
-			Data: &statefeed.ReorgData{
-				NewSlot: newHeadBlock.Block.Slot,
-				OldSlot: s.headSlot(),
-			},
+			Data: reorg,
 		})
 
 		reorgCount.Inc()
@@ -120,6 +139,16 @@ func (s *Service) saveHead(ctx context.Context, headRoot [32]byte) error {
 	// Cache the new head info.
 	s.setHead(headRoot, newHeadBlock, newHeadState)
 
+	s.stateNotifier.StateFeed().Send(&feed.Event{
+		Type: statefeed.NewHead,
+		Data: &statefeed.NewHeadData{
+			Slot:              newHeadBlock.Block.Slot,
+			BlockRoot:         headRoot,
+			PreviousBlockRoot: oldHeadRoot,
+			EpochTransition:   helpers.SlotToEpoch(newHeadBlock.Block.Slot) > helpers.SlotToEpoch(oldHeadSlot),
+		},
+	})
+
 	// Save the new head root to DB.
 	if err := s.beaconDB.SaveHeadBlockRoot(ctx, headRoot); err != nil {
 		return errors.Wrap(err, "could not save head root in DB")
@@ -128,6 +157,47 @@ func (s *Service) saveHead(ctx context.Context, headRoot [32]byte) error {
 	return nil
 }
 
+// This walks back the old and new head chains until they meet, returning the root and slot of the
+// latest block they share, and the number of blocks of the old chain which are not part of the new one.
+func (s *Service) commonAncestor(ctx context.Context, oldRoot [32]byte, newRoot [32]byte) ([32]byte, uint64, uint64, error) {
+	oldBlk, err := s.beaconDB.Block(ctx, oldRoot)
+	if err != nil {
+		return [32]byte{}, 0, 0, err
+	}
+	newBlk, err := s.beaconDB.Block(ctx, newRoot)
+	if err != nil {
+		return [32]byte{}, 0, 0, err
+	}
+	depth := uint64(0)
+	for oldRoot != newRoot {
+		if ctx.Err() != nil {
+			return [32]byte{}, 0, 0, ctx.Err()
+		}
+		if oldBlk == nil || oldBlk.Block == nil || newBlk == nil || newBlk.Block == nil {
+			return [32]byte{}, 0, 0, errors.New("chains do not share a known block")
+		}
+		// Step back the chain with the higher block, or both of them if they are at the same slot.
+		oldSlot, newSlot := oldBlk.Block.Slot, newBlk.Block.Slot
+		if oldSlot >= newSlot {
+			oldRoot = bytesutil.ToBytes32(oldBlk.Block.ParentRoot)
+			if oldBlk, err = s.beaconDB.Block(ctx, oldRoot); err != nil {
+				return [32]byte{}, 0, 0, err
+			}
+			depth++
+		}
+		if newSlot >= oldSlot {
+			newRoot = bytesutil.ToBytes32(newBlk.Block.ParentRoot)
+			if newBlk, err = s.beaconDB.Block(ctx, newRoot); err != nil {
+				return [32]byte{}, 0, 0, err
+			}
+		}
+	}
+	if oldBlk == nil || oldBlk.Block == nil {
+		return [32]byte{}, 0, 0, errors.New("nil common ancestor block")
+	}
+	return oldRoot, oldBlk.Block.Slot, depth, nil
+}
+
 // This gets called to update canonical root mapping. It does not save head block
 // root in DB. With the inception of initial-sync-cache-state flag, it uses finalized
 // check point as anchors to resume sync therefore head is no longer needed to be saved on per slot basis.
@@ -5,8 +5,13 @@ import (
 	"context"
 	"testing"
 
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
@@ -93,6 +98,87 @@ func TestSaveHead_Different_Reorg(t *testing.T) {
 	require.LogsContain(t, hook, "Chain reorg occurred")
 }
 
+func TestSaveHead_Reorg_SendsEvents(t *testing.T) {
+	ctx := context.Background()
+	db, sc := testDB.SetupDB(t)
+	service := setupBeaconChain(t, db, sc)
+
+	saveBlock := func(slot uint64, parent [32]byte) [32]byte {
+		b := testutil.NewBeaconBlock()
+		b.Block.Slot = slot
+		b.Block.ParentRoot = parent[:]
+		require.NoError(t, service.beaconDB.SaveBlock(ctx, b))
+		r, err := b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		return r
+	}
+	// The old chain is genesis <- a <- b, the new one genesis <- c <- d.
+	genesis := saveBlock(0, [32]byte{})
+	a := saveBlock(1, genesis)
+	b := saveBlock(2, a)
+	c := saveBlock(2, genesis)
+	d := saveBlock(params.BeaconConfig().SlotsPerEpoch, c)
+	service.head = &head{slot: 2, root: b}
+
+	headState := testutil.NewBeaconState()
+	require.NoError(t, headState.SetSlot(params.BeaconConfig().SlotsPerEpoch))
+	require.NoError(t, service.beaconDB.SaveStateSummary(ctx, &pb.StateSummary{Slot: params.BeaconConfig().SlotsPerEpoch, Root: d[:]}))
+	require.NoError(t, service.beaconDB.SaveState(ctx, headState, d))
+
+	events := make(chan *feed.Event, 2)
+	sub := service.stateNotifier.StateFeed().Subscribe(events)
+	defer sub.Unsubscribe()
+	require.NoError(t, service.saveHead(ctx, d))
+
+	e := <-events
+	require.Equal(t, statefeed.Reorg, int(e.Type))
+	assert.DeepEqual(t, &statefeed.ReorgData{
+		NewSlot:            params.BeaconConfig().SlotsPerEpoch,
+		OldSlot:            2,
+		OldHeadRoot:        b,
+		NewHeadRoot:        d,
+		CommonAncestorRoot: genesis,
+		CommonAncestorSlot: 0,
+		Depth:              2,
+	}, e.Data)
+	e = <-events
+	require.Equal(t, statefeed.NewHead, int(e.Type))
+	assert.DeepEqual(t, &statefeed.NewHeadData{
+		Slot:              params.BeaconConfig().SlotsPerEpoch,
+		BlockRoot:         d,
+		PreviousBlockRoot: b,
+		EpochTransition:   true,
+	}, e.Data)
+}
+
+func TestNotifyCheckpoints(t *testing.T) {
+	db, sc := testDB.SetupDB(t)
+	service := setupBeaconChain(t, db, sc)
+	events := make(chan *feed.Event, 2)
+	sub := service.stateNotifier.StateFeed().Subscribe(events)
+	defer sub.Unsubscribe()
+
+	// The first checkpoints are only recorded.
+	service.justifiedCheckpt = &ethpb.Checkpoint{Epoch: 1, Root: bytesutil.PadTo([]byte{'a'}, 32)}
+	service.finalizedCheckpt = &ethpb.Checkpoint{Epoch: 0, Root: params.BeaconConfig().ZeroHash[:]}
+	service.notifyCheckpoints()
+	assert.Equal(t, 0, len(events))
+
+	service.justifiedCheckpt = &ethpb.Checkpoint{Epoch: 2, Root: bytesutil.PadTo([]byte{'b'}, 32)}
+	service.notifyCheckpoints()
+	require.Equal(t, 1, len(events))
+	e := <-events
+	require.Equal(t, statefeed.JustifiedCheckpoint, int(e.Type))
+	assert.DeepEqual(t, &statefeed.CheckpointData{Epoch: 2, Root: [32]byte{'b'}}, e.Data)
+
+	service.finalizedCheckpt = &ethpb.Checkpoint{Epoch: 1, Root: bytesutil.PadTo([]byte{'a'}, 32)}
+	service.notifyCheckpoints()
+	require.Equal(t, 1, len(events))
+	e = <-events
+	require.Equal(t, statefeed.FinalizedCheckpoint, int(e.Type))
+	assert.DeepEqual(t, &statefeed.CheckpointData{Epoch: 1, Root: [32]byte{'a'}}, e.Data)
+}
+
 func TestUpdateRecentCanonicalBlocks_CanUpdateWithoutParent(t *testing.T) {
 	db, sc := testDB.SetupDB(t)
 	service := setupBeaconChain(t, db, sc)
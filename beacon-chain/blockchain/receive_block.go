@@ -8,6 +8,8 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/attestationutil"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/traceutil"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
@@ -94,6 +96,8 @@ func (s *Service) ReceiveBlockInitialSync(ctx context.Context, block *ethpb.Sign
 		},
 	})
 
+	s.notifyCheckpoints()
+
 	// Reports on blockCopy and fork choice metrics.
 	reportSlotMetrics(blockCopy.Block.Slot, s.headSlot(), s.CurrentSlot(), s.finalizedCheckpt)
 
@@ -141,10 +145,43 @@ func (s *Service) ReceiveBlockBatch(ctx context.Context, blocks []*ethpb.SignedB
 		// Reports on blockCopy and fork choice metrics.
 		reportSlotMetrics(blockCopy.Block.Slot, s.headSlot(), s.CurrentSlot(), s.finalizedCheckpt)
 	}
+	s.notifyCheckpoints()
 
 	return nil
 }
 
+// This sends an event on the state feed for each of the justified and finalized checkpoints which changed
+// since the last notification. Checkpoints seen before the chain is initialized are recorded without an event.
+func (s *Service) notifyCheckpoints() {
+	s.notifiedCheckptLock.Lock()
+	defer s.notifiedCheckptLock.Unlock()
+
+	if j := s.justifiedCheckpt; j != nil {
+		if s.notifiedJustifiedCheckpt != nil && !attestationutil.CheckPointIsEqual(j, s.notifiedJustifiedCheckpt) {
+			s.stateNotifier.StateFeed().Send(&feed.Event{
+				Type: statefeed.JustifiedCheckpoint,
+				Data: &statefeed.CheckpointData{
+					Epoch: j.Epoch,
+					Root:  bytesutil.ToBytes32(j.Root),
+				},
+			})
+		}
+		s.notifiedJustifiedCheckpt = stateTrie.CopyCheckpoint(j)
+	}
+	if f := s.finalizedCheckpt; f != nil {
+		if s.notifiedFinalizedCheckpt != nil && !attestationutil.CheckPointIsEqual(f, s.notifiedFinalizedCheckpt) {
+			s.stateNotifier.StateFeed().Send(&feed.Event{
+				Type: statefeed.FinalizedCheckpoint,
+				Data: &statefeed.CheckpointData{
+					Epoch: f.Epoch,
+					Root:  bytesutil.ToBytes32(f.Root),
+				},
+			})
+		}
+		s.notifiedFinalizedCheckpt = stateTrie.CopyCheckpoint(f)
+	}
+}
+
 // HasInitSyncBlock returns true if the block of the input root exists in initial sync blocks cache.
 func (s *Service) HasInitSyncBlock(root [32]byte) bool {
 	return s.hasInitSyncBlock(root)
@@ -75,6 +75,9 @@ type Service struct {
 	justifiedBalances         []uint64
 	justifiedBalancesLock     sync.RWMutex
 	checkPtInfoCache          *checkPtInfoCache
+	notifiedJustifiedCheckpt  *ethpb.Checkpoint
+	notifiedFinalizedCheckpt  *ethpb.Checkpoint
+	notifiedCheckptLock       sync.Mutex
 }
 
 // Config options for the service.
@@ -151,46 +154,19 @@ func (s *Service) Start() {
 	// If the chain has already been initialized, simply start the block processing routine.
 	if beaconState != nil {
 		log.Info("Blockchain data already exists in DB, initializing...")
-		s.genesisTime = time.Unix(int64(beaconState.GenesisTime()), 0)
-		s.opsService.SetGenesisTime(beaconState.GenesisTime())
-		if err := s.initializeChainInfo(s.ctx); err != nil {
-			log.Fatalf("Could not set up chain info: %v", err)
+		if err := s.resumeChain(beaconState); err != nil {
+			log.Fatalf("Could not resume chain: %v", err)
 		}
 
-		// We start a counter to genesis, if needed.
+		// We start a counter to genesis, if needed. A node started from a checkpoint
+		// state has no genesis state in its DB and is past genesis by definition.
 		gState, err := s.beaconDB.GenesisState(s.ctx)
 		if err != nil {
 			log.Fatalf("Could not retrieve genesis state: %v", err)
 		}
-		go slotutil.CountdownToGenesis(s.ctx, s.genesisTime, uint64(gState.NumValidators()))
-
-		justifiedCheckpoint, err := s.beaconDB.JustifiedCheckpoint(s.ctx)
-		if err != nil {
-			log.Fatalf("Could not get justified checkpoint: %v", err)
-		}
-		finalizedCheckpoint, err := s.beaconDB.FinalizedCheckpoint(s.ctx)
-		if err != nil {
-			log.Fatalf("Could not get finalized checkpoint: %v", err)
+		if gState != nil {
+			go slotutil.CountdownToGenesis(s.ctx, s.genesisTime, uint64(gState.NumValidators()))
 		}
-
-		// Resume fork choice.
-		s.justifiedCheckpt = stateTrie.CopyCheckpoint(justifiedCheckpoint)
-		if err := s.cacheJustifiedStateBalances(s.ctx, s.ensureRootNotZeros(bytesutil.ToBytes32(s.justifiedCheckpt.Root))); err != nil {
-			log.Fatalf("Could not cache justified state balances: %v", err)
-		}
-		s.prevJustifiedCheckpt = stateTrie.CopyCheckpoint(justifiedCheckpoint)
-		s.bestJustifiedCheckpt = stateTrie.CopyCheckpoint(justifiedCheckpoint)
-		s.finalizedCheckpt = stateTrie.CopyCheckpoint(finalizedCheckpoint)
-		s.prevFinalizedCheckpt = stateTrie.CopyCheckpoint(finalizedCheckpoint)
-		s.resumeForkChoice(justifiedCheckpoint, finalizedCheckpoint)
-
-		s.stateNotifier.StateFeed().Send(&feed.Event{
-			Type: statefeed.Initialized,
-			Data: &statefeed.InitializedData{
-				StartTime:             s.genesisTime,
-				GenesisValidatorsRoot: beaconState.GenesisValidatorRoot(),
-			},
-		})
 	} else {
 		log.Info("Waiting to reach the validator deposit threshold to start the beacon chain...")
 		if s.chainStartFetcher == nil {
@@ -227,6 +203,73 @@ func (s *Service) Start() {
 	}
 
 	go s.processAttestation(attestationProcessorSubscribed)
+	go s.saveForkChoiceSnapshots()
+}
+
+// Resume initializes the service from the chain data in the DB, as Start does, without starting
+// the routines driven by the wall clock: the attestation processing routine, the fork choice
+// snapshot routine and the countdown to genesis. It is meant for callers driving the chain
+// themselves, such as the replay of recorded gossip traffic against a DB snapshot.
+func (s *Service) Resume() error {
+	beaconState, err := s.beaconDB.HeadState(s.ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not fetch head state")
+	}
+	if beaconState == nil {
+		cp, err := s.beaconDB.FinalizedCheckpoint(s.ctx)
+		if err != nil {
+			return errors.Wrap(err, "could not fetch finalized checkpoint")
+		}
+		beaconState, err = s.stateGen.StateByRoot(s.ctx, bytesutil.ToBytes32(cp.Root))
+		if err != nil {
+			return errors.Wrap(err, "could not fetch beacon state by root")
+		}
+	}
+	if beaconState == nil {
+		return errors.New("no chain data in DB")
+	}
+	return s.resumeChain(beaconState)
+}
+
+// resumeChain sets up the chain info and fork choice from the head state and the checkpoints in
+// the DB, and notifies the other services that the chain is initialized.
+func (s *Service) resumeChain(beaconState *stateTrie.BeaconState) error {
+	s.genesisTime = time.Unix(int64(beaconState.GenesisTime()), 0)
+	s.opsService.SetGenesisTime(beaconState.GenesisTime())
+	if err := s.initializeChainInfo(s.ctx); err != nil {
+		return errors.Wrap(err, "could not set up chain info")
+	}
+
+	justifiedCheckpoint, err := s.beaconDB.JustifiedCheckpoint(s.ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get justified checkpoint")
+	}
+	finalizedCheckpoint, err := s.beaconDB.FinalizedCheckpoint(s.ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get finalized checkpoint")
+	}
+
+	// Resume fork choice.
+	s.justifiedCheckpt = stateTrie.CopyCheckpoint(justifiedCheckpoint)
+	if err := s.cacheJustifiedStateBalances(s.ctx, s.ensureRootNotZeros(bytesutil.ToBytes32(s.justifiedCheckpt.Root))); err != nil {
+		return errors.Wrap(err, "could not cache justified state balances")
+	}
+	s.prevJustifiedCheckpt = stateTrie.CopyCheckpoint(justifiedCheckpoint)
+	s.bestJustifiedCheckpt = stateTrie.CopyCheckpoint(justifiedCheckpoint)
+	s.finalizedCheckpt = stateTrie.CopyCheckpoint(finalizedCheckpoint)
+	s.prevFinalizedCheckpt = stateTrie.CopyCheckpoint(finalizedCheckpoint)
+	s.notifiedJustifiedCheckpt = stateTrie.CopyCheckpoint(justifiedCheckpoint)
+	s.notifiedFinalizedCheckpt = stateTrie.CopyCheckpoint(finalizedCheckpoint)
+	s.resumeForkChoice(justifiedCheckpoint, finalizedCheckpoint)
+
+	s.stateNotifier.StateFeed().Send(&feed.Event{
+		Type: statefeed.Initialized,
+		Data: &statefeed.InitializedData{
+			StartTime:             s.genesisTime,
+			GenesisValidatorsRoot: beaconState.GenesisValidatorRoot(),
+		},
+	})
+	return nil
 }
 
 // processChainStartTime initializes a series of deposits from the ChainStart deposits in the eth1
@@ -306,6 +349,11 @@ func (s *Service) Stop() error {
 		return err
 	}
 
+	// Save fork choice so its votes and weights survive the restart.
+	if err := s.saveForkChoiceSnapshot(s.ctx); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -370,6 +418,8 @@ func (s *Service) saveGenesisData(ctx context.Context, genesisState *stateTrie.B
 	s.bestJustifiedCheckpt = stateTrie.CopyCheckpoint(genesisCheckpoint)
 	s.finalizedCheckpt = stateTrie.CopyCheckpoint(genesisCheckpoint)
 	s.prevFinalizedCheckpt = stateTrie.CopyCheckpoint(genesisCheckpoint)
+	s.notifiedJustifiedCheckpt = stateTrie.CopyCheckpoint(genesisCheckpoint)
+	s.notifiedFinalizedCheckpt = stateTrie.CopyCheckpoint(genesisCheckpoint)
 
 	if err := s.forkChoiceStore.ProcessBlock(ctx,
 		genesisBlk.Block.Slot,
@@ -392,14 +442,23 @@ func (s *Service) initializeChainInfo(ctx context.Context) error {
 	if err != nil {
 		return errors.Wrap(err, "could not get genesis block from db")
 	}
-	if genesisBlock == nil {
-		return errors.New("no genesis block in db")
-	}
-	genesisBlkRoot, err := genesisBlock.Block.HashTreeRoot()
-	if err != nil {
-		return errors.Wrap(err, "could not get signing root of genesis block")
+	if genesisBlock != nil {
+		genesisBlkRoot, err := genesisBlock.Block.HashTreeRoot()
+		if err != nil {
+			return errors.Wrap(err, "could not get signing root of genesis block")
+		}
+		s.genesisRoot = genesisBlkRoot
+	} else {
+		// A node started from a checkpoint state does not have the genesis block,
+		// its chain begins at the origin block instead.
+		originRoot, err := s.beaconDB.OriginBlockRoot(ctx)
+		if err != nil {
+			return errors.Wrap(err, "could not get origin block root from db")
+		}
+		if originRoot == params.BeaconConfig().ZeroHash {
+			return errors.New("no genesis block in db")
+		}
 	}
-	s.genesisRoot = genesisBlkRoot
 
 	if flags.Get().UnsafeSync {
 		headBlock, err := s.beaconDB.HeadBlock(ctx)
@@ -451,8 +510,43 @@ func (s *Service) initializeChainInfo(ctx context.Context) error {
 // This is called when a client starts from non-genesis slot. This passes last justified and finalized
 // information to fork choice service to initializes fork choice store.
 func (s *Service) resumeForkChoice(justifiedCheckpoint *ethpb.Checkpoint, finalizedCheckpoint *ethpb.Checkpoint) {
+	restored, err := s.restoreForkChoice(s.ctx, finalizedCheckpoint)
+	if err != nil {
+		log.Fatalf("Could not restore fork choice: %v", err)
+	}
+	if restored != nil {
+		s.forkChoiceStore = restored
+		return
+	}
+
 	store := protoarray.New(justifiedCheckpoint.Epoch, finalizedCheckpoint.Epoch, bytesutil.ToBytes32(finalizedCheckpoint.Root))
 	s.forkChoiceStore = store
+
+	// A node started from a checkpoint state has no ancestors of the origin block to fill
+	// the fork choice store with, so the origin block is inserted as the tree root.
+	originRoot, err := s.beaconDB.OriginBlockRoot(s.ctx)
+	if err != nil {
+		log.Fatalf("Could not get origin block root: %v", err)
+	}
+	if originRoot == params.BeaconConfig().ZeroHash || originRoot != bytesutil.ToBytes32(finalizedCheckpoint.Root) {
+		return
+	}
+	originBlock, err := s.beaconDB.Block(s.ctx, originRoot)
+	if err != nil {
+		log.Fatalf("Could not get origin block: %v", err)
+	}
+	if originBlock == nil {
+		log.Fatal("Origin block is missing from the database")
+	}
+	if err := store.ProcessBlock(s.ctx,
+		originBlock.Block.Slot,
+		originRoot,
+		bytesutil.ToBytes32(originBlock.Block.ParentRoot),
+		bytesutil.ToBytes32(originBlock.Block.Body.Graffiti),
+		justifiedCheckpoint.Epoch,
+		finalizedCheckpoint.Epoch); err != nil {
+		log.Fatalf("Could not process origin block for fork choice: %v", err)
+	}
 }
 
 // This returns true if block has been processed before. Two ways to verify the block has been processed:
@@ -93,7 +93,7 @@ func setupBeaconChain(t *testing.T, beaconDB db.Database, sc *cache.StateSummary
 	require.NoError(t, err)
 	web3Service, err = powchain.NewService(ctx, &powchain.Web3ServiceConfig{
 		BeaconDB:        beaconDB,
-		HTTPEndPoint:    endpoint,
+		HTTPEndpoints:   []string{endpoint},
 		DepositContract: common.Address{},
 	})
 	require.NoError(t, err, "Unable to set up web3 service")
@@ -223,6 +223,36 @@ func TestChainService_CorrectGenesisRoots(t *testing.T) {
 
 }
 
+func TestChainService_Resume(t *testing.T) {
+	ctx := context.Background()
+	db, sc := testDB.SetupDB(t)
+
+	chainService := setupBeaconChain(t, db, sc)
+
+	genesisBlk := testutil.NewBeaconBlock()
+	blkRoot, err := genesisBlk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, genesisBlk))
+	s := testutil.NewBeaconState()
+	require.NoError(t, db.SaveState(ctx, s, blkRoot))
+	require.NoError(t, db.SaveHeadBlockRoot(ctx, blkRoot))
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, blkRoot))
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Root: blkRoot[:]}))
+
+	require.NoError(t, chainService.Resume())
+	require.DeepEqual(t, blkRoot[:], chainService.finalizedCheckpt.Root, "Finalize Checkpoint root is incorrect")
+	headRoot, err := chainService.HeadRoot(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, blkRoot[:], headRoot, "Head root is incorrect")
+	require.NoError(t, chainService.Stop(), "Unable to stop chain service")
+}
+
+func TestChainService_Resume_NoChainData(t *testing.T) {
+	db, sc := testDB.SetupDB(t)
+	c := &Service{ctx: context.Background(), beaconDB: db, stateGen: stategen.New(db, sc)}
+	assert.ErrorContains(t, "no chain data in DB", c.Resume())
+}
+
 func TestChainService_InitializeChainInfo(t *testing.T) {
 	db, sc := testDB.SetupDB(t)
 	ctx := context.Background()
@@ -263,6 +293,35 @@ func TestChainService_InitializeChainInfo(t *testing.T) {
 	assert.Equal(t, genesisRoot, c.genesisRoot, "Genesis block root incorrect")
 }
 
+func TestChainService_InitializeChainInfo_FromOrigin(t *testing.T) {
+	db, sc := testDB.SetupDB(t)
+	ctx := context.Background()
+
+	originSlot := params.BeaconConfig().SlotsPerEpoch * 4
+	originState := testutil.NewBeaconState()
+	require.NoError(t, originState.SetSlot(originSlot))
+	stateRoot, err := originState.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	originBlock := testutil.NewBeaconBlock()
+	originBlock.Block.Slot = originSlot
+	originBlock.Block.ParentRoot = bytesutil.PadTo([]byte{'p'}, 32)
+	originBlock.Block.StateRoot = stateRoot[:]
+	originRoot, err := originBlock.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveOriginCheckpoint(ctx, originState, originBlock))
+
+	c := &Service{ctx: ctx, beaconDB: db, stateGen: stategen.New(db, sc)}
+	require.NoError(t, c.initializeChainInfo(ctx))
+	headRoot, err := c.HeadRoot(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, originRoot[:], headRoot, "Head root incorrect")
+	assert.Equal(t, originSlot, c.HeadSlot(), "Head slot incorrect")
+
+	cp := &ethpb.Checkpoint{Epoch: helpers.SlotToEpoch(originSlot), Root: originRoot[:]}
+	c.resumeForkChoice(cp, cp)
+	assert.Equal(t, true, c.forkChoiceStore.HasNode(originRoot), "Origin block not in fork choice store")
+}
+
 func TestChainService_SaveHeadNoDB(t *testing.T) {
 	db, sc := testDB.SetupDB(t)
 	ctx := context.Background()
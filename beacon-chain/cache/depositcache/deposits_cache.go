@@ -53,11 +53,20 @@ type DepositCache struct {
 	pendingDeposits    []*dbpb.DepositContainer
 	deposits           []*dbpb.DepositContainer
 	finalizedDeposits  *FinalizedDeposits
+	snapshot           *depositSnapshot
 	depositsLock       sync.RWMutex
 	chainStartDeposits []*ethpb.Deposit
 	chainStartPubkeys  map[string]bool
 }
 
+// depositSnapshot records the deposits the cache was started from when it is created from a
+// snapshot of the deposit trie, for which there are no deposit containers.
+type depositSnapshot struct {
+	count           uint64
+	root            [32]byte
+	eth1BlockHeight uint64
+}
+
 // New instantiates a new deposit cache
 func New() (*DepositCache, error) {
 	finalizedDepositsTrie, err := trieutil.NewTrie(int(params.BeaconConfig().DepositContractTreeDepth))
@@ -111,6 +120,26 @@ func (dc *DepositCache) InsertDepositContainers(ctx context.Context, ctrs []*dbp
 	historicalDepositsCount.Add(float64(len(ctrs)))
 }
 
+// InsertDepositSnapshot starts the cache from a snapshot of the deposit trie holding the first
+// count deposits, included in the eth1 chain up to the given block. These deposits are considered
+// finalized, and the deposits inserted afterwards must follow them.
+func (dc *DepositCache) InsertDepositSnapshot(ctx context.Context, trie *trieutil.SparseMerkleTrie, count uint64, eth1BlockHeight uint64) {
+	ctx, span := trace.StartSpan(ctx, "DepositsCache.InsertDepositSnapshot")
+	defer span.End()
+	dc.depositsLock.Lock()
+	defer dc.depositsLock.Unlock()
+
+	dc.finalizedDeposits = &FinalizedDeposits{
+		Deposits:        trie.Copy(),
+		MerkleTrieIndex: int64(count) - 1,
+	}
+	dc.snapshot = &depositSnapshot{
+		count:           count,
+		root:            trie.Root(),
+		eth1BlockHeight: eth1BlockHeight,
+	}
+}
+
 // InsertFinalizedDeposits inserts deposits up to eth1DepositIndex (inclusive) into the finalized deposits cache.
 func (dc *DepositCache) InsertFinalizedDeposits(ctx context.Context, eth1DepositIndex int64) {
 	ctx, span := trace.StartSpan(ctx, "DepositsCache.InsertFinalizedDeposits")
@@ -118,6 +147,11 @@ func (dc *DepositCache) InsertFinalizedDeposits(ctx context.Context, eth1Deposit
 	dc.depositsLock.Lock()
 	defer dc.depositsLock.Unlock()
 
+	// The finalized deposits may already go further, when the cache was started from a snapshot.
+	if eth1DepositIndex <= dc.finalizedDeposits.MerkleTrieIndex {
+		return
+	}
+
 	depositTrie := dc.finalizedDeposits.Deposits
 	insertIndex := dc.finalizedDeposits.MerkleTrieIndex + 1
 	for _, d := range dc.deposits {
@@ -195,12 +229,20 @@ func (dc *DepositCache) DepositsNumberAndRootAtHeight(ctx context.Context, block
 	dc.depositsLock.RLock()
 	defer dc.depositsLock.RUnlock()
 	heightIdx := sort.Search(len(dc.deposits), func(i int) bool { return dc.deposits[i].Eth1BlockHeight > blockHeight.Uint64() })
+	// The cached deposits follow those of the snapshot the cache was started from, if any.
+	var snapshotCount uint64
+	if dc.snapshot != nil {
+		snapshotCount = dc.snapshot.count
+		if heightIdx == 0 && blockHeight.Uint64() >= dc.snapshot.eth1BlockHeight {
+			return dc.snapshot.count, dc.snapshot.root
+		}
+	}
 	// send the deposit root of the empty trie, if eth1follow distance is greater than the time of the earliest
 	// deposit.
 	if heightIdx == 0 {
 		return 0, [32]byte{}
 	}
-	return uint64(heightIdx), bytesutil.ToBytes32(dc.deposits[heightIdx-1].DepositRoot)
+	return snapshotCount + uint64(heightIdx), bytesutil.ToBytes32(dc.deposits[heightIdx-1].DepositRoot)
 }
 
 // DepositByPubkey looks through historical deposits and finds one which contains
@@ -573,3 +573,57 @@ func TestNonFinalizedDeposits_ReturnsNonFinalizedDepositsUpToBlockNumber(t *test
 	deps := dc.NonFinalizedDeposits(context.Background(), big.NewInt(10))
 	assert.Equal(t, 1, len(deps))
 }
+
+func TestInsertDepositSnapshot_ContinuesFromSnapshot(t *testing.T) {
+	dc, err := New()
+	require.NoError(t, err)
+
+	depth := int(params.BeaconConfig().DepositContractTreeDepth)
+	var items [][]byte
+	for i := 0; i < 5; i++ {
+		items = append(items, bytesutil.PadTo([]byte{byte(i)}, 32))
+	}
+	fullTrie, err := trieutil.GenerateTrieFromItems(items[:3], depth)
+	require.NoError(t, err)
+	finalized, err := fullTrie.FinalizedBranch(3)
+	require.NoError(t, err)
+	snapshotTrie, err := trieutil.CreateTrieFromSnapshot(finalized, 3, depth)
+	require.NoError(t, err)
+
+	dc.InsertDepositSnapshot(context.Background(), snapshotTrie, 3, 100)
+	cachedDeposits := dc.FinalizedDeposits(context.Background())
+	assert.Equal(t, int64(2), cachedDeposits.MerkleTrieIndex)
+	assert.Equal(t, fullTrie.HashTreeRoot(), cachedDeposits.Deposits.HashTreeRoot())
+
+	// A finalized deposit index preceding the snapshot leaves the finalized deposits untouched.
+	dc.InsertFinalizedDeposits(context.Background(), 1)
+	assert.Equal(t, int64(2), dc.FinalizedDeposits(context.Background()).MerkleTrieIndex)
+
+	n, root := dc.DepositsNumberAndRootAtHeight(context.Background(), big.NewInt(99))
+	assert.Equal(t, 0, int(n))
+	assert.Equal(t, [32]byte{}, root)
+	n, root = dc.DepositsNumberAndRootAtHeight(context.Background(), big.NewInt(100))
+	assert.Equal(t, 3, int(n))
+	assert.Equal(t, fullTrie.Root(), root)
+
+	dc.InsertDeposit(context.Background(), &ethpb.Deposit{
+		Data: &ethpb.Deposit_Data{
+			PublicKey:             bytesutil.PadTo([]byte{3}, 48),
+			WithdrawalCredentials: make([]byte, 32),
+			Signature:             make([]byte, 96),
+		},
+	}, 101, 3, bytesutil.ToBytes32([]byte("root")))
+	n, _ = dc.DepositsNumberAndRootAtHeight(context.Background(), big.NewInt(100))
+	assert.Equal(t, 3, int(n))
+	n, root = dc.DepositsNumberAndRootAtHeight(context.Background(), big.NewInt(101))
+	assert.Equal(t, 4, int(n))
+	assert.Equal(t, bytesutil.ToBytes32([]byte("root")), root)
+
+	dc.InsertFinalizedDeposits(context.Background(), 3)
+	cachedDeposits = dc.FinalizedDeposits(context.Background())
+	assert.Equal(t, int64(3), cachedDeposits.MerkleTrieIndex)
+	depHash, err := dc.deposits[0].Deposit.Data.HashTreeRoot()
+	require.NoError(t, err)
+	fullTrie.Insert(depHash[:], 3)
+	assert.Equal(t, fullTrie.HashTreeRoot(), cachedDeposits.Deposits.HashTreeRoot())
+}
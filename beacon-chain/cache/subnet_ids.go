@@ -17,6 +17,8 @@ type subnetIDs struct {
 	aggregatorLock    sync.RWMutex
 	persistentSubnets *cache.Cache
 	subnetsLock       sync.RWMutex
+	longLived         []uint64
+	longLivedLock     sync.RWMutex
 }
 
 // SubnetIDs for attester and aggregator.
@@ -132,3 +134,20 @@ func (c *subnetIDs) AddPersistentCommittee(pubkey []byte, comIndex []uint64, dur
 
 	c.persistentSubnets.Set(string(pubkey), comIndex, duration)
 }
+
+// SetLongLivedSubnets replaces the subnets the node subscribes to on its own behalf, regardless of the
+// validators attached to it.
+func (c *subnetIDs) SetLongLivedSubnets(subnets []uint64) {
+	c.longLivedLock.Lock()
+	defer c.longLivedLock.Unlock()
+
+	c.longLived = append([]uint64{}, subnets...)
+}
+
+// GetLongLivedSubnets retrieves the subnets the node subscribes to on its own behalf.
+func (c *subnetIDs) GetLongLivedSubnets() []uint64 {
+	c.longLivedLock.RLock()
+	defer c.longLivedLock.RUnlock()
+
+	return append([]uint64{}, c.longLived...)
+}
@@ -64,3 +64,16 @@ func TestSubnetIDsCache_PersistentCommitteeRoundtrip(t *testing.T) {
 	coms := c.GetAllSubnets()
 	assert.Equal(t, 20, len(coms))
 }
+
+func TestSubnetIDsCache_LongLivedSubnets(t *testing.T) {
+	c := newSubnetIDs()
+	assert.Equal(t, 0, len(c.GetLongLivedSubnets()))
+
+	subnets := []uint64{3, 40}
+	c.SetLongLivedSubnets(subnets)
+	subnets[0] = 5
+	assert.DeepEqual(t, []uint64{3, 40}, c.GetLongLivedSubnets())
+
+	c.SetLongLivedSubnets(nil)
+	assert.Equal(t, 0, len(c.GetLongLivedSubnets()))
+}
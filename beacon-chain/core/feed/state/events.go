@@ -17,6 +17,12 @@ const (
 	// Reorg is an event sent when the new head state's slot after a block
 	// transition is lower than its previous head state slot value.
 	Reorg
+	// NewHead is sent when the head of the chain changes, whether it extends the previous head or not.
+	NewHead
+	// FinalizedCheckpoint is sent when the finalized checkpoint of the node changes.
+	FinalizedCheckpoint
+	// JustifiedCheckpoint is sent when the justified checkpoint of the node changes.
+	JustifiedCheckpoint
 )
 
 // BlockProcessedData is the data sent with BlockProcessed events.
@@ -55,4 +61,34 @@ type ReorgData struct {
 	NewSlot uint64
 	// OldSlot is the slot of the head state before the reorg.
 	OldSlot uint64
+	// OldHeadRoot is the root of the head block before the reorg.
+	OldHeadRoot [32]byte
+	// NewHeadRoot is the root of the head block after the reorg.
+	NewHeadRoot [32]byte
+	// CommonAncestorRoot is the root of the latest block shared by the old and new chains.
+	CommonAncestorRoot [32]byte
+	// CommonAncestorSlot is the slot of the latest block shared by the old and new chains.
+	CommonAncestorSlot uint64
+	// Depth is the number of blocks of the old chain which are no longer canonical.
+	Depth uint64
+}
+
+// NewHeadData is the data sent with NewHead events.
+type NewHeadData struct {
+	// Slot is the slot of the new head block.
+	Slot uint64
+	// BlockRoot of the new head block.
+	BlockRoot [32]byte
+	// PreviousBlockRoot is the root of the head block before the change.
+	PreviousBlockRoot [32]byte
+	// EpochTransition is true if the new head is in a later epoch than the previous head.
+	EpochTransition bool
+}
+
+// CheckpointData is the data sent with FinalizedCheckpoint and JustifiedCheckpoint events.
+type CheckpointData struct {
+	// Epoch of the new checkpoint.
+	Epoch uint64
+	// Root of the block of the new checkpoint.
+	Root [32]byte
 }
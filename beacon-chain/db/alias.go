@@ -1,6 +1,9 @@
 package db
 
-import "github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
+import (
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+)
 
 // ReadOnlyDatabase exposes Prysm's eth2 data backend for read access only, no information about
 // head info. For head info, use github.com/prysmaticlabs/prysm/blockchain.HeadFetcher.
@@ -22,3 +25,7 @@ type HeadAccessDatabase = iface.HeadAccessDatabase
 // key-value or relational database in practice. This is the full database interface which should
 // not be used often. Prefer a more restrictive interface in this package.
 type Database = iface.Database
+
+// ErrHistoryPruned is returned when requesting blocks or states below the lowest slot retained by a
+// node pruning its history.
+var ErrHistoryPruned = kv.ErrHistoryPruned
@@ -0,0 +1,194 @@
+// Package archive defines a self-describing file format for archiving the finalized beacon chain.
+// An archive file covers a fixed slot range and is a sequence of records, each made of an 8 byte
+// header followed by its data. The header holds a 2 byte record type, the data length as a 4 byte
+// little-endian integer and 2 reserved zero bytes. Every file starts with a version record and a
+// slot range record, followed by the snappy compressed SSZ encodings of the finalized blocks and
+// the archived point states within the range, in ascending slot order.
+package archive
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+// headerSize is the size of a record header in bytes.
+const headerSize = 8
+
+// maxRecordSize bounds the size of a single record to protect against corrupted files.
+const maxRecordSize = 1 << 30
+
+// FileExtension is the extension used for archive files.
+const FileExtension = ".era"
+
+// RecordType identifies the content of a record.
+type RecordType [2]byte
+
+var (
+	// TypeVersion marks the start of an archive file. It has no data.
+	TypeVersion = RecordType{0x65, 0x32}
+	// TypeSlotRange holds the start slot and the number of slots covered by the file.
+	TypeSlotRange = RecordType{0x69, 0x32}
+	// TypeCompressedBlock holds a snappy compressed SSZ encoded signed beacon block.
+	TypeCompressedBlock = RecordType{0x01, 0x00}
+	// TypeCompressedState holds a snappy compressed SSZ encoded beacon state.
+	TypeCompressedState = RecordType{0x02, 0x00}
+)
+
+// ErrUnexpectedRecord is returned when a record does not match the archive layout.
+var ErrUnexpectedRecord = errors.New("unexpected archive record")
+
+// FileName returns the name of the archive file covering slots starting at startSlot.
+func FileName(startSlot uint64) string {
+	return fmt.Sprintf("prysm_archive_%010d%s", startSlot, FileExtension)
+}
+
+// Record is a single typed entry of an archive file.
+type Record struct {
+	Type RecordType
+	Data []byte
+}
+
+// Block decodes a compressed block record.
+func (r *Record) Block() (*ethpb.SignedBeaconBlock, error) {
+	if r.Type != TypeCompressedBlock {
+		return nil, ErrUnexpectedRecord
+	}
+	enc, err := snappy.Decode(nil, r.Data)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decompress block")
+	}
+	blk := &ethpb.SignedBeaconBlock{}
+	if err := blk.UnmarshalSSZ(enc); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal block")
+	}
+	return blk, nil
+}
+
+// State decodes a compressed state record.
+func (r *Record) State() (*pb.BeaconState, error) {
+	if r.Type != TypeCompressedState {
+		return nil, ErrUnexpectedRecord
+	}
+	enc, err := snappy.Decode(nil, r.Data)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decompress state")
+	}
+	st := &pb.BeaconState{}
+	if err := st.UnmarshalSSZ(enc); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal state")
+	}
+	return st, nil
+}
+
+// Writer writes archive records to an underlying writer.
+type Writer struct {
+	w io.Writer
+}
+
+// NewWriter returns a writer for an archive file.
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{w: w}
+}
+
+// WriteHeader writes the version and slot range records which start every archive file.
+func (w *Writer) WriteHeader(startSlot, slotCount uint64) error {
+	if err := w.writeRecord(TypeVersion, nil); err != nil {
+		return err
+	}
+	data := make([]byte, 16)
+	binary.LittleEndian.PutUint64(data[:8], startSlot)
+	binary.LittleEndian.PutUint64(data[8:], slotCount)
+	return w.writeRecord(TypeSlotRange, data)
+}
+
+// WriteBlock writes a compressed block record.
+func (w *Writer) WriteBlock(blk *ethpb.SignedBeaconBlock) error {
+	enc, err := blk.MarshalSSZ()
+	if err != nil {
+		return errors.Wrap(err, "could not marshal block")
+	}
+	return w.writeRecord(TypeCompressedBlock, snappy.Encode(nil, enc))
+}
+
+// WriteState writes a compressed state record.
+func (w *Writer) WriteState(st *pb.BeaconState) error {
+	enc, err := st.MarshalSSZ()
+	if err != nil {
+		return errors.Wrap(err, "could not marshal state")
+	}
+	return w.writeRecord(TypeCompressedState, snappy.Encode(nil, enc))
+}
+
+func (w *Writer) writeRecord(typ RecordType, data []byte) error {
+	if len(data) > maxRecordSize {
+		return fmt.Errorf("record of %d bytes exceeds maximum record size", len(data))
+	}
+	header := make([]byte, headerSize)
+	copy(header[:2], typ[:])
+	binary.LittleEndian.PutUint32(header[2:6], uint32(len(data)))
+	if _, err := w.w.Write(header); err != nil {
+		return err
+	}
+	_, err := w.w.Write(data)
+	return err
+}
+
+// Reader reads archive records from an underlying reader.
+type Reader struct {
+	r io.Reader
+}
+
+// NewReader returns a reader for an archive file.
+func NewReader(r io.Reader) *Reader {
+	return &Reader{r: r}
+}
+
+// ReadHeader reads the version and slot range records which start every archive file and
+// returns the slot range covered by the file.
+func (r *Reader) ReadHeader() (uint64, uint64, error) {
+	rec, err := r.Next()
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "could not read version record")
+	}
+	if rec.Type != TypeVersion {
+		return 0, 0, errors.Wrap(ErrUnexpectedRecord, "archive does not start with a version record")
+	}
+	rec, err = r.Next()
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "could not read slot range record")
+	}
+	if rec.Type != TypeSlotRange || len(rec.Data) != 16 {
+		return 0, 0, errors.Wrap(ErrUnexpectedRecord, "missing slot range record")
+	}
+	return binary.LittleEndian.Uint64(rec.Data[:8]), binary.LittleEndian.Uint64(rec.Data[8:]), nil
+}
+
+// Next reads the next record. It returns io.EOF when there are no more records.
+func (r *Reader) Next() (*Record, error) {
+	header := make([]byte, headerSize)
+	if _, err := io.ReadFull(r.r, header); err != nil {
+		if err == io.ErrUnexpectedEOF {
+			return nil, errors.Wrap(err, "truncated record header")
+		}
+		return nil, err
+	}
+	if header[6] != 0 || header[7] != 0 {
+		return nil, errors.New("non-zero reserved bytes in record header")
+	}
+	length := binary.LittleEndian.Uint32(header[2:6])
+	if length > maxRecordSize {
+		return nil, fmt.Errorf("record of %d bytes exceeds maximum record size", length)
+	}
+	rec := &Record{Data: make([]byte, length)}
+	copy(rec.Type[:], header[:2])
+	if _, err := io.ReadFull(r.r, rec.Data); err != nil {
+		return nil, errors.Wrap(err, "truncated record data")
+	}
+	return rec, nil
+}
@@ -0,0 +1,74 @@
+package archive
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestWriterReader_RoundTrip(t *testing.T) {
+	buf := new(bytes.Buffer)
+	w := NewWriter(buf)
+	require.NoError(t, w.WriteHeader(8192, 8192))
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = 8200
+	require.NoError(t, w.WriteBlock(blk))
+	st := testutil.NewBeaconState()
+	require.NoError(t, st.SetSlot(8224))
+	require.NoError(t, w.WriteState(st.InnerStateUnsafe()))
+
+	r := NewReader(buf)
+	startSlot, slotCount, err := r.ReadHeader()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(8192), startSlot)
+	assert.Equal(t, uint64(8192), slotCount)
+
+	rec, err := r.Next()
+	require.NoError(t, err)
+	assert.Equal(t, TypeCompressedBlock, rec.Type)
+	decodedBlk, err := rec.Block()
+	require.NoError(t, err)
+	assert.Equal(t, true, proto.Equal(blk, decodedBlk), "Wanted: %v, received: %v", blk, decodedBlk)
+	_, err = rec.State()
+	assert.ErrorContains(t, ErrUnexpectedRecord.Error(), err)
+
+	rec, err = r.Next()
+	require.NoError(t, err)
+	decodedSt, err := rec.State()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(8224), decodedSt.Slot)
+
+	_, err = r.Next()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestReader_ReadHeader_MissingVersion(t *testing.T) {
+	buf := new(bytes.Buffer)
+	w := NewWriter(buf)
+	require.NoError(t, w.WriteBlock(testutil.NewBeaconBlock()))
+
+	_, _, err := NewReader(buf).ReadHeader()
+	assert.ErrorContains(t, "version record", err)
+}
+
+func TestReader_Next_Truncated(t *testing.T) {
+	buf := new(bytes.Buffer)
+	w := NewWriter(buf)
+	require.NoError(t, w.WriteBlock(testutil.NewBeaconBlock()))
+
+	truncated := bytes.NewReader(buf.Bytes()[:buf.Len()-1])
+	_, err := NewReader(truncated).Next()
+	assert.ErrorContains(t, "truncated record data", err)
+
+	_, err = NewReader(bytes.NewReader(buf.Bytes()[:headerSize-1])).Next()
+	assert.ErrorContains(t, "truncated record header", err)
+}
+
+func TestFileName(t *testing.T) {
+	assert.Equal(t, "prysm_archive_0000008192.era", FileName(8192))
+}
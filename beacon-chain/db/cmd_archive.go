@@ -0,0 +1,204 @@
+package db
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/archive"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/mathutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+// Commands for exporting, importing, verifying and restoring the beacon node database.
+var Commands = &cli.Command{
+	Name:     "db",
+	Category: "db",
+	Usage:    "defines commands for interacting with the beacon node database",
+	Subcommands: []*cli.Command{
+		{
+			Name: "export",
+			Description: `exports the finalized blocks and archived point states of the beacon node database
+into snappy compressed SSZ archive files, each covering a fixed range of slots.`,
+			Flags: []cli.Flag{
+				cmd.DataDirFlag,
+				flags.ArchiveDirFlag,
+				flags.ArchiveStartSlotFlag,
+				flags.ArchiveEndSlotFlag,
+			},
+			Action: func(cliCtx *cli.Context) error {
+				if err := exportArchives(cliCtx); err != nil {
+					logrus.WithField("prefix", "db").Fatalf("Could not export database: %v", err)
+				}
+				return nil
+			},
+		},
+		{
+			Name: "import",
+			Description: `imports the archive files written by the export command into the beacon node database,
+rebuilding the block slot index, the finalized block roots index and the state summaries.`,
+			Flags: []cli.Flag{
+				cmd.DataDirFlag,
+				flags.ArchiveDirFlag,
+			},
+			Action: func(cliCtx *cli.Context) error {
+				if err := importArchives(cliCtx); err != nil {
+					logrus.WithField("prefix", "db").Fatalf("Could not import archives: %v", err)
+				}
+				return nil
+			},
+		},
+		{
+			Name: "verify",
+			Description: `verifies the consistency of the beacon node database and reports every problem found
+with the block parent chain, the block and state indices, the finalized block roots index, the state
+summaries and the genesis and head block roots. With --repair, the derived indices are rebuilt.`,
+			Flags: []cli.Flag{
+				cmd.DataDirFlag,
+				flags.DBRepairFlag,
+			},
+			Action: func(cliCtx *cli.Context) error {
+				if err := verifyDB(cliCtx); err != nil {
+					logrus.WithField("prefix", "db").Fatalf("Could not verify database: %v", err)
+				}
+				return nil
+			},
+		},
+		{
+			Name: "restore",
+			Description: `restores the beacon node database from a full or incremental backup. The backup, and
+every backup it builds upon, is validated and the rebuilt database is verified before it replaces the
+current database, which is kept next to it with a .pre-restore suffix.`,
+			Flags: []cli.Flag{
+				cmd.DataDirFlag,
+				cmd.RestoreFromFlag,
+			},
+			Action: func(cliCtx *cli.Context) error {
+				if err := restoreDB(cliCtx); err != nil {
+					logrus.WithField("prefix", "db").Fatalf("Could not restore database: %v", err)
+				}
+				return nil
+			},
+		},
+	},
+}
+
+// openDB opens the beacon node database in the data directory.
+func openDB(cliCtx *cli.Context) (Database, error) {
+	dbPath := filepath.Join(cliCtx.String(cmd.DataDirFlag.Name), kv.BeaconNodeDbDirName)
+	return NewDB(dbPath, cache.NewStateSummaryCache())
+}
+
+// exportArchives writes one archive file for every slot range between the start slot and the
+// end slot, which defaults to the finalized slot.
+func exportArchives(cliCtx *cli.Context) error {
+	ctx := context.Background()
+	log := logrus.WithField("prefix", "db")
+	d, err := openDB(cliCtx)
+	if err != nil {
+		return errors.Wrap(err, "could not open database")
+	}
+	defer func() {
+		if err := d.Close(); err != nil {
+			log.WithError(err).Error("Failed to close database")
+		}
+	}()
+
+	cp, err := d.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve finalized checkpoint")
+	}
+	endSlot, err := helpers.StartSlot(cp.Epoch)
+	if err != nil {
+		return err
+	}
+	if cliCtx.IsSet(flags.ArchiveEndSlotFlag.Name) && cliCtx.Uint64(flags.ArchiveEndSlotFlag.Name) < endSlot {
+		endSlot = cliCtx.Uint64(flags.ArchiveEndSlotFlag.Name)
+	}
+	dir := cliCtx.String(flags.ArchiveDirFlag.Name)
+	if err := os.MkdirAll(dir, params.BeaconIoConfig().ReadWriteExecutePermissions); err != nil {
+		return err
+	}
+
+	slotsPerFile := params.BeaconConfig().SlotsPerHistoricalRoot
+	startSlot := cliCtx.Uint64(flags.ArchiveStartSlotFlag.Name) / slotsPerFile * slotsPerFile
+	for slot := startSlot; slot <= endSlot; slot += slotsPerFile {
+		path := filepath.Join(dir, archive.FileName(slot))
+		// The last file stops at the end slot rather than at the end of its slot range.
+		slotCount := mathutil.Min(slotsPerFile, endSlot+1-slot)
+		if err := exportArchive(ctx, d, path, slot, slotCount); err != nil {
+			return errors.Wrapf(err, "could not export archive %s", path)
+		}
+		log.WithFields(logrus.Fields{
+			"file":      path,
+			"startSlot": slot,
+		}).Info("Exported archive")
+	}
+	return nil
+}
+
+// exportArchive writes a single archive file. The file is written under a temporary name first,
+// so an interrupted export never leaves a partial archive behind.
+func exportArchive(ctx context.Context, d Database, path string, startSlot, slotCount uint64) error {
+	tmpPath := path + ".tmp"
+	f, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, params.BeaconIoConfig().ReadWritePermissions)
+	if err != nil {
+		return err
+	}
+	if err := d.ExportArchive(ctx, f, startSlot, slotCount); err != nil {
+		if closeErr := f.Close(); closeErr != nil {
+			logrus.WithField("prefix", "db").WithError(closeErr).Error("Failed to close archive file")
+		}
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, path)
+}
+
+// importArchives imports every archive file in the archive directory in ascending slot order.
+func importArchives(cliCtx *cli.Context) error {
+	ctx := context.Background()
+	log := logrus.WithField("prefix", "db")
+	d, err := openDB(cliCtx)
+	if err != nil {
+		return errors.Wrap(err, "could not open database")
+	}
+	defer func() {
+		if err := d.Close(); err != nil {
+			log.WithError(err).Error("Failed to close database")
+		}
+	}()
+
+	paths, err := filepath.Glob(filepath.Join(cliCtx.String(flags.ArchiveDirFlag.Name), "*"+archive.FileExtension))
+	if err != nil {
+		return err
+	}
+	// Archive file names are zero padded, so lexical order is slot order.
+	sort.Strings(paths)
+	for _, path := range paths {
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		err = d.ImportArchive(ctx, f)
+		if closeErr := f.Close(); closeErr != nil {
+			log.WithError(closeErr).Error("Failed to close archive file")
+		}
+		if err != nil {
+			return errors.Wrapf(err, "could not import archive %s", path)
+		}
+		log.WithField("file", path).Info("Imported archive")
+	}
+	return nil
+}
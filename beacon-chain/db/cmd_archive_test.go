@@ -0,0 +1,93 @@
+package db
+
+import (
+	"context"
+	"flag"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/archive"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/urfave/cli/v2"
+)
+
+func TestExportArchives_StopsAtEndSlot(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	params.UseMinimalConfig()
+	ctx := context.Background()
+	dataDir, err := ioutil.TempDir("", "export")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, os.RemoveAll(dataDir))
+	}()
+
+	// Finalize a chain of blocks at every slot up to the start of epoch 10.
+	store, err := kv.NewKVStore(filepath.Join(dataDir, kv.BeaconNodeDbDirName), cache.NewStateSummaryCache())
+	require.NoError(t, err)
+	genesis := testutil.NewBeaconBlock()
+	root, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, store.SaveBlock(ctx, genesis))
+	require.NoError(t, store.SaveGenesisBlockRoot(ctx, root))
+	finalizedSlot := 10 * params.BeaconConfig().SlotsPerEpoch
+	for slot := uint64(1); slot <= finalizedSlot; slot++ {
+		blk := testutil.NewBeaconBlock()
+		blk.Block.Slot = slot
+		blk.Block.ParentRoot = append([]byte{}, root[:]...)
+		root, err = blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, store.SaveBlock(ctx, blk))
+	}
+	require.NoError(t, store.SaveStateSummary(ctx, &pb.StateSummary{Slot: finalizedSlot, Root: root[:]}))
+	require.NoError(t, store.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 10, Root: root[:]}))
+	// Only one store may register its bolt metrics collector at a time.
+	require.NoError(t, store.Close())
+
+	// The end slot is not a multiple of the slots per archive file.
+	slotsPerFile := params.BeaconConfig().SlotsPerHistoricalRoot
+	endSlot := slotsPerFile + 6
+	require.Equal(t, true, endSlot < finalizedSlot)
+	archiveDir := filepath.Join(dataDir, "archives")
+	set := flag.NewFlagSet("test", 0)
+	set.String(cmd.DataDirFlag.Name, dataDir, "")
+	set.String(flags.ArchiveDirFlag.Name, archiveDir, "")
+	set.Uint64(flags.ArchiveEndSlotFlag.Name, 0, "")
+	require.NoError(t, set.Set(flags.ArchiveEndSlotFlag.Name, strconv.FormatUint(endSlot, 10)))
+	require.NoError(t, exportArchives(cli.NewContext(&cli.App{}, set, nil)))
+
+	f, err := os.Open(filepath.Join(archiveDir, archive.FileName(slotsPerFile)))
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, f.Close())
+	}()
+	r := archive.NewReader(f)
+	startSlot, slotCount, err := r.ReadHeader()
+	require.NoError(t, err)
+	assert.Equal(t, slotsPerFile, startSlot)
+	assert.Equal(t, endSlot+1-slotsPerFile, slotCount)
+	var lastSlot uint64
+	for {
+		rec, err := r.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		blk, err := rec.Block()
+		require.NoError(t, err)
+		lastSlot = blk.Block.Slot
+	}
+	assert.Equal(t, endSlot, lastSlot)
+}
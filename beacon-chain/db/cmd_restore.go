@@ -0,0 +1,52 @@
+package db
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/shared/backup"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+// restoreDB rebuilds the beacon node database from a backup and swaps it in once the rebuilt
+// database passed verification.
+func restoreDB(cliCtx *cli.Context) error {
+	from := cliCtx.String(cmd.RestoreFromFlag.Name)
+	dbPath := filepath.Join(cliCtx.String(cmd.DataDirFlag.Name), kv.BeaconNodeDbDirName, kv.DatabaseFileName)
+	if err := backup.Restore(context.Background(), from, dbPath, verifyRestoredDB); err != nil {
+		return err
+	}
+	logrus.WithField("prefix", "db").WithFields(logrus.Fields{
+		"backup":   from,
+		"database": dbPath,
+	}).Info("Restored database from backup")
+	return nil
+}
+
+// verifyRestoredDB checks the consistency of a database rebuilt from a backup.
+func verifyRestoredDB(restoredPath string) error {
+	log := logrus.WithField("prefix", "db")
+	store, err := kv.NewKVStore(filepath.Dir(restoredPath), cache.NewStateSummaryCache())
+	if err != nil {
+		return errors.Wrap(err, "could not open restored database")
+	}
+	found, err := store.Verify(context.Background(), false /* repair */)
+	if closeErr := store.Close(); closeErr != nil {
+		log.WithError(closeErr).Error("Failed to close restored database")
+	}
+	if err != nil {
+		return err
+	}
+	for _, i := range found {
+		log.WithField("check", i.Check).Warn(i.Message)
+	}
+	if len(found) > 0 {
+		return errors.Errorf("found %d inconsistencies in the backup", len(found))
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package db
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+// verifyDB walks the beacon node database and logs every inconsistency found. The database is
+// opened directly, as verification is specific to the key value store.
+func verifyDB(cliCtx *cli.Context) error {
+	log := logrus.WithField("prefix", "db")
+	dbPath := filepath.Join(cliCtx.String(cmd.DataDirFlag.Name), kv.BeaconNodeDbDirName)
+	store, err := kv.NewKVStore(dbPath, cache.NewStateSummaryCache())
+	if err != nil {
+		return errors.Wrap(err, "could not open database")
+	}
+	defer func() {
+		if err := store.Close(); err != nil {
+			log.WithError(err).Error("Failed to close database")
+		}
+	}()
+
+	repair := cliCtx.Bool(flags.DBRepairFlag.Name)
+	found, err := store.Verify(context.Background(), repair)
+	for _, i := range found {
+		log.WithField("check", i.Check).Warn(i.Message)
+	}
+	if err != nil {
+		return err
+	}
+	if len(found) == 0 {
+		log.Info("Database is consistent")
+		return nil
+	}
+	if repair {
+		log.WithField("inconsistencies", len(found)).Info("Rebuilt database indices, run verify again to check for remaining problems")
+		return nil
+	}
+	return errors.Errorf("found %d inconsistencies, run with --%s to rebuild the database indices", len(found), flags.DBRepairFlag.Name)
+}
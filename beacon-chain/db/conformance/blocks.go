@@ -0,0 +1,192 @@
+package conformance
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func testBlocks(t *testing.T, db iface.Database) {
+	ctx := context.Background()
+	c := newChain(t, 3)
+	assert.Equal(t, false, db.HasBlock(ctx, c.roots[1]))
+	blk, err := db.Block(ctx, c.roots[1])
+	require.NoError(t, err)
+	assert.Equal(t, true, blk == nil, "Expected no block")
+
+	require.NoError(t, db.SaveBlock(ctx, c.blocks[1]))
+	// Saved blocks are skipped.
+	require.NoError(t, db.SaveBlocks(ctx, c.blocks))
+	for i, root := range c.roots {
+		assert.Equal(t, true, db.HasBlock(ctx, root))
+		blk, err := db.Block(ctx, root)
+		require.NoError(t, err)
+		assert.Equal(t, true, proto.Equal(c.blocks[i], blk), "Wanted: %v, received: %v", c.blocks[i], blk)
+	}
+}
+
+func testBlockFilters(t *testing.T, db iface.Database) {
+	ctx := context.Background()
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	c := newChain(t, 2*slotsPerEpoch+4)
+	c.save(t, db)
+	forked, forkedRoot := c.fork(t, 5)
+	require.NoError(t, db.SaveBlock(ctx, forked))
+	r := c.roots
+
+	tests := []struct {
+		name   string
+		filter *filters.QueryFilter
+		want   [][32]byte
+	}{
+		{
+			name:   "slot range",
+			filter: filters.NewFilter().SetStartSlot(4).SetEndSlot(6),
+			want:   [][32]byte{r[4], r[5], forkedRoot, r[6]},
+		},
+		{
+			name:   "open slot range",
+			filter: filters.NewFilter().SetStartSlot(2*slotsPerEpoch + 2),
+			want:   r[2*slotsPerEpoch+2:],
+		},
+		{
+			name:   "end slot before start slot",
+			filter: filters.NewFilter().SetStartSlot(6).SetEndSlot(4),
+			want:   [][32]byte{},
+		},
+		{
+			name:   "epoch range",
+			filter: filters.NewFilter().SetStartEpoch(1).SetEndEpoch(1),
+			want:   r[slotsPerEpoch : 2*slotsPerEpoch],
+		},
+		{
+			name:   "epochs override slots",
+			filter: filters.NewFilter().SetStartSlot(1).SetEndSlot(2).SetStartEpoch(1).SetEndEpoch(1),
+			want:   r[slotsPerEpoch : 2*slotsPerEpoch],
+		},
+		{
+			name:   "slot step",
+			filter: filters.NewFilter().SetStartSlot(2).SetEndSlot(10).SetSlotStep(4),
+			want:   [][32]byte{r[2], r[6], r[10]},
+		},
+		{
+			name:   "parent root",
+			filter: filters.NewFilter().SetParentRoot(r[4][:]),
+			want:   [][32]byte{r[5], forkedRoot},
+		},
+		{
+			name:   "unknown parent root",
+			filter: filters.NewFilter().SetParentRoot(bytesutil.PadTo([]byte("unknown"), 32)),
+			want:   [][32]byte{},
+		},
+		{
+			name:   "parent root within slot range",
+			filter: filters.NewFilter().SetParentRoot(r[4][:]).SetStartSlot(5).SetEndSlot(5),
+			want:   [][32]byte{r[5], forkedRoot},
+		},
+		{
+			name:   "parent root outside of slot range",
+			filter: filters.NewFilter().SetParentRoot(r[4][:]).SetStartSlot(6).SetEndSlot(8),
+			want:   [][32]byte{},
+		},
+		{
+			// A slot range without any block does not restrict the parent root lookup.
+			name:   "parent root with empty slot range",
+			filter: filters.NewFilter().SetParentRoot(r[4][:]).SetStartSlot(1000).SetEndSlot(1001),
+			want:   [][32]byte{r[5], forkedRoot},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			roots, err := db.BlockRoots(ctx, tt.filter)
+			require.NoError(t, err)
+			assert.Equal(t, len(tt.want), len(roots))
+			for i := range roots {
+				if i < len(tt.want) {
+					assert.Equal(t, tt.want[i], roots[i], "Unexpected root at position %d", i)
+				}
+			}
+			blks, err := db.Blocks(ctx, tt.filter)
+			require.NoError(t, err)
+			require.Equal(t, len(roots), len(blks))
+			for i, blk := range blks {
+				root, err := blk.Block.HashTreeRoot()
+				require.NoError(t, err)
+				assert.Equal(t, roots[i], root)
+			}
+		})
+	}
+
+	_, err := db.Blocks(ctx, nil)
+	assert.ErrorContains(t, "must specify a filter criteria for retrieving blocks", err)
+	_, err = db.BlockRoots(ctx, nil)
+	assert.ErrorContains(t, "must specify a filter criteria for retrieving blocks", err)
+	_, err = db.Blocks(ctx, filters.NewFilter().SetHeadBlockRoot(r[1][:]))
+	assert.ErrorContains(t, "not supported for blocks", err)
+}
+
+func testGenesisAndHeadBlocks(t *testing.T, db iface.Database) {
+	ctx := context.Background()
+	c := newChain(t, 3)
+	blk, err := db.GenesisBlock(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, true, blk == nil, "Expected no genesis block")
+	blk, err = db.HeadBlock(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, true, blk == nil, "Expected no head block")
+
+	c.save(t, db)
+	blk, err = db.GenesisBlock(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, true, proto.Equal(c.blocks[0], blk), "Wanted: %v, received: %v", c.blocks[0], blk)
+
+	assert.ErrorContains(t, "no state or state summary found with head block root", db.SaveHeadBlockRoot(ctx, c.roots[3]))
+	require.NoError(t, db.SaveStateSummary(ctx, &pb.StateSummary{Slot: 3, Root: c.roots[3][:]}))
+	require.NoError(t, db.SaveHeadBlockRoot(ctx, c.roots[3]))
+	blk, err = db.HeadBlock(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, true, proto.Equal(c.blocks[3], blk), "Wanted: %v, received: %v", c.blocks[3], blk)
+}
+
+func testHighestSlotBlocksBelow(t *testing.T, db iface.Database) {
+	ctx := context.Background()
+	c := newChain(t, 6)
+	blks, err := db.HighestSlotBlocksBelow(ctx, 3)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(blks))
+	assert.Equal(t, true, blks[0] == nil, "Expected no block")
+
+	// Leave a gap at slot 4.
+	require.NoError(t, db.SaveBlocks(ctx, append(c.blocks[:4:4], c.blocks[5:]...)))
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, c.roots[0]))
+	forked, _ := c.fork(t, 3)
+	require.NoError(t, db.SaveBlock(ctx, forked))
+
+	tests := []struct {
+		slot uint64
+		want *ethpb.SignedBeaconBlock
+	}{
+		{slot: 0, want: c.blocks[0]},
+		{slot: 1, want: c.blocks[0]},
+		{slot: 3, want: c.blocks[2]},
+		// The first block saved at the highest slot is returned.
+		{slot: 4, want: c.blocks[3]},
+		{slot: 5, want: c.blocks[3]},
+		{slot: 100, want: c.blocks[6]},
+	}
+	for _, tt := range tests {
+		blks, err := db.HighestSlotBlocksBelow(ctx, tt.slot)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(blks))
+		assert.Equal(t, true, proto.Equal(tt.want, blks[0]), "Unexpected block below slot %d", tt.slot)
+	}
+}
@@ -0,0 +1,122 @@
+package conformance
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func testCheckpoints(t *testing.T, db iface.Database) {
+	ctx := context.Background()
+	zero := &ethpb.Checkpoint{Root: params.BeaconConfig().ZeroHash[:]}
+	cp, err := db.JustifiedCheckpoint(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, zero, cp)
+	cp, err = db.FinalizedCheckpoint(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, zero, cp)
+
+	c := newChain(t, 2)
+	c.save(t, db)
+	justified := &ethpb.Checkpoint{Epoch: 0, Root: c.roots[2][:]}
+	finalized := &ethpb.Checkpoint{Epoch: 0, Root: c.roots[1][:]}
+	assert.ErrorContains(t, "missing state summary for finalized root", db.SaveJustifiedCheckpoint(ctx, justified))
+	assert.ErrorContains(t, "missing state summary for finalized root", db.SaveFinalizedCheckpoint(ctx, finalized))
+
+	require.NoError(t, db.SaveStateSummaries(ctx, []*pb.StateSummary{
+		{Slot: 1, Root: c.roots[1][:]},
+		{Slot: 2, Root: c.roots[2][:]},
+	}))
+	require.NoError(t, db.SaveJustifiedCheckpoint(ctx, justified))
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, finalized))
+	cp, err = db.JustifiedCheckpoint(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, justified, cp)
+	cp, err = db.FinalizedCheckpoint(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, finalized, cp)
+}
+
+func testFinalizedBlockRoots(t *testing.T, db iface.Database) {
+	ctx := context.Background()
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	c := newChain(t, 2*slotsPerEpoch+2)
+	c.save(t, db)
+	forked, forkedRoot := c.fork(t, 5)
+	require.NoError(t, db.SaveBlock(ctx, forked))
+	// Without a finalized checkpoint, only the genesis block is finalized.
+	assert.Equal(t, true, db.IsFinalizedBlock(ctx, c.roots[0]))
+	assert.Equal(t, false, db.IsFinalizedBlock(ctx, c.roots[1]))
+
+	finalize := func(epoch uint64) [32]byte {
+		root := c.roots[epoch*slotsPerEpoch]
+		require.NoError(t, db.SaveStateSummary(ctx, &pb.StateSummary{Slot: epoch * slotsPerEpoch, Root: root[:]}))
+		require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: epoch, Root: root[:]}))
+		return root
+	}
+
+	finalize(1)
+	for i := uint64(0); i <= slotsPerEpoch; i++ {
+		assert.Equal(t, true, db.IsFinalizedBlock(ctx, c.roots[i]), "Block at slot %d is not finalized", i)
+	}
+	assert.Equal(t, false, db.IsFinalizedBlock(ctx, forkedRoot), "Forked block is finalized")
+	// The other blocks of the finalized epoch are considered final, canonical or not.
+	assert.Equal(t, true, db.IsFinalizedBlock(ctx, c.roots[slotsPerEpoch+1]))
+
+	finalize(2)
+	for i := uint64(0); i <= 2*slotsPerEpoch; i++ {
+		assert.Equal(t, true, db.IsFinalizedBlock(ctx, c.roots[i]), "Block at slot %d is not finalized", i)
+	}
+	assert.Equal(t, false, db.IsFinalizedBlock(ctx, forkedRoot), "Forked block is finalized")
+	assert.Equal(t, true, db.IsFinalizedBlock(ctx, c.roots[2*slotsPerEpoch+1]))
+}
+
+func testForkChoiceSnapshot(t *testing.T, db iface.Database) {
+	ctx := context.Background()
+	snapshot, err := db.ForkChoiceSnapshot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, true, snapshot == nil, "Expected no fork choice snapshot")
+
+	c := newChain(t, 2)
+	// A chain of nodes, each the parent of the next, with no best child or descendant.
+	nodes := make([]*dbpb.ForkChoiceNode, len(c.roots))
+	for i := range c.roots {
+		parent := uint64(math.MaxUint64)
+		if i > 0 {
+			parent = uint64(i - 1)
+		}
+		nodes[i] = &dbpb.ForkChoiceNode{
+			Slot:           c.blocks[i].Block.Slot,
+			Root:           c.roots[i][:],
+			Parent:         parent,
+			BestChild:      math.MaxUint64,
+			BestDescendant: math.MaxUint64,
+			Graffiti:       make([]byte, 32),
+		}
+	}
+	require.NoError(t, db.SaveForkChoiceSnapshot(ctx, &dbpb.ForkChoiceSnapshot{Nodes: nodes[:1]}))
+	want := &dbpb.ForkChoiceSnapshot{
+		FinalizedRoot: c.roots[0][:],
+		Nodes:         nodes,
+		Votes: []*dbpb.ForkChoiceVote{
+			{CurrentRoot: make([]byte, 32), NextRoot: c.roots[2][:], NextEpoch: 1},
+			{CurrentRoot: make([]byte, 32), NextRoot: c.roots[2][:], NextEpoch: 1},
+		},
+		Balances: []uint64{1, 1},
+	}
+	require.NoError(t, db.SaveForkChoiceSnapshot(ctx, want))
+
+	snapshot, err = db.ForkChoiceSnapshot(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, snapshot)
+	assert.Equal(t, true, proto.Equal(want, snapshot), "Wanted: %v, received: %v", want, snapshot)
+}
@@ -0,0 +1,134 @@
+// Package conformance defines a suite of tests which every implementation of the beacon node
+// database must pass, so that the bolt and in-memory stores are interchangeable.
+package conformance
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+// Setup returns an empty database, which is closed once the test completes.
+type Setup func(t *testing.T) iface.Database
+
+// Run the conformance suite against the databases returned by setup. Every test is run as a
+// subtest against its own empty database.
+func Run(t *testing.T, setup Setup) {
+	tests := []struct {
+		name string
+		run  func(t *testing.T, db iface.Database)
+	}{
+		{name: "Blocks", run: testBlocks},
+		{name: "BlockFilters", run: testBlockFilters},
+		{name: "GenesisAndHeadBlocks", run: testGenesisAndHeadBlocks},
+		{name: "HighestSlotBlocksBelow", run: testHighestSlotBlocksBelow},
+		{name: "States", run: testStates},
+		{name: "DeleteStates", run: testDeleteStates},
+		{name: "ArchivedPoints", run: testArchivedPoints},
+		{name: "StateSummaries", run: testStateSummaries},
+		{name: "StateDiffs", run: testStateDiffs},
+		{name: "Checkpoints", run: testCheckpoints},
+		{name: "FinalizedBlockRoots", run: testFinalizedBlockRoots},
+		{name: "ForkChoiceSnapshot", run: testForkChoiceSnapshot},
+		{name: "Operations", run: testOperations},
+		{name: "OperationPools", run: testOperationPools},
+		{name: "DepositContractAddress", run: testDepositContractAddress},
+		{name: "PowchainData", run: testPowchainData},
+		{name: "OriginCheckpoint", run: testOriginCheckpoint},
+		{name: "OriginCheckpointAdvancedState", run: testOriginCheckpointAdvancedState},
+		{name: "BackfillBlocks", run: testBackfillBlocks},
+		{name: "PruneHistory", run: testPruneHistory},
+		{name: "ExportImportArchive", run: func(t *testing.T, db iface.Database) {
+			testExportImportArchive(t, db, setup)
+		}},
+		{name: "ImportArchivesInOrder", run: func(t *testing.T, db iface.Database) {
+			testImportArchivesInOrder(t, db, setup)
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.run(t, setup(t))
+		})
+	}
+}
+
+// chain is a canonical chain of blocks, the block at index i being at slot i.
+type chain struct {
+	blocks []*ethpb.SignedBeaconBlock
+	roots  [][32]byte
+	// states holds the states committed to by the blocks at the slots they were requested for.
+	states map[uint64]*state.BeaconState
+}
+
+// newChain returns a genesis block and n blocks at slots 1 to n descending from it. The blocks at
+// stateSlots commit to a state, so the state can be saved as an archived point or exported.
+func newChain(t *testing.T, n uint64, stateSlots ...uint64) *chain {
+	c := &chain{states: make(map[uint64]*state.BeaconState)}
+	withState := make(map[uint64]bool)
+	for _, slot := range stateSlots {
+		withState[slot] = true
+	}
+	parentRoot := [32]byte{}
+	for slot := uint64(0); slot <= n; slot++ {
+		blk := testutil.NewBeaconBlock()
+		blk.Block.Slot = slot
+		if slot > 0 {
+			blk.Block.ParentRoot = append([]byte{}, parentRoot[:]...)
+		}
+		if withState[slot] {
+			c.states[slot] = commitToState(t, blk)
+		}
+		root, err := blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+		c.blocks = append(c.blocks, blk)
+		c.roots = append(c.roots, root)
+		parentRoot = root
+	}
+	return c
+}
+
+// save the blocks of the chain, the genesis block root and the states of the chain.
+func (c *chain) save(t *testing.T, db iface.Database) {
+	ctx := context.Background()
+	require.NoError(t, db.SaveBlocks(ctx, c.blocks))
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, c.roots[0]))
+	for slot, st := range c.states {
+		require.NoError(t, db.SaveState(ctx, st, c.roots[slot]))
+	}
+}
+
+// fork returns a block at the same slot and with the same parent as the block at the given slot
+// of the chain, but with a different root.
+func (c *chain) fork(t *testing.T, slot uint64) (*ethpb.SignedBeaconBlock, [32]byte) {
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = slot
+	blk.Block.ParentRoot = append([]byte{}, c.blocks[slot].Block.ParentRoot...)
+	blk.Block.Body.Graffiti = append([]byte("fork"), make([]byte, 28)...)
+	root, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	return blk, root
+}
+
+// commitToState sets the state root of blk to commit to a state at the block slot and returns
+// that state.
+func commitToState(t *testing.T, blk *ethpb.SignedBeaconBlock) *state.BeaconState {
+	st := testutil.NewBeaconState()
+	require.NoError(t, st.SetSlot(blk.Block.Slot))
+	bodyRoot, err := blk.Block.Body.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, st.SetLatestBlockHeader(&ethpb.BeaconBlockHeader{
+		Slot:       blk.Block.Slot,
+		ParentRoot: blk.Block.ParentRoot,
+		StateRoot:  make([]byte, 32),
+		BodyRoot:   bodyRoot[:],
+	}))
+	stateRoot, err := st.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	blk.Block.StateRoot = stateRoot[:]
+	return st
+}
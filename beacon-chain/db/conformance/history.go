@@ -0,0 +1,259 @@
+package conformance
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+// finalizedChain saves a chain of two epochs and two slots, with an archived state at slot 8 and a
+// state summary for every block, finalized at the start of epoch 1.
+func finalizedChain(t *testing.T, db iface.Database) *chain {
+	ctx := context.Background()
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	c := newChain(t, slotsPerEpoch+2, 8)
+	c.save(t, db)
+	summaries := make([]*pb.StateSummary, len(c.blocks))
+	for i, blk := range c.blocks {
+		summaries[i] = &pb.StateSummary{Slot: blk.Block.Slot, Root: c.roots[i][:]}
+	}
+	require.NoError(t, db.SaveStateSummaries(ctx, summaries))
+	require.NoError(t, db.SaveHeadBlockRoot(ctx, c.roots[len(c.roots)-1]))
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 1, Root: c.roots[slotsPerEpoch][:]}))
+	return c
+}
+
+func testOriginCheckpoint(t *testing.T, db iface.Database) {
+	ctx := context.Background()
+	slot := params.BeaconConfig().SlotsPerEpoch * 3
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = slot
+	blk.Block.ParentRoot = bytesutil.PadTo([]byte("parent"), 32)
+	st := commitToState(t, blk)
+	root, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	mismatched := testutil.NewBeaconBlock()
+	mismatched.Block.Slot = slot
+	mismatched.Block.StateRoot = bytesutil.PadTo([]byte("bad"), 32)
+	assert.ErrorContains(t, "does not match origin block state root", db.SaveOriginCheckpoint(ctx, st, mismatched))
+	unaligned := testutil.NewBeaconBlock()
+	unaligned.Block.Slot = slot - 1
+	assert.ErrorContains(t, "is not at the start of an epoch", db.SaveOriginCheckpoint(ctx, commitToState(t, unaligned), unaligned))
+	originRoot, err := db.OriginBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, [32]byte{}, originRoot)
+
+	require.NoError(t, db.SaveOriginCheckpoint(ctx, st, blk))
+	originRoot, err = db.OriginBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, root, originRoot)
+	headBlk, err := db.HeadBlock(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, true, proto.Equal(blk, headBlk), "Wanted: %v, received: %v", blk, headBlk)
+	assert.Equal(t, true, db.HasState(ctx, root))
+	assert.Equal(t, true, db.HasStateSummary(ctx, root))
+	assert.Equal(t, root, db.LastArchivedRoot(ctx))
+	wanted := &ethpb.Checkpoint{Epoch: 3, Root: root[:]}
+	finalized, err := db.FinalizedCheckpoint(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, wanted, finalized)
+	justified, err := db.JustifiedCheckpoint(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, wanted, justified)
+	assert.Equal(t, true, db.IsFinalizedBlock(ctx, root))
+	assert.ErrorContains(t, "already contains a chain", db.SaveOriginCheckpoint(ctx, st, blk))
+
+	backfillRoot, err := db.BackfillBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, [32]byte{}, backfillRoot)
+	require.NoError(t, db.SaveBackfillBlockRoot(ctx, root))
+	backfillRoot, err = db.BackfillBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, root, backfillRoot)
+}
+
+func testOriginCheckpointAdvancedState(t *testing.T, db iface.Database) {
+	ctx := context.Background()
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	// The finalized epoch starts with skipped slots, so its block is below the epoch start.
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = slotsPerEpoch*3 - 2
+	blk.Block.ParentRoot = bytesutil.PadTo([]byte("parent"), 32)
+	st := commitToState(t, blk)
+	root, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	// Advance the state to the start of the epoch, as processing the empty slots would.
+	header := st.LatestBlockHeader()
+	header.StateRoot = blk.Block.StateRoot
+	require.NoError(t, st.SetLatestBlockHeader(header))
+	require.NoError(t, st.SetSlot(slotsPerEpoch*3+2))
+	assert.ErrorContains(t, "is not at the start of an epoch", db.SaveOriginCheckpoint(ctx, st, blk))
+	require.NoError(t, st.SetSlot(slotsPerEpoch*3))
+
+	unrelated := testutil.NewBeaconBlock()
+	unrelated.Block.Slot = blk.Block.Slot
+	assert.ErrorContains(t, "does not match origin block root", db.SaveOriginCheckpoint(ctx, st, unrelated))
+	ahead := testutil.NewBeaconBlock()
+	ahead.Block.Slot = slotsPerEpoch*3 + 1
+	assert.ErrorContains(t, "is before origin block slot", db.SaveOriginCheckpoint(ctx, st, ahead))
+
+	require.NoError(t, db.SaveOriginCheckpoint(ctx, st, blk))
+	originRoot, err := db.OriginBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, root, originRoot)
+	assert.Equal(t, true, db.HasState(ctx, root))
+	wanted := &ethpb.Checkpoint{Epoch: 3, Root: root[:]}
+	finalized, err := db.FinalizedCheckpoint(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, wanted, finalized)
+	assert.Equal(t, true, db.IsFinalizedBlock(ctx, root))
+}
+
+func testBackfillBlocks(t *testing.T, db iface.Database) {
+	ctx := context.Background()
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	n := slotsPerEpoch * 2
+	c := newChain(t, n, n)
+	require.NoError(t, db.SaveOriginCheckpoint(ctx, c.states[n], c.blocks[n]))
+
+	// Backfill the chain below the origin in two batches, highest first.
+	require.NoError(t, db.SaveBackfillBlocks(ctx, c.blocks[slotsPerEpoch:n]))
+	backfillRoot, err := db.BackfillBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, c.roots[slotsPerEpoch], backfillRoot)
+	require.NoError(t, db.SaveBackfillBlocks(ctx, c.blocks[:slotsPerEpoch]))
+	backfillRoot, err = db.BackfillBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, c.roots[0], backfillRoot)
+
+	for i, root := range c.roots {
+		assert.Equal(t, true, db.HasBlock(ctx, root), "missing block at slot %d", i)
+		assert.Equal(t, true, db.HasStateSummary(ctx, root), "missing state summary at slot %d", i)
+		assert.Equal(t, true, db.IsFinalizedBlock(ctx, root), "block at slot %d is not finalized", i)
+	}
+	summary, err := db.StateSummary(ctx, c.roots[1])
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), summary.Slot)
+	genesis, err := db.GenesisBlock(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, true, proto.Equal(c.blocks[0], genesis), "Wanted: %v, received: %v", c.blocks[0], genesis)
+	blks, err := db.Blocks(ctx, filters.NewFilter().SetStartSlot(0).SetEndSlot(n))
+	require.NoError(t, err)
+	assert.Equal(t, len(c.blocks), len(blks))
+}
+
+func testPruneHistory(t *testing.T, db iface.Database) {
+	ctx := context.Background()
+	c := finalizedChain(t, db)
+
+	lowest, err := db.PruneHistory(ctx, 4)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), lowest, "Pruned without a state to anchor the retained history")
+
+	lowest, err = db.PruneHistory(ctx, 12)
+	require.NoError(t, err)
+	// The archived state at slot 8 is the highest state at or below slot 12.
+	assert.Equal(t, uint64(8), lowest)
+	lowest, err = db.LowestRetainedSlot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(8), lowest)
+	lowest, err = db.PruneHistory(ctx, 4)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(8), lowest)
+
+	for i, root := range c.roots {
+		if i == 0 || i >= 8 {
+			assert.Equal(t, true, db.HasBlock(ctx, root), "Missing block at slot %d", i)
+			assert.Equal(t, true, db.IsFinalizedBlock(ctx, root), "Block at slot %d is not finalized", i)
+			continue
+		}
+		assert.Equal(t, false, db.HasBlock(ctx, root), "Block at slot %d was not pruned", i)
+		assert.Equal(t, false, db.HasStateSummary(ctx, root), "State summary at slot %d was not pruned", i)
+		assert.Equal(t, false, db.IsFinalizedBlock(ctx, root), "Finalized index at slot %d was not pruned", i)
+	}
+	assert.Equal(t, true, db.HasState(ctx, c.roots[8]))
+	genesis, err := db.GenesisBlock(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, true, proto.Equal(c.blocks[0], genesis), "Wanted: %v, received: %v", c.blocks[0], genesis)
+	roots, err := db.BlockRoots(ctx, filters.NewFilter().SetStartSlot(1).SetEndSlot(7))
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(roots))
+}
+
+// closeAndSetup closes db once its archives are exported and returns the database to import
+// them into, as the bolt store registers its metrics under names only one open store may use.
+func closeAndSetup(t *testing.T, db iface.Database, setup Setup) iface.Database {
+	require.NoError(t, db.Close())
+	return setup(t)
+}
+
+func testExportImportArchive(t *testing.T, db iface.Database, setup Setup) {
+	ctx := context.Background()
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	c := finalizedChain(t, db)
+	forked, forkedRoot := c.fork(t, 5)
+	require.NoError(t, db.SaveBlock(ctx, forked))
+
+	assert.ErrorContains(t, "slot count must be greater than 0", db.ExportArchive(ctx, new(bytes.Buffer), 0, 0))
+	buf := new(bytes.Buffer)
+	require.NoError(t, db.ExportArchive(ctx, buf, 0, slotsPerEpoch+1))
+	imported := closeAndSetup(t, db, setup)
+	require.NoError(t, imported.ImportArchive(ctx, buf))
+
+	for i, root := range c.roots {
+		if uint64(i) > slotsPerEpoch {
+			assert.Equal(t, false, imported.HasBlock(ctx, root), "Block at slot %d outside of the range was imported", i)
+			continue
+		}
+		assert.Equal(t, true, imported.HasBlock(ctx, root), "Missing block at slot %d", i)
+		assert.Equal(t, true, imported.IsFinalizedBlock(ctx, root), "Block at slot %d is not finalized", i)
+		assert.Equal(t, true, imported.HasStateSummary(ctx, root), "Missing state summary at slot %d", i)
+	}
+	assert.Equal(t, false, imported.HasBlock(ctx, forkedRoot), "Non canonical block was imported")
+	assert.Equal(t, true, imported.HasState(ctx, c.roots[8]))
+	assert.Equal(t, c.roots[8], imported.ArchivedPointRoot(ctx, 8))
+	genesis, err := imported.GenesisBlock(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, true, proto.Equal(c.blocks[0], genesis), "Wanted: %v, received: %v", c.blocks[0], genesis)
+	blks, err := imported.HighestSlotBlocksBelow(ctx, slotsPerEpoch+1)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(blks))
+	assert.Equal(t, slotsPerEpoch, blks[0].Block.Slot)
+}
+
+func testImportArchivesInOrder(t *testing.T, db iface.Database, setup Setup) {
+	ctx := context.Background()
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	c := finalizedChain(t, db)
+	first, second := new(bytes.Buffer), new(bytes.Buffer)
+	require.NoError(t, db.ExportArchive(ctx, first, 0, 4))
+	require.NoError(t, db.ExportArchive(ctx, second, 4, slotsPerEpoch-3))
+	imported := closeAndSetup(t, db, setup)
+
+	// An archive which does not extend the finalized chain is rejected, and nothing is saved.
+	assert.ErrorContains(t, "does not descend from the highest finalized block", imported.ImportArchive(ctx, bytes.NewReader(second.Bytes())))
+	assert.Equal(t, false, imported.HasBlock(ctx, c.roots[4]), "Block of a rejected archive was saved")
+	assert.Equal(t, false, imported.HasStateSummary(ctx, c.roots[4]), "State summary of a rejected archive was saved")
+	assert.Equal(t, false, imported.HasState(ctx, c.roots[8]), "State of a rejected archive was saved")
+
+	require.NoError(t, imported.ImportArchive(ctx, first))
+	require.NoError(t, imported.ImportArchive(ctx, second))
+	for i := uint64(0); i <= slotsPerEpoch; i++ {
+		assert.Equal(t, true, imported.HasBlock(ctx, c.roots[i]), "Missing block at slot %d", i)
+		assert.Equal(t, true, imported.IsFinalizedBlock(ctx, c.roots[i]), "Block at slot %d is not finalized", i)
+	}
+	assert.Equal(t, true, imported.HasState(ctx, c.roots[8]))
+}
@@ -0,0 +1,153 @@
+package conformance
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func testOperations(t *testing.T, db iface.Database) {
+	ctx := context.Background()
+	header := func(slot uint64) *ethpb.SignedBeaconBlockHeader {
+		return &ethpb.SignedBeaconBlockHeader{
+			Header: &ethpb.BeaconBlockHeader{
+				Slot:          slot,
+				ProposerIndex: 5,
+				BodyRoot:      make([]byte, 32),
+				ParentRoot:    make([]byte, 32),
+				StateRoot:     make([]byte, 32),
+			},
+			Signature: make([]byte, 96),
+		}
+	}
+	attestation := func(slot uint64) *ethpb.IndexedAttestation {
+		return &ethpb.IndexedAttestation{
+			Data: &ethpb.AttestationData{
+				BeaconBlockRoot: make([]byte, 32),
+				Slot:            slot,
+				Source:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+				Target:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+			},
+			Signature: make([]byte, 96),
+		}
+	}
+
+	proposerSlashing := &ethpb.ProposerSlashing{Header_1: header(1), Header_2: header(2)}
+	proposerSlashingRoot, err := proposerSlashing.HashTreeRoot()
+	require.NoError(t, err)
+	attesterSlashing := &ethpb.AttesterSlashing{Attestation_1: attestation(5), Attestation_2: attestation(7)}
+	attesterSlashingRoot, err := attesterSlashing.HashTreeRoot()
+	require.NoError(t, err)
+	exit := &ethpb.VoluntaryExit{Epoch: 5, ValidatorIndex: 3}
+	exitRoot, err := exit.HashTreeRoot()
+	require.NoError(t, err)
+
+	assert.Equal(t, false, db.HasProposerSlashing(ctx, proposerSlashingRoot))
+	retrievedProposerSlashing, err := db.ProposerSlashing(ctx, proposerSlashingRoot)
+	require.NoError(t, err)
+	assert.Equal(t, (*ethpb.ProposerSlashing)(nil), retrievedProposerSlashing)
+	assert.Equal(t, false, db.HasAttesterSlashing(ctx, attesterSlashingRoot))
+	retrievedAttesterSlashing, err := db.AttesterSlashing(ctx, attesterSlashingRoot)
+	require.NoError(t, err)
+	assert.Equal(t, (*ethpb.AttesterSlashing)(nil), retrievedAttesterSlashing)
+	assert.Equal(t, false, db.HasVoluntaryExit(ctx, exitRoot))
+	retrievedExit, err := db.VoluntaryExit(ctx, exitRoot)
+	require.NoError(t, err)
+	assert.Equal(t, (*ethpb.VoluntaryExit)(nil), retrievedExit)
+
+	require.NoError(t, db.SaveProposerSlashing(ctx, proposerSlashing))
+	require.NoError(t, db.SaveAttesterSlashing(ctx, attesterSlashing))
+	require.NoError(t, db.SaveVoluntaryExit(ctx, exit))
+
+	assert.Equal(t, true, db.HasProposerSlashing(ctx, proposerSlashingRoot))
+	retrievedProposerSlashing, err = db.ProposerSlashing(ctx, proposerSlashingRoot)
+	require.NoError(t, err)
+	assert.Equal(t, true, proto.Equal(proposerSlashing, retrievedProposerSlashing), "Wanted %v, received %v", proposerSlashing, retrievedProposerSlashing)
+	assert.Equal(t, true, db.HasAttesterSlashing(ctx, attesterSlashingRoot))
+	retrievedAttesterSlashing, err = db.AttesterSlashing(ctx, attesterSlashingRoot)
+	require.NoError(t, err)
+	assert.Equal(t, true, proto.Equal(attesterSlashing, retrievedAttesterSlashing), "Wanted %v, received %v", attesterSlashing, retrievedAttesterSlashing)
+	assert.Equal(t, true, db.HasVoluntaryExit(ctx, exitRoot))
+	retrievedExit, err = db.VoluntaryExit(ctx, exitRoot)
+	require.NoError(t, err)
+	assert.Equal(t, true, proto.Equal(exit, retrievedExit), "Wanted %v, received %v", exit, retrievedExit)
+}
+
+func testDepositContractAddress(t *testing.T, db iface.Database) {
+	ctx := context.Background()
+	retrieved, err := db.DepositContractAddress(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, ([]uint8)(nil), retrieved, "Expected nil contract address")
+
+	contractAddress := common.Address{1, 2, 3}
+	require.NoError(t, db.SaveDepositContractAddress(ctx, contractAddress))
+	retrieved, err = db.DepositContractAddress(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, contractAddress, common.BytesToAddress(retrieved), "Unexpected address")
+	assert.ErrorContains(t, "cannot override deposit contract address", db.SaveDepositContractAddress(ctx, common.Address{4, 5, 6}))
+}
+
+func testPowchainData(t *testing.T, db iface.Database) {
+	ctx := context.Background()
+	data, err := db.PowchainData(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, (*dbpb.ETH1ChainData)(nil), data)
+
+	want := &dbpb.ETH1ChainData{
+		CurrentEth1Data: &dbpb.LatestETH1Data{BlockHeight: 10, BlockHash: []byte("hash")},
+		ChainstartData:  &dbpb.ChainStartData{Chainstarted: true, GenesisTime: 100},
+	}
+	require.NoError(t, db.SavePowchainData(ctx, want))
+	data, err = db.PowchainData(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, true, proto.Equal(want, data), "Wanted %v, received %v", want, data)
+
+	// Saving the data again replaces it.
+	want.CurrentEth1Data.BlockHeight = 11
+	require.NoError(t, db.SavePowchainData(ctx, want))
+	data, err = db.PowchainData(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(11), data.CurrentEth1Data.BlockHeight)
+}
+
+func testOperationPools(t *testing.T, db iface.Database) {
+	ctx := context.Background()
+	pools, err := db.OperationPools(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, (*dbpb.OperationPools)(nil), pools)
+
+	att := &ethpb.Attestation{
+		AggregationBits: []byte{0b1101},
+		Data: &ethpb.AttestationData{
+			Slot:            3,
+			BeaconBlockRoot: make([]byte, 32),
+			Source:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+			Target:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+		},
+		Signature: make([]byte, 96),
+	}
+	want := &dbpb.OperationPools{
+		VoluntaryExits: []*ethpb.SignedVoluntaryExit{
+			{Exit: &ethpb.VoluntaryExit{Epoch: 5, ValidatorIndex: 3}, Signature: make([]byte, 96)},
+		},
+		AggregatedAttestations: []*ethpb.Attestation{att},
+	}
+	require.NoError(t, db.SaveOperationPools(ctx, want))
+	pools, err = db.OperationPools(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, true, proto.Equal(want, pools), "Wanted %v, received %v", want, pools)
+
+	// Saving the pools again replaces them.
+	want = &dbpb.OperationPools{UnaggregatedAttestations: []*ethpb.Attestation{att}}
+	require.NoError(t, db.SaveOperationPools(ctx, want))
+	pools, err = db.OperationPools(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, true, proto.Equal(want, pools), "Wanted %v, received %v", want, pools)
+}
@@ -0,0 +1,238 @@
+package conformance
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/statediff"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+// stateAtSlot returns a new state at the given slot.
+func stateAtSlot(t *testing.T, slot uint64) *state.BeaconState {
+	st := testutil.NewBeaconState()
+	require.NoError(t, st.SetSlot(slot))
+	return st
+}
+
+// assertState checks that got is a state with the same root as want.
+func assertState(t *testing.T, want, got *state.BeaconState) {
+	require.NotNil(t, got)
+	wantRoot, err := want.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	gotRoot, err := got.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, wantRoot, gotRoot, "Unexpected state at slot %d", got.Slot())
+}
+
+func testStates(t *testing.T, db iface.Database) {
+	ctx := context.Background()
+	root := bytesutil.ToBytes32([]byte("root"))
+	st, err := db.State(ctx, root)
+	require.NoError(t, err)
+	assert.Equal(t, true, st == nil, "Expected no state")
+	st, err = db.GenesisState(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, true, st == nil, "Expected no genesis state")
+	st, err = db.HeadState(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, true, st == nil, "Expected no head state")
+	assert.ErrorContains(t, "nil state", db.SaveStates(ctx, nil, nil))
+
+	want := stateAtSlot(t, 3)
+	require.NoError(t, db.SaveState(ctx, want, root))
+	assert.Equal(t, true, db.HasState(ctx, root))
+	st, err = db.State(ctx, root)
+	require.NoError(t, err)
+	assertState(t, want, st)
+
+	// States are not shared with the caller.
+	require.NoError(t, st.SetSlot(4))
+	require.NoError(t, want.SetSlot(5))
+	st, err = db.State(ctx, root)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), st.Slot())
+
+	genesisRoot := bytesutil.ToBytes32([]byte("genesis"))
+	headRoot := bytesutil.ToBytes32([]byte("head"))
+	genesis := stateAtSlot(t, 0)
+	head := stateAtSlot(t, 10)
+	require.NoError(t, db.SaveStates(ctx, []*state.BeaconState{genesis, head}, [][32]byte{genesisRoot, headRoot}))
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisRoot))
+	require.NoError(t, db.SaveHeadBlockRoot(ctx, headRoot))
+	st, err = db.GenesisState(ctx)
+	require.NoError(t, err)
+	assertState(t, genesis, st)
+	st, err = db.HeadState(ctx)
+	require.NoError(t, err)
+	assertState(t, head, st)
+
+	tests := []struct {
+		slot uint64
+		want *state.BeaconState
+	}{
+		{slot: 0, want: genesis},
+		{slot: 3, want: genesis},
+		{slot: 4, want: stateAtSlot(t, 3)},
+		{slot: 11, want: head},
+	}
+	for _, tt := range tests {
+		states, err := db.HighestSlotStatesBelow(ctx, tt.slot)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(states))
+		assertState(t, tt.want, states[0])
+	}
+}
+
+func testDeleteStates(t *testing.T, db iface.Database) {
+	ctx := context.Background()
+	c := newChain(t, 4)
+	require.NoError(t, db.SaveBlocks(ctx, c.blocks))
+	for i, root := range c.roots {
+		require.NoError(t, db.SaveState(ctx, stateAtSlot(t, uint64(i)), root))
+	}
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, c.roots[0]))
+	require.NoError(t, db.SaveHeadBlockRoot(ctx, c.roots[4]))
+
+	for _, root := range [][32]byte{c.roots[0], c.roots[4]} {
+		assert.ErrorContains(t, "cannot delete genesis, finalized, or head state", db.DeleteState(ctx, root))
+	}
+	// Nothing is deleted if one of the states is protected.
+	assert.ErrorContains(t, "cannot delete genesis, finalized, or head state", db.DeleteStates(ctx, [][32]byte{c.roots[1], c.roots[4]}))
+	assert.Equal(t, true, db.HasState(ctx, c.roots[1]))
+
+	require.NoError(t, db.DeleteStates(ctx, [][32]byte{c.roots[1], c.roots[2]}))
+	assert.Equal(t, false, db.HasState(ctx, c.roots[1]))
+	assert.Equal(t, false, db.HasState(ctx, c.roots[2]))
+	assert.Equal(t, false, db.HasArchivedPoint(ctx, 1))
+	assert.Equal(t, true, db.HasArchivedPoint(ctx, 3))
+	// Deleting a missing state is not an error.
+	require.NoError(t, db.DeleteState(ctx, c.roots[1]))
+
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 0, Root: c.roots[3][:]}))
+	assert.ErrorContains(t, "cannot delete genesis, finalized, or head state", db.DeleteState(ctx, c.roots[3]))
+}
+
+func testArchivedPoints(t *testing.T, db iface.Database) {
+	ctx := context.Background()
+	slot, err := db.LastArchivedSlot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), slot)
+	assert.Equal(t, [32]byte{}, db.LastArchivedRoot(ctx))
+	assert.Equal(t, false, db.HasArchivedPoint(ctx, 0))
+
+	roots := map[uint64][32]byte{
+		8:  bytesutil.ToBytes32([]byte("a")),
+		64: bytesutil.ToBytes32([]byte("b")),
+		32: bytesutil.ToBytes32([]byte("c")),
+	}
+	for slot, root := range roots {
+		require.NoError(t, db.SaveState(ctx, stateAtSlot(t, slot), root))
+	}
+	for slot, root := range roots {
+		assert.Equal(t, true, db.HasArchivedPoint(ctx, slot))
+		assert.Equal(t, root, db.ArchivedPointRoot(ctx, slot))
+	}
+	assert.Equal(t, false, db.HasArchivedPoint(ctx, 16))
+	assert.Equal(t, [32]byte{}, db.ArchivedPointRoot(ctx, 16))
+	slot, err = db.LastArchivedSlot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(64), slot)
+	assert.Equal(t, roots[64], db.LastArchivedRoot(ctx))
+}
+
+func testStateSummaries(t *testing.T, db iface.Database) {
+	ctx := context.Background()
+	summaries := []*pb.StateSummary{
+		{Slot: 1, Root: bytesutil.PadTo([]byte("a"), 32)},
+		{Slot: 2, Root: bytesutil.PadTo([]byte("b"), 32)},
+	}
+	root := bytesutil.ToBytes32(summaries[0].Root)
+	assert.Equal(t, false, db.HasStateSummary(ctx, root))
+	summary, err := db.StateSummary(ctx, root)
+	require.NoError(t, err)
+	assert.Equal(t, true, summary == nil, "Expected no state summary")
+
+	require.NoError(t, db.SaveStateSummaries(ctx, summaries))
+	require.NoError(t, db.SaveStateSummary(ctx, &pb.StateSummary{Slot: 3, Root: summaries[1].Root}))
+	assert.Equal(t, true, db.HasStateSummary(ctx, root))
+	summary, err = db.StateSummary(ctx, root)
+	require.NoError(t, err)
+	assert.DeepEqual(t, summaries[0], summary)
+	// Saving a summary again replaces it.
+	summary, err = db.StateSummary(ctx, bytesutil.ToBytes32(summaries[1].Root))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), summary.Slot)
+}
+
+func testStateDiffs(t *testing.T, db iface.Database) {
+	ctx := context.Background()
+	base := testutil.NewBeaconState().CloneInnerState()
+	diffs := make(map[uint64]*statediff.Diff)
+	targets := make(map[uint64]*pb.BeaconState)
+	roots := make(map[uint64][32]byte)
+	for _, slot := range []uint64{4, 8} {
+		target := proto.Clone(base).(*pb.BeaconState)
+		target.Slot = slot
+		target.BlockRoots[1] = bytesutil.PadTo([]byte{byte(slot)}, 32)
+		d, err := statediff.New(base, target)
+		require.NoError(t, err)
+		diffs[slot] = d
+		targets[slot] = target
+		roots[slot] = bytesutil.ToBytes32([]byte{byte(slot)})
+	}
+
+	assert.Equal(t, false, db.HasStateDiff(ctx, roots[4]))
+	d, err := db.StateDiff(ctx, roots[4])
+	require.NoError(t, err)
+	assert.Equal(t, true, d == nil, "Expected no state diff")
+	d, err = db.HighestSlotStateDiffBelow(ctx, 100)
+	require.NoError(t, err)
+	assert.Equal(t, true, d == nil, "Expected no state diff")
+	assert.ErrorContains(t, "nil state diff", db.SaveStateDiff(ctx, roots[4], nil))
+
+	for slot, d := range diffs {
+		require.NoError(t, db.SaveStateDiff(ctx, roots[slot], d))
+	}
+	for slot, root := range roots {
+		assert.Equal(t, true, db.HasStateDiff(ctx, root))
+		d, err := db.StateDiff(ctx, root)
+		require.NoError(t, err)
+		got, err := d.Apply(base)
+		require.NoError(t, err)
+		wantRoot, err := targets[slot].HashTreeRoot()
+		require.NoError(t, err)
+		gotRoot, err := got.HashTreeRoot()
+		require.NoError(t, err)
+		assert.Equal(t, wantRoot, gotRoot, "Did not rebuild state at slot %d", slot)
+	}
+
+	tests := []struct {
+		slot     uint64
+		wantSlot uint64
+		wantNil  bool
+	}{
+		{slot: 4, wantNil: true},
+		{slot: 5, wantSlot: 4},
+		{slot: 8, wantSlot: 4},
+		{slot: 9, wantSlot: 8},
+	}
+	for _, tt := range tests {
+		d, err := db.HighestSlotStateDiffBelow(ctx, tt.slot)
+		require.NoError(t, err)
+		if tt.wantNil {
+			assert.Equal(t, true, d == nil, "Expected no state diff below slot %d", tt.slot)
+			continue
+		}
+		require.NotNil(t, d)
+		assert.Equal(t, tt.wantSlot, d.Slot)
+	}
+}
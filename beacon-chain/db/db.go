@@ -5,9 +5,13 @@ package db
 import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/memory"
 )
 
-// NewDB initializes a new DB.
+// NewDB initializes a new DB. An in-memory DB is returned if dirPath is memory.DataDir.
 func NewDB(dirPath string, stateSummaryCache *cache.StateSummaryCache) (Database, error) {
+	if dirPath == memory.DataDir {
+		return memory.NewStore(stateSummaryCache), nil
+	}
 	return kv.NewKVStore(dirPath, stateSummaryCache)
 }
@@ -1,5 +1,9 @@
 package db
 
-import "github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+import (
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/memory"
+)
 
 var _ = Database(&kv.Store{})
+var _ = Database(&memory.Store{})
@@ -0,0 +1,90 @@
+package exporter
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+)
+
+// Type of an exported event.
+type Type string
+
+const (
+	// BlockEvent is exported when a block is saved to the database.
+	BlockEvent Type = "block"
+	// HeadEvent is exported when a new head block root is saved to the database.
+	HeadEvent Type = "head"
+	// FinalizedCheckpointEvent is exported when a finalized checkpoint is saved to the database.
+	FinalizedCheckpointEvent Type = "finalized_checkpoint"
+	// ReorgEvent is exported when the new head is at a lower slot than the previous head.
+	ReorgEvent Type = "reorg"
+	// ProposerSlashingEvent is exported when a proposer slashing is received from RPC or gossip.
+	ProposerSlashingEvent Type = "proposer_slashing"
+	// AttesterSlashingEvent is exported when an attester slashing is received from RPC or gossip.
+	AttesterSlashingEvent Type = "attester_slashing"
+	// VoluntaryExitEvent is exported when a voluntary exit is received from RPC or gossip.
+	VoluntaryExitEvent Type = "voluntary_exit"
+)
+
+var marshaler = &jsonpb.Marshaler{}
+
+// Event is a beacon chain event exported to the sinks.
+type Event struct {
+	Type Type
+	Time time.Time
+	// Root identifies the object of the event, such as the block root of block and head events.
+	// It is set to the hash tree root of the data when left empty.
+	Root []byte
+	// Data is a protobuf message, encoded with the same JSON mapping as the gRPC gateway, or a
+	// JSON encodable value.
+	Data interface{}
+}
+
+// HeadData is the data of head events.
+type HeadData struct {
+	Slot uint64 `json:"slot,string"`
+}
+
+// ReorgData is the data of reorg events.
+type ReorgData struct {
+	NewSlot uint64 `json:"newSlot,string"`
+	OldSlot uint64 `json:"oldSlot,string"`
+}
+
+type envelope struct {
+	Type Type            `json:"type"`
+	Time time.Time       `json:"time"`
+	Root string          `json:"root,omitempty"`
+	Data json.RawMessage `json:"data"`
+}
+
+// MarshalJSON encodes the event as a single line JSON object.
+func (e *Event) MarshalJSON() ([]byte, error) {
+	var data []byte
+	if msg, ok := e.Data.(proto.Message); ok {
+		buf := bytes.NewBuffer(nil)
+		if err := marshaler.Marshal(buf, msg); err != nil {
+			return nil, err
+		}
+		data = buf.Bytes()
+	} else {
+		var err error
+		data, err = json.Marshal(e.Data)
+		if err != nil {
+			return nil, err
+		}
+	}
+	env := &envelope{
+		Type: e.Type,
+		Time: e.Time.UTC(),
+		Data: data,
+	}
+	if len(e.Root) > 0 {
+		env.Root = fmt.Sprintf("%#x", e.Root)
+	}
+	return json.Marshal(env)
+}
@@ -0,0 +1,74 @@
+package exporter
+
+import (
+	"context"
+
+	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
+)
+
+var _ = iface.Database(&Exporter{})
+
+// Exporter wraps a database and exports the blocks, head block roots and finalized checkpoints
+// saved to it. The slashings and voluntary exits saved to the database are not exported, as the
+// service already exports the ones received from the operation feed.
+type Exporter struct {
+	db iface.Database
+	s  *Service
+}
+
+// Wrap the database to export the events saved to it with the service.
+func Wrap(db iface.Database, s *Service) iface.Database {
+	return &Exporter{db: db, s: s}
+}
+
+// Close closes the underlying database. The sinks are closed once the service is stopped.
+func (e Exporter) Close() error {
+	return e.db.Close()
+}
+
+// SaveBlock exports the block once it is saved.
+func (e Exporter) SaveBlock(ctx context.Context, block *eth.SignedBeaconBlock) error {
+	if err := e.db.SaveBlock(ctx, block); err != nil {
+		return err
+	}
+	e.s.Export(&Event{Type: BlockEvent, Data: block})
+	return nil
+}
+
+// SaveBlocks exports the blocks once they are saved.
+func (e Exporter) SaveBlocks(ctx context.Context, blocks []*eth.SignedBeaconBlock) error {
+	if err := e.db.SaveBlocks(ctx, blocks); err != nil {
+		return err
+	}
+	for _, block := range blocks {
+		e.s.Export(&Event{Type: BlockEvent, Data: block})
+	}
+	return nil
+}
+
+// SaveHeadBlockRoot exports the head block root, along with the slot of its block, once it is
+// saved.
+func (e Exporter) SaveHeadBlockRoot(ctx context.Context, blockRoot [32]byte) error {
+	if err := e.db.SaveHeadBlockRoot(ctx, blockRoot); err != nil {
+		return err
+	}
+	data := &HeadData{}
+	blk, err := e.db.Block(ctx, blockRoot)
+	if err != nil {
+		log.WithError(err).Error("Could not retrieve head block to export")
+	} else if blk != nil && blk.Block != nil {
+		data.Slot = blk.Block.Slot
+	}
+	e.s.Export(&Event{Type: HeadEvent, Root: blockRoot[:], Data: data})
+	return nil
+}
+
+// SaveFinalizedCheckpoint exports the checkpoint once it is saved.
+func (e Exporter) SaveFinalizedCheckpoint(ctx context.Context, checkpoint *eth.Checkpoint) error {
+	if err := e.db.SaveFinalizedCheckpoint(ctx, checkpoint); err != nil {
+		return err
+	}
+	e.s.Export(&Event{Type: FinalizedCheckpointEvent, Root: checkpoint.Root, Data: checkpoint})
+	return nil
+}
@@ -0,0 +1,114 @@
+package exporter
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// FileSink appends the events to a newline delimited JSON file. Once the file would grow past its
+// maximum size, it is rotated: path is renamed to path.1, path.1 to path.2 and so on, and the
+// rotated files past the maximum number of backups are removed.
+type FileSink struct {
+	path       string
+	maxSize    int64
+	maxBackups int
+	lock       sync.Mutex
+	f          *os.File
+	size       int64
+}
+
+// NewFileSink opens the file at path for appending. A maxSize of zero disables the rotation.
+func NewFileSink(path string, maxSize int64, maxBackups int) (*FileSink, error) {
+	if err := os.MkdirAll(filepath.Dir(path), params.BeaconIoConfig().ReadWriteExecutePermissions); err != nil {
+		return nil, err
+	}
+	s := &FileSink{
+		path:       path,
+		maxSize:    maxSize,
+		maxBackups: maxBackups,
+	}
+	if err := s.open(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Export appends the encoded event to the file, rotating it first if needed.
+func (s *FileSink) Export(_ *Event, enc []byte) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.f == nil {
+		return errors.New("file sink is closed")
+	}
+	if s.maxSize > 0 && s.size > 0 && s.size+int64(len(enc)) > s.maxSize {
+		if err := s.rotate(); err != nil {
+			return errors.Wrap(err, "could not rotate export file")
+		}
+	}
+	n, err := s.f.Write(enc)
+	s.size += int64(n)
+	return err
+}
+
+// Close the file.
+func (s *FileSink) Close() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.f == nil {
+		return nil
+	}
+	err := s.f.Close()
+	s.f = nil
+	return err
+}
+
+func (s *FileSink) open() error {
+	f, err := os.OpenFile(s.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, params.BeaconIoConfig().ReadWritePermissions)
+	if err != nil {
+		return err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		if closeErr := f.Close(); closeErr != nil {
+			log.WithError(closeErr).Error("Could not close export file")
+		}
+		return err
+	}
+	s.f = f
+	s.size = info.Size()
+	return nil
+}
+
+func (s *FileSink) rotate() error {
+	if err := s.f.Close(); err != nil {
+		return err
+	}
+	s.f = nil
+	if s.maxBackups == 0 {
+		if err := os.Remove(s.path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return s.open()
+	}
+	if err := os.Remove(s.backupPath(s.maxBackups)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	for i := s.maxBackups - 1; i > 0; i-- {
+		if err := os.Rename(s.backupPath(i), s.backupPath(i+1)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	if err := os.Rename(s.path, s.backupPath(1)); err != nil {
+		return err
+	}
+	return s.open()
+}
+
+func (s *FileSink) backupPath(i int) string {
+	return fmt.Sprintf("%s.%d", s.path, i)
+}
@@ -0,0 +1,48 @@
+package exporter
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func readFile(t *testing.T, path string) string {
+	b, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	return string(b)
+}
+
+func TestFileSink_Rotates(t *testing.T) {
+	dir, err := ioutil.TempDir("", "exporter")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, os.RemoveAll(dir))
+	})
+	path := filepath.Join(dir, "events", "events.json")
+	s, err := NewFileSink(path, 8, 1)
+	require.NoError(t, err)
+	require.NoError(t, s.Export(nil, []byte("one\n")))
+	require.NoError(t, s.Export(nil, []byte("two\n")))
+	require.NoError(t, s.Export(nil, []byte("three\n")))
+	assert.Equal(t, "three\n", readFile(t, path))
+	assert.Equal(t, "one\ntwo\n", readFile(t, path+".1"))
+
+	require.NoError(t, s.Export(nil, []byte("four\n")))
+	assert.Equal(t, "four\n", readFile(t, path))
+	assert.Equal(t, "three\n", readFile(t, path+".1"))
+	_, err = os.Stat(path + ".2")
+	assert.Equal(t, true, os.IsNotExist(err), "Rotated file past the maximum number of backups was kept")
+	require.NoError(t, s.Close())
+	assert.ErrorContains(t, "file sink is closed", s.Export(nil, []byte("five\n")))
+
+	// Events are appended to an existing file.
+	s, err = NewFileSink(path, 0, 0)
+	require.NoError(t, err)
+	require.NoError(t, s.Export(nil, []byte("five\n")))
+	require.NoError(t, s.Close())
+	assert.Equal(t, "four\nfive\n", readFile(t, path))
+}
@@ -0,0 +1,12 @@
+// +build !kafka_enabled
+
+package exporter
+
+import (
+	"github.com/pkg/errors"
+)
+
+// NewKafkaSink returns an error, as the beacon node was built without kafka support.
+func NewKafkaSink(_ string) (Sink, error) {
+	return nil, errors.New("kafka export requires a beacon node built with kafka enabled")
+}
@@ -0,0 +1,41 @@
+// +build kafka_enabled
+
+package exporter
+
+import (
+	"gopkg.in/confluentinc/confluent-kafka-go.v1/kafka"
+	_ "gopkg.in/confluentinc/confluent-kafka-go.v1/kafka/librdkafka" // Required for c++ kafka library.
+)
+
+// KafkaSink produces the events to kafka topics named after the event type, such as beacon_block.
+// The message key is the event root.
+type KafkaSink struct {
+	p *kafka.Producer
+}
+
+// NewKafkaSink connects to the kafka servers of the bootstrap.servers list.
+func NewKafkaSink(bootstrapServers string) (Sink, error) {
+	p, err := kafka.NewProducer(&kafka.ConfigMap{"bootstrap.servers": bootstrapServers})
+	if err != nil {
+		return nil, err
+	}
+	return &KafkaSink{p: p}, nil
+}
+
+// Export produces the encoded event, without its trailing newline, to the topic of its type.
+func (s *KafkaSink) Export(e *Event, enc []byte) error {
+	topic := "beacon_" + string(e.Type)
+	return s.p.Produce(&kafka.Message{
+		TopicPartition: kafka.TopicPartition{
+			Topic: &topic,
+		},
+		Value: enc[:len(enc)-1],
+		Key:   e.Root,
+	}, nil)
+}
+
+// Close the kafka producer.
+func (s *KafkaSink) Close() error {
+	s.p.Close()
+	return nil
+}
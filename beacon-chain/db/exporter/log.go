@@ -0,0 +1,7 @@
+package exporter
+
+import (
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "exporter")
@@ -0,0 +1,21 @@
+package exporter
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	exportedEvents = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "exporter_events_total",
+		Help: "The number of events exported, by event type.",
+	}, []string{"type"})
+	droppedEvents = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "exporter_dropped_events_total",
+		Help: "The number of events dropped because the export queue was full.",
+	})
+	exportFailures = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "exporter_failures_total",
+		Help: "The number of times a sink failed to export an event.",
+	})
+)
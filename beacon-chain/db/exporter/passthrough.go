@@ -1,13 +1,15 @@
-package kafka
+package exporter
 
 import (
 	"context"
+	"io"
 
 	"github.com/ethereum/go-ethereum/common"
 	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/statediff"
 	"github.com/prysmaticlabs/prysm/proto/beacon/db"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 )
@@ -27,6 +29,21 @@ func (e Exporter) Backup(ctx context.Context) error {
 	return e.db.Backup(ctx)
 }
 
+// WriteBackup -- passthrough.
+func (e Exporter) WriteBackup(ctx context.Context, path, parentPath string) error {
+	return e.db.WriteBackup(ctx, path, parentPath)
+}
+
+// ExportArchive -- passthrough.
+func (e Exporter) ExportArchive(ctx context.Context, w io.Writer, startSlot, slotCount uint64) error {
+	return e.db.ExportArchive(ctx, w, startSlot, slotCount)
+}
+
+// ImportArchive -- passthrough.
+func (e Exporter) ImportArchive(ctx context.Context, r io.Reader) error {
+	return e.db.ImportArchive(ctx, r)
+}
+
 // Block -- passthrough.
 func (e Exporter) Block(ctx context.Context, blockRoot [32]byte) (*eth.SignedBeaconBlock, error) {
 	return e.db.Block(ctx, blockRoot)
@@ -117,16 +134,46 @@ func (e Exporter) DepositContractAddress(ctx context.Context) ([]byte, error) {
 	return e.db.DepositContractAddress(ctx)
 }
 
-// SaveHeadBlockRoot -- passthrough.
-func (e Exporter) SaveHeadBlockRoot(ctx context.Context, blockRoot [32]byte) error {
-	return e.db.SaveHeadBlockRoot(ctx, blockRoot)
-}
-
 // GenesisBlock -- passthrough.
 func (e Exporter) GenesisBlock(ctx context.Context) (*ethpb.SignedBeaconBlock, error) {
 	return e.db.GenesisBlock(ctx)
 }
 
+// OriginBlockRoot -- passthrough.
+func (e Exporter) OriginBlockRoot(ctx context.Context) ([32]byte, error) {
+	return e.db.OriginBlockRoot(ctx)
+}
+
+// SaveOriginCheckpoint -- passthrough.
+func (e Exporter) SaveOriginCheckpoint(ctx context.Context, state *state.BeaconState, block *eth.SignedBeaconBlock) error {
+	return e.db.SaveOriginCheckpoint(ctx, state, block)
+}
+
+// BackfillBlockRoot -- passthrough.
+func (e Exporter) BackfillBlockRoot(ctx context.Context) ([32]byte, error) {
+	return e.db.BackfillBlockRoot(ctx)
+}
+
+// LowestRetainedSlot -- passthrough.
+func (e Exporter) LowestRetainedSlot(ctx context.Context) (uint64, error) {
+	return e.db.LowestRetainedSlot(ctx)
+}
+
+// PruneHistory -- passthrough.
+func (e Exporter) PruneHistory(ctx context.Context, slot uint64) (uint64, error) {
+	return e.db.PruneHistory(ctx, slot)
+}
+
+// SaveBackfillBlockRoot -- passthrough.
+func (e Exporter) SaveBackfillBlockRoot(ctx context.Context, blockRoot [32]byte) error {
+	return e.db.SaveBackfillBlockRoot(ctx, blockRoot)
+}
+
+// SaveBackfillBlocks -- passthrough.
+func (e Exporter) SaveBackfillBlocks(ctx context.Context, blocks []*eth.SignedBeaconBlock) error {
+	return e.db.SaveBackfillBlocks(ctx, blocks)
+}
+
 // SaveGenesisBlockRoot -- passthrough.
 func (e Exporter) SaveGenesisBlockRoot(ctx context.Context, blockRoot [32]byte) error {
 	return e.db.SaveGenesisBlockRoot(ctx, blockRoot)
@@ -172,11 +219,6 @@ func (e Exporter) SaveJustifiedCheckpoint(ctx context.Context, checkpoint *eth.C
 	return e.db.SaveJustifiedCheckpoint(ctx, checkpoint)
 }
 
-// SaveFinalizedCheckpoint -- passthrough.
-func (e Exporter) SaveFinalizedCheckpoint(ctx context.Context, checkpoint *eth.Checkpoint) error {
-	return e.db.SaveFinalizedCheckpoint(ctx, checkpoint)
-}
-
 // SaveDepositContractAddress -- passthrough.
 func (e Exporter) SaveDepositContractAddress(ctx context.Context, addr common.Address) error {
 	return e.db.SaveDepositContractAddress(ctx, addr)
@@ -202,6 +244,26 @@ func (e Exporter) HasStateSummary(ctx context.Context, blockRoot [32]byte) bool
 	return e.db.HasStateSummary(ctx, blockRoot)
 }
 
+// StateDiff -- passthrough.
+func (e Exporter) StateDiff(ctx context.Context, blockRoot [32]byte) (*statediff.Diff, error) {
+	return e.db.StateDiff(ctx, blockRoot)
+}
+
+// HasStateDiff -- passthrough.
+func (e Exporter) HasStateDiff(ctx context.Context, blockRoot [32]byte) bool {
+	return e.db.HasStateDiff(ctx, blockRoot)
+}
+
+// HighestSlotStateDiffBelow -- passthrough.
+func (e Exporter) HighestSlotStateDiffBelow(ctx context.Context, slot uint64) (*statediff.Diff, error) {
+	return e.db.HighestSlotStateDiffBelow(ctx, slot)
+}
+
+// SaveStateDiff -- passthrough.
+func (e Exporter) SaveStateDiff(ctx context.Context, blockRoot [32]byte, diff *statediff.Diff) error {
+	return e.db.SaveStateDiff(ctx, blockRoot, diff)
+}
+
 // IsFinalizedBlock -- passthrough.
 func (e Exporter) IsFinalizedBlock(ctx context.Context, blockRoot [32]byte) bool {
 	return e.db.IsFinalizedBlock(ctx, blockRoot)
@@ -251,3 +313,23 @@ func (e Exporter) LastArchivedSlot(ctx context.Context) (uint64, error) {
 func (e Exporter) RunMigrations(ctx context.Context) error {
 	return e.db.RunMigrations(ctx)
 }
+
+// ForkChoiceSnapshot -- passthrough.
+func (e Exporter) ForkChoiceSnapshot(ctx context.Context) (*db.ForkChoiceSnapshot, error) {
+	return e.db.ForkChoiceSnapshot(ctx)
+}
+
+// SaveForkChoiceSnapshot -- passthrough.
+func (e Exporter) SaveForkChoiceSnapshot(ctx context.Context, snapshot *db.ForkChoiceSnapshot) error {
+	return e.db.SaveForkChoiceSnapshot(ctx, snapshot)
+}
+
+// OperationPools -- passthrough.
+func (e Exporter) OperationPools(ctx context.Context) (*db.OperationPools, error) {
+	return e.db.OperationPools(ctx)
+}
+
+// SaveOperationPools -- passthrough.
+func (e Exporter) SaveOperationPools(ctx context.Context, pools *db.OperationPools) error {
+	return e.db.SaveOperationPools(ctx, pools)
+}
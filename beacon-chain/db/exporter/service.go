@@ -0,0 +1,263 @@
+// Package exporter streams beacon chain events, such as saved blocks, head changes, finalized
+// checkpoints and received slashings and exits, to pluggable sinks so external indexers can
+// consume them without polling the beacon node APIs.
+package exporter
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	fssz "github.com/ferranbt/fastssz"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/shared"
+)
+
+var _ = shared.Service(&Service{})
+
+// queueSize is the number of events which can wait to be exported before new events are dropped.
+const queueSize = 1024
+
+// Config to set up the exporter service. Events are exported to the file, the socket and the kafka
+// servers which are set, and to any additional sinks.
+type Config struct {
+	StateNotifier     statefeed.Notifier
+	OperationNotifier opfeed.Notifier
+	// FilePath of the newline delimited JSON file the events are appended to.
+	FilePath string
+	// FileMaxSize is the size in bytes past which the file is rotated.
+	FileMaxSize int64
+	// FileMaxBackups is the number of rotated files to keep.
+	FileMaxBackups int
+	// SocketPath of the Unix socket the events are streamed on.
+	SocketPath string
+	// KafkaBootstrapServers to produce the events to.
+	KafkaBootstrapServers string
+	Sinks                 []Sink
+}
+
+// Service exports the events of the chain feeds, and of the databases wrapped with it, to its
+// sinks. Events are exported asynchronously, and dropped when the sinks do not keep up.
+type Service struct {
+	ctx               context.Context
+	cancel            context.CancelFunc
+	stateNotifier     statefeed.Notifier
+	operationNotifier opfeed.Notifier
+	queue             chan *Event
+	lock              sync.RWMutex
+	sinks             []Sink
+	err               error
+}
+
+// NewService opens the configured sinks.
+func NewService(ctx context.Context, cfg *Config) (*Service, error) {
+	sinks := append([]Sink{}, cfg.Sinks...)
+	if cfg.FilePath != "" {
+		sink, err := NewFileSink(cfg.FilePath, cfg.FileMaxSize, cfg.FileMaxBackups)
+		if err != nil {
+			closeSinks(sinks)
+			return nil, errors.Wrap(err, "could not open export file")
+		}
+		sinks = append(sinks, sink)
+	}
+	if cfg.SocketPath != "" {
+		sink, err := NewSocketSink(cfg.SocketPath)
+		if err != nil {
+			closeSinks(sinks)
+			return nil, errors.Wrap(err, "could not listen on export socket")
+		}
+		sinks = append(sinks, sink)
+	}
+	if cfg.KafkaBootstrapServers != "" {
+		sink, err := NewKafkaSink(cfg.KafkaBootstrapServers)
+		if err != nil {
+			closeSinks(sinks)
+			return nil, errors.Wrap(err, "could not connect to kafka")
+		}
+		sinks = append(sinks, sink)
+	}
+	if len(sinks) == 0 {
+		return nil, errors.New("no export sink configured")
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		ctx:               ctx,
+		cancel:            cancel,
+		stateNotifier:     cfg.StateNotifier,
+		operationNotifier: cfg.OperationNotifier,
+		queue:             make(chan *Event, queueSize),
+		sinks:             sinks,
+	}, nil
+}
+
+// Start exporting events.
+func (s *Service) Start() {
+	log.WithField("sinks", len(s.sinks)).Info("Exporting chain events")
+	go s.run()
+	if s.stateNotifier != nil {
+		go s.subscribeStateFeed()
+	}
+	if s.operationNotifier != nil {
+		go s.subscribeOperationFeed()
+	}
+}
+
+// Stop exporting events and close the sinks.
+func (s *Service) Stop() error {
+	s.cancel()
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	closeSinks(s.sinks)
+	s.sinks = nil
+	return nil
+}
+
+// Status returns the error of the last failed export, if the latest export failed.
+func (s *Service) Status() error {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.err
+}
+
+// Export queues an event for export. The event is dropped if the queue is full or the service
+// was stopped.
+func (s *Service) Export(e *Event) {
+	if s.ctx.Err() != nil {
+		return
+	}
+	if e.Time.IsZero() {
+		e.Time = time.Now()
+	}
+	select {
+	case s.queue <- e:
+	default:
+		droppedEvents.Inc()
+	}
+}
+
+func (s *Service) run() {
+	for {
+		select {
+		case e := <-s.queue:
+			err := s.export(e)
+			if err != nil {
+				exportFailures.Inc()
+				log.WithError(err).WithField("type", e.Type).Error("Could not export event")
+			} else {
+				exportedEvents.WithLabelValues(string(e.Type)).Inc()
+			}
+			s.lock.Lock()
+			s.err = err
+			s.lock.Unlock()
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// export encodes the event once and passes it to every sink.
+func (s *Service) export(e *Event) error {
+	if len(e.Root) == 0 {
+		root, err := dataRoot(e.Data)
+		if err != nil {
+			return errors.Wrap(err, "could not compute event root")
+		}
+		e.Root = root
+	}
+	enc, err := json.Marshal(e)
+	if err != nil {
+		return errors.Wrap(err, "could not encode event")
+	}
+	enc = append(enc, '\n')
+
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	var lastErr error
+	for _, sink := range s.sinks {
+		if err := sink.Export(e, enc); err != nil {
+			lastErr = err
+		}
+	}
+	return lastErr
+}
+
+func (s *Service) subscribeStateFeed() {
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := s.stateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+	for {
+		select {
+		case event := <-stateChannel:
+			if event.Type != statefeed.Reorg {
+				continue
+			}
+			data, ok := event.Data.(*statefeed.ReorgData)
+			if !ok {
+				log.Error("Event feed data is not type *statefeed.ReorgData")
+				continue
+			}
+			s.Export(&Event{
+				Type: ReorgEvent,
+				Data: &ReorgData{NewSlot: data.NewSlot, OldSlot: data.OldSlot},
+			})
+		case <-s.ctx.Done():
+			return
+		case err := <-stateSub.Err():
+			log.WithError(err).Error("Subscription to state notifier failed")
+			return
+		}
+	}
+}
+
+func (s *Service) subscribeOperationFeed() {
+	opChannel := make(chan *feed.Event, 1)
+	opSub := s.operationNotifier.OperationFeed().Subscribe(opChannel)
+	defer opSub.Unsubscribe()
+	for {
+		select {
+		case event := <-opChannel:
+			switch data := event.Data.(type) {
+			case *opfeed.ExitReceivedData:
+				s.Export(&Event{Type: VoluntaryExitEvent, Data: data.Exit})
+			case *opfeed.ProposerSlashingReceivedData:
+				s.Export(&Event{Type: ProposerSlashingEvent, Data: data.ProposerSlashing})
+			case *opfeed.AttesterSlashingReceivedData:
+				s.Export(&Event{Type: AttesterSlashingEvent, Data: data.AttesterSlashing})
+			}
+		case <-s.ctx.Done():
+			return
+		case err := <-opSub.Err():
+			log.WithError(err).Error("Subscription to operation notifier failed")
+			return
+		}
+	}
+}
+
+// dataRoot returns the hash tree root of the event data, or the block root for signed blocks.
+func dataRoot(data interface{}) ([]byte, error) {
+	if blk, ok := data.(*ethpb.SignedBeaconBlock); ok {
+		data = blk.Block
+	}
+	v, ok := data.(fssz.HashRoot)
+	if !ok {
+		return nil, nil
+	}
+	root, err := v.HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+	return root[:], nil
+}
+
+func closeSinks(sinks []Sink) {
+	for _, sink := range sinks {
+		if err := sink.Close(); err != nil {
+			log.WithError(err).Error("Could not close export sink")
+		}
+	}
+}
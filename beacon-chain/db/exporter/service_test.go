@@ -0,0 +1,139 @@
+package exporter
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/memory"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+type testSink struct {
+	lock   sync.Mutex
+	events []*Event
+	lines  []string
+}
+
+func (s *testSink) Export(e *Event, enc []byte) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.events = append(s.events, e)
+	s.lines = append(s.lines, string(enc))
+	return nil
+}
+
+func (s *testSink) Close() error {
+	return nil
+}
+
+// wait until n events were exported.
+func (s *testSink) wait(t *testing.T, n int) []*Event {
+	for i := 0; i < 100; i++ {
+		s.lock.Lock()
+		events := s.events
+		s.lock.Unlock()
+		if len(events) >= n {
+			return events
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("Did not export %d events", n)
+	return nil
+}
+
+func setupService(t *testing.T, cfg *Config) (*Service, *testSink) {
+	sink := &testSink{}
+	cfg.Sinks = []Sink{sink}
+	s, err := NewService(context.Background(), cfg)
+	require.NoError(t, err)
+	s.Start()
+	t.Cleanup(func() {
+		require.NoError(t, s.Stop())
+	})
+	return s, sink
+}
+
+func TestExporter_ExportsSavedEvents(t *testing.T) {
+	ctx := context.Background()
+	s, sink := setupService(t, &Config{})
+	db := Wrap(memory.NewStore(cache.NewStateSummaryCache()), s)
+
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = 5
+	root, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	// The finalized chain is walked back to genesis, which is the parent of the block.
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, bytesutil.ToBytes32(blk.Block.ParentRoot)))
+	require.NoError(t, db.SaveBlock(ctx, blk))
+	require.NoError(t, db.SaveStateSummary(ctx, &pb.StateSummary{Slot: 5, Root: root[:]}))
+	require.NoError(t, db.SaveHeadBlockRoot(ctx, root))
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 1, Root: root[:]}))
+
+	events := sink.wait(t, 3)
+	assert.Equal(t, BlockEvent, events[0].Type)
+	assert.DeepEqual(t, root[:], events[0].Root, "Block event root is not the block root")
+	assert.Equal(t, HeadEvent, events[1].Type)
+	assert.DeepEqual(t, &HeadData{Slot: 5}, events[1].Data)
+	assert.Equal(t, FinalizedCheckpointEvent, events[2].Type)
+	assert.DeepEqual(t, root[:], events[2].Root)
+
+	var line map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(sink.lines[1]), &line))
+	assert.Equal(t, "head", line["type"])
+	assert.DeepEqual(t, map[string]interface{}{"slot": "5"}, line["data"])
+}
+
+func TestService_ExportsFeedEvents(t *testing.T) {
+	stateNotifier := &mock.MockStateNotifier{}
+	opNotifier := &mock.MockOperationNotifier{}
+	_, sink := setupService(t, &Config{
+		StateNotifier:     stateNotifier,
+		OperationNotifier: opNotifier,
+	})
+
+	exit := &ethpb.SignedVoluntaryExit{Exit: &ethpb.VoluntaryExit{ValidatorIndex: 3}, Signature: make([]byte, 96)}
+	// Wait for the service to subscribe, as events sent without subscribers are not delivered.
+	for opNotifier.OperationFeed().Send(&feed.Event{
+		Type: opfeed.ExitReceived,
+		Data: &opfeed.ExitReceivedData{Exit: exit},
+	}) == 0 {
+		time.Sleep(10 * time.Millisecond)
+	}
+	sink.wait(t, 1)
+	for stateNotifier.StateFeed().Send(&feed.Event{
+		Type: statefeed.Reorg,
+		Data: &statefeed.ReorgData{NewSlot: 4, OldSlot: 6},
+	}) == 0 {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	events := sink.wait(t, 2)
+	assert.Equal(t, VoluntaryExitEvent, events[0].Type)
+	assert.DeepEqual(t, exit, events[0].Data)
+	root, err := exit.HashTreeRoot()
+	require.NoError(t, err)
+	assert.DeepEqual(t, root[:], events[0].Root)
+	assert.Equal(t, ReorgEvent, events[1].Type)
+	assert.DeepEqual(t, &ReorgData{NewSlot: 4, OldSlot: 6}, events[1].Data)
+}
+
+func TestService_DropsEventsOnceStopped(t *testing.T) {
+	s, sink := setupService(t, &Config{})
+	require.NoError(t, s.Stop())
+	s.Export(&Event{Type: HeadEvent, Data: &HeadData{}})
+	assert.Equal(t, 0, len(s.queue))
+	assert.Equal(t, 0, len(sink.events))
+}
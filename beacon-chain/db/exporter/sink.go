@@ -0,0 +1,9 @@
+package exporter
+
+// Sink receives the exported events.
+type Sink interface {
+	// Export an event. The event is also passed JSON encoded, as a newline terminated line.
+	Export(e *Event, enc []byte) error
+	// Close the sink. No events are exported once it is closed.
+	Close() error
+}
@@ -0,0 +1,112 @@
+package exporter
+
+import (
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// socketWriteTimeout bounds the time a slow client can hold up the export of an event.
+const socketWriteTimeout = time.Second
+
+// SocketSink listens on a local Unix socket and streams the events, as newline delimited JSON, to
+// every connected client. Events are not buffered for clients which are not connected, and a
+// client which does not keep up with the events is disconnected.
+type SocketSink struct {
+	path     string
+	listener net.Listener
+	lock     sync.Mutex
+	conns    map[net.Conn]bool
+	closed   bool
+}
+
+// NewSocketSink listens on the Unix socket at path, replacing a stale socket file left behind by
+// a previous run.
+func NewSocketSink(path string) (*SocketSink, error) {
+	if info, err := os.Stat(path); err == nil {
+		if info.Mode()&os.ModeSocket == 0 {
+			return nil, errors.Errorf("%s exists and is not a socket", path)
+		}
+		if err := os.Remove(path); err != nil {
+			return nil, errors.Wrap(err, "could not remove stale socket")
+		}
+	}
+	listener, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, err
+	}
+	s := &SocketSink{
+		path:     path,
+		listener: listener,
+		conns:    make(map[net.Conn]bool),
+	}
+	go s.accept()
+	return s, nil
+}
+
+// Export writes the encoded event to every connected client.
+func (s *SocketSink) Export(_ *Event, enc []byte) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for conn := range s.conns {
+		err := conn.SetWriteDeadline(time.Now().Add(socketWriteTimeout))
+		if err == nil {
+			_, err = conn.Write(enc)
+		}
+		if err != nil {
+			log.WithError(err).Debug("Disconnecting export socket client")
+			s.disconnect(conn)
+		}
+	}
+	return nil
+}
+
+// Close stops listening, disconnects the clients and removes the socket file.
+func (s *SocketSink) Close() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+	for conn := range s.conns {
+		s.disconnect(conn)
+	}
+	// Closing a Unix listener also removes its socket file.
+	return s.listener.Close()
+}
+
+func (s *SocketSink) accept() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			s.lock.Lock()
+			closed := s.closed
+			s.lock.Unlock()
+			if !closed {
+				log.WithError(err).Error("Could not accept export socket client")
+			}
+			return
+		}
+		s.lock.Lock()
+		if s.closed {
+			s.lock.Unlock()
+			if err := conn.Close(); err != nil {
+				log.WithError(err).Debug("Could not close export socket client")
+			}
+			return
+		}
+		s.conns[conn] = true
+		s.lock.Unlock()
+	}
+}
+
+func (s *SocketSink) disconnect(conn net.Conn) {
+	delete(s.conns, conn)
+	if err := conn.Close(); err != nil {
+		log.WithError(err).Debug("Could not close export socket client")
+	}
+}
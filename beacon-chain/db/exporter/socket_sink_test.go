@@ -0,0 +1,57 @@
+package exporter
+
+import (
+	"bufio"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestSocketSink_StreamsToClients(t *testing.T) {
+	dir, err := ioutil.TempDir("", "exporter")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, os.RemoveAll(dir))
+	})
+	path := filepath.Join(dir, "events.sock")
+	s, err := NewSocketSink(path)
+	require.NoError(t, err)
+
+	conn, err := net.Dial("unix", path)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, conn.Close())
+	}()
+	for i := 0; i < 100; i++ {
+		s.lock.Lock()
+		connected := len(s.conns)
+		s.lock.Unlock()
+		if connected == 1 {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	require.NoError(t, s.Export(nil, []byte("{\"type\":\"head\"}\n")))
+	line, err := bufio.NewReader(conn).ReadString('\n')
+	require.NoError(t, err)
+	assert.Equal(t, "{\"type\":\"head\"}\n", line)
+
+	require.NoError(t, s.Close())
+	_, err = os.Stat(path)
+	assert.Equal(t, true, os.IsNotExist(err), "Socket file was not removed")
+
+	// A stale socket file is replaced.
+	l, err := net.Listen("unix", path)
+	require.NoError(t, err)
+	l.(*net.UnixListener).SetUnlinkOnClose(false)
+	require.NoError(t, l.Close())
+	s, err = NewSocketSink(path)
+	require.NoError(t, err)
+	require.NoError(t, s.Close())
+}
@@ -12,6 +12,7 @@ import (
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/statediff"
 	"github.com/prysmaticlabs/prysm/proto/beacon/db"
 	ethereum_beacon_p2p_v1 "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 )
@@ -24,8 +25,11 @@ type ReadOnlyDatabase interface {
 	BlockRoots(ctx context.Context, f *filters.QueryFilter) ([][32]byte, error)
 	HasBlock(ctx context.Context, blockRoot [32]byte) bool
 	GenesisBlock(ctx context.Context) (*ethpb.SignedBeaconBlock, error)
+	OriginBlockRoot(ctx context.Context) ([32]byte, error)
+	BackfillBlockRoot(ctx context.Context) ([32]byte, error)
 	IsFinalizedBlock(ctx context.Context, blockRoot [32]byte) bool
 	HighestSlotBlocksBelow(ctx context.Context, slot uint64) ([]*ethpb.SignedBeaconBlock, error)
+	LowestRetainedSlot(ctx context.Context) (uint64, error)
 	// State related methods.
 	State(ctx context.Context, blockRoot [32]byte) (*state.BeaconState, error)
 	GenesisState(ctx context.Context) (*state.BeaconState, error)
@@ -33,6 +37,9 @@ type ReadOnlyDatabase interface {
 	StateSummary(ctx context.Context, blockRoot [32]byte) (*ethereum_beacon_p2p_v1.StateSummary, error)
 	HasStateSummary(ctx context.Context, blockRoot [32]byte) bool
 	HighestSlotStatesBelow(ctx context.Context, slot uint64) ([]*state.BeaconState, error)
+	StateDiff(ctx context.Context, blockRoot [32]byte) (*statediff.Diff, error)
+	HasStateDiff(ctx context.Context, blockRoot [32]byte) bool
+	HighestSlotStateDiffBelow(ctx context.Context, slot uint64) (*statediff.Diff, error)
 	// Slashing operations.
 	ProposerSlashing(ctx context.Context, slashingRoot [32]byte) (*eth.ProposerSlashing, error)
 	AttesterSlashing(ctx context.Context, slashingRoot [32]byte) (*eth.AttesterSlashing, error)
@@ -62,6 +69,8 @@ type NoHeadAccessDatabase interface {
 	SaveBlock(ctx context.Context, block *eth.SignedBeaconBlock) error
 	SaveBlocks(ctx context.Context, blocks []*eth.SignedBeaconBlock) error
 	SaveGenesisBlockRoot(ctx context.Context, blockRoot [32]byte) error
+	SaveBackfillBlockRoot(ctx context.Context, blockRoot [32]byte) error
+	SaveBackfillBlocks(ctx context.Context, blocks []*eth.SignedBeaconBlock) error
 	// State related methods.
 	SaveState(ctx context.Context, state *state.BeaconState, blockRoot [32]byte) error
 	SaveStates(ctx context.Context, states []*state.BeaconState, blockRoots [][32]byte) error
@@ -69,6 +78,9 @@ type NoHeadAccessDatabase interface {
 	DeleteStates(ctx context.Context, blockRoots [][32]byte) error
 	SaveStateSummary(ctx context.Context, summary *ethereum_beacon_p2p_v1.StateSummary) error
 	SaveStateSummaries(ctx context.Context, summaries []*ethereum_beacon_p2p_v1.StateSummary) error
+	SaveStateDiff(ctx context.Context, blockRoot [32]byte, diff *statediff.Diff) error
+	// History pruning.
+	PruneHistory(ctx context.Context, slot uint64) (uint64, error)
 	// Slashing operations.
 	SaveProposerSlashing(ctx context.Context, slashing *eth.ProposerSlashing) error
 	SaveAttesterSlashing(ctx context.Context, slashing *eth.AttesterSlashing) error
@@ -95,6 +107,14 @@ type HeadAccessDatabase interface {
 	SaveHeadBlockRoot(ctx context.Context, blockRoot [32]byte) error
 	// State related methods.
 	HeadState(ctx context.Context) (*state.BeaconState, error)
+	// Checkpoint sync related methods.
+	SaveOriginCheckpoint(ctx context.Context, state *state.BeaconState, block *eth.SignedBeaconBlock) error
+	// Fork choice related methods.
+	ForkChoiceSnapshot(ctx context.Context) (*db.ForkChoiceSnapshot, error)
+	SaveForkChoiceSnapshot(ctx context.Context, snapshot *db.ForkChoiceSnapshot) error
+	// Operation pool related methods.
+	OperationPools(ctx context.Context) (*db.OperationPools, error)
+	SaveOperationPools(ctx context.Context, pools *db.OperationPools) error
 }
 
 // Database interface with full access.
@@ -107,4 +127,7 @@ type Database interface {
 
 	// Backup and restore methods
 	Backup(ctx context.Context) error
+	WriteBackup(ctx context.Context, path, parentPath string) error
+	ExportArchive(ctx context.Context, w io.Writer, startSlot, slotCount uint64) error
+	ImportArchive(ctx context.Context, r io.Reader) error
 }
@@ -0,0 +1,372 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/archive"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// ExportArchive writes the finalized blocks and the archived point states of the canonical chain
+// within the slot range [startSlot, startSlot+slotCount) to w, using the archive file format.
+func (kv *Store) ExportArchive(ctx context.Context, w io.Writer, startSlot, slotCount uint64) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.ExportArchive")
+	defer span.End()
+
+	if slotCount == 0 {
+		return errors.New("slot count must be greater than 0")
+	}
+	endSlot := startSlot + slotCount
+	originSlot, err := kv.originSlot(ctx)
+	if err != nil {
+		return err
+	}
+
+	var blockRoots, stateRoots [][32]byte
+	if err := kv.db.View(func(tx *bolt.Tx) error {
+		blockRoots = canonicalRootsInRange(tx, tx.Bucket(blockSlotIndicesBucket), startSlot, endSlot, originSlot)
+		stateRoots = canonicalRootsInRange(tx, tx.Bucket(stateSlotIndicesBucket), startSlot, endSlot, originSlot)
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	aw := archive.NewWriter(w)
+	if err := aw.WriteHeader(startSlot, slotCount); err != nil {
+		return errors.Wrap(err, "could not write archive header")
+	}
+	for _, root := range blockRoots {
+		blk, err := kv.Block(ctx, root)
+		if err != nil {
+			return err
+		}
+		if blk == nil {
+			return fmt.Errorf("missing block in database: block root=%#x", root)
+		}
+		if err := aw.WriteBlock(blk); err != nil {
+			return errors.Wrapf(err, "could not write block at slot %d", blk.Block.Slot)
+		}
+	}
+	for _, root := range stateRoots {
+		st, err := kv.State(ctx, root)
+		if err != nil {
+			return err
+		}
+		if st == nil {
+			return fmt.Errorf("missing state in database: block root=%#x", root)
+		}
+		if err := aw.WriteState(st.InnerStateUnsafe()); err != nil {
+			return errors.Wrapf(err, "could not write state at slot %d", st.Slot())
+		}
+	}
+	return nil
+}
+
+// ImportArchive reads an archive file from r and saves its blocks and states. Since archives only
+// contain the finalized canonical chain, the imported blocks are added to the finalized block roots
+// index. The block slot index and state summaries of the imported blocks and states are rebuilt.
+// The first imported block must be the genesis block or a child of the genesis block or of the
+// highest finalized block in the database, so archives are imported in slot order. Everything is
+// written in a single transaction, so a failed import leaves the database unchanged.
+func (kv *Store) ImportArchive(ctx context.Context, r io.Reader) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.ImportArchive")
+	defer span.End()
+
+	ar := archive.NewReader(r)
+	startSlot, slotCount, err := ar.ReadHeader()
+	if err != nil {
+		return err
+	}
+	endSlot := startSlot + slotCount
+
+	var blks []*ethpb.SignedBeaconBlock
+	var blockRoots [][32]byte
+	var states []*state.BeaconState
+	var stateRoots [][32]byte
+	importedRoots := make(map[[32]byte]bool)
+	for {
+		rec, err := ar.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		switch rec.Type {
+		case archive.TypeCompressedBlock:
+			blk, err := rec.Block()
+			if err != nil {
+				return err
+			}
+			if blk.Block.Slot < startSlot || blk.Block.Slot >= endSlot {
+				return fmt.Errorf("block at slot %d is outside of the archive slot range", blk.Block.Slot)
+			}
+			if len(blks) > 0 {
+				prev := blks[len(blks)-1]
+				if blk.Block.Slot <= prev.Block.Slot || !bytes.Equal(blk.Block.ParentRoot, blockRoots[len(blockRoots)-1][:]) {
+					return fmt.Errorf("block at slot %d does not descend from block at slot %d", blk.Block.Slot, prev.Block.Slot)
+				}
+			}
+			root, err := blk.Block.HashTreeRoot()
+			if err != nil {
+				return err
+			}
+			blks = append(blks, blk)
+			blockRoots = append(blockRoots, root)
+			importedRoots[root] = true
+		case archive.TypeCompressedState:
+			pbState, err := rec.State()
+			if err != nil {
+				return err
+			}
+			if pbState.Slot < startSlot || pbState.Slot >= endSlot {
+				return fmt.Errorf("state at slot %d is outside of the archive slot range", pbState.Slot)
+			}
+			st, err := state.InitializeFromProtoUnsafe(pbState)
+			if err != nil {
+				return err
+			}
+			root, err := latestBlockRoot(ctx, st)
+			if err != nil {
+				return err
+			}
+			if !importedRoots[root] && !kv.HasBlock(ctx, root) {
+				return fmt.Errorf("state at slot %d has unknown latest block root %#x", st.Slot(), root)
+			}
+			states = append(states, st)
+			stateRoots = append(stateRoots, root)
+		default:
+			return errors.Wrapf(archive.ErrUnexpectedRecord, "record type %#x", rec.Type)
+		}
+	}
+
+	encBlocks := make([][]byte, len(blks))
+	summaries := make(map[[32]byte][]byte, len(blks)+len(states))
+	for i, blk := range blks {
+		if encBlocks[i], err = encode(ctx, blk); err != nil {
+			return err
+		}
+		if summaries[blockRoots[i]], err = encode(ctx, &pb.StateSummary{Slot: blk.Block.Slot, Root: blockRoots[i][:]}); err != nil {
+			return err
+		}
+	}
+	encStates := make([][]byte, len(states))
+	for i, st := range states {
+		if encStates[i], err = encode(ctx, st.InnerStateUnsafe()); err != nil {
+			return err
+		}
+		if summaries[stateRoots[i]], err = encode(ctx, &pb.StateSummary{Slot: st.Slot(), Root: stateRoots[i][:]}); err != nil {
+			return err
+		}
+	}
+
+	return kv.db.Update(func(tx *bolt.Tx) error {
+		if len(blks) > 0 {
+			if err := checkArchiveLinksToChain(tx, blks[0], blockRoots[0]); err != nil {
+				return err
+			}
+		}
+		bkt := tx.Bucket(blocksBucket)
+		for i, blk := range blks {
+			if bkt.Get(blockRoots[i][:]) != nil {
+				continue
+			}
+			if err := updateValueForIndices(ctx, createBlockIndicesFromBlock(ctx, blk.Block), blockRoots[i][:], tx); err != nil {
+				return errors.Wrap(err, "could not update block indices")
+			}
+			if err := bkt.Put(blockRoots[i][:], encBlocks[i]); err != nil {
+				return errors.Wrap(err, "could not save block")
+			}
+		}
+		stateBkt := tx.Bucket(stateBucket)
+		for i, st := range states {
+			if err := updateValueForIndices(ctx, createStateIndicesFromStateSlot(ctx, st.Slot()), stateRoots[i][:], tx); err != nil {
+				return errors.Wrap(err, "could not update state indices")
+			}
+			if err := stateBkt.Put(stateRoots[i][:], encStates[i]); err != nil {
+				return errors.Wrap(err, "could not save state")
+			}
+		}
+		summaryBkt := tx.Bucket(stateSummaryBucket)
+		for root, enc := range summaries {
+			if err := summaryBkt.Put(root[:], enc); err != nil {
+				return errors.Wrap(err, "could not save state summary")
+			}
+		}
+		return kv.indexFinalizedChain(ctx, tx, blks, blockRoots)
+	})
+}
+
+// checkArchiveLinksToChain checks that the first block of an archive extends the chain in the
+// database: it is either the genesis block, or its parent is the genesis block or the highest
+// block of the finalized canonical chain.
+func checkArchiveLinksToChain(tx *bolt.Tx, first *ethpb.SignedBeaconBlock, firstRoot [32]byte) error {
+	genesisRoot := tx.Bucket(blocksBucket).Get(genesisBlockRootKey)
+	if first.Block.Slot == 0 {
+		if genesisRoot != nil && !bytes.Equal(genesisRoot, firstRoot[:]) {
+			return fmt.Errorf("archive genesis block %#x does not match the genesis block %#x in the database", firstRoot, genesisRoot)
+		}
+		return nil
+	}
+	if genesisRoot != nil && bytes.Equal(first.Block.ParentRoot, genesisRoot) {
+		return nil
+	}
+	tipRoot := finalizedTipRoot(tx)
+	if tipRoot == nil || !bytes.Equal(first.Block.ParentRoot, tipRoot) {
+		return fmt.Errorf("block at slot %d does not descend from the highest finalized block in the database", first.Block.Slot)
+	}
+	return nil
+}
+
+// finalizedTipRoot returns the root of the highest block of the finalized canonical chain, or nil
+// if no block is indexed as finalized.
+func finalizedTipRoot(tx *bolt.Tx) []byte {
+	genesisRoot := tx.Bucket(blocksBucket).Get(genesisBlockRootKey)
+	finalizedBkt := tx.Bucket(finalizedBlockRootsIndexBucket)
+	c := tx.Bucket(blockSlotIndicesBucket).Cursor()
+	for k, v := c.Last(); k != nil; k, v = c.Prev() {
+		for i := 0; i+32 <= len(v); i += 32 {
+			root := v[i : i+32]
+			indexed := finalizedBkt.Get(root)
+			if bytes.Equal(root, genesisRoot) || (indexed != nil && !bytes.Equal(indexed, containerFinalizedButNotCanonical)) {
+				return root
+			}
+		}
+	}
+	return nil
+}
+
+// indexFinalizedChain adds a chain of finalized blocks in ascending slot order to the finalized
+// block roots index, linking it with the already indexed parent and child blocks.
+func (kv *Store) indexFinalizedChain(ctx context.Context, tx *bolt.Tx, blks []*ethpb.SignedBeaconBlock, roots [][32]byte) error {
+	if len(blks) == 0 {
+		return nil
+	}
+	bkt := tx.Bucket(finalizedBlockRootsIndexBucket)
+	for i, blk := range blks {
+		container := &dbpb.FinalizedBlockRootContainer{
+			ParentRoot: blk.Block.ParentRoot,
+		}
+		if i+1 < len(blks) {
+			container.ChildRoot = roots[i+1][:]
+		} else {
+			container.ChildRoot = finalizedChildRoot(tx, roots[i])
+		}
+		enc, err := encode(ctx, container)
+		if err != nil {
+			return err
+		}
+		if err := bkt.Put(roots[i][:], enc); err != nil {
+			return err
+		}
+		if blk.Block.Slot == 0 && tx.Bucket(blocksBucket).Get(genesisBlockRootKey) == nil {
+			if err := tx.Bucket(blocksBucket).Put(genesisBlockRootKey, roots[i][:]); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Point the indexed parent of the first block at it.
+	parentRoot := blks[0].Block.ParentRoot
+	if parentBytes := bkt.Get(parentRoot); parentBytes != nil && !bytes.Equal(parentBytes, containerFinalizedButNotCanonical) {
+		parent := &dbpb.FinalizedBlockRootContainer{}
+		if err := decode(ctx, parentBytes, parent); err != nil {
+			return err
+		}
+		parent.ChildRoot = roots[0][:]
+		enc, err := encode(ctx, parent)
+		if err != nil {
+			return err
+		}
+		if err := bkt.Put(parentRoot, enc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// finalizedChildRoot returns the root of the finalized canonical child of a block, if it is indexed.
+func finalizedChildRoot(tx *bolt.Tx, root [32]byte) []byte {
+	children := tx.Bucket(blockParentRootIndicesBucket).Get(root[:])
+	bkt := tx.Bucket(finalizedBlockRootsIndexBucket)
+	for i := 0; i+32 <= len(children); i += 32 {
+		if v := bkt.Get(children[i : i+32]); v != nil && !bytes.Equal(v, containerFinalizedButNotCanonical) {
+			return children[i : i+32]
+		}
+	}
+	return nil
+}
+
+// canonicalRootsInRange returns the roots in a slot indices bucket within [startSlot, endSlot)
+// which belong to the finalized canonical chain. Blocks at or below the origin block of a
+// checkpoint synced node were verified against it and are considered canonical.
+func canonicalRootsInRange(tx *bolt.Tx, bkt *bolt.Bucket, startSlot, endSlot, originSlot uint64) [][32]byte {
+	genesisRoot := tx.Bucket(blocksBucket).Get(genesisBlockRootKey)
+	hasOrigin := tx.Bucket(blocksBucket).Get(originBlockRootKey) != nil
+	finalizedBkt := tx.Bucket(finalizedBlockRootsIndexBucket)
+
+	var roots [][32]byte
+	c := bkt.Cursor()
+	for k, v := c.Seek(bytesutil.Uint64ToBytesBigEndian(startSlot)); k != nil; k, v = c.Next() {
+		slot := bytesutil.BytesToUint64BigEndian(k)
+		if slot >= endSlot {
+			break
+		}
+		for i := 0; i+32 <= len(v); i += 32 {
+			root := v[i : i+32]
+			indexed := finalizedBkt.Get(root)
+			canonical := bytes.Equal(root, genesisRoot) ||
+				(indexed != nil && !bytes.Equal(indexed, containerFinalizedButNotCanonical)) ||
+				(hasOrigin && slot <= originSlot)
+			if canonical {
+				roots = append(roots, bytesutil.ToBytes32(root))
+			}
+		}
+	}
+	return roots
+}
+
+// originSlot returns the slot of the origin block of a checkpoint synced node, or 0.
+func (kv *Store) originSlot(ctx context.Context) (uint64, error) {
+	originRoot, err := kv.OriginBlockRoot(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if originRoot == [32]byte{} {
+		return 0, nil
+	}
+	origin, err := kv.Block(ctx, originRoot)
+	if err != nil {
+		return 0, err
+	}
+	if origin == nil {
+		return 0, errors.New("origin block not found in database")
+	}
+	return origin.Block.Slot, nil
+}
+
+// latestBlockRoot returns the root of the latest block processed by a state.
+func latestBlockRoot(ctx context.Context, st *state.BeaconState) ([32]byte, error) {
+	header := st.LatestBlockHeader()
+	if header == nil {
+		return [32]byte{}, errors.New("nil latest block header")
+	}
+	if bytesutil.ToBytes32(header.StateRoot) == [32]byte{} {
+		stateRoot, err := st.HashTreeRoot(ctx)
+		if err != nil {
+			return [32]byte{}, err
+		}
+		header.StateRoot = stateRoot[:]
+	}
+	return header.HashTreeRoot()
+}
@@ -0,0 +1,141 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/archive"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+// archivedStateForBlock sets the state root of blk to commit to a state at the block slot and
+// returns that state.
+func archivedStateForBlock(t *testing.T, blk *ethpb.SignedBeaconBlock) (*ethpb.SignedBeaconBlock, *state.BeaconState) {
+	st := testutil.NewBeaconState()
+	require.NoError(t, st.SetSlot(blk.Block.Slot))
+	bodyRoot, err := blk.Block.Body.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, st.SetLatestBlockHeader(&ethpb.BeaconBlockHeader{
+		Slot:       blk.Block.Slot,
+		ParentRoot: blk.Block.ParentRoot,
+		StateRoot:  make([]byte, 32),
+		BodyRoot:   bodyRoot[:],
+	}))
+	stateRoot, err := st.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	blk.Block.StateRoot = stateRoot[:]
+	return blk, st
+}
+
+func setupFinalizedChain(t *testing.T, db *Store, n uint64) ([]*ethpb.SignedBeaconBlock, [32]byte) {
+	ctx := context.Background()
+	genesis := testutil.NewBeaconBlock()
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, genesis))
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisRoot))
+
+	blks := makeBlocks(t, 0, n, genesisRoot)
+	// Replace the block at slot 8 with one committing to an archived state.
+	blk, st := archivedStateForBlock(t, blks[7])
+	blks[7] = blk
+	root, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	for i := 8; i < len(blks); i++ {
+		blks[i].Block.ParentRoot = append([]byte{}, root[:]...)
+		root, err = blks[i].Block.HashTreeRoot()
+		require.NoError(t, err)
+	}
+	require.NoError(t, db.SaveBlocks(ctx, blks))
+	archivedRoot, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveState(ctx, st, archivedRoot))
+
+	headRoot := bytesutil.ToBytes32(sszRootOrDie(t, blks[len(blks)-1]))
+	require.NoError(t, db.SaveStateSummary(ctx, &pb.StateSummary{Slot: n, Root: headRoot[:]}))
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 1, Root: headRoot[:]}))
+	return append([]*ethpb.SignedBeaconBlock{genesis}, blks...), archivedRoot
+}
+
+func TestStore_ExportImportArchive(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+	chain, archivedRoot := setupFinalizedChain(t, db, 20)
+
+	buf := new(bytes.Buffer)
+	require.NoError(t, db.ExportArchive(ctx, buf, 0, 16))
+	// Only one store may register its bolt metrics collector at a time.
+	require.NoError(t, db.Close())
+
+	imported := setupDB(t)
+	require.NoError(t, imported.ImportArchive(ctx, buf))
+
+	for _, blk := range chain {
+		root, err := blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+		if blk.Block.Slot >= 16 {
+			assert.Equal(t, false, imported.HasBlock(ctx, root), "Block at slot %d outside of the range was imported", blk.Block.Slot)
+			continue
+		}
+		assert.Equal(t, true, imported.HasBlock(ctx, root), "Missing block at slot %d", blk.Block.Slot)
+		assert.Equal(t, true, imported.IsFinalizedBlock(ctx, root), "Block at slot %d is not finalized", blk.Block.Slot)
+		assert.Equal(t, true, imported.HasStateSummary(ctx, root), "Missing state summary at slot %d", blk.Block.Slot)
+	}
+	assert.Equal(t, true, imported.HasState(ctx, archivedRoot))
+	assert.Equal(t, archivedRoot, imported.ArchivedPointRoot(ctx, 8))
+	genesis, err := imported.GenesisBlock(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, true, proto.Equal(chain[0], genesis), "Wanted: %v, received: %v", chain[0], genesis)
+	blks, err := imported.HighestSlotBlocksBelow(ctx, 16)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(blks))
+	assert.Equal(t, uint64(15), blks[0].Block.Slot)
+}
+
+func TestStore_ExportArchive_SkipsNonFinalized(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+	chain, _ := setupFinalizedChain(t, db, 20)
+
+	fork := testutil.NewBeaconBlock()
+	fork.Block.Slot = 5
+	fork.Block.ParentRoot = sszRootOrDie(t, chain[3])
+	require.NoError(t, db.SaveBlock(ctx, fork))
+
+	buf := new(bytes.Buffer)
+	require.NoError(t, db.ExportArchive(ctx, buf, 0, 16))
+	r := archive.NewReader(buf)
+	_, _, err := r.ReadHeader()
+	require.NoError(t, err)
+	for i := 0; i < 16; i++ {
+		rec, err := r.Next()
+		require.NoError(t, err)
+		blk, err := rec.Block()
+		require.NoError(t, err)
+		assert.Equal(t, true, proto.Equal(chain[i], blk), "Wanted: %v, received: %v", chain[i], blk)
+	}
+}
+
+func TestStore_ImportArchive_RejectsBrokenChain(t *testing.T) {
+	ctx := context.Background()
+	buf := new(bytes.Buffer)
+	w := archive.NewWriter(buf)
+	require.NoError(t, w.WriteHeader(0, 16))
+	blks := makeBlocks(t, 0, 3, [32]byte{})
+	blks[2].Block.ParentRoot = bytesutil.PadTo([]byte("unknown"), 32)
+	for _, blk := range blks {
+		require.NoError(t, w.WriteBlock(blk))
+	}
+
+	db := setupDB(t)
+	require.ErrorContains(t, "does not descend from block", db.ImportArchive(ctx, buf))
+	assert.Equal(t, false, db.HasBlock(ctx, bytesutil.ToBytes32(sszRootOrDie(t, blks[0]))))
+}
@@ -7,13 +7,13 @@ import (
 	"path"
 
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/backup"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/sirupsen/logrus"
-	bolt "go.etcd.io/bbolt"
 	"go.opencensus.io/trace"
 )
 
-const backupsDirectoryName = "backups"
+const backupsDirectoryName = backup.DirectoryName
 
 // Backup the database to the datadir backup directory.
 // Example for backup at slot 345: $DATADIR/backups/prysm_beacondb_at_slot_0000345.backup
@@ -35,27 +35,13 @@ func (kv *Store) Backup(ctx context.Context) error {
 	}
 	backupPath := path.Join(backupsDir, fmt.Sprintf("prysm_beacondb_at_slot_%07d.backup", head.Block.Slot))
 	logrus.WithField("prefix", "db").WithField("backup", backupPath).Info("Writing backup database.")
+	return kv.WriteBackup(ctx, backupPath, "")
+}
 
-	copyDB, err := bolt.Open(backupPath, params.BeaconIoConfig().ReadWritePermissions, &bolt.Options{Timeout: params.BeaconIoConfig().BoltTimeout})
-	if err != nil {
-		panic(err)
-	}
-	defer func() {
-		if err := copyDB.Close(); err != nil {
-			logrus.WithError(err).Error("Failed to close destination database")
-		}
-	}()
-
-	return kv.db.View(func(tx *bolt.Tx) error {
-		return tx.ForEach(func(name []byte, b *bolt.Bucket) error {
-			logrus.Debugf("Copying bucket %s\n", name)
-			return copyDB.Update(func(tx2 *bolt.Tx) error {
-				b2, err := tx2.CreateBucketIfNotExists(name)
-				if err != nil {
-					return err
-				}
-				return b.ForEach(b2.Put)
-			})
-		})
-	})
+// WriteBackup writes a backup of the database to path. When parentPath is not empty, only the
+// buckets which changed since the backup at parentPath are written.
+func (kv *Store) WriteBackup(ctx context.Context, path, parentPath string) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.WriteBackup")
+	defer span.End()
+	return backup.Write(ctx, kv.db, path, parentPath)
 }
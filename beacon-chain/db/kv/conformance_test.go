@@ -0,0 +1,14 @@
+package kv
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/conformance"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
+)
+
+func TestStore_Conformance(t *testing.T) {
+	conformance.Run(t, func(t *testing.T) iface.Database {
+		return setupDB(t)
+	})
+}
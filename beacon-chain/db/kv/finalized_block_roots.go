@@ -46,6 +46,8 @@ func (kv *Store) updateFinalizedBlockRoots(ctx context.Context, tx *bolt.Tx, che
 	root := checkpoint.Root
 	var previousRoot []byte
 	genesisRoot := tx.Bucket(blocksBucket).Get(genesisBlockRootKey)
+	originRoot := tx.Bucket(blocksBucket).Get(originBlockRootKey)
+	prunedSlot := lowestRetainedSlot(tx)
 
 	// De-index recent finalized block roots, to be re-indexed.
 	previousFinalizedCheckpoint := &ethpb.Checkpoint{}
@@ -72,7 +74,8 @@ func (kv *Store) updateFinalizedBlockRoots(ctx context.Context, tx *bolt.Tx, che
 	}
 
 	// Walk up the ancestry chain until we reach a block root present in the finalized block roots
-	// index bucket or genesis block root.
+	// index bucket, the genesis block root, the origin block root of a checkpoint synced node or
+	// the lowest retained block of a node pruning its history.
 	for {
 		if bytes.Equal(root, genesisRoot) {
 			break
@@ -105,6 +108,15 @@ func (kv *Store) updateFinalizedBlockRoots(ctx context.Context, tx *bolt.Tx, che
 			return err
 		}
 
+		// Blocks below the origin block, or below the lowest retained slot of a node pruning its
+		// history, are not available in the database.
+		if originRoot != nil && bytes.Equal(root, originRoot) {
+			break
+		}
+		if prunedSlot > 0 && block.Slot <= prunedSlot {
+			break
+		}
+
 		// Found parent, loop exit condition.
 		if parentBytes := bkt.Get(block.ParentRoot); parentBytes != nil {
 			parent := &dbpb.FinalizedBlockRootContainer{}
@@ -0,0 +1,43 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/gogo/protobuf/proto"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// ForkChoiceSnapshot returns the latest fork choice snapshot saved to the DB, or nil if none was
+// saved.
+func (kv *Store) ForkChoiceSnapshot(ctx context.Context) (*dbpb.ForkChoiceSnapshot, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.ForkChoiceSnapshot")
+	defer span.End()
+	var snapshot *dbpb.ForkChoiceSnapshot
+	err := kv.db.View(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(chainMetadataBucket).Get(forkChoiceSnapshotKey)
+		if enc == nil {
+			return nil
+		}
+		snapshot = &dbpb.ForkChoiceSnapshot{}
+		return proto.Unmarshal(enc, snapshot)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// SaveForkChoiceSnapshot saves the fork choice snapshot, replacing the previous one.
+func (kv *Store) SaveForkChoiceSnapshot(ctx context.Context, snapshot *dbpb.ForkChoiceSnapshot) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveForkChoiceSnapshot")
+	defer span.End()
+	enc, err := proto.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+	return kv.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(chainMetadataBucket).Put(forkChoiceSnapshotKey, enc)
+	})
+}
@@ -23,9 +23,12 @@ const (
 	// VotesCacheSize with 1M validators will be 8MB.
 	VotesCacheSize = 1 << 23
 	// NumOfVotes specifies the vote cache size.
-	NumOfVotes       = 1 << 20
-	databaseFileName = "beaconchain.db"
-	boltAllocSize    = 8 * 1024 * 1024
+	NumOfVotes    = 1 << 20
+	boltAllocSize = 8 * 1024 * 1024
+	// DatabaseFileName is the name of the beacon node database file.
+	DatabaseFileName = "beaconchain.db"
+	// BeaconNodeDbDirName is the name of the directory containing the beacon node database.
+	BeaconNodeDbDirName = "beaconchaindata"
 )
 
 // BlockCacheSize specifies 1000 slots worth of blocks cached, which
@@ -49,7 +52,7 @@ func NewKVStore(dirPath string, stateSummaryCache *cache.StateSummaryCache) (*St
 	if err := os.MkdirAll(dirPath, params.BeaconIoConfig().ReadWriteExecutePermissions); err != nil {
 		return nil, err
 	}
-	datafile := path.Join(dirPath, databaseFileName)
+	datafile := path.Join(dirPath, DatabaseFileName)
 	boltDB, err := bolt.Open(datafile, params.BeaconIoConfig().ReadWritePermissions, &bolt.Options{Timeout: 1 * time.Second, InitialMmapSize: 10e6})
 	if err != nil {
 		if err == bolt.ErrTimeout {
@@ -97,6 +100,7 @@ func NewKVStore(dirPath string, stateSummaryCache *cache.StateSummaryCache) (*St
 			checkpointBucket,
 			powchainBucket,
 			stateSummaryBucket,
+			stateDiffBucket,
 			// Indices buckets.
 			attestationHeadBlockRootBucket,
 			attestationSourceRootIndicesBucket,
@@ -105,6 +109,7 @@ func NewKVStore(dirPath string, stateSummaryCache *cache.StateSummaryCache) (*St
 			attestationTargetEpochIndicesBucket,
 			blockSlotIndicesBucket,
 			stateSlotIndicesBucket,
+			stateDiffSlotIndicesBucket,
 			blockParentRootIndicesBucket,
 			finalizedBlockRootsIndexBucket,
 			// New State Management service bucket.
@@ -127,7 +132,7 @@ func (kv *Store) ClearDB() error {
 		return nil
 	}
 	prometheus.Unregister(createBoltCollector(kv.db))
-	if err := os.Remove(path.Join(kv.databasePath, databaseFileName)); err != nil {
+	if err := os.Remove(path.Join(kv.databasePath, DatabaseFileName)); err != nil {
 		return errors.Wrap(err, "could not remove database file")
 	}
 	return nil
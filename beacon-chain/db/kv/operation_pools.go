@@ -0,0 +1,44 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/gogo/protobuf/proto"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// OperationPools returns the pending operations of the operation pools saved to the DB, or nil
+// if none were saved.
+func (kv *Store) OperationPools(ctx context.Context) (*dbpb.OperationPools, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.OperationPools")
+	defer span.End()
+	var pools *dbpb.OperationPools
+	err := kv.db.View(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(chainMetadataBucket).Get(operationPoolsKey)
+		if enc == nil {
+			return nil
+		}
+		pools = &dbpb.OperationPools{}
+		return proto.Unmarshal(enc, pools)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pools, nil
+}
+
+// SaveOperationPools saves the pending operations of the operation pools, replacing the
+// previously saved ones.
+func (kv *Store) SaveOperationPools(ctx context.Context, pools *dbpb.OperationPools) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveOperationPools")
+	defer span.End()
+	enc, err := proto.Marshal(pools)
+	if err != nil {
+		return err
+	}
+	return kv.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(chainMetadataBucket).Put(operationPoolsKey, enc)
+	})
+}
@@ -0,0 +1,233 @@
+package kv
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// OriginBlockRoot returns the block root of the finalized anchor the node was started from
+// using checkpoint sync. A zero root is returned if the node was started from genesis.
+func (kv *Store) OriginBlockRoot(ctx context.Context) ([32]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.OriginBlockRoot")
+	defer span.End()
+
+	var root []byte
+	err := kv.db.View(func(tx *bolt.Tx) error {
+		root = tx.Bucket(blocksBucket).Get(originBlockRootKey)
+		return nil
+	})
+	return bytesutil.ToBytes32(root), err
+}
+
+// SaveOriginCheckpoint seeds an empty database with a trusted finalized state and its block.
+// The state must be at the start of an epoch, and the database must not hold a head, finalized
+// or genesis block yet. The state may be the post state of the block or that state advanced
+// through empty slots, as the state at the start of the finalized epoch is when the epoch
+// begins with skipped slots. The block becomes the origin of the local chain: it is saved as the
+// head, justified and finalized checkpoint of the epoch of the state, so the node can forward
+// sync from it instead of from genesis. Everything is written in a single transaction, so a
+// failure never leaves a partially seeded database behind.
+func (kv *Store) SaveOriginCheckpoint(ctx context.Context, st *state.BeaconState, blk *ethpb.SignedBeaconBlock) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveOriginCheckpoint")
+	defer span.End()
+
+	if st == nil || blk == nil || blk.Block == nil {
+		return errors.New("nil origin state or block")
+	}
+	if !helpers.IsEpochStart(st.Slot()) {
+		return fmt.Errorf("origin state slot %d is not at the start of an epoch", st.Slot())
+	}
+	if blk.Block.Slot > st.Slot() {
+		return fmt.Errorf("origin state slot %d is before origin block slot %d", st.Slot(), blk.Block.Slot)
+	}
+	blockRoot, err := blk.Block.HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "could not hash origin block")
+	}
+	if err := verifyOriginState(ctx, st, blk, blockRoot); err != nil {
+		return err
+	}
+
+	encBlock, err := encode(ctx, blk)
+	if err != nil {
+		return err
+	}
+	encState, err := encode(ctx, st.InnerStateUnsafe())
+	if err != nil {
+		return err
+	}
+	encSummary, err := encode(ctx, &pb.StateSummary{
+		Slot: st.Slot(),
+		Root: blockRoot[:],
+	})
+	if err != nil {
+		return err
+	}
+	cp := &ethpb.Checkpoint{
+		Epoch: helpers.SlotToEpoch(st.Slot()),
+		Root:  blockRoot[:],
+	}
+	encCheckpoint, err := encode(ctx, cp)
+	if err != nil {
+		return err
+	}
+	// The origin block is the first entry of the finalized block roots index, its parent is
+	// not available in the database.
+	encContainer, err := encode(ctx, &dbpb.FinalizedBlockRootContainer{ParentRoot: blk.Block.ParentRoot})
+	if err != nil {
+		return err
+	}
+
+	return kv.db.Update(func(tx *bolt.Tx) error {
+		blocks := tx.Bucket(blocksBucket)
+		checkpoints := tx.Bucket(checkpointBucket)
+		if blocks.Get(headBlockRootKey) != nil || blocks.Get(genesisBlockRootKey) != nil || checkpoints.Get(finalizedCheckpointKey) != nil {
+			return errors.New("cannot save origin checkpoint in a database that already contains a chain")
+		}
+		if err := updateValueForIndices(ctx, createBlockIndicesFromBlock(ctx, blk.Block), blockRoot[:], tx); err != nil {
+			return errors.Wrap(err, "could not update block indices")
+		}
+		if err := blocks.Put(blockRoot[:], encBlock); err != nil {
+			return errors.Wrap(err, "could not save origin block")
+		}
+		if err := updateValueForIndices(ctx, createStateIndicesFromStateSlot(ctx, st.Slot()), blockRoot[:], tx); err != nil {
+			return errors.Wrap(err, "could not update state indices")
+		}
+		if err := tx.Bucket(stateBucket).Put(blockRoot[:], encState); err != nil {
+			return errors.Wrap(err, "could not save origin state")
+		}
+		if err := tx.Bucket(stateSummaryBucket).Put(blockRoot[:], encSummary); err != nil {
+			return errors.Wrap(err, "could not save origin state summary")
+		}
+		if err := blocks.Put(originBlockRootKey, blockRoot[:]); err != nil {
+			return errors.Wrap(err, "could not save origin block root")
+		}
+		if err := blocks.Put(headBlockRootKey, blockRoot[:]); err != nil {
+			return errors.Wrap(err, "could not save head block root")
+		}
+		if err := checkpoints.Put(justifiedCheckpointKey, encCheckpoint); err != nil {
+			return errors.Wrap(err, "could not save justified checkpoint")
+		}
+		if err := checkpoints.Put(finalizedCheckpointKey, encCheckpoint); err != nil {
+			return errors.Wrap(err, "could not save finalized checkpoint")
+		}
+		finalized := tx.Bucket(finalizedBlockRootsIndexBucket)
+		if err := finalized.Put(blockRoot[:], encContainer); err != nil {
+			return errors.Wrap(err, "could not index origin block as finalized")
+		}
+		return finalized.Put(previousFinalizedCheckpointKey, encCheckpoint)
+	})
+}
+
+// verifyOriginState checks that the origin state descends from the origin block. A state at the
+// slot of the block must be its post state. A state advanced through empty slots commits to
+// the block through its latest block header, whose state root is filled in by the first slot
+// processed after the block.
+func verifyOriginState(ctx context.Context, st *state.BeaconState, blk *ethpb.SignedBeaconBlock, blockRoot [32]byte) error {
+	if st.Slot() == blk.Block.Slot {
+		stateRoot, err := st.HashTreeRoot(ctx)
+		if err != nil {
+			return errors.Wrap(err, "could not hash origin state")
+		}
+		if stateRoot != bytesutil.ToBytes32(blk.Block.StateRoot) {
+			return fmt.Errorf("origin state root %#x does not match origin block state root %#x", stateRoot, blk.Block.StateRoot)
+		}
+		return nil
+	}
+	headerRoot, err := st.LatestBlockHeader().HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "could not hash origin state latest block header")
+	}
+	if headerRoot != blockRoot {
+		return fmt.Errorf("origin state latest block header root %#x does not match origin block root %#x", headerRoot, blockRoot)
+	}
+	return nil
+}
+
+// BackfillBlockRoot returns the root of the lowest block saved by the historical block
+// backfill below the origin block. A zero root is returned if backfill has not started.
+func (kv *Store) BackfillBlockRoot(ctx context.Context) ([32]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.BackfillBlockRoot")
+	defer span.End()
+
+	var root []byte
+	err := kv.db.View(func(tx *bolt.Tx) error {
+		root = tx.Bucket(blocksBucket).Get(backfillBlockRootKey)
+		return nil
+	})
+	return bytesutil.ToBytes32(root), err
+}
+
+// SaveBackfillBlockRoot records the root of the lowest block saved by the historical
+// block backfill, so backfill can resume from it after a restart.
+func (kv *Store) SaveBackfillBlockRoot(ctx context.Context, blockRoot [32]byte) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveBackfillBlockRoot")
+	defer span.End()
+
+	return kv.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(blocksBucket).Put(backfillBlockRootKey, blockRoot[:])
+	})
+}
+
+// SaveBackfillBlocks saves a batch of historical blocks in ascending slot order, the highest of
+// which is the parent of the lowest block already in the database. As the blocks are below the
+// finalized origin, each gets a state summary and a finalized block roots index entry, and the
+// lowest block of the batch is recorded as the backfill block root. Everything is written in a
+// single transaction, so backfill resumes from a consistent point after a restart.
+func (kv *Store) SaveBackfillBlocks(ctx context.Context, blks []*ethpb.SignedBeaconBlock) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveBackfillBlocks")
+	defer span.End()
+	if len(blks) == 0 {
+		return nil
+	}
+
+	roots := make([][32]byte, len(blks))
+	encBlocks := make([][]byte, len(blks))
+	encSummaries := make([][]byte, len(blks))
+	for i, blk := range blks {
+		root, err := blk.Block.HashTreeRoot()
+		if err != nil {
+			return err
+		}
+		roots[i] = root
+		if encBlocks[i], err = encode(ctx, blk); err != nil {
+			return err
+		}
+		if encSummaries[i], err = encode(ctx, &pb.StateSummary{Slot: blk.Block.Slot, Root: root[:]}); err != nil {
+			return err
+		}
+	}
+
+	return kv.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(blocksBucket)
+		summaries := tx.Bucket(stateSummaryBucket)
+		for i, blk := range blks {
+			if bkt.Get(roots[i][:]) == nil {
+				indicesByBucket := createBlockIndicesFromBlock(ctx, blk.Block)
+				if err := updateValueForIndices(ctx, indicesByBucket, roots[i][:], tx); err != nil {
+					return errors.Wrap(err, "could not update DB indices")
+				}
+				if err := bkt.Put(roots[i][:], encBlocks[i]); err != nil {
+					return err
+				}
+			}
+			if err := summaries.Put(roots[i][:], encSummaries[i]); err != nil {
+				return err
+			}
+		}
+		if err := kv.indexFinalizedChain(ctx, tx, blks, roots); err != nil {
+			return errors.Wrap(err, "could not index backfilled blocks")
+		}
+		return bkt.Put(backfillBlockRootKey, roots[0][:])
+	})
+}
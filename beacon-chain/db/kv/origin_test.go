@@ -0,0 +1,137 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func originStateAndBlock(t *testing.T, slot uint64) (*ethpb.SignedBeaconBlock, [32]byte) {
+	st := testutil.NewBeaconState()
+	require.NoError(t, st.SetSlot(slot))
+	stateRoot, err := st.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = slot
+	blk.Block.ParentRoot = bytesutil.PadTo([]byte{'p', 'a', 'r', 'e', 'n', 't'}, 32)
+	blk.Block.StateRoot = stateRoot[:]
+	root, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	return blk, root
+}
+
+func TestStore_SaveOriginCheckpoint(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	slot := params.BeaconConfig().SlotsPerEpoch * 3
+	st := testutil.NewBeaconState()
+	require.NoError(t, st.SetSlot(slot))
+	blk, root := originStateAndBlock(t, slot)
+	require.NoError(t, db.SaveOriginCheckpoint(ctx, st, blk))
+
+	originRoot, err := db.OriginBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, root, originRoot)
+
+	headBlk, err := db.HeadBlock(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, true, proto.Equal(blk, headBlk), "Wanted: %v, received: %v", blk, headBlk)
+	assert.Equal(t, true, db.HasState(ctx, root))
+	assert.Equal(t, true, db.HasStateSummary(ctx, root))
+	assert.Equal(t, root, db.LastArchivedRoot(ctx))
+
+	wanted := &ethpb.Checkpoint{Epoch: 3, Root: root[:]}
+	finalized, err := db.FinalizedCheckpoint(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, wanted, finalized)
+	justified, err := db.JustifiedCheckpoint(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, wanted, justified)
+	assert.Equal(t, true, db.IsFinalizedBlock(ctx, root))
+}
+
+func TestStore_SaveOriginCheckpoint_NotEmpty(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	genesis := testutil.NewBeaconBlock()
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, genesis))
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisRoot))
+
+	slot := params.BeaconConfig().SlotsPerEpoch
+	st := testutil.NewBeaconState()
+	require.NoError(t, st.SetSlot(slot))
+	blk, _ := originStateAndBlock(t, slot)
+	require.ErrorContains(t, "already contains a chain", db.SaveOriginCheckpoint(ctx, st, blk))
+
+	originRoot, err := db.OriginBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, [32]byte{}, originRoot)
+}
+
+func TestStore_SaveOriginCheckpoint_StateRootMismatch(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	slot := params.BeaconConfig().SlotsPerEpoch
+	st := testutil.NewBeaconState()
+	require.NoError(t, st.SetSlot(slot))
+	blk, _ := originStateAndBlock(t, slot)
+	blk.Block.StateRoot = bytesutil.PadTo([]byte{'b', 'a', 'd'}, 32)
+	require.ErrorContains(t, "does not match origin block state root", db.SaveOriginCheckpoint(ctx, st, blk))
+
+	originRoot, err := db.OriginBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, [32]byte{}, originRoot)
+}
+
+func TestStore_FinalizedBlockRoots_StopsAtOrigin(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	st := testutil.NewBeaconState()
+	require.NoError(t, st.SetSlot(slotsPerEpoch))
+	origin, originRoot := originStateAndBlock(t, slotsPerEpoch)
+	require.NoError(t, db.SaveOriginCheckpoint(ctx, st, origin))
+
+	blks := makeBlocks(t, slotsPerEpoch, slotsPerEpoch*2, originRoot)
+	require.NoError(t, db.SaveBlocks(ctx, blks))
+	cp := &ethpb.Checkpoint{
+		Epoch: 3,
+		Root:  sszRootOrDie(t, blks[len(blks)-1]),
+	}
+	require.NoError(t, db.SaveState(ctx, st, bytesutil.ToBytes32(cp.Root)))
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, cp))
+
+	assert.Equal(t, true, db.IsFinalizedBlock(ctx, originRoot), "Expected origin block to be finalized")
+	for i, blk := range blks {
+		root := sszRootOrDie(t, blk)
+		assert.Equal(t, true, db.IsFinalizedBlock(ctx, bytesutil.ToBytes32(root)), "%d - Expected block %#x to be finalized", i, root)
+	}
+}
+
+func TestStore_BackfillBlockRoot(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	root, err := db.BackfillBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, [32]byte{}, root)
+
+	wanted := bytesutil.ToBytes32([]byte{'b', 'a', 'c', 'k', 'f', 'i', 'l', 'l'})
+	require.NoError(t, db.SaveBackfillBlockRoot(ctx, wanted))
+	root, err = db.BackfillBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, wanted, root)
+}
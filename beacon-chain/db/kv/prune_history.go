@@ -0,0 +1,193 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// ErrHistoryPruned is returned when requesting blocks or states below the lowest slot retained by
+// a node pruning its history.
+var ErrHistoryPruned = errors.New("history below the lowest retained slot was pruned")
+
+// LowestRetainedSlot returns the slot below which the finalized history was pruned. Zero is
+// returned if the history was never pruned.
+func (kv *Store) LowestRetainedSlot(ctx context.Context) (uint64, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.LowestRetainedSlot")
+	defer span.End()
+
+	var slot uint64
+	err := kv.db.View(func(tx *bolt.Tx) error {
+		slot = lowestRetainedSlot(tx)
+		return nil
+	})
+	return slot, err
+}
+
+// PruneHistory deletes the finalized history below the given slot: the blocks, along with their
+// slot and parent root index entries, finalized block roots index entries and state summaries,
+// and the states and state diffs. The genesis block and state are kept, as is the highest state at
+// or below the slot, from which the retained states are regenerated. The slot of that state
+// becomes the lowest retained slot, which is returned. Nothing above the finalized checkpoint is
+// ever pruned.
+func (kv *Store) PruneHistory(ctx context.Context, slot uint64) (uint64, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.PruneHistory")
+	defer span.End()
+
+	var lowest uint64
+	err := kv.db.Update(func(tx *bolt.Tx) error {
+		lowest = lowestRetainedSlot(tx)
+		finalizedSlot, err := finalizedStartSlot(ctx, tx)
+		if err != nil {
+			return err
+		}
+		if slot > finalizedSlot {
+			slot = finalizedSlot
+		}
+		// The highest state at or below the slot anchors the retained history.
+		c := tx.Bucket(stateSlotIndicesBucket).Cursor()
+		k, roots := c.Seek(bytesutil.Uint64ToBytesBigEndian(slot + 1))
+		if k == nil {
+			k, roots = c.Last()
+		} else {
+			k, roots = c.Prev()
+		}
+		if k == nil || len(roots) < 32 {
+			return nil
+		}
+		anchorSlot := bytesutil.BytesToUint64BigEndian(k)
+		anchorRoot := append([]byte{}, roots[len(roots)-32:]...)
+		if anchorSlot <= lowest {
+			return nil
+		}
+
+		blocks := tx.Bucket(blocksBucket)
+		genesisRoot := append([]byte{}, blocks.Get(genesisBlockRootKey)...)
+		keep := func(root []byte) bool {
+			return bytes.Equal(root, genesisRoot) || bytes.Equal(root, anchorRoot)
+		}
+		if err := kv.pruneBlocksBelow(ctx, tx, anchorSlot, keep); err != nil {
+			return errors.Wrap(err, "could not prune blocks")
+		}
+		if err := pruneSlotIndexed(tx, stateSlotIndicesBucket, stateBucket, anchorSlot, keep); err != nil {
+			return errors.Wrap(err, "could not prune states")
+		}
+		if err := pruneSlotIndexed(tx, stateDiffSlotIndicesBucket, stateDiffBucket, anchorSlot, keep); err != nil {
+			return errors.Wrap(err, "could not prune state diffs")
+		}
+		// A pruned origin block no longer bounds the history of a checkpoint synced node.
+		for _, key := range [][]byte{originBlockRootKey, backfillBlockRootKey} {
+			if root := blocks.Get(key); root != nil && blocks.Get(root) == nil {
+				if err := blocks.Delete(key); err != nil {
+					return err
+				}
+			}
+		}
+		lowest = anchorSlot
+		return tx.Bucket(chainMetadataBucket).Put(lowestRetainedSlotKey, bytesutil.Uint64ToBytesBigEndian(anchorSlot))
+	})
+	return lowest, err
+}
+
+// pruneBlocksBelow deletes the blocks below the given slot, except for the kept ones, along with
+// their index entries, state summaries and states.
+func (kv *Store) pruneBlocksBelow(ctx context.Context, tx *bolt.Tx, slot uint64, keep func([]byte) bool) error {
+	var roots [][]byte
+	c := tx.Bucket(blockSlotIndicesBucket).Cursor()
+	for k, v := c.First(); k != nil && bytesutil.BytesToUint64BigEndian(k) < slot; k, v = c.Next() {
+		for i := 0; i+32 <= len(v); i += 32 {
+			if !keep(v[i : i+32]) {
+				roots = append(roots, append([]byte{}, v[i:i+32]...))
+			}
+		}
+	}
+
+	blocks := tx.Bucket(blocksBucket)
+	for _, root := range roots {
+		if enc := blocks.Get(root); enc != nil {
+			blk := &ethpb.SignedBeaconBlock{}
+			if err := decode(ctx, enc, blk); err != nil {
+				return err
+			}
+			if err := deleteValueForIndices(ctx, createBlockIndicesFromBlock(ctx, blk.Block), root, tx); err != nil {
+				return errors.Wrap(err, "could not delete root for DB indices")
+			}
+			if err := blocks.Delete(root); err != nil {
+				return err
+			}
+			kv.blockCache.Del(string(root))
+		}
+		for _, bkt := range [][]byte{stateSummaryBucket, finalizedBlockRootsIndexBucket, stateBucket} {
+			if err := tx.Bucket(bkt).Delete(root); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// pruneSlotIndexed deletes the values of a slot indexed bucket below the given slot, except for
+// the kept ones, along with their index entries.
+func pruneSlotIndexed(tx *bolt.Tx, indexBucket, valueBucket []byte, slot uint64, keep func([]byte) bool) error {
+	index := tx.Bucket(indexBucket)
+	// Buckets are only modified once iterated, as writes invalidate cursors.
+	kept := make(map[string][]byte)
+	var pruned [][]byte
+	c := index.Cursor()
+	for k, v := c.First(); k != nil && bytesutil.BytesToUint64BigEndian(k) < slot; k, v = c.Next() {
+		var roots []byte
+		for i := 0; i+32 <= len(v); i += 32 {
+			if keep(v[i : i+32]) {
+				roots = append(roots, v[i:i+32]...)
+			} else {
+				pruned = append(pruned, append([]byte{}, v[i:i+32]...))
+			}
+		}
+		kept[string(k)] = roots
+	}
+	for _, root := range pruned {
+		if err := tx.Bucket(valueBucket).Delete(root); err != nil {
+			return err
+		}
+	}
+	for k, roots := range kept {
+		if len(roots) == 0 {
+			if err := index.Delete([]byte(k)); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := index.Put([]byte(k), roots); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func lowestRetainedSlot(tx *bolt.Tx) uint64 {
+	enc := tx.Bucket(chainMetadataBucket).Get(lowestRetainedSlotKey)
+	if enc == nil {
+		return 0
+	}
+	return bytesutil.BytesToUint64BigEndian(enc)
+}
+
+// finalizedStartSlot returns the start slot of the finalized epoch, or zero if nothing was
+// finalized yet.
+func finalizedStartSlot(ctx context.Context, tx *bolt.Tx) (uint64, error) {
+	enc := tx.Bucket(checkpointBucket).Get(finalizedCheckpointKey)
+	if enc == nil {
+		return 0, nil
+	}
+	cp := &ethpb.Checkpoint{}
+	if err := decode(ctx, enc, cp); err != nil {
+		return 0, err
+	}
+	return helpers.StartSlot(cp.Epoch)
+}
@@ -0,0 +1,62 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStore_PruneHistory(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+	chain, archivedRoot := setupVerifiedChain(t, db)
+
+	lowest, err := db.PruneHistory(ctx, 12)
+	require.NoError(t, err)
+	// The archived state at slot 8 is the highest state at or below slot 12.
+	assert.Equal(t, uint64(8), lowest)
+	lowest, err = db.LowestRetainedSlot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(8), lowest)
+
+	for _, blk := range chain {
+		root := bytesutil.ToBytes32(sszRootOrDie(t, blk))
+		if blk.Block.Slot == 0 || blk.Block.Slot >= 8 {
+			assert.Equal(t, true, db.HasBlock(ctx, root), "Missing block at slot %d", blk.Block.Slot)
+			continue
+		}
+		assert.Equal(t, false, db.HasBlock(ctx, root), "Block at slot %d was not pruned", blk.Block.Slot)
+		assert.Equal(t, false, db.HasStateSummary(ctx, root), "State summary at slot %d was not pruned", blk.Block.Slot)
+		assert.Equal(t, false, db.IsFinalizedBlock(ctx, root), "Finalized index at slot %d was not pruned", blk.Block.Slot)
+	}
+	assert.Equal(t, true, db.HasState(ctx, archivedRoot))
+	assert.Equal(t, archivedRoot, db.ArchivedPointRoot(ctx, 8))
+	genesis, err := db.GenesisBlock(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, true, proto.Equal(chain[0], genesis), "Wanted: %v, received: %v", chain[0], genesis)
+
+	found, err := db.Verify(ctx, false)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(found), "Unexpected inconsistencies: %v", found)
+}
+
+func TestStore_PruneHistory_BelowLowestRetainedSlot(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+	setupVerifiedChain(t, db)
+
+	lowest, err := db.PruneHistory(ctx, 4)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), lowest, "Pruned without a state to anchor the retained history")
+
+	lowest, err = db.PruneHistory(ctx, 8)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(8), lowest)
+	lowest, err = db.PruneHistory(ctx, 4)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(8), lowest)
+}
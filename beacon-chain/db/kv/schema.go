@@ -11,6 +11,7 @@ var (
 	blocksBucket            = []byte("blocks")
 	stateBucket             = []byte("state")
 	stateSummaryBucket      = []byte("state-summary")
+	stateDiffBucket         = []byte("state-diff")
 	proposerSlashingsBucket = []byte("proposer-slashings")
 	attesterSlashingsBucket = []byte("attester-slashings")
 	voluntaryExitsBucket    = []byte("voluntary-exits")
@@ -27,6 +28,7 @@ var (
 	blockParentRootIndicesBucket        = []byte("block-parent-root-indices")
 	blockSlotIndicesBucket              = []byte("block-slot-indices")
 	stateSlotIndicesBucket              = []byte("state-slot-indices")
+	stateDiffSlotIndicesBucket          = []byte("state-diff-slot-indices")
 	attestationHeadBlockRootBucket      = []byte("attestation-head-block-root-indices")
 	attestationSourceRootIndicesBucket  = []byte("attestation-source-root-indices")
 	attestationSourceEpochIndicesBucket = []byte("attestation-source-epoch-indices")
@@ -37,10 +39,15 @@ var (
 	// Specific item keys.
 	headBlockRootKey          = []byte("head-root")
 	genesisBlockRootKey       = []byte("genesis-root")
+	originBlockRootKey        = []byte("origin-block-root")
+	backfillBlockRootKey      = []byte("backfill-block-root")
 	depositContractAddressKey = []byte("deposit-contract")
 	justifiedCheckpointKey    = []byte("justified-checkpoint")
 	finalizedCheckpointKey    = []byte("finalized-checkpoint")
 	powchainDataKey           = []byte("powchain-data")
+	lowestRetainedSlotKey     = []byte("lowest-retained-slot")
+	forkChoiceSnapshotKey     = []byte("fork-choice-snapshot")
+	operationPoolsKey         = []byte("operation-pools")
 
 	// Deprecated: This index key was migrated in PR 6461. Do not use, except for migrations.
 	lastArchivedIndexKey = []byte("last-archived")
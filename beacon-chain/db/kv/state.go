@@ -125,7 +125,10 @@ func (kv *Store) SaveStates(ctx context.Context, states []*state.BeaconState, bl
 
 	return kv.db.Update(func(tx *bolt.Tx) error {
 		bucket := tx.Bucket(stateBucket)
-		for i, rt := range blockRoots {
+		for i := range blockRoots {
+			// Bolt holds on to the keys and values until the transaction commits, so each root
+			// needs its own backing array rather than the loop variable.
+			rt := blockRoots[i]
 			indicesByBucket := createStateIndicesFromStateSlot(ctx, states[i].Slot())
 			if err := updateValueForIndices(ctx, indicesByBucket, rt[:], tx); err != nil {
 				return errors.Wrap(err, "could not update DB indices")
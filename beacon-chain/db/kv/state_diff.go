@@ -0,0 +1,99 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/statediff"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// SaveStateDiff saves the diff which rebuilds the state of the input block root from an earlier
+// saved state. Diffs are indexed by the slot of the state they rebuild.
+func (kv *Store) SaveStateDiff(ctx context.Context, blockRoot [32]byte, diff *statediff.Diff) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveStateDiff")
+	defer span.End()
+	if diff == nil {
+		return errors.New("nil state diff")
+	}
+	enc := snappy.Encode(nil, diff.Marshal())
+	return kv.db.Update(func(tx *bolt.Tx) error {
+		indices := map[string][]byte{
+			string(stateDiffSlotIndicesBucket): bytesutil.Uint64ToBytesBigEndian(diff.Slot),
+		}
+		if err := updateValueForIndices(ctx, indices, blockRoot[:], tx); err != nil {
+			return errors.Wrap(err, "could not update DB indices")
+		}
+		return tx.Bucket(stateDiffBucket).Put(blockRoot[:], enc)
+	})
+}
+
+// StateDiff returns the state diff of the input block root, or nil if there is none.
+func (kv *Store) StateDiff(ctx context.Context, blockRoot [32]byte) (*statediff.Diff, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.StateDiff")
+	defer span.End()
+	var diff *statediff.Diff
+	err := kv.db.View(func(tx *bolt.Tx) error {
+		var err error
+		diff, err = decodeStateDiff(tx.Bucket(stateDiffBucket).Get(blockRoot[:]))
+		return err
+	})
+	return diff, err
+}
+
+// HasStateDiff checks if a state diff of the input block root exists in the db.
+func (kv *Store) HasStateDiff(ctx context.Context, blockRoot [32]byte) bool {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.HasStateDiff")
+	defer span.End()
+	var exists bool
+	if err := kv.db.View(func(tx *bolt.Tx) error {
+		exists = tx.Bucket(stateDiffBucket).Get(blockRoot[:]) != nil
+		return nil
+	}); err != nil { // This view never returns an error, but we'll handle anyway for sanity.
+		panic(err)
+	}
+	return exists
+}
+
+// HighestSlotStateDiffBelow returns the state diff with the highest slot below the input slot,
+// or nil if there is none.
+func (kv *Store) HighestSlotStateDiffBelow(ctx context.Context, slot uint64) (*statediff.Diff, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.HighestSlotStateDiffBelow")
+	defer span.End()
+	var diff *statediff.Diff
+	err := kv.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket(stateDiffSlotIndicesBucket).Cursor()
+		k, roots := c.Seek(bytesutil.Uint64ToBytesBigEndian(slot))
+		if k == nil {
+			k, roots = c.Last()
+		} else {
+			k, roots = c.Prev()
+		}
+		if k == nil || len(roots) < 32 {
+			return nil
+		}
+		// Diffs are only saved for the canonical chain, so the last root at the slot is used.
+		var err error
+		diff, err = decodeStateDiff(tx.Bucket(stateDiffBucket).Get(roots[len(roots)-32:]))
+		return err
+	})
+	return diff, err
+}
+
+func decodeStateDiff(enc []byte) (*statediff.Diff, error) {
+	if enc == nil {
+		return nil, nil
+	}
+	data, err := snappy.Decode(nil, enc)
+	if err != nil {
+		return nil, err
+	}
+	diff := &statediff.Diff{}
+	if err := diff.Unmarshal(data); err != nil {
+		return nil, err
+	}
+	return diff, nil
+}
@@ -0,0 +1,72 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/statediff"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStore_StateDiff_CanSaveRetrieve(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	st := testutil.NewBeaconState()
+	base := st.CloneInnerState()
+	target := st.CloneInnerState()
+	target.Slot = 64
+	target.RandaoMixes[1] = bytesutil.PadTo([]byte("mix"), 32)
+	diff, err := statediff.New(base, target)
+	require.NoError(t, err)
+
+	r := bytesutil.ToBytes32([]byte{'A'})
+	require.Equal(t, false, db.HasStateDiff(ctx, r), "State diff should not be saved")
+	saved, err := db.StateDiff(ctx, r)
+	require.NoError(t, err)
+	assert.Equal(t, (*statediff.Diff)(nil), saved)
+
+	require.NoError(t, db.SaveStateDiff(ctx, r, diff))
+	require.Equal(t, true, db.HasStateDiff(ctx, r), "State diff should be saved")
+	saved, err = db.StateDiff(ctx, r)
+	require.NoError(t, err)
+	got, err := saved.Apply(base)
+	require.NoError(t, err)
+	assert.Equal(t, true, proto.Equal(target, got), "Did not rebuild target state")
+}
+
+func TestStore_HighestSlotStateDiffBelow(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	st := testutil.NewBeaconState()
+	base := st.CloneInnerState()
+	for _, slot := range []uint64{64, 128, 192} {
+		target := st.CloneInnerState()
+		target.Slot = slot
+		diff, err := statediff.New(base, target)
+		require.NoError(t, err)
+		require.NoError(t, db.SaveStateDiff(ctx, bytesutil.ToBytes32(bytesutil.Bytes8(slot)), diff))
+	}
+
+	tests := []struct {
+		slot   uint64
+		wanted uint64
+	}{
+		{slot: 65, wanted: 64},
+		{slot: 128, wanted: 64},
+		{slot: 129, wanted: 128},
+		{slot: 1000, wanted: 192},
+	}
+	for _, tt := range tests {
+		diff, err := db.HighestSlotStateDiffBelow(ctx, tt.slot)
+		require.NoError(t, err)
+		require.NotNil(t, diff)
+		assert.Equal(t, tt.wanted, diff.Slot, "Wrong diff below slot %d", tt.slot)
+	}
+	diff, err := db.HighestSlotStateDiffBelow(ctx, 64)
+	require.NoError(t, err)
+	assert.Equal(t, (*statediff.Diff)(nil), diff)
+}
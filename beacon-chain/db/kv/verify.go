@@ -0,0 +1,572 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// Names of the checks run by Verify.
+const (
+	CheckGenesis        = "genesis"
+	CheckHead           = "head"
+	CheckBlockParents   = "block-parents"
+	CheckBlockIndices   = "block-indices"
+	CheckFinalizedIndex = "finalized-index"
+	CheckArchivedPoints = "archived-points"
+	CheckStateSummaries = "state-summaries"
+	CheckDecoding       = "decoding"
+)
+
+// Inconsistency describes a single problem found in the database by Verify.
+type Inconsistency struct {
+	Check   string
+	Message string
+}
+
+// String returns a human readable description of the inconsistency.
+func (i *Inconsistency) String() string {
+	return fmt.Sprintf("%s: %s", i.Check, i.Message)
+}
+
+// blockInfo holds the fields of a block needed to verify the database.
+type blockInfo struct {
+	slot       uint64
+	parentRoot [32]byte
+}
+
+// verifier accumulates the inconsistencies found while walking the database.
+type verifier struct {
+	ctx    context.Context
+	found  []*Inconsistency
+	blocks map[[32]byte]*blockInfo
+	states map[[32]byte]uint64
+	diffs  map[[32]byte]uint64
+	// Roots in database key order, so inconsistencies are reported deterministically.
+	blockRoots [][32]byte
+	stateRoots [][32]byte
+	diffRoots  [][32]byte
+	genesis    []byte
+	origin     []byte
+	backfill   []byte
+	prunedSlot uint64
+}
+
+func (v *verifier) report(check string, format string, args ...interface{}) {
+	v.found = append(v.found, &Inconsistency{Check: check, Message: fmt.Sprintf(format, args...)})
+}
+
+// Verify walks the database and reports every inconsistency between the stored blocks and states
+// and the data derived from them: the parent chain of every block, the block slot and parent root
+// indices, the finalized block roots index, the archived point (state slot) index, the state
+// summaries, and the genesis and head block roots.
+//
+// If repair is set and inconsistencies were found, the derived indices are rebuilt from the blocks,
+// states and finalized checkpoint. Blocks and states themselves are never modified, so problems with
+// them are only reported. The returned inconsistencies are those found before repairing.
+func (kv *Store) Verify(ctx context.Context, repair bool) ([]*Inconsistency, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.Verify")
+	defer span.End()
+
+	v := &verifier{
+		ctx:    ctx,
+		blocks: make(map[[32]byte]*blockInfo),
+		states: make(map[[32]byte]uint64),
+		diffs:  make(map[[32]byte]uint64),
+	}
+	if err := kv.db.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(blocksBucket)
+		v.genesis = bkt.Get(genesisBlockRootKey)
+		v.origin = bkt.Get(originBlockRootKey)
+		v.backfill = bkt.Get(backfillBlockRootKey)
+		v.prunedSlot = lowestRetainedSlot(tx)
+		if err := v.loadBlocks(tx); err != nil {
+			return err
+		}
+		if err := v.loadStates(tx); err != nil {
+			return err
+		}
+		v.verifyGenesisAndHead(tx)
+		v.verifyBlocks(tx)
+		if err := v.verifyStateSummaries(tx); err != nil {
+			return err
+		}
+		v.verifyArchivedPoints(tx)
+		return v.verifyFinalizedIndex(tx)
+	}); err != nil {
+		return nil, err
+	}
+
+	if !repair || len(v.found) == 0 {
+		return v.found, nil
+	}
+	if err := kv.repairIndices(ctx, v); err != nil {
+		return v.found, errors.Wrap(err, "could not repair database indices")
+	}
+	return v.found, nil
+}
+
+// loadBlocks reads the slot and parent root of every block in the database.
+func (v *verifier) loadBlocks(tx *bolt.Tx) error {
+	return tx.Bucket(blocksBucket).ForEach(func(k, enc []byte) error {
+		// Skip the metadata keys stored alongside the blocks.
+		if len(k) != 32 {
+			return nil
+		}
+		blk := &ethpb.SignedBeaconBlock{}
+		if err := decode(v.ctx, enc, blk); err != nil || blk.Block == nil {
+			v.report(CheckDecoding, "block %#x could not be decoded", k)
+			return nil
+		}
+		root := bytesutil.ToBytes32(k)
+		v.blocks[root] = &blockInfo{
+			slot:       blk.Block.Slot,
+			parentRoot: bytesutil.ToBytes32(blk.Block.ParentRoot),
+		}
+		v.blockRoots = append(v.blockRoots, root)
+		return nil
+	})
+}
+
+// loadStates reads the slot of every state and state diff in the database.
+func (v *verifier) loadStates(tx *bolt.Tx) error {
+	if err := tx.Bucket(stateBucket).ForEach(func(k, enc []byte) error {
+		st, err := createState(v.ctx, enc)
+		if err != nil {
+			v.report(CheckDecoding, "state %#x could not be decoded", k)
+			return nil
+		}
+		root := bytesutil.ToBytes32(k)
+		v.states[root] = st.Slot
+		v.stateRoots = append(v.stateRoots, root)
+		return nil
+	}); err != nil {
+		return err
+	}
+	return tx.Bucket(stateDiffBucket).ForEach(func(k, enc []byte) error {
+		diff, err := decodeStateDiff(enc)
+		if err != nil {
+			v.report(CheckDecoding, "state diff %#x could not be decoded", k)
+			return nil
+		}
+		root := bytesutil.ToBytes32(k)
+		v.diffs[root] = diff.Slot
+		v.diffRoots = append(v.diffRoots, root)
+		return nil
+	})
+}
+
+func (v *verifier) verifyGenesisAndHead(tx *bolt.Tx) {
+	if v.genesis == nil {
+		// A checkpoint synced node only learns the genesis block root once backfill completes, or
+		// never if it prunes its history.
+		if v.origin == nil && v.prunedSlot == 0 && len(v.blocks) > 0 {
+			v.report(CheckGenesis, "genesis block root is not set")
+		}
+	} else if blk, ok := v.blocks[bytesutil.ToBytes32(v.genesis)]; !ok {
+		v.report(CheckGenesis, "genesis block %#x is missing", v.genesis)
+	} else if blk.slot != 0 {
+		v.report(CheckGenesis, "genesis block %#x is at slot %d", v.genesis, blk.slot)
+	}
+	if v.origin != nil {
+		if _, ok := v.blocks[bytesutil.ToBytes32(v.origin)]; !ok {
+			v.report(CheckGenesis, "origin block %#x is missing", v.origin)
+		}
+	}
+
+	headRoot := tx.Bucket(blocksBucket).Get(headBlockRootKey)
+	if headRoot == nil {
+		if len(v.blocks) > 0 {
+			v.report(CheckHead, "head block root is not set")
+		}
+		return
+	}
+	if _, ok := v.blocks[bytesutil.ToBytes32(headRoot)]; !ok {
+		v.report(CheckHead, "head block %#x is missing", headRoot)
+	}
+	if tx.Bucket(stateBucket).Get(headRoot) == nil && tx.Bucket(stateSummaryBucket).Get(headRoot) == nil {
+		v.report(CheckHead, "head block %#x has neither a state nor a state summary", headRoot)
+	}
+}
+
+// verifyBlocks checks that the parent of every block is in the database and that every block is
+// present in the block slot and parent root indices, and conversely.
+func (v *verifier) verifyBlocks(tx *bolt.Tx) {
+	slotIndex := tx.Bucket(blockSlotIndicesBucket)
+	parentIndex := tx.Bucket(blockParentRootIndicesBucket)
+	for _, root := range v.blockRoots {
+		blk := v.blocks[root]
+		if !v.mayMissParent(root, blk) {
+			if _, ok := v.blocks[blk.parentRoot]; !ok {
+				v.report(CheckBlockParents, "block %#x at slot %d has missing parent %#x", root, blk.slot, blk.parentRoot)
+			}
+		}
+		if !containsRoot(slotIndex.Get(bytesutil.Uint64ToBytesBigEndian(blk.slot)), root[:]) {
+			v.report(CheckBlockIndices, "block %#x is missing from the slot index at slot %d", root, blk.slot)
+		}
+		if !containsRoot(parentIndex.Get(blk.parentRoot[:]), root[:]) {
+			v.report(CheckBlockIndices, "block %#x is missing from the parent root index of %#x", root, blk.parentRoot)
+		}
+	}
+
+	c := slotIndex.Cursor()
+	for k, roots := c.First(); k != nil; k, roots = c.Next() {
+		slot := bytesutil.BytesToUint64BigEndian(k)
+		for i := 0; i+32 <= len(roots); i += 32 {
+			blk, ok := v.blocks[bytesutil.ToBytes32(roots[i:i+32])]
+			if !ok {
+				v.report(CheckBlockIndices, "slot index at slot %d references missing block %#x", slot, roots[i:i+32])
+			} else if blk.slot != slot {
+				v.report(CheckBlockIndices, "slot index at slot %d references block %#x at slot %d", slot, roots[i:i+32], blk.slot)
+			}
+		}
+	}
+	c = parentIndex.Cursor()
+	for k, roots := c.First(); k != nil; k, roots = c.Next() {
+		for i := 0; i+32 <= len(roots); i += 32 {
+			blk, ok := v.blocks[bytesutil.ToBytes32(roots[i:i+32])]
+			if !ok {
+				v.report(CheckBlockIndices, "parent root index of %#x references missing block %#x", k, roots[i:i+32])
+			} else if !bytes.Equal(blk.parentRoot[:], k) {
+				v.report(CheckBlockIndices, "parent root index of %#x references block %#x with parent %#x", k, roots[i:i+32], blk.parentRoot)
+			}
+		}
+	}
+}
+
+// mayMissParent returns true for the blocks whose parent is not expected to be in the database:
+// the genesis block, the lowest block of a checkpoint synced node and the lowest retained block of
+// a node pruning its history.
+func (v *verifier) mayMissParent(root [32]byte, blk *blockInfo) bool {
+	if blk.slot == 0 || bytes.Equal(root[:], v.genesis) {
+		return true
+	}
+	if v.prunedSlot > 0 && blk.slot <= v.prunedSlot {
+		return true
+	}
+	if v.backfill != nil {
+		return bytes.Equal(root[:], v.backfill)
+	}
+	return bytes.Equal(root[:], v.origin)
+}
+
+// verifyStateSummaries checks that every block has a state summary at the block slot, or past it
+// for a block with a state advanced through empty slots, and that every state summary references a
+// block or a state.
+func (v *verifier) verifyStateSummaries(tx *bolt.Tx) error {
+	bkt := tx.Bucket(stateSummaryBucket)
+	for _, root := range v.blockRoots {
+		blk := v.blocks[root]
+		enc := bkt.Get(root[:])
+		if enc == nil {
+			v.report(CheckStateSummaries, "block %#x at slot %d has no state summary", root, blk.slot)
+			continue
+		}
+		summary := &pb.StateSummary{}
+		if err := decode(v.ctx, enc, summary); err != nil {
+			return err
+		}
+		if !v.validSummarySlot(root, summary.Slot) {
+			v.report(CheckStateSummaries, "state summary of block %#x is at slot %d, block is at slot %d", root, summary.Slot, blk.slot)
+		}
+	}
+	return bkt.ForEach(func(k, _ []byte) error {
+		root := bytesutil.ToBytes32(k)
+		if _, ok := v.blocks[root]; ok {
+			return nil
+		}
+		if _, ok := v.states[root]; !ok {
+			v.report(CheckStateSummaries, "state summary %#x references neither a block nor a state", k)
+		}
+		return nil
+	})
+}
+
+// validSummarySlot returns true if a state summary of a block may be at slot. The summary of a
+// state advanced through empty slots past its block, such as the origin state of a checkpoint
+// synced node or a state archived at a skipped slot, is at the slot of the state.
+func (v *verifier) validSummarySlot(root [32]byte, slot uint64) bool {
+	blk := v.blocks[root]
+	if slot == blk.slot {
+		return true
+	}
+	_, hasState := v.states[root]
+	_, hasDiff := v.diffs[root]
+	return (hasState || hasDiff) && slot > blk.slot
+}
+
+// verifyArchivedPoints checks the state slot index, which holds the archived points since the
+// archived index migration, and the state diff slot index against the saved states and diffs.
+func (v *verifier) verifyArchivedPoints(tx *bolt.Tx) {
+	if tx.Bucket(archivedRootBucket) != nil &&
+		!bytes.Equal(tx.Bucket(migrationsBucket).Get(migrationArchivedIndex0Key), migrationCompleted) {
+		v.report(CheckArchivedPoints, "deprecated archived index has not been migrated")
+	}
+
+	v.verifySlotIndex(tx.Bucket(stateSlotIndicesBucket), "state", v.stateRoots, v.states)
+	v.verifySlotIndex(tx.Bucket(stateDiffSlotIndicesBucket), "state diff", v.diffRoots, v.diffs)
+}
+
+// verifySlotIndex checks that every state, or state diff, is indexed at its slot and has a block,
+// and that the index only references saved entries.
+func (v *verifier) verifySlotIndex(slotIndex *bolt.Bucket, name string, roots [][32]byte, slots map[[32]byte]uint64) {
+	for _, root := range roots {
+		slot := slots[root]
+		if _, ok := v.blocks[root]; !ok {
+			v.report(CheckArchivedPoints, "%s %#x at slot %d has no block", name, root, slot)
+		}
+		if !containsRoot(slotIndex.Get(bytesutil.Uint64ToBytesBigEndian(slot)), root[:]) {
+			v.report(CheckArchivedPoints, "%s %#x is missing from the slot index at slot %d", name, root, slot)
+		}
+	}
+	c := slotIndex.Cursor()
+	for k, indexed := c.First(); k != nil; k, indexed = c.Next() {
+		slot := bytesutil.BytesToUint64BigEndian(k)
+		for i := 0; i+32 <= len(indexed); i += 32 {
+			indexedSlot, ok := slots[bytesutil.ToBytes32(indexed[i:i+32])]
+			if !ok {
+				v.report(CheckArchivedPoints, "%s slot index at slot %d references missing %s %#x", name, slot, name, indexed[i:i+32])
+			} else if indexedSlot != slot {
+				v.report(CheckArchivedPoints, "%s slot index at slot %d references %s %#x at slot %d", name, slot, name, indexed[i:i+32], indexedSlot)
+			}
+		}
+	}
+}
+
+// verifyFinalizedIndex checks that the finalized block roots index holds the canonical chain from
+// the finalized checkpoint back to the genesis or origin block, and only references known blocks.
+func (v *verifier) verifyFinalizedIndex(tx *bolt.Tx) error {
+	bkt := tx.Bucket(finalizedBlockRootsIndexBucket)
+	if err := bkt.ForEach(func(k, _ []byte) error {
+		if bytes.Equal(k, previousFinalizedCheckpointKey) {
+			return nil
+		}
+		if _, ok := v.blocks[bytesutil.ToBytes32(k)]; !ok {
+			v.report(CheckFinalizedIndex, "finalized block roots index references missing block %#x", k)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	enc := tx.Bucket(checkpointBucket).Get(finalizedCheckpointKey)
+	if enc == nil {
+		return nil
+	}
+	cp := &ethpb.Checkpoint{}
+	if err := decode(v.ctx, enc, cp); err != nil {
+		return err
+	}
+	if bytes.Equal(cp.Root, v.genesis) {
+		return nil
+	}
+	if prev := bkt.Get(previousFinalizedCheckpointKey); prev != nil {
+		prevCp := &ethpb.Checkpoint{}
+		if err := decode(v.ctx, prev, prevCp); err != nil {
+			return err
+		}
+		if prevCp.Epoch != cp.Epoch || !bytes.Equal(prevCp.Root, cp.Root) {
+			v.report(CheckFinalizedIndex, "finalized block roots index was built for epoch %d, finalized epoch is %d", prevCp.Epoch, cp.Epoch)
+		}
+	} else {
+		v.report(CheckFinalizedIndex, "finalized block roots index was never built for finalized epoch %d", cp.Epoch)
+	}
+
+	root := bytesutil.ToBytes32(cp.Root)
+	for {
+		blk, ok := v.blocks[root]
+		if !ok {
+			v.report(CheckFinalizedIndex, "finalized block %#x is missing", root)
+			return nil
+		}
+		indexed := bkt.Get(root[:])
+		if indexed == nil || bytes.Equal(indexed, containerFinalizedButNotCanonical) {
+			v.report(CheckFinalizedIndex, "finalized block %#x at slot %d is not indexed as canonical", root, blk.slot)
+		} else {
+			container := &dbpb.FinalizedBlockRootContainer{}
+			if err := decode(v.ctx, indexed, container); err != nil {
+				return err
+			}
+			if !bytes.Equal(container.ParentRoot, blk.parentRoot[:]) {
+				v.report(CheckFinalizedIndex, "finalized block %#x at slot %d is indexed with parent %#x, wanted %#x",
+					root, blk.slot, container.ParentRoot, blk.parentRoot)
+			}
+		}
+		// Blocks below the origin block, or below the lowest backfilled block once backfill has
+		// started, and below the lowest retained slot are not indexed.
+		if bytes.Equal(root[:], v.lowestIndexedRoot()) || blk.slot == 0 || bytes.Equal(blk.parentRoot[:], v.genesis) {
+			return nil
+		}
+		if v.prunedSlot > 0 && blk.slot <= v.prunedSlot {
+			return nil
+		}
+		root = blk.parentRoot
+	}
+}
+
+// lowestIndexedRoot returns the root of the lowest block of the finalized block roots index of a
+// checkpoint synced node: the lowest backfilled block, or the origin block before backfill starts.
+func (v *verifier) lowestIndexedRoot() []byte {
+	if v.backfill != nil {
+		return v.backfill
+	}
+	return v.origin
+}
+
+// repairIndices rebuilds the indices derived from the blocks, states and finalized checkpoint.
+// Each index is rebuilt in its own transaction, as rebuilding the finalized block roots index
+// reads the rebuilt block slot index.
+func (kv *Store) repairIndices(ctx context.Context, v *verifier) error {
+	if err := kv.db.Update(func(tx *bolt.Tx) error {
+		if err := recreateBucket(tx, blockSlotIndicesBucket); err != nil {
+			return err
+		}
+		if err := recreateBucket(tx, blockParentRootIndicesBucket); err != nil {
+			return err
+		}
+		for i := range v.blockRoots {
+			// Bolt holds on to the keys and values until the transaction commits, so each root
+			// needs its own backing array rather than the loop variable, here and below.
+			root := v.blockRoots[i]
+			blk := v.blocks[root]
+			indices := createBlockIndicesFromBlock(ctx, &ethpb.BeaconBlock{Slot: blk.slot, ParentRoot: blk.parentRoot[:]})
+			if err := updateValueForIndices(ctx, indices, root[:], tx); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "could not rebuild block indices")
+	}
+
+	if err := kv.db.Update(func(tx *bolt.Tx) error {
+		if err := recreateBucket(tx, stateSlotIndicesBucket); err != nil {
+			return err
+		}
+		for i := range v.stateRoots {
+			root := v.stateRoots[i]
+			slot := v.states[root]
+			if err := updateValueForIndices(ctx, createStateIndicesFromStateSlot(ctx, slot), root[:], tx); err != nil {
+				return err
+			}
+		}
+		if err := recreateBucket(tx, stateDiffSlotIndicesBucket); err != nil {
+			return err
+		}
+		for i := range v.diffRoots {
+			root := v.diffRoots[i]
+			indices := map[string][]byte{
+				string(stateDiffSlotIndicesBucket): bytesutil.Uint64ToBytesBigEndian(v.diffs[root]),
+			}
+			if err := updateValueForIndices(ctx, indices, root[:], tx); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "could not rebuild state slot indices")
+	}
+
+	if err := kv.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(stateSummaryBucket)
+		for i := range v.blockRoots {
+			root := v.blockRoots[i]
+			if enc := bkt.Get(root[:]); enc != nil {
+				summary := &pb.StateSummary{}
+				if err := decode(ctx, enc, summary); err == nil && v.validSummarySlot(root, summary.Slot) {
+					continue
+				}
+			}
+			blk := v.blocks[root]
+			enc, err := encode(ctx, &pb.StateSummary{Slot: blk.slot, Root: root[:]})
+			if err != nil {
+				return err
+			}
+			if err := bkt.Put(root[:], enc); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "could not rebuild state summaries")
+	}
+
+	return errors.Wrap(kv.db.Update(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(checkpointBucket).Get(finalizedCheckpointKey)
+		if err := recreateBucket(tx, finalizedBlockRootsIndexBucket); err != nil {
+			return err
+		}
+		if enc == nil {
+			return nil
+		}
+		cp := &ethpb.Checkpoint{}
+		if err := decode(ctx, enc, cp); err != nil {
+			return err
+		}
+		if err := kv.updateFinalizedBlockRoots(ctx, tx, cp); err != nil {
+			return err
+		}
+		return v.indexBackfilledBlocks(ctx, tx)
+	}), "could not rebuild finalized block roots index")
+}
+
+// indexBackfilledBlocks indexes the blocks saved by the historical block backfill as finalized.
+// Rebuilding the finalized block roots index from the finalized checkpoint stops at the origin
+// block, so the chain below it is walked down to the lowest backfilled block.
+func (v *verifier) indexBackfilledBlocks(ctx context.Context, tx *bolt.Tx) error {
+	if v.origin == nil || v.backfill == nil {
+		return nil
+	}
+	bkt := tx.Bucket(finalizedBlockRootsIndexBucket)
+	childRoot := bytesutil.ToBytes32(v.origin)
+	for !bytes.Equal(childRoot[:], v.backfill) {
+		child, ok := v.blocks[childRoot]
+		if !ok || child.slot == 0 {
+			return nil
+		}
+		root := child.parentRoot
+		blk, ok := v.blocks[root]
+		if !ok {
+			return nil
+		}
+		enc, err := encode(ctx, &dbpb.FinalizedBlockRootContainer{
+			ParentRoot: blk.parentRoot[:],
+			ChildRoot:  childRoot[:],
+		})
+		if err != nil {
+			return err
+		}
+		if err := bkt.Put(root[:], enc); err != nil {
+			return err
+		}
+		childRoot = root
+	}
+	return nil
+}
+
+// recreateBucket empties a bucket by deleting and creating it again.
+func recreateBucket(tx *bolt.Tx, name []byte) error {
+	if err := tx.DeleteBucket(name); err != nil && err != bolt.ErrBucketNotFound {
+		return err
+	}
+	_, err := tx.CreateBucket(name)
+	return err
+}
+
+// containsRoot returns true if a concatenation of 32 byte roots contains root.
+func containsRoot(roots []byte, root []byte) bool {
+	for i := 0; i+32 <= len(roots); i += 32 {
+		if bytes.Equal(roots[i:i+32], root) {
+			return true
+		}
+	}
+	return false
+}
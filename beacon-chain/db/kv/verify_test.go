@@ -0,0 +1,174 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	bolt "go.etcd.io/bbolt"
+)
+
+// setupVerifiedChain saves a finalized chain along with the state summaries and head block root
+// a running node would have written for it.
+func setupVerifiedChain(t *testing.T, db *Store) ([]*ethpb.SignedBeaconBlock, [32]byte) {
+	ctx := context.Background()
+	chain, archivedRoot := setupFinalizedChain(t, db, 20)
+	summaries := make([]*pb.StateSummary, len(chain))
+	for i, blk := range chain {
+		summaries[i] = &pb.StateSummary{Slot: blk.Block.Slot, Root: sszRootOrDie(t, blk)}
+	}
+	require.NoError(t, db.SaveStateSummaries(ctx, summaries))
+	require.NoError(t, db.SaveHeadBlockRoot(ctx, bytesutil.ToBytes32(sszRootOrDie(t, chain[len(chain)-1]))))
+	return chain, archivedRoot
+}
+
+func checksOf(found []*Inconsistency) map[string]int {
+	checks := make(map[string]int)
+	for _, i := range found {
+		checks[i.Check]++
+	}
+	return checks
+}
+
+func TestStore_Verify_Consistent(t *testing.T) {
+	db := setupDB(t)
+	setupVerifiedChain(t, db)
+
+	found, err := db.Verify(context.Background(), false)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(found), "Unexpected inconsistencies: %v", found)
+}
+
+func TestStore_Verify_CheckpointSynced(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	slot := params.BeaconConfig().SlotsPerEpoch * 3
+	st := testutil.NewBeaconState()
+	require.NoError(t, st.SetSlot(slot))
+	blk, _ := originStateAndBlock(t, slot)
+	require.NoError(t, db.SaveOriginCheckpoint(ctx, st, blk))
+
+	found, err := db.Verify(ctx, false)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(found), "Unexpected inconsistencies: %v", found)
+}
+
+func TestStore_Verify_ReportsAndRepairsIndices(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	chain, archivedRoot := setupVerifiedChain(t, db)
+
+	orphan := testutil.NewBeaconBlock()
+	orphan.Block.Slot = 25
+	orphan.Block.ParentRoot = bytesutil.PadTo([]byte("unknown"), 32)
+	require.NoError(t, db.SaveBlock(ctx, orphan))
+	require.NoError(t, db.SaveStateSummary(ctx, &pb.StateSummary{Slot: 25, Root: sszRootOrDie(t, orphan)}))
+
+	require.NoError(t, db.db.Update(func(tx *bolt.Tx) error {
+		if err := tx.Bucket(blockSlotIndicesBucket).Delete(bytesutil.Uint64ToBytesBigEndian(3)); err != nil {
+			return err
+		}
+		if err := tx.Bucket(stateSummaryBucket).Delete(sszRootOrDie(t, chain[5])); err != nil {
+			return err
+		}
+		if err := tx.Bucket(finalizedBlockRootsIndexBucket).Delete(sszRootOrDie(t, chain[10])); err != nil {
+			return err
+		}
+		return tx.Bucket(stateSlotIndicesBucket).Delete(bytesutil.Uint64ToBytesBigEndian(8))
+	}))
+
+	found, err := db.Verify(ctx, false)
+	require.NoError(t, err)
+	checks := checksOf(found)
+	assert.Equal(t, 1, checks[CheckBlockParents])
+	assert.Equal(t, 1, checks[CheckBlockIndices])
+	assert.Equal(t, 1, checks[CheckStateSummaries])
+	assert.Equal(t, 1, checks[CheckFinalizedIndex])
+	assert.Equal(t, 1, checks[CheckArchivedPoints])
+
+	found, err = db.Verify(ctx, true)
+	require.NoError(t, err)
+	assert.Equal(t, len(checks), len(checksOf(found)))
+
+	// Only the missing parent, which cannot be derived, remains.
+	found, err = db.Verify(ctx, false)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(found), "Unexpected inconsistencies: %v", found)
+	assert.Equal(t, CheckBlockParents, found[0].Check)
+	for _, blk := range chain {
+		root := bytesutil.ToBytes32(sszRootOrDie(t, blk))
+		assert.Equal(t, true, db.IsFinalizedBlock(ctx, root), "Block at slot %d is not finalized", blk.Block.Slot)
+		assert.Equal(t, true, db.HasStateSummary(ctx, root), "Missing state summary at slot %d", blk.Block.Slot)
+	}
+	assert.Equal(t, archivedRoot, db.ArchivedPointRoot(ctx, 8))
+}
+
+func TestStore_Verify_GenesisAndHead(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	chain, _ := setupVerifiedChain(t, db)
+
+	require.NoError(t, db.db.Update(func(tx *bolt.Tx) error {
+		if err := tx.Bucket(blocksBucket).Put(genesisBlockRootKey, sszRootOrDie(t, chain[1])); err != nil {
+			return err
+		}
+		return tx.Bucket(blocksBucket).Put(headBlockRootKey, bytesutil.PadTo([]byte("missing"), 32))
+	}))
+
+	found, err := db.Verify(ctx, false)
+	require.NoError(t, err)
+	checks := checksOf(found)
+	assert.Equal(t, 1, checks[CheckGenesis])
+	assert.Equal(t, 2, checks[CheckHead])
+}
+
+func TestStore_Verify_RepairsBackfilledCheckpointSync(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	// The origin block is below the start of epoch 2 and its state is advanced to it, so the
+	// origin state summary is past the origin block.
+	slot := params.BeaconConfig().SlotsPerEpoch * 2
+	blks := makeBlocks(t, 0, slot-2, [32]byte{})
+	origin, st := archivedStateForBlock(t, blks[len(blks)-1])
+	header := st.LatestBlockHeader()
+	header.StateRoot = origin.Block.StateRoot
+	require.NoError(t, st.SetLatestBlockHeader(header))
+	require.NoError(t, st.SetSlot(slot))
+	require.NoError(t, db.SaveOriginCheckpoint(ctx, st, origin))
+	require.NoError(t, db.SaveBackfillBlocks(ctx, blks[:len(blks)-1]))
+
+	found, err := db.Verify(ctx, false)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(found), "Unexpected inconsistencies: %v", found)
+
+	require.NoError(t, db.db.Update(func(tx *bolt.Tx) error {
+		if err := tx.Bucket(stateSummaryBucket).Delete(sszRootOrDie(t, blks[2])); err != nil {
+			return err
+		}
+		return tx.Bucket(finalizedBlockRootsIndexBucket).Delete(sszRootOrDie(t, blks[3]))
+	}))
+	found, err = db.Verify(ctx, true)
+	require.NoError(t, err)
+	checks := checksOf(found)
+	assert.Equal(t, 1, checks[CheckStateSummaries])
+	assert.Equal(t, 1, checks[CheckFinalizedIndex])
+
+	found, err = db.Verify(ctx, false)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(found), "Unexpected inconsistencies: %v", found)
+	for _, blk := range blks {
+		root := bytesutil.ToBytes32(sszRootOrDie(t, blk))
+		assert.Equal(t, true, db.IsFinalizedBlock(ctx, root), "Block at slot %d is not finalized", blk.Block.Slot)
+	}
+	summary, err := db.StateSummary(ctx, bytesutil.ToBytes32(sszRootOrDie(t, origin)))
+	require.NoError(t, err)
+	assert.Equal(t, slot, summary.Slot)
+}
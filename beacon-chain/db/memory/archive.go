@@ -0,0 +1,268 @@
+package memory
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/archive"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+)
+
+// ExportArchive writes the finalized blocks and the archived point states of the canonical chain
+// within the slot range [startSlot, startSlot+slotCount) to w, using the archive file format.
+func (s *Store) ExportArchive(ctx context.Context, w io.Writer, startSlot, slotCount uint64) error {
+	if slotCount == 0 {
+		return errors.New("slot count must be greater than 0")
+	}
+	endSlot := startSlot + slotCount
+
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	var originSlot uint64
+	if s.originRoot != nil {
+		origin, ok := s.blocks[*s.originRoot]
+		if !ok {
+			return errors.New("origin block not found in database")
+		}
+		originSlot = origin.Block.Slot
+	}
+
+	aw := archive.NewWriter(w)
+	if err := aw.WriteHeader(startSlot, slotCount); err != nil {
+		return errors.Wrap(err, "could not write archive header")
+	}
+	for _, root := range s.canonicalRootsInRange(s.blockSlotIndex, startSlot, endSlot, originSlot) {
+		blk, ok := s.blocks[root]
+		if !ok {
+			return fmt.Errorf("missing block in database: block root=%#x", root)
+		}
+		if err := aw.WriteBlock(blk); err != nil {
+			return errors.Wrapf(err, "could not write block at slot %d", blk.Block.Slot)
+		}
+	}
+	for _, root := range s.canonicalRootsInRange(s.stateSlotIndex, startSlot, endSlot, originSlot) {
+		st, ok := s.states[root]
+		if !ok {
+			return fmt.Errorf("missing state in database: block root=%#x", root)
+		}
+		if err := aw.WriteState(st); err != nil {
+			return errors.Wrapf(err, "could not write state at slot %d", st.Slot)
+		}
+	}
+	return nil
+}
+
+// ImportArchive reads an archive file from r and saves its blocks and states. Since archives only
+// contain the finalized canonical chain, the imported blocks are added to the finalized block roots
+// index, and state summaries of the imported blocks and states are saved. The first imported block
+// must be the genesis block or a child of the genesis block or of the highest finalized block in
+// the store, so archives are imported in slot order. A failed import leaves the store unchanged.
+func (s *Store) ImportArchive(ctx context.Context, r io.Reader) error {
+	ar := archive.NewReader(r)
+	startSlot, slotCount, err := ar.ReadHeader()
+	if err != nil {
+		return err
+	}
+	endSlot := startSlot + slotCount
+
+	var blks []*ethpb.SignedBeaconBlock
+	var blockRoots [][32]byte
+	var states []*state.BeaconState
+	var stateRoots [][32]byte
+	importedRoots := make(map[[32]byte]bool)
+	for {
+		rec, err := ar.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		switch rec.Type {
+		case archive.TypeCompressedBlock:
+			blk, err := rec.Block()
+			if err != nil {
+				return err
+			}
+			if blk.Block.Slot < startSlot || blk.Block.Slot >= endSlot {
+				return fmt.Errorf("block at slot %d is outside of the archive slot range", blk.Block.Slot)
+			}
+			if len(blks) > 0 {
+				prev := blks[len(blks)-1]
+				if blk.Block.Slot <= prev.Block.Slot || !bytes.Equal(blk.Block.ParentRoot, blockRoots[len(blockRoots)-1][:]) {
+					return fmt.Errorf("block at slot %d does not descend from block at slot %d", blk.Block.Slot, prev.Block.Slot)
+				}
+			}
+			root, err := blk.Block.HashTreeRoot()
+			if err != nil {
+				return err
+			}
+			blks = append(blks, blk)
+			blockRoots = append(blockRoots, root)
+			importedRoots[root] = true
+		case archive.TypeCompressedState:
+			pbState, err := rec.State()
+			if err != nil {
+				return err
+			}
+			if pbState.Slot < startSlot || pbState.Slot >= endSlot {
+				return fmt.Errorf("state at slot %d is outside of the archive slot range", pbState.Slot)
+			}
+			st, err := state.InitializeFromProtoUnsafe(pbState)
+			if err != nil {
+				return err
+			}
+			root, err := latestBlockRoot(ctx, st)
+			if err != nil {
+				return err
+			}
+			if !importedRoots[root] && !s.HasBlock(ctx, root) {
+				return fmt.Errorf("state at slot %d has unknown latest block root %#x", st.Slot(), root)
+			}
+			states = append(states, st)
+			stateRoots = append(stateRoots, root)
+		default:
+			return errors.Wrapf(archive.ErrUnexpectedRecord, "record type %#x", rec.Type)
+		}
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if len(blks) > 0 {
+		if err := s.checkArchiveLinksToChain(blks[0], blockRoots[0]); err != nil {
+			return err
+		}
+	}
+	for i, blk := range blks {
+		if _, ok := s.blocks[blockRoots[i]]; !ok {
+			s.blocks[blockRoots[i]] = blk
+			s.indexBlock(blk.Block, blockRoots[i])
+		}
+		s.stateSummaries[blockRoots[i]] = &pb.StateSummary{Slot: blk.Block.Slot, Root: blockRoots[i][:]}
+	}
+	for i, st := range states {
+		s.saveState(st.InnerStateUnsafe(), stateRoots[i])
+		s.stateSummaries[stateRoots[i]] = &pb.StateSummary{Slot: st.Slot(), Root: stateRoots[i][:]}
+	}
+	s.indexFinalizedChain(blks, blockRoots)
+	return nil
+}
+
+// checkArchiveLinksToChain checks that the first block of an archive extends the chain in the
+// store: it is either the genesis block, or its parent is the genesis block or the highest block
+// of the finalized canonical chain.
+func (s *Store) checkArchiveLinksToChain(first *ethpb.SignedBeaconBlock, firstRoot [32]byte) error {
+	if first.Block.Slot == 0 {
+		if s.genesisRoot != nil && *s.genesisRoot != firstRoot {
+			return fmt.Errorf("archive genesis block %#x does not match the genesis block %#x in the database", firstRoot, *s.genesisRoot)
+		}
+		return nil
+	}
+	parentRoot := bytesutil.ToBytes32(first.Block.ParentRoot)
+	if s.genesisRoot != nil && parentRoot == *s.genesisRoot {
+		return nil
+	}
+	tipRoot, ok := s.finalizedTipRoot()
+	if !ok || parentRoot != tipRoot {
+		return fmt.Errorf("block at slot %d does not descend from the highest finalized block in the database", first.Block.Slot)
+	}
+	return nil
+}
+
+// finalizedTipRoot returns the root of the highest block of the finalized canonical chain, if any
+// block is indexed as finalized.
+func (s *Store) finalizedTipRoot() ([32]byte, bool) {
+	for n := len(s.blockSlotIndex.slots) - 1; n >= 0; n-- {
+		for _, root := range s.blockSlotIndex.get(s.blockSlotIndex.slots[n]) {
+			if (s.genesisRoot != nil && root == *s.genesisRoot) || s.finalizedIndex[root] != nil {
+				return root, true
+			}
+		}
+	}
+	return [32]byte{}, false
+}
+
+// indexFinalizedChain adds a chain of finalized blocks in ascending slot order to the finalized
+// block roots index, linking it with the already indexed parent and child blocks.
+func (s *Store) indexFinalizedChain(blks []*ethpb.SignedBeaconBlock, roots [][32]byte) {
+	if len(blks) == 0 {
+		return
+	}
+	for i, blk := range blks {
+		container := &dbpb.FinalizedBlockRootContainer{
+			ParentRoot: bytesutil.SafeCopyBytes(blk.Block.ParentRoot),
+		}
+		if i+1 < len(blks) {
+			container.ChildRoot = append([]byte{}, roots[i+1][:]...)
+		} else {
+			container.ChildRoot = s.finalizedChildRoot(roots[i])
+		}
+		s.finalizedIndex[roots[i]] = container
+		if blk.Block.Slot == 0 && s.genesisRoot == nil {
+			s.genesisRoot = cloneRoot(roots[i])
+		}
+	}
+
+	// Point the indexed parent of the first block at it.
+	parentRoot := bytesutil.ToBytes32(blks[0].Block.ParentRoot)
+	if parent := s.finalizedIndex[parentRoot]; parent != nil {
+		updated := proto.Clone(parent).(*dbpb.FinalizedBlockRootContainer)
+		updated.ChildRoot = append([]byte{}, roots[0][:]...)
+		s.finalizedIndex[parentRoot] = updated
+	}
+}
+
+// finalizedChildRoot returns the root of the finalized canonical child of a block, if it is indexed.
+func (s *Store) finalizedChildRoot(root [32]byte) []byte {
+	for _, child := range s.blockParentIndex[root] {
+		if s.finalizedIndex[child] != nil {
+			return append([]byte{}, child[:]...)
+		}
+	}
+	return nil
+}
+
+// canonicalRootsInRange returns the roots in a slot index within [startSlot, endSlot) which belong
+// to the finalized canonical chain. Blocks at or below the origin block of a checkpoint synced
+// node were verified against it and are considered canonical.
+func (s *Store) canonicalRootsInRange(index *slotIndex, startSlot, endSlot, originSlot uint64) [][32]byte {
+	var roots [][32]byte
+	for _, slot := range index.slotsFrom(startSlot) {
+		if slot >= endSlot {
+			break
+		}
+		for _, root := range index.get(slot) {
+			canonical := (s.genesisRoot != nil && root == *s.genesisRoot) ||
+				s.finalizedIndex[root] != nil ||
+				(s.originRoot != nil && slot <= originSlot)
+			if canonical {
+				roots = append(roots, root)
+			}
+		}
+	}
+	return roots
+}
+
+// latestBlockRoot returns the root of the latest block processed by a state.
+func latestBlockRoot(ctx context.Context, st *state.BeaconState) ([32]byte, error) {
+	header := st.LatestBlockHeader()
+	if header == nil {
+		return [32]byte{}, errors.New("nil latest block header")
+	}
+	if bytesutil.ToBytes32(header.StateRoot) == [32]byte{} {
+		stateRoot, err := st.HashTreeRoot(ctx)
+		if err != nil {
+			return [32]byte{}, err
+		}
+		header.StateRoot = stateRoot[:]
+	}
+	return header.HashTreeRoot()
+}
@@ -0,0 +1,294 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/sliceutil"
+)
+
+// Block retrieval by root.
+func (s *Store) Block(ctx context.Context, blockRoot [32]byte) (*ethpb.SignedBeaconBlock, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return copyBlock(s.blocks[blockRoot]), nil
+}
+
+// HeadBlock returns the latest canonical block in eth2.
+func (s *Store) HeadBlock(ctx context.Context) (*ethpb.SignedBeaconBlock, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	if s.headRoot == nil {
+		return nil, nil
+	}
+	return copyBlock(s.blocks[*s.headRoot]), nil
+}
+
+// Blocks retrieves a list of beacon blocks by filter criteria.
+func (s *Store) Blocks(ctx context.Context, f *filters.QueryFilter) ([]*ethpb.SignedBeaconBlock, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	roots, err := s.blockRootsByFilter(f)
+	if err != nil {
+		return nil, err
+	}
+	blocks := make([]*ethpb.SignedBeaconBlock, 0, len(roots))
+	for _, root := range roots {
+		if blk, ok := s.blocks[root]; ok {
+			blocks = append(blocks, copyBlock(blk))
+		}
+	}
+	return blocks, nil
+}
+
+// BlockRoots retrieves a list of beacon block roots by filter criteria.
+func (s *Store) BlockRoots(ctx context.Context, f *filters.QueryFilter) ([][32]byte, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	roots, err := s.blockRootsByFilter(f)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve block roots")
+	}
+	return roots, nil
+}
+
+// HasBlock checks if a block by root exists in the db.
+func (s *Store) HasBlock(ctx context.Context, blockRoot [32]byte) bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	_, ok := s.blocks[blockRoot]
+	return ok
+}
+
+// SaveBlock to the db.
+func (s *Store) SaveBlock(ctx context.Context, signed *ethpb.SignedBeaconBlock) error {
+	return s.SaveBlocks(ctx, []*ethpb.SignedBeaconBlock{signed})
+}
+
+// SaveBlocks to the db. Blocks which were already saved are skipped.
+func (s *Store) SaveBlocks(ctx context.Context, blocks []*ethpb.SignedBeaconBlock) error {
+	roots := make([][32]byte, len(blocks))
+	for i, blk := range blocks {
+		root, err := blk.Block.HashTreeRoot()
+		if err != nil {
+			return err
+		}
+		roots[i] = root
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for i, blk := range blocks {
+		if _, ok := s.blocks[roots[i]]; ok {
+			continue
+		}
+		s.blocks[roots[i]] = copyBlock(blk)
+		s.indexBlock(blk.Block, roots[i])
+	}
+	return nil
+}
+
+// SaveHeadBlockRoot to the db.
+func (s *Store) SaveHeadBlockRoot(ctx context.Context, blockRoot [32]byte) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if !s.hasStateOrSummary(blockRoot) {
+		return errors.New("no state or state summary found with head block root")
+	}
+	s.headRoot = cloneRoot(blockRoot)
+	return nil
+}
+
+// GenesisBlock retrieves the genesis block of the beacon chain.
+func (s *Store) GenesisBlock(ctx context.Context) (*ethpb.SignedBeaconBlock, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	if s.genesisRoot == nil {
+		return nil, nil
+	}
+	return copyBlock(s.blocks[*s.genesisRoot]), nil
+}
+
+// SaveGenesisBlockRoot to the db.
+func (s *Store) SaveGenesisBlockRoot(ctx context.Context, blockRoot [32]byte) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.genesisRoot = cloneRoot(blockRoot)
+	return nil
+}
+
+// HighestSlotBlocksBelow returns the block with the highest slot below the input slot from the db.
+// The genesis block is returned if there is none.
+func (s *Store) HighestSlotBlocksBelow(ctx context.Context, slot uint64) ([]*ethpb.SignedBeaconBlock, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	var blk *ethpb.SignedBeaconBlock
+	if best, ok := s.blockSlotIndex.highestBelow(slot); ok {
+		blk = s.blocks[s.blockSlotIndex.get(best)[0]]
+	}
+	if blk == nil && s.genesisRoot != nil {
+		blk = s.blocks[*s.genesisRoot]
+	}
+	return []*ethpb.SignedBeaconBlock{copyBlock(blk)}, nil
+}
+
+// indexBlock adds the block root to the block slot and parent root indices.
+func (s *Store) indexBlock(blk *ethpb.BeaconBlock, root [32]byte) {
+	s.blockSlotIndex.add(blk.Slot, root)
+	if len(blk.ParentRoot) == 0 {
+		return
+	}
+	parentRoot := bytesutil.ToBytes32(blk.ParentRoot)
+	for _, r := range s.blockParentIndex[parentRoot] {
+		if r == root {
+			return
+		}
+	}
+	s.blockParentIndex[parentRoot] = append(s.blockParentIndex[parentRoot], root)
+}
+
+// deleteBlock removes the block and its index entries.
+func (s *Store) deleteBlock(root [32]byte) {
+	blk, ok := s.blocks[root]
+	if !ok {
+		return
+	}
+	s.blockSlotIndex.remove(blk.Block.Slot, root)
+	parentRoot := bytesutil.ToBytes32(blk.Block.ParentRoot)
+	children := s.blockParentIndex[parentRoot]
+	for i, r := range children {
+		if r == root {
+			children = append(children[:i:i], children[i+1:]...)
+			break
+		}
+	}
+	if len(children) == 0 {
+		delete(s.blockParentIndex, parentRoot)
+	} else {
+		s.blockParentIndex[parentRoot] = children
+	}
+	delete(s.blocks, root)
+}
+
+// blockRootsByFilter retrieves the block roots given the filter criteria, with the same semantics
+// as the bolt store: the roots within the slot range are intersected with the roots of the
+// children of the parent root, if one is given.
+func (s *Store) blockRootsByFilter(f *filters.QueryFilter) ([][32]byte, error) {
+	// If no filter criteria are specified, return an error.
+	if f == nil {
+		return nil, errors.New("must specify a filter criteria for retrieving blocks")
+	}
+
+	var parentRoot []byte
+	for k, v := range f.Filters() {
+		switch k {
+		case filters.ParentRoot:
+			root, ok := v.([]byte)
+			if !ok {
+				return nil, errors.New("parent root is not []byte")
+			}
+			parentRoot = root
+		// The following cases are passthroughs for blocks, as they are not used
+		// for filtering indices.
+		case filters.StartSlot:
+		case filters.EndSlot:
+		case filters.StartEpoch:
+		case filters.EndEpoch:
+		case filters.SlotStep:
+		default:
+			return nil, fmt.Errorf("filter criterion %v not supported for blocks", k)
+		}
+	}
+
+	filtersMap := f.Filters()
+	rootsBySlotRange, err := s.blockRootsBySlotRange(
+		filtersMap[filters.StartSlot],
+		filtersMap[filters.EndSlot],
+		filtersMap[filters.StartEpoch],
+		filtersMap[filters.EndEpoch],
+		filtersMap[filters.SlotStep],
+	)
+	if err != nil {
+		return nil, err
+	}
+	if parentRoot == nil {
+		return rootsBySlotRange, nil
+	}
+
+	var children [][]byte
+	if len(parentRoot) == 32 {
+		children = toByteSlices(s.blockParentIndex[bytesutil.ToBytes32(parentRoot)])
+	}
+	keys := children
+	if len(rootsBySlotRange) > 0 {
+		keys = sliceutil.IntersectionByteSlices(toByteSlices(rootsBySlotRange), children)
+	}
+	roots := make([][32]byte, len(keys))
+	for i, k := range keys {
+		roots[i] = bytesutil.ToBytes32(k)
+	}
+	return roots, nil
+}
+
+// blockRootsBySlotRange returns the roots of the blocks within a slot range. An end slot of zero
+// leaves the range open ended. Start and end epochs take precedence over the slots when both are
+// given, and only every step-th slot from the start slot is included.
+func (s *Store) blockRootsBySlotRange(
+	startSlotEncoded interface{},
+	endSlotEncoded interface{},
+	startEpochEncoded interface{},
+	endEpochEncoded interface{},
+	slotStepEncoded interface{},
+) ([][32]byte, error) {
+	var startSlot, endSlot, step uint64
+	var ok bool
+	if startSlot, ok = startSlotEncoded.(uint64); !ok {
+		startSlot = 0
+	}
+	if endSlot, ok = endSlotEncoded.(uint64); !ok {
+		endSlot = 0
+	}
+	if step, ok = slotStepEncoded.(uint64); !ok || step == 0 {
+		step = 1
+	}
+	startEpoch, startEpochOk := startEpochEncoded.(uint64)
+	endEpoch, endEpochOk := endEpochEncoded.(uint64)
+	var err error
+	if startEpochOk && endEpochOk {
+		startSlot, err = helpers.StartSlot(startEpoch)
+		if err != nil {
+			return nil, err
+		}
+		endSlot, err = helpers.StartSlot(endEpoch)
+		if err != nil {
+			return nil, err
+		}
+		endSlot = endSlot + params.BeaconConfig().SlotsPerEpoch - 1
+	}
+
+	roots := make([][32]byte, 0)
+	for _, slot := range s.blockSlotIndex.slotsFrom(startSlot) {
+		if endSlot != 0 && slot > endSlot {
+			break
+		}
+		if step > 1 && (slot-startSlot)%step != 0 {
+			continue
+		}
+		roots = append(roots, s.blockSlotIndex.get(slot)...)
+	}
+	return roots, nil
+}
+
+func toByteSlices(roots [][32]byte) [][]byte {
+	s := make([][]byte, len(roots))
+	for i := range roots {
+		s[i] = roots[i][:]
+	}
+	return s
+}
@@ -0,0 +1,158 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+var errMissingStateForCheckpoint = errors.New("missing state summary for finalized root")
+
+// JustifiedCheckpoint returns the latest justified checkpoint in beacon chain.
+func (s *Store) JustifiedCheckpoint(ctx context.Context) (*ethpb.Checkpoint, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	if s.justifiedCheckpoint == nil {
+		return &ethpb.Checkpoint{Root: params.BeaconConfig().ZeroHash[:]}, nil
+	}
+	return copyCheckpoint(s.justifiedCheckpoint), nil
+}
+
+// FinalizedCheckpoint returns the latest finalized checkpoint in beacon chain.
+func (s *Store) FinalizedCheckpoint(ctx context.Context) (*ethpb.Checkpoint, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	if s.finalizedCheckpoint == nil {
+		return &ethpb.Checkpoint{Root: params.BeaconConfig().ZeroHash[:]}, nil
+	}
+	return copyCheckpoint(s.finalizedCheckpoint), nil
+}
+
+// SaveJustifiedCheckpoint saves justified checkpoint in beacon chain.
+func (s *Store) SaveJustifiedCheckpoint(ctx context.Context, checkpoint *ethpb.Checkpoint) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if !s.hasStateOrSummary(bytesutil.ToBytes32(checkpoint.Root)) {
+		return errMissingStateForCheckpoint
+	}
+	s.justifiedCheckpoint = copyCheckpoint(checkpoint)
+	return nil
+}
+
+// SaveFinalizedCheckpoint saves finalized checkpoint in beacon chain, and updates the index of
+// finalized block roots.
+func (s *Store) SaveFinalizedCheckpoint(ctx context.Context, checkpoint *ethpb.Checkpoint) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if !s.hasStateOrSummary(bytesutil.ToBytes32(checkpoint.Root)) {
+		return errMissingStateForCheckpoint
+	}
+	// The index is updated on a copy, so a failed update leaves the store untouched.
+	index := make(map[[32]byte]*dbpb.FinalizedBlockRootContainer, len(s.finalizedIndex))
+	for root, container := range s.finalizedIndex {
+		index[root] = container
+	}
+	if err := s.updateFinalizedBlockRoots(index, checkpoint); err != nil {
+		return err
+	}
+	s.finalizedIndex = index
+	s.finalizedCheckpoint = copyCheckpoint(checkpoint)
+	s.previousFinalizedCheckpoint = copyCheckpoint(checkpoint)
+	return nil
+}
+
+// IsFinalizedBlock returns true if the block root is present in the finalized block root index.
+// A beacon block root contained exists in this index if it is considered finalized and canonical.
+// Note: beacon blocks from the latest finalized epoch return true, whether or not they are
+// considered canonical in the "head view" of the beacon node.
+func (s *Store) IsFinalizedBlock(ctx context.Context, blockRoot [32]byte) bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	if _, ok := s.finalizedIndex[blockRoot]; ok {
+		return true
+	}
+	return rootOrZero(s.genesisRoot) == blockRoot
+}
+
+// updateFinalizedBlockRoots updates the finalized block roots index the same way as the bolt
+// store does: the blocks from the previous finalized epoch onwards are de-indexed, the canonical
+// chain is walked back from the finalized root until an indexed block, genesis, the origin block
+// or the lowest retained slot is reached, and the other blocks of the finalized epoch are marked
+// as finalized but not canonical. Containers are never mutated, as they may be shared with the
+// current index.
+func (s *Store) updateFinalizedBlockRoots(index map[[32]byte]*dbpb.FinalizedBlockRootContainer, checkpoint *ethpb.Checkpoint) error {
+	previousEpoch := uint64(0)
+	if s.previousFinalizedCheckpoint != nil {
+		previousEpoch = s.previousFinalizedCheckpoint.Epoch
+	}
+	blockRoots, err := s.blockRootsByFilter(filters.NewFilter().
+		SetStartEpoch(previousEpoch).
+		SetEndEpoch(checkpoint.Epoch + 1),
+	)
+	if err != nil {
+		return err
+	}
+	for _, root := range blockRoots {
+		delete(index, root)
+	}
+
+	root := bytesutil.ToBytes32(checkpoint.Root)
+	var previousRoot []byte
+	for {
+		if s.genesisRoot != nil && root == *s.genesisRoot {
+			break
+		}
+		signedBlock := s.blocks[root]
+		if signedBlock == nil || signedBlock.Block == nil {
+			return fmt.Errorf("missing block in database: block root=%#x", root)
+		}
+		block := signedBlock.Block
+		index[root] = &dbpb.FinalizedBlockRootContainer{
+			ParentRoot: bytesutil.SafeCopyBytes(block.ParentRoot),
+			ChildRoot:  previousRoot,
+		}
+
+		// Blocks below the origin block, or below the lowest retained slot of a node pruning its
+		// history, are not available in the store.
+		if s.originRoot != nil && root == *s.originRoot {
+			break
+		}
+		if s.lowestRetainedSlot > 0 && block.Slot <= s.lowestRetainedSlot {
+			break
+		}
+
+		// Found parent, loop exit condition.
+		parentRoot := bytesutil.ToBytes32(block.ParentRoot)
+		if parent, ok := index[parentRoot]; ok {
+			if parent != nil {
+				updated := proto.Clone(parent).(*dbpb.FinalizedBlockRootContainer)
+				updated.ChildRoot = append([]byte{}, root[:]...)
+				index[parentRoot] = updated
+			}
+			break
+		}
+		previousRoot = append([]byte{}, root[:]...)
+		root = parentRoot
+	}
+
+	// Mark the other blocks from the current finalized epoch.
+	roots, err := s.blockRootsByFilter(filters.NewFilter().SetStartEpoch(checkpoint.Epoch).SetEndEpoch(checkpoint.Epoch + 1))
+	if err != nil {
+		return err
+	}
+	finalizedRoot := bytesutil.ToBytes32(checkpoint.Root)
+	for _, root := range roots {
+		if _, ok := index[root]; ok || root == finalizedRoot {
+			continue
+		}
+		index[root] = nil
+	}
+	return nil
+}
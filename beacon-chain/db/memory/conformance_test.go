@@ -0,0 +1,15 @@
+package memory
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/conformance"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
+)
+
+func TestStore_Conformance(t *testing.T) {
+	conformance.Run(t, func(t *testing.T) iface.Database {
+		return NewStore(cache.NewStateSummaryCache())
+	})
+}
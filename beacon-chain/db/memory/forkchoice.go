@@ -0,0 +1,28 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/gogo/protobuf/proto"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+)
+
+// ForkChoiceSnapshot returns the latest fork choice snapshot saved to the store, or nil if none
+// was saved.
+func (s *Store) ForkChoiceSnapshot(ctx context.Context) (*dbpb.ForkChoiceSnapshot, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	if s.forkChoiceSnapshot == nil {
+		return nil, nil
+	}
+	return proto.Clone(s.forkChoiceSnapshot).(*dbpb.ForkChoiceSnapshot), nil
+}
+
+// SaveForkChoiceSnapshot saves the fork choice snapshot, replacing the previous one.
+func (s *Store) SaveForkChoiceSnapshot(ctx context.Context, snapshot *dbpb.ForkChoiceSnapshot) error {
+	snapshot = proto.Clone(snapshot).(*dbpb.ForkChoiceSnapshot)
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.forkChoiceSnapshot = snapshot
+	return nil
+}
@@ -0,0 +1,29 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/gogo/protobuf/proto"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+)
+
+// OperationPools returns the pending operations of the operation pools saved to the store, or
+// nil if none were saved.
+func (s *Store) OperationPools(ctx context.Context) (*dbpb.OperationPools, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	if s.operationPools == nil {
+		return nil, nil
+	}
+	return proto.Clone(s.operationPools).(*dbpb.OperationPools), nil
+}
+
+// SaveOperationPools saves the pending operations of the operation pools, replacing the
+// previously saved ones.
+func (s *Store) SaveOperationPools(ctx context.Context, pools *dbpb.OperationPools) error {
+	pools = proto.Clone(pools).(*dbpb.OperationPools)
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.operationPools = pools
+	return nil
+}
@@ -0,0 +1,144 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+)
+
+// ProposerSlashing retrieval by slashing root.
+func (s *Store) ProposerSlashing(ctx context.Context, slashingRoot [32]byte) (*ethpb.ProposerSlashing, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	slashing, ok := s.proposerSlashings[slashingRoot]
+	if !ok {
+		return nil, nil
+	}
+	return proto.Clone(slashing).(*ethpb.ProposerSlashing), nil
+}
+
+// HasProposerSlashing verifies if a slashing is stored in the db.
+func (s *Store) HasProposerSlashing(ctx context.Context, slashingRoot [32]byte) bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	_, ok := s.proposerSlashings[slashingRoot]
+	return ok
+}
+
+// SaveProposerSlashing to the db by its hash tree root.
+func (s *Store) SaveProposerSlashing(ctx context.Context, slashing *ethpb.ProposerSlashing) error {
+	slashingRoot, err := slashing.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.proposerSlashings[slashingRoot] = proto.Clone(slashing).(*ethpb.ProposerSlashing)
+	return nil
+}
+
+// AttesterSlashing retrieval by hash tree root.
+func (s *Store) AttesterSlashing(ctx context.Context, slashingRoot [32]byte) (*ethpb.AttesterSlashing, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	slashing, ok := s.attesterSlashings[slashingRoot]
+	if !ok {
+		return nil, nil
+	}
+	return proto.Clone(slashing).(*ethpb.AttesterSlashing), nil
+}
+
+// HasAttesterSlashing verifies if a slashing is stored in the db.
+func (s *Store) HasAttesterSlashing(ctx context.Context, slashingRoot [32]byte) bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	_, ok := s.attesterSlashings[slashingRoot]
+	return ok
+}
+
+// SaveAttesterSlashing to the db by its hash tree root.
+func (s *Store) SaveAttesterSlashing(ctx context.Context, slashing *ethpb.AttesterSlashing) error {
+	slashingRoot, err := slashing.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.attesterSlashings[slashingRoot] = proto.Clone(slashing).(*ethpb.AttesterSlashing)
+	return nil
+}
+
+// VoluntaryExit retrieval by signing root.
+func (s *Store) VoluntaryExit(ctx context.Context, exitRoot [32]byte) (*ethpb.VoluntaryExit, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	exit, ok := s.voluntaryExits[exitRoot]
+	if !ok {
+		return nil, nil
+	}
+	return proto.Clone(exit).(*ethpb.VoluntaryExit), nil
+}
+
+// HasVoluntaryExit verifies if a voluntary exit is stored in the db by its signing root.
+func (s *Store) HasVoluntaryExit(ctx context.Context, exitRoot [32]byte) bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	_, ok := s.voluntaryExits[exitRoot]
+	return ok
+}
+
+// SaveVoluntaryExit to the db by its signing root.
+func (s *Store) SaveVoluntaryExit(ctx context.Context, exit *ethpb.VoluntaryExit) error {
+	exitRoot, err := exit.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.voluntaryExits[exitRoot] = proto.Clone(exit).(*ethpb.VoluntaryExit)
+	return nil
+}
+
+// DepositContractAddress returns contract address is the address of
+// the deposit contract on the proof of work chain.
+func (s *Store) DepositContractAddress(ctx context.Context) ([]byte, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	if s.depositContractAddress == nil {
+		return nil, nil
+	}
+	return append([]byte{}, s.depositContractAddress...), nil
+}
+
+// SaveDepositContractAddress to the db. It returns an error if an address has been previously saved.
+func (s *Store) SaveDepositContractAddress(ctx context.Context, addr common.Address) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.depositContractAddress != nil {
+		return fmt.Errorf("cannot override deposit contract address: %v", s.depositContractAddress)
+	}
+	s.depositContractAddress = addr.Bytes()
+	return nil
+}
+
+// SavePowchainData saves the pow chain data.
+func (s *Store) SavePowchainData(ctx context.Context, data *dbpb.ETH1ChainData) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.powchainData = proto.Clone(data).(*dbpb.ETH1ChainData)
+	return nil
+}
+
+// PowchainData retrieves the powchain data.
+func (s *Store) PowchainData(ctx context.Context) (*dbpb.ETH1ChainData, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	if s.powchainData == nil {
+		return nil, nil
+	}
+	return proto.Clone(s.powchainData).(*dbpb.ETH1ChainData), nil
+}
@@ -0,0 +1,154 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+)
+
+// OriginBlockRoot returns the block root of the finalized anchor the node was started from
+// using checkpoint sync. A zero root is returned if the node was started from genesis.
+func (s *Store) OriginBlockRoot(ctx context.Context) ([32]byte, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return rootOrZero(s.originRoot), nil
+}
+
+// SaveOriginCheckpoint seeds an empty store with a trusted finalized state and its block.
+// The state must be at the start of an epoch, and the store must not hold a head, finalized
+// or genesis block yet. The state may be the post state of the block or that state advanced
+// through empty slots. The block becomes the origin of the local chain: it is saved as the head,
+// justified and finalized checkpoint of the epoch of the state, so the node can forward sync from
+// it instead of from genesis.
+func (s *Store) SaveOriginCheckpoint(ctx context.Context, st *state.BeaconState, blk *ethpb.SignedBeaconBlock) error {
+	if st == nil || blk == nil || blk.Block == nil {
+		return errors.New("nil origin state or block")
+	}
+	if !helpers.IsEpochStart(st.Slot()) {
+		return fmt.Errorf("origin state slot %d is not at the start of an epoch", st.Slot())
+	}
+	if blk.Block.Slot > st.Slot() {
+		return fmt.Errorf("origin state slot %d is before origin block slot %d", st.Slot(), blk.Block.Slot)
+	}
+	blockRoot, err := blk.Block.HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "could not hash origin block")
+	}
+	if err := verifyOriginState(ctx, st, blk, blockRoot); err != nil {
+		return err
+	}
+	s.lock.RLock()
+	seeded := s.headRoot != nil || s.genesisRoot != nil || s.finalizedCheckpoint != nil
+	s.lock.RUnlock()
+	if seeded {
+		return errors.New("cannot save origin checkpoint in a store that already contains a chain")
+	}
+
+	if err := s.SaveBlock(ctx, blk); err != nil {
+		return errors.Wrap(err, "could not save origin block")
+	}
+	if err := s.SaveState(ctx, st, blockRoot); err != nil {
+		return errors.Wrap(err, "could not save origin state")
+	}
+	if err := s.SaveStateSummary(ctx, &pb.StateSummary{
+		Slot: st.Slot(),
+		Root: blockRoot[:],
+	}); err != nil {
+		return errors.Wrap(err, "could not save origin state summary")
+	}
+	s.lock.Lock()
+	s.originRoot = cloneRoot(blockRoot)
+	s.lock.Unlock()
+	if err := s.SaveHeadBlockRoot(ctx, blockRoot); err != nil {
+		return errors.Wrap(err, "could not save head block root")
+	}
+	cp := &ethpb.Checkpoint{
+		Epoch: helpers.SlotToEpoch(st.Slot()),
+		Root:  blockRoot[:],
+	}
+	if err := s.SaveJustifiedCheckpoint(ctx, cp); err != nil {
+		return errors.Wrap(err, "could not save justified checkpoint")
+	}
+	if err := s.SaveFinalizedCheckpoint(ctx, cp); err != nil {
+		return errors.Wrap(err, "could not save finalized checkpoint")
+	}
+	return nil
+}
+
+// verifyOriginState checks that the origin state descends from the origin block. A state at the
+// slot of the block must be its post state. A state advanced through empty slots commits to
+// the block through its latest block header.
+func verifyOriginState(ctx context.Context, st *state.BeaconState, blk *ethpb.SignedBeaconBlock, blockRoot [32]byte) error {
+	if st.Slot() == blk.Block.Slot {
+		stateRoot, err := st.HashTreeRoot(ctx)
+		if err != nil {
+			return errors.Wrap(err, "could not hash origin state")
+		}
+		if stateRoot != bytesutil.ToBytes32(blk.Block.StateRoot) {
+			return fmt.Errorf("origin state root %#x does not match origin block state root %#x", stateRoot, blk.Block.StateRoot)
+		}
+		return nil
+	}
+	headerRoot, err := st.LatestBlockHeader().HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "could not hash origin state latest block header")
+	}
+	if headerRoot != blockRoot {
+		return fmt.Errorf("origin state latest block header root %#x does not match origin block root %#x", headerRoot, blockRoot)
+	}
+	return nil
+}
+
+// BackfillBlockRoot returns the root of the lowest block saved by the historical block
+// backfill below the origin block. A zero root is returned if backfill has not started.
+func (s *Store) BackfillBlockRoot(ctx context.Context) ([32]byte, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return rootOrZero(s.backfillRoot), nil
+}
+
+// SaveBackfillBlockRoot records the root of the lowest block saved by the historical
+// block backfill.
+func (s *Store) SaveBackfillBlockRoot(ctx context.Context, blockRoot [32]byte) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.backfillRoot = cloneRoot(blockRoot)
+	return nil
+}
+
+// SaveBackfillBlocks saves a batch of historical blocks in ascending slot order, the highest of
+// which is the parent of the lowest block already in the database. Each block gets a state
+// summary and a finalized block roots index entry, and the lowest block of the batch is recorded
+// as the backfill block root.
+func (s *Store) SaveBackfillBlocks(ctx context.Context, blks []*ethpb.SignedBeaconBlock) error {
+	if len(blks) == 0 {
+		return nil
+	}
+	roots := make([][32]byte, len(blks))
+	for i, blk := range blks {
+		root, err := blk.Block.HashTreeRoot()
+		if err != nil {
+			return err
+		}
+		roots[i] = root
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for i, blk := range blks {
+		if _, ok := s.blocks[roots[i]]; !ok {
+			s.blocks[roots[i]] = copyBlock(blk)
+			s.indexBlock(blk.Block, roots[i])
+		}
+		s.stateSummaries[roots[i]] = &pb.StateSummary{Slot: blk.Block.Slot, Root: append([]byte{}, roots[i][:]...)}
+	}
+	s.indexFinalizedChain(blks, roots)
+	s.backfillRoot = cloneRoot(roots[0])
+	return nil
+}
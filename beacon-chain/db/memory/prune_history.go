@@ -0,0 +1,94 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+)
+
+// LowestRetainedSlot returns the slot below which the finalized history was pruned. Zero is
+// returned if the history was never pruned.
+func (s *Store) LowestRetainedSlot(ctx context.Context) (uint64, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.lowestRetainedSlot, nil
+}
+
+// PruneHistory deletes the finalized history below the given slot, with the same semantics as the
+// bolt store: the genesis block and state are kept, as is the highest state at or below the slot,
+// whose slot becomes the lowest retained slot and is returned. Nothing above the finalized
+// checkpoint is ever pruned.
+func (s *Store) PruneHistory(ctx context.Context, slot uint64) (uint64, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.finalizedCheckpoint == nil {
+		slot = 0
+	} else {
+		finalizedSlot, err := helpers.StartSlot(s.finalizedCheckpoint.Epoch)
+		if err != nil {
+			return s.lowestRetainedSlot, err
+		}
+		if slot > finalizedSlot {
+			slot = finalizedSlot
+		}
+	}
+	// The highest state at or below the slot anchors the retained history.
+	anchorSlot, ok := s.stateSlotIndex.highestBelow(slot + 1)
+	if !ok || anchorSlot <= s.lowestRetainedSlot {
+		return s.lowestRetainedSlot, nil
+	}
+	roots := s.stateSlotIndex.get(anchorSlot)
+	anchorRoot := roots[len(roots)-1]
+	keep := func(root [32]byte) bool {
+		return root == anchorRoot || (s.genesisRoot != nil && root == *s.genesisRoot)
+	}
+
+	for _, slot := range append([]uint64{}, s.blockSlotIndex.slotsFrom(0)...) {
+		if slot >= anchorSlot {
+			break
+		}
+		for _, root := range append([][32]byte{}, s.blockSlotIndex.get(slot)...) {
+			if keep(root) {
+				continue
+			}
+			s.deleteBlock(root)
+			delete(s.stateSummaries, root)
+			delete(s.finalizedIndex, root)
+			s.deleteState(root)
+		}
+	}
+	for _, slot := range append([]uint64{}, s.stateSlotIndex.slotsFrom(0)...) {
+		if slot >= anchorSlot {
+			break
+		}
+		for _, root := range append([][32]byte{}, s.stateSlotIndex.get(slot)...) {
+			if !keep(root) {
+				s.deleteState(root)
+			}
+		}
+	}
+	for _, slot := range append([]uint64{}, s.stateDiffIndex.slotsFrom(0)...) {
+		if slot >= anchorSlot {
+			break
+		}
+		for _, root := range append([][32]byte{}, s.stateDiffIndex.get(slot)...) {
+			if !keep(root) {
+				s.stateDiffIndex.remove(slot, root)
+				delete(s.stateDiffs, root)
+			}
+		}
+	}
+	// A pruned origin block no longer bounds the history of a checkpoint synced node.
+	if s.originRoot != nil {
+		if _, ok := s.blocks[*s.originRoot]; !ok {
+			s.originRoot = nil
+		}
+	}
+	if s.backfillRoot != nil {
+		if _, ok := s.blocks[*s.backfillRoot]; !ok {
+			s.backfillRoot = nil
+		}
+	}
+	s.lowestRetainedSlot = anchorSlot
+	return anchorSlot, nil
+}
@@ -0,0 +1,94 @@
+package memory
+
+import (
+	"sort"
+)
+
+// slotIndex maps slots to the roots indexed at them, in insertion order. Slots are iterated in
+// ascending order, like the big endian keys of the slot indices buckets of the bolt store.
+type slotIndex struct {
+	slots []uint64
+	roots map[uint64][][32]byte
+}
+
+func newSlotIndex() *slotIndex {
+	return &slotIndex{roots: make(map[uint64][][32]byte)}
+}
+
+// add indexes the root at the slot, unless it is already indexed there.
+func (i *slotIndex) add(slot uint64, root [32]byte) {
+	roots, ok := i.roots[slot]
+	if !ok {
+		n := i.search(slot)
+		i.slots = append(i.slots, 0)
+		copy(i.slots[n+1:], i.slots[n:])
+		i.slots[n] = slot
+	}
+	for _, r := range roots {
+		if r == root {
+			return
+		}
+	}
+	i.roots[slot] = append(roots, root)
+}
+
+// remove clears the root from the slot, removing the slot once no root is left.
+func (i *slotIndex) remove(slot uint64, root [32]byte) {
+	roots, ok := i.roots[slot]
+	if !ok {
+		return
+	}
+	kept := make([][32]byte, 0, len(roots))
+	for _, r := range roots {
+		if r != root {
+			kept = append(kept, r)
+		}
+	}
+	if len(kept) > 0 {
+		i.roots[slot] = kept
+		return
+	}
+	delete(i.roots, slot)
+	n := i.search(slot)
+	i.slots = append(i.slots[:n], i.slots[n+1:]...)
+}
+
+// get returns the roots indexed at the slot.
+func (i *slotIndex) get(slot uint64) [][32]byte {
+	return i.roots[slot]
+}
+
+// has returns true if a root is indexed at the slot.
+func (i *slotIndex) has(slot uint64) bool {
+	_, ok := i.roots[slot]
+	return ok
+}
+
+// search returns the position of the lowest indexed slot at or above the given slot.
+func (i *slotIndex) search(slot uint64) int {
+	return sort.Search(len(i.slots), func(n int) bool {
+		return i.slots[n] >= slot
+	})
+}
+
+// highestBelow returns the highest indexed slot strictly below the given slot.
+func (i *slotIndex) highestBelow(slot uint64) (uint64, bool) {
+	n := i.search(slot)
+	if n == 0 {
+		return 0, false
+	}
+	return i.slots[n-1], true
+}
+
+// last returns the highest indexed slot.
+func (i *slotIndex) last() (uint64, bool) {
+	if len(i.slots) == 0 {
+		return 0, false
+	}
+	return i.slots[len(i.slots)-1], true
+}
+
+// slotsFrom returns the indexed slots at or above the given slot, in ascending order.
+func (i *slotIndex) slotsFrom(slot uint64) []uint64 {
+	return i.slots[i.search(slot):]
+}
@@ -0,0 +1,269 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/statediff"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+)
+
+// State returns the saved state using block's signing root,
+// this particular block was used to generate the state.
+func (s *Store) State(ctx context.Context, blockRoot [32]byte) (*state.BeaconState, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.state(blockRoot)
+}
+
+// HeadState returns the latest canonical state in beacon chain.
+func (s *Store) HeadState(ctx context.Context) (*state.BeaconState, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	if s.headRoot == nil {
+		return nil, nil
+	}
+	return s.state(*s.headRoot)
+}
+
+// GenesisState returns the genesis state in beacon chain.
+func (s *Store) GenesisState(ctx context.Context) (*state.BeaconState, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	if s.genesisRoot == nil {
+		return nil, nil
+	}
+	return s.state(*s.genesisRoot)
+}
+
+// SaveState stores a state to the db using block's signing root which was used to generate the state.
+func (s *Store) SaveState(ctx context.Context, st *state.BeaconState, blockRoot [32]byte) error {
+	return s.SaveStates(ctx, []*state.BeaconState{st}, [][32]byte{blockRoot})
+}
+
+// SaveStates stores multiple states to the db using the provided corresponding roots.
+func (s *Store) SaveStates(ctx context.Context, states []*state.BeaconState, blockRoots [][32]byte) error {
+	if states == nil {
+		return errors.New("nil state")
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for i, root := range blockRoots {
+		s.saveState(states[i].CloneInnerState(), root)
+	}
+	return nil
+}
+
+// HasState checks if a state by root exists in the db.
+func (s *Store) HasState(ctx context.Context, blockRoot [32]byte) bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	_, ok := s.states[blockRoot]
+	return ok
+}
+
+// DeleteState by block root.
+func (s *Store) DeleteState(ctx context.Context, blockRoot [32]byte) error {
+	return s.DeleteStates(ctx, [][32]byte{blockRoot})
+}
+
+// DeleteStates by block roots. Nothing is deleted if one of the states is the genesis,
+// finalized or head state.
+func (s *Store) DeleteStates(ctx context.Context, blockRoots [][32]byte) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	finalizedRoot := s.genesisRoot
+	if s.finalizedCheckpoint != nil {
+		finalizedRoot = cloneRoot(bytesutil.ToBytes32(s.finalizedCheckpoint.Root))
+	}
+	protected := func(root [32]byte) bool {
+		return (finalizedRoot != nil && root == *finalizedRoot) ||
+			(s.genesisRoot != nil && root == *s.genesisRoot) ||
+			(s.headRoot != nil && root == *s.headRoot)
+	}
+	for _, root := range blockRoots {
+		if _, ok := s.states[root]; ok && protected(root) {
+			return errors.New("cannot delete genesis, finalized, or head state")
+		}
+	}
+	for _, root := range blockRoots {
+		s.deleteState(root)
+	}
+	return nil
+}
+
+// HighestSlotStatesBelow returns the states with the highest slot below the input slot
+// from the db. The genesis state is returned if there is none.
+func (s *Store) HighestSlotStatesBelow(ctx context.Context, slot uint64) ([]*state.BeaconState, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	var st *state.BeaconState
+	var err error
+	if best, ok := s.stateSlotIndex.highestBelow(slot); ok {
+		st, err = s.state(s.stateSlotIndex.get(best)[0])
+		if err != nil {
+			return nil, err
+		}
+	}
+	if st == nil && s.genesisRoot != nil {
+		st, err = s.state(*s.genesisRoot)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return []*state.BeaconState{st}, nil
+}
+
+// SaveStateSummary saves a state summary object to the DB.
+func (s *Store) SaveStateSummary(ctx context.Context, summary *pb.StateSummary) error {
+	return s.SaveStateSummaries(ctx, []*pb.StateSummary{summary})
+}
+
+// SaveStateSummaries saves state summary objects to the DB.
+func (s *Store) SaveStateSummaries(ctx context.Context, summaries []*pb.StateSummary) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for _, summary := range summaries {
+		s.stateSummaries[bytesutil.ToBytes32(summary.Root)] = proto.Clone(summary).(*pb.StateSummary)
+	}
+	return nil
+}
+
+// StateSummary returns the state summary object from the db using input block root.
+func (s *Store) StateSummary(ctx context.Context, blockRoot [32]byte) (*pb.StateSummary, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	summary, ok := s.stateSummaries[blockRoot]
+	if !ok {
+		return nil, nil
+	}
+	return proto.Clone(summary).(*pb.StateSummary), nil
+}
+
+// HasStateSummary returns true if a state summary exists in DB.
+func (s *Store) HasStateSummary(ctx context.Context, blockRoot [32]byte) bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	_, ok := s.stateSummaries[blockRoot]
+	return ok
+}
+
+// SaveStateDiff saves the diff which rebuilds the state of the input block root from an earlier
+// saved state. Diffs are indexed by the slot of the state they rebuild.
+func (s *Store) SaveStateDiff(ctx context.Context, blockRoot [32]byte, diff *statediff.Diff) error {
+	if diff == nil {
+		return errors.New("nil state diff")
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.stateDiffIndex.add(diff.Slot, blockRoot)
+	s.stateDiffs[blockRoot] = diff.Marshal()
+	return nil
+}
+
+// StateDiff returns the state diff of the input block root, or nil if there is none.
+func (s *Store) StateDiff(ctx context.Context, blockRoot [32]byte) (*statediff.Diff, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return decodeStateDiff(s.stateDiffs[blockRoot])
+}
+
+// HasStateDiff checks if a state diff of the input block root exists in the db.
+func (s *Store) HasStateDiff(ctx context.Context, blockRoot [32]byte) bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	_, ok := s.stateDiffs[blockRoot]
+	return ok
+}
+
+// HighestSlotStateDiffBelow returns the state diff with the highest slot below the input slot,
+// or nil if there is none.
+func (s *Store) HighestSlotStateDiffBelow(ctx context.Context, slot uint64) (*statediff.Diff, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	best, ok := s.stateDiffIndex.highestBelow(slot)
+	if !ok {
+		return nil, nil
+	}
+	// Diffs are only saved for the canonical chain, so the last root at the slot is used.
+	roots := s.stateDiffIndex.get(best)
+	return decodeStateDiff(s.stateDiffs[roots[len(roots)-1]])
+}
+
+// LastArchivedSlot from the db.
+func (s *Store) LastArchivedSlot(ctx context.Context) (uint64, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	slot, _ := s.stateSlotIndex.last()
+	return slot, nil
+}
+
+// LastArchivedRoot from the db.
+func (s *Store) LastArchivedRoot(ctx context.Context) [32]byte {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	slot, ok := s.stateSlotIndex.last()
+	if !ok {
+		return [32]byte{}
+	}
+	return s.stateSlotIndex.get(slot)[0]
+}
+
+// ArchivedPointRoot returns the block root of an archived point from the DB.
+// This is essential for cold state management and to restore a cold state.
+func (s *Store) ArchivedPointRoot(ctx context.Context, slot uint64) [32]byte {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	roots := s.stateSlotIndex.get(slot)
+	if len(roots) == 0 {
+		return [32]byte{}
+	}
+	return roots[0]
+}
+
+// HasArchivedPoint returns true if an archived point exists in DB.
+func (s *Store) HasArchivedPoint(ctx context.Context, slot uint64) bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.stateSlotIndex.has(slot)
+}
+
+// state returns a copy of the saved state of the block root, or nil if there is none.
+func (s *Store) state(blockRoot [32]byte) (*state.BeaconState, error) {
+	st, ok := s.states[blockRoot]
+	if !ok {
+		return nil, nil
+	}
+	return state.InitializeFromProto(st)
+}
+
+func (s *Store) saveState(st *pb.BeaconState, blockRoot [32]byte) {
+	if prev, ok := s.states[blockRoot]; ok {
+		s.stateSlotIndex.remove(prev.Slot, blockRoot)
+	}
+	s.states[blockRoot] = st
+	s.stateSlotIndex.add(st.Slot, blockRoot)
+}
+
+func (s *Store) deleteState(blockRoot [32]byte) {
+	st, ok := s.states[blockRoot]
+	if !ok {
+		return
+	}
+	s.stateSlotIndex.remove(st.Slot, blockRoot)
+	delete(s.states, blockRoot)
+}
+
+func decodeStateDiff(enc []byte) (*statediff.Diff, error) {
+	if enc == nil {
+		return nil, nil
+	}
+	diff := &statediff.Diff{}
+	if err := diff.Unmarshal(enc); err != nil {
+		return nil, err
+	}
+	return diff, nil
+}
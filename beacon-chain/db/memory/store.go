@@ -0,0 +1,163 @@
+// Package memory defines an in-memory implementation of the Database interface defined by a
+// Prysm beacon node, for tests and ephemeral development nodes. Nothing is persisted: all data
+// is lost once the process exits.
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+var _ = iface.Database(&Store{})
+
+// DataDir is the data directory which selects the in-memory database, as in --datadir=:memory:.
+const DataDir = ":memory:"
+
+var errBackupNotSupported = errors.New("an in-memory database can not be backed up")
+
+// Store defines an implementation of the Prysm Database interface holding every object in memory.
+// Objects are copied on their way in and out of the store, so callers never share memory with it,
+// just like with the encoded values of the bolt store.
+type Store struct {
+	lock              sync.RWMutex
+	stateSummaryCache *cache.StateSummaryCache
+
+	blocks           map[[32]byte]*ethpb.SignedBeaconBlock
+	blockSlotIndex   *slotIndex
+	blockParentIndex map[[32]byte][][32]byte
+	genesisRoot      *[32]byte
+	headRoot         *[32]byte
+	originRoot       *[32]byte
+	backfillRoot     *[32]byte
+
+	states         map[[32]byte]*pb.BeaconState
+	stateSlotIndex *slotIndex
+	stateSummaries map[[32]byte]*pb.StateSummary
+	stateDiffs     map[[32]byte][]byte
+	stateDiffIndex *slotIndex
+
+	justifiedCheckpoint         *ethpb.Checkpoint
+	finalizedCheckpoint         *ethpb.Checkpoint
+	previousFinalizedCheckpoint *ethpb.Checkpoint
+	// finalizedIndex holds no container for the blocks of the most recent finalized epoch which
+	// still need reindexing to determine whether they are canonical.
+	finalizedIndex     map[[32]byte]*dbpb.FinalizedBlockRootContainer
+	lowestRetainedSlot uint64
+
+	proposerSlashings map[[32]byte]*ethpb.ProposerSlashing
+	attesterSlashings map[[32]byte]*ethpb.AttesterSlashing
+	voluntaryExits    map[[32]byte]*ethpb.VoluntaryExit
+
+	depositContractAddress []byte
+	powchainData           *dbpb.ETH1ChainData
+	forkChoiceSnapshot     *dbpb.ForkChoiceSnapshot
+	operationPools         *dbpb.OperationPools
+}
+
+// NewStore initializes an empty in-memory store.
+func NewStore(stateSummaryCache *cache.StateSummaryCache) *Store {
+	s := &Store{stateSummaryCache: stateSummaryCache}
+	s.reset()
+	return s
+}
+
+func (s *Store) reset() {
+	s.blocks = make(map[[32]byte]*ethpb.SignedBeaconBlock)
+	s.blockSlotIndex = newSlotIndex()
+	s.blockParentIndex = make(map[[32]byte][][32]byte)
+	s.genesisRoot = nil
+	s.headRoot = nil
+	s.originRoot = nil
+	s.backfillRoot = nil
+	s.states = make(map[[32]byte]*pb.BeaconState)
+	s.stateSlotIndex = newSlotIndex()
+	s.stateSummaries = make(map[[32]byte]*pb.StateSummary)
+	s.stateDiffs = make(map[[32]byte][]byte)
+	s.stateDiffIndex = newSlotIndex()
+	s.justifiedCheckpoint = nil
+	s.finalizedCheckpoint = nil
+	s.previousFinalizedCheckpoint = nil
+	s.finalizedIndex = make(map[[32]byte]*dbpb.FinalizedBlockRootContainer)
+	s.lowestRetainedSlot = 0
+	s.proposerSlashings = make(map[[32]byte]*ethpb.ProposerSlashing)
+	s.attesterSlashings = make(map[[32]byte]*ethpb.AttesterSlashing)
+	s.voluntaryExits = make(map[[32]byte]*ethpb.VoluntaryExit)
+	s.depositContractAddress = nil
+	s.powchainData = nil
+	s.forkChoiceSnapshot = nil
+	s.operationPools = nil
+}
+
+// Close the store. It is a no-op, as there is nothing to release.
+func (s *Store) Close() error {
+	return nil
+}
+
+// ClearDB removes every object from the store.
+func (s *Store) ClearDB() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.reset()
+	return nil
+}
+
+// DatabasePath returns DataDir, as the store has no location on disk.
+func (s *Store) DatabasePath() string {
+	return DataDir
+}
+
+// RunMigrations is a no-op, as an in-memory store always uses the current schema.
+func (s *Store) RunMigrations(ctx context.Context) error {
+	return nil
+}
+
+// Backup is not supported by the in-memory store.
+func (s *Store) Backup(ctx context.Context) error {
+	return errBackupNotSupported
+}
+
+// WriteBackup is not supported by the in-memory store.
+func (s *Store) WriteBackup(ctx context.Context, path, parentPath string) error {
+	return errBackupNotSupported
+}
+
+// hasStateOrSummary returns true if a state or a state summary of the block root was saved,
+// which is required before the root can become the head or a checkpoint.
+func (s *Store) hasStateOrSummary(root [32]byte) bool {
+	_, hasState := s.states[root]
+	_, hasSummary := s.stateSummaries[root]
+	return hasState || hasSummary || (s.stateSummaryCache != nil && s.stateSummaryCache.Has(root))
+}
+
+func rootOrZero(root *[32]byte) [32]byte {
+	if root == nil {
+		return [32]byte{}
+	}
+	return *root
+}
+
+func cloneRoot(root [32]byte) *[32]byte {
+	return &root
+}
+
+func copyBlock(blk *ethpb.SignedBeaconBlock) *ethpb.SignedBeaconBlock {
+	if blk == nil {
+		return nil
+	}
+	return proto.Clone(blk).(*ethpb.SignedBeaconBlock)
+}
+
+func copyCheckpoint(cp *ethpb.Checkpoint) *ethpb.Checkpoint {
+	if cp == nil {
+		return nil
+	}
+	return proto.Clone(cp).(*ethpb.Checkpoint)
+}
@@ -0,0 +1,11 @@
+package pruner
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var lowestRetainedSlot = promauto.NewGauge(prometheus.GaugeOpts{
+	Name: "history_lowest_retained_slot",
+	Help: "The slot below which the finalized history was pruned from the database.",
+})
@@ -0,0 +1,121 @@
+// Package pruner deletes the finalized blocks and states older than the configured history
+// retention window from the database.
+package pruner
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+)
+
+var _ = shared.Service(&Service{})
+
+// Config to set up the pruning service.
+type Config struct {
+	DB db.NoHeadAccessDatabase
+	// RetentionEpochs is the number of epochs of history kept below the finalized checkpoint.
+	RetentionEpochs uint64
+}
+
+// Service prunes the history below the retention window once every epoch.
+type Service struct {
+	ctx             context.Context
+	cancel          context.CancelFunc
+	db              db.NoHeadAccessDatabase
+	retentionEpochs uint64
+	lock            sync.RWMutex
+	err             error
+}
+
+// NewService configures the pruning service.
+func NewService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		ctx:             ctx,
+		cancel:          cancel,
+		db:              cfg.DB,
+		retentionEpochs: cfg.RetentionEpochs,
+	}
+}
+
+// Start the pruning service.
+func (s *Service) Start() {
+	log.WithField("retentionEpochs", s.retentionEpochs).Info("Pruning history below the retention window")
+	go s.run()
+}
+
+// Stop the pruning service.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status returns the error of the last pruning run, if it failed.
+func (s *Service) Status() error {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.err
+}
+
+func (s *Service) run() {
+	ticker := time.NewTicker(time.Duration(params.BeaconConfig().SecondsPerSlot*params.BeaconConfig().SlotsPerEpoch) * time.Second)
+	defer ticker.Stop()
+	for {
+		err := s.prune(s.ctx)
+		if err != nil {
+			log.WithError(err).Error("Could not prune history")
+		}
+		s.lock.Lock()
+		s.err = err
+		s.lock.Unlock()
+
+		select {
+		case <-ticker.C:
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// prune deletes the history below the start of the epoch which is the retention window older
+// than the finalized checkpoint.
+func (s *Service) prune(ctx context.Context) error {
+	cp, err := s.db.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve finalized checkpoint")
+	}
+	if cp == nil || cp.Epoch <= s.retentionEpochs {
+		return nil
+	}
+	slot, err := helpers.StartSlot(cp.Epoch - s.retentionEpochs)
+	if err != nil {
+		return err
+	}
+	previous, err := s.db.LowestRetainedSlot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve lowest retained slot")
+	}
+	if slot <= previous {
+		return nil
+	}
+	start := time.Now()
+	lowest, err := s.db.PruneHistory(ctx, slot)
+	if err != nil {
+		return err
+	}
+	lowestRetainedSlot.Set(float64(lowest))
+	if lowest > previous {
+		log.WithFields(logrus.Fields{
+			"lowestRetainedSlot": lowest,
+			"duration":           time.Since(start),
+		}).Info("Pruned history")
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package pruner
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestService_Prune(t *testing.T) {
+	ctx := context.Background()
+	beaconDB, _ := dbtest.SetupDB(t)
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+
+	genesis := testutil.NewBeaconBlock()
+	parentRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveBlock(ctx, genesis))
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, parentRoot))
+	roots := make([][32]byte, 0, 4*slotsPerEpoch)
+	for slot := uint64(1); slot <= 4*slotsPerEpoch; slot++ {
+		b := testutil.NewBeaconBlock()
+		b.Block.Slot = slot
+		b.Block.ParentRoot = parentRoot[:]
+		require.NoError(t, beaconDB.SaveBlock(ctx, b))
+		parentRoot, err = b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		roots = append(roots, parentRoot)
+		// Save a state at every epoch boundary.
+		if slot%slotsPerEpoch == 0 {
+			st := testutil.NewBeaconState()
+			require.NoError(t, st.SetSlot(slot))
+			require.NoError(t, beaconDB.SaveState(ctx, st, parentRoot))
+		}
+	}
+
+	s := NewService(ctx, &Config{DB: beaconDB, RetentionEpochs: 2})
+	// Nothing is pruned before the finalized checkpoint is past the retention window.
+	require.NoError(t, beaconDB.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 2, Root: roots[2*slotsPerEpoch-1][:]}))
+	require.NoError(t, s.prune(ctx))
+	lowest, err := beaconDB.LowestRetainedSlot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), lowest)
+
+	require.NoError(t, beaconDB.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 4, Root: roots[4*slotsPerEpoch-1][:]}))
+	require.NoError(t, s.prune(ctx))
+	lowest, err = beaconDB.LowestRetainedSlot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2*slotsPerEpoch, lowest)
+	for i, root := range roots {
+		slot := uint64(i + 1)
+		assert.Equal(t, slot >= lowest, beaconDB.HasBlock(ctx, root), "Unexpected block presence at slot %d", slot)
+	}
+}
@@ -1,5 +1,5 @@
 // Package testing allows for spinning up a real bolt-db
-// instance for unit tests throughout the Prysm repo.
+// or an in-memory database instance for unit tests throughout the Prysm repo.
 package testing
 
 import (
@@ -11,6 +11,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/memory"
 	"github.com/prysmaticlabs/prysm/shared/rand"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 )
@@ -37,3 +38,9 @@ func SetupDB(t testing.TB) (db.Database, *cache.StateSummaryCache) {
 	})
 	return s, sc
 }
+
+// SetupInMemoryDB instantiates and returns an in-memory database.
+func SetupInMemoryDB(t testing.TB) (db.Database, *cache.StateSummaryCache) {
+	sc := cache.NewStateSummaryCache()
+	return memory.NewStore(sc), sc
+}
@@ -7,10 +7,10 @@ import (
 )
 
 var (
-	// HTTPWeb3ProviderFlag provides an HTTP access endpoint to an ETH 1.0 RPC.
+	// HTTPWeb3ProviderFlag provides HTTP access endpoints to ETH 1.0 RPCs, in order of priority.
 	HTTPWeb3ProviderFlag = &cli.StringFlag{
 		Name:  "http-web3provider",
-		Usage: "A mainchain web3 provider string http endpoint",
+		Usage: "A mainchain web3 provider string http endpoint. Can be a comma-separated list of endpoints in order of priority, the first healthy one is used",
 		Value: "",
 	}
 	// DepositContractFlag defines a flag for the deposit contract address.
@@ -102,11 +102,80 @@ var (
 		Usage: "The slot durations of when an archived state gets saved in the DB.",
 		Value: 2048,
 	}
+	// SlotsPerArchiveSnapshot specifies the number of slots between the archived points which are saved as full
+	// states. The archived points in between are saved as diffs against the last full state.
+	SlotsPerArchiveSnapshot = &cli.IntFlag{
+		Name: "slots-per-archive-snapshot",
+		Usage: "The slot durations of when an archived state gets saved in the DB as a full state, archived states " +
+			"in between are saved as diffs. Must be a multiple of --slots-per-archive-point, 0 saves full states only.",
+		Value: 0,
+	}
+	// HistoryRetentionEpochs specifies the number of finalized epochs of history to keep in the DB.
+	HistoryRetentionEpochs = &cli.Uint64Flag{
+		Name: "history-retention-epochs",
+		Usage: "The number of epochs of blocks and states to keep below the finalized checkpoint. Older history " +
+			"is pruned from the DB, 0 keeps the full history.",
+		Value: 0,
+	}
 	// DisableDiscv5 disables running discv5.
 	DisableDiscv5 = &cli.BoolFlag{
 		Name:  "disable-discv5",
 		Usage: "Does not run the discoveryV5 dht.",
 	}
+	// P2PMaxPeersPerIP specifies the max number of peers connected from a single IP address.
+	P2PMaxPeersPerIP = &cli.UintFlag{
+		Name: "p2p-max-peers-per-ip",
+		Usage: "The max number of p2p peers to maintain from a single IP address. Zero disables the limit. " +
+			"Loopback and private addresses are not limited.",
+		Value: 4,
+	}
+	// P2PMaxPeersPerSubnet specifies the max number of peers connected from a single subnet.
+	P2PMaxPeersPerSubnet = &cli.UintFlag{
+		Name: "p2p-max-peers-per-subnet",
+		Usage: "The max number of p2p peers to maintain from a single /24 IPv4 or /64 IPv6 subnet. " +
+			"Zero disables the limit. Loopback and private addresses are not limited.",
+		Value: 10,
+	}
+	// P2PMinOutboundPeersRatio specifies the share of the peer limit reserved for outbound peers.
+	P2PMinOutboundPeersRatio = &cli.Float64Flag{
+		Name: "p2p-min-outbound-peers-ratio",
+		Usage: "The share of the max number of p2p peers reserved for peers dialed by this node, " +
+			"which inbound peers can not take.",
+		Value: 0.2,
+	}
+	// P2PLongLivedSubnets specifies the number of attestation subnets the node subscribes to on its own behalf.
+	P2PLongLivedSubnets = &cli.Uint64Flag{
+		Name: "p2p-long-lived-subnets",
+		Usage: "The number of random attestation subnets the node subscribes to and advertises in its ENR, " +
+			"regardless of attached validators. Each subnet is rotated after EPOCHS_PER_RANDOM_SUBNET_SUBSCRIPTION " +
+			"to twice as many epochs.",
+		Value: 2,
+	}
+	// P2PMinPeersPerSubnet specifies the number of peers below which the node searches for more peers on a subnet.
+	P2PMinPeersPerSubnet = &cli.Uint64Flag{
+		Name: "p2p-min-peers-per-subnet",
+		Usage: "The number of peers on an attestation subnet the node needs below which it searches the " +
+			"network for more peers on the subnet. Zero disables the search.",
+		Value: 4,
+	}
+	// P2PRecordDir specifies the directory to record the gossip and req/resp traffic to.
+	P2PRecordDir = &cli.StringFlag{
+		Name: "p2p-record-dir",
+		Usage: "Records every gossip message and req/resp exchange of the node to rotating files in this " +
+			"directory, for offline inspection and replay. Recording is disabled if empty.",
+	}
+	// P2PRecordMaxFileSize specifies the size in megabytes after which the recording moves on to a new file.
+	P2PRecordMaxFileSize = &cli.Uint64Flag{
+		Name:  "p2p-record-max-file-size",
+		Usage: "The size in megabytes after which the p2p traffic recording moves on to a new file.",
+		Value: 256,
+	}
+	// P2PRecordMaxFiles specifies the number of recording files kept.
+	P2PRecordMaxFiles = &cli.UintFlag{
+		Name:  "p2p-record-max-files",
+		Usage: "The number of p2p traffic recording files kept, the oldest are deleted first. Zero keeps all files.",
+		Value: 16,
+	}
 	// BlockBatchLimit specifies the requested block batch size.
 	BlockBatchLimit = &cli.IntFlag{
 		Name:  "block-batch-limit",
@@ -119,6 +188,15 @@ var (
 		Usage: "The factor by which block batch limit may increase on burst.",
 		Value: 10,
 	}
+	// RPCQuotas overrides the per peer rate limits of rpc methods.
+	RPCQuotas = &cli.StringSliceFlag{
+		Name: "rpc-quota",
+		Usage: "Overrides the per peer rate limit of an rpc method, as <method>[.inbound|.outbound]=<rate>/<burst>. " +
+			"The rate is replenished per second and counts blocks for block requests, requests otherwise. " +
+			"Without a direction, both the limits of requests from and to a peer are set. " +
+			"Block requests by range and by root share a single limit. " +
+			"e.g. --rpc-quota=status=1/5 --rpc-quota=beacon_blocks_by_range.inbound=64/640",
+	}
 	// DisableSync disables a node from syncing at start-up. Instead the node enters regular sync
 	// immediately.
 	DisableSync = &cli.BoolFlag{
@@ -152,4 +230,67 @@ var (
 			"If such a sync is not possible, the node will treat it a critical and irrecoverable failure",
 		Value: "",
 	}
+	// CheckpointStateFlag defines the path to a finalized beacon state used to start the node with checkpoint sync.
+	CheckpointStateFlag = &cli.StringFlag{
+		Name: "checkpoint-state",
+		Usage: "Rather than syncing from genesis, start the beacon node from a trusted finalized beacon state. " +
+			"Input is a path to an SSZ encoded BeaconState and must be used together with --checkpoint-block",
+	}
+	// CheckpointBlockFlag defines the path to the signed block matching the checkpoint state.
+	CheckpointBlockFlag = &cli.StringFlag{
+		Name: "checkpoint-block",
+		Usage: "Path to the SSZ encoded SignedBeaconBlock of the latest block included in --checkpoint-state. " +
+			"The state must be at the start of an epoch and may be advanced past the block through empty slots. " +
+			"Only used on an empty database",
+	}
+	// DepositSnapshotFlag defines the path to a deposit snapshot used to start the deposit trie.
+	DepositSnapshotFlag = &cli.StringFlag{
+		Name: "deposit-snapshot",
+		Usage: "Start the deposit trie from a snapshot of the finalized deposits, as returned by the debug " +
+			"deposit snapshot endpoint, rather than replaying every deposit log. Only used when the database holds no eth1 data, " +
+			"and requires a beacon state to start from, such as a checkpoint state",
+	}
+	// Eth1SimulatorFlag runs a simulated eth1 chain in process.
+	Eth1SimulatorFlag = &cli.BoolFlag{
+		Name: "eth1-simulator",
+		Usage: "Run a simulated eth1 chain with the deposit contract deployed in process, rather than connecting " +
+			"to an eth1 node. The chain is kept in memory and restarts from genesis on every run, so this is only " +
+			"meant for development. It requires a database without eth1 data, such as one cleared with --force-clear-db",
+	}
+	// Eth1SimulatorBlockTimeFlag defines the time between two blocks of the simulated eth1 chain.
+	Eth1SimulatorBlockTimeFlag = &cli.Uint64Flag{
+		Name:  "eth1-simulator-block-time",
+		Usage: "Number of seconds between two blocks of the simulated eth1 chain",
+		Value: 14, // Average mainnet eth1 block time.
+	}
+	// Eth1SimulatorHTTPPortFlag defines the port the simulated eth1 chain serves its JSON-RPC API at.
+	Eth1SimulatorHTTPPortFlag = &cli.IntFlag{
+		Name:  "eth1-simulator-http-port",
+		Usage: "Port the simulated eth1 chain serves its JSON-RPC API at, for deposit tools to send deposits to",
+		Value: 8545,
+	}
+	// ExportFileFlag defines the file chain events are exported to.
+	ExportFileFlag = &cli.StringFlag{
+		Name: "export-file",
+		Usage: "Export blocks, head changes, finalized checkpoints, reorgs, slashings and voluntary exits " +
+			"to this file as newline delimited JSON",
+	}
+	// ExportFileMaxSizeFlag defines the size past which the export file is rotated.
+	ExportFileMaxSizeFlag = &cli.Uint64Flag{
+		Name:  "export-file-max-size",
+		Usage: "The size in megabytes past which the export file is rotated, 0 disables the rotation",
+		Value: 100,
+	}
+	// ExportFileMaxBackupsFlag defines the number of rotated export files to keep.
+	ExportFileMaxBackupsFlag = &cli.IntFlag{
+		Name:  "export-file-max-backups",
+		Usage: "The number of rotated export files to keep",
+		Value: 5,
+	}
+	// ExportSocketFlag defines the Unix socket chain events are streamed on.
+	ExportSocketFlag = &cli.StringFlag{
+		Name: "export-socket",
+		Usage: "Stream blocks, head changes, finalized checkpoints, reorgs, slashings and voluntary exits " +
+			"as newline delimited JSON to the clients of a Unix socket listening at this path",
+	}
 )
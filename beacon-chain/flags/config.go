@@ -15,6 +15,7 @@ type GlobalFlags struct {
 	MinimumSyncPeers           int
 	BlockBatchLimit            int
 	BlockBatchLimitBurstFactor int
+	HistoryRetentionEpochs     uint64
 }
 
 var globalConfig *GlobalFlags
@@ -47,6 +48,7 @@ func ConfigureGlobalFlags(ctx *cli.Context) {
 	cfg.DisableDiscv5 = ctx.Bool(DisableDiscv5.Name)
 	cfg.BlockBatchLimit = ctx.Int(BlockBatchLimit.Name)
 	cfg.BlockBatchLimitBurstFactor = ctx.Int(BlockBatchLimitBurstFactor.Name)
+	cfg.HistoryRetentionEpochs = ctx.Uint64(HistoryRetentionEpochs.Name)
 	configureMinimumPeers(ctx, cfg)
 
 	Init(cfg)
@@ -0,0 +1,33 @@
+package flags
+
+import (
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	// ArchiveDirFlag defines the directory holding the archive files written by `db export`
+	// and read by `db import`.
+	ArchiveDirFlag = &cli.StringFlag{
+		Name:     "archive-dir",
+		Usage:    "Directory holding the finalized chain archive files",
+		Required: true,
+	}
+	// ArchiveStartSlotFlag defines the first slot to export. It is rounded down to the start of
+	// the archive file containing it.
+	ArchiveStartSlotFlag = &cli.Uint64Flag{
+		Name:  "start-slot",
+		Usage: "First slot of the finalized chain to export",
+	}
+	// ArchiveEndSlotFlag defines the slot up to which the chain is exported. It defaults to the
+	// start slot of the finalized epoch.
+	ArchiveEndSlotFlag = &cli.Uint64Flag{
+		Name:  "end-slot",
+		Usage: "Slot up to which the finalized chain is exported, defaults to the finalized slot",
+	}
+	// DBRepairFlag makes `db verify` rebuild the derived indices of the database when
+	// inconsistencies are found.
+	DBRepairFlag = &cli.BoolFlag{
+		Name:  "repair",
+		Usage: "Rebuild the block, state and finalized indices of the database if inconsistencies are found",
+	}
+)
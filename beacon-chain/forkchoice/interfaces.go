@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
 )
 
 // ForkChoicer represents the full fork choice interface composed of all of the sub-interfaces.
@@ -13,6 +14,7 @@ type ForkChoicer interface {
 	AttestationProcessor // to track new attestation for fork choice.
 	Pruner               // to clean old data for fork choice.
 	Getter               // to retrieve fork choice information.
+	Snapshotter          // to persist fork choice across restarts.
 }
 
 // HeadRetriever retrieves head root of the current chain.
@@ -44,3 +46,8 @@ type Getter interface {
 	HasParent(root [32]byte) bool
 	AncestorRoot(ctx context.Context, root [32]byte, slot uint64) ([]byte, error)
 }
+
+// Snapshotter copies the fork choice store so it can be saved and resumed after a restart.
+type Snapshotter interface {
+	Snapshot() *dbpb.ForkChoiceSnapshot
+}
@@ -0,0 +1,133 @@
+package protoarray
+
+import (
+	"fmt"
+
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+)
+
+// Snapshot returns a copy of the fork choice store: its block nodes, the latest votes of the
+// validators and the balances the node weights were computed with. A snapshot lets fork choice
+// resume after a restart with the weights it had, rather than from the finalized checkpoint alone.
+func (f *ForkChoice) Snapshot() *dbpb.ForkChoiceSnapshot {
+	f.store.nodeIndicesLock.Lock()
+	defer f.store.nodeIndicesLock.Unlock()
+	f.votesLock.RLock()
+	defer f.votesLock.RUnlock()
+	f.balancesLock.RLock()
+	defer f.balancesLock.RUnlock()
+
+	nodes := make([]*dbpb.ForkChoiceNode, len(f.store.nodes))
+	for i, n := range f.store.nodes {
+		nodes[i] = &dbpb.ForkChoiceNode{
+			Slot:           n.slot,
+			Root:           copyRoot(n.root),
+			Parent:         n.parent,
+			JustifiedEpoch: n.justifiedEpoch,
+			FinalizedEpoch: n.finalizedEpoch,
+			Weight:         n.weight,
+			BestChild:      n.bestChild,
+			BestDescendant: n.bestDescendant,
+			Graffiti:       copyRoot(n.graffiti),
+		}
+	}
+	votes := make([]*dbpb.ForkChoiceVote, len(f.votes))
+	for i, v := range f.votes {
+		votes[i] = &dbpb.ForkChoiceVote{
+			CurrentRoot: copyRoot(v.currentRoot),
+			NextRoot:    copyRoot(v.nextRoot),
+			NextEpoch:   v.nextEpoch,
+		}
+	}
+	return &dbpb.ForkChoiceSnapshot{
+		JustifiedEpoch: f.store.justifiedEpoch,
+		FinalizedEpoch: f.store.finalizedEpoch,
+		FinalizedRoot:  copyRoot(f.store.finalizedRoot),
+		Nodes:          nodes,
+		Votes:          votes,
+		Balances:       append([]uint64{}, f.balances...),
+	}
+}
+
+// NewFromSnapshot initializes a fork choice store from a snapshot. An error is returned if the
+// snapshot nodes do not form a valid tree.
+func NewFromSnapshot(s *dbpb.ForkChoiceSnapshot) (*ForkChoice, error) {
+	finalizedRoot, err := toRoot(s.FinalizedRoot)
+	if err != nil {
+		return nil, fmt.Errorf("invalid finalized root: %v", err)
+	}
+	store := &Store{
+		justifiedEpoch: s.JustifiedEpoch,
+		finalizedEpoch: s.FinalizedEpoch,
+		finalizedRoot:  finalizedRoot,
+		nodes:          make([]*Node, 0, len(s.Nodes)),
+		nodesIndices:   make(map[[32]byte]uint64, len(s.Nodes)),
+		pruneThreshold: defaultPruneThreshold,
+	}
+	count := uint64(len(s.Nodes))
+	for i, n := range s.Nodes {
+		// Nodes are only ever appended after their parent, and pruning preserves their order.
+		if n.Parent != NonExistentNode && n.Parent >= uint64(i) {
+			return nil, errInvalidNodeIndex
+		}
+		if n.BestChild != NonExistentNode && n.BestChild >= count {
+			return nil, errInvalidBestChildIndex
+		}
+		if n.BestDescendant != NonExistentNode && n.BestDescendant >= count {
+			return nil, errInvalidBestDescendantIndex
+		}
+		root, err := toRoot(n.Root)
+		if err != nil {
+			return nil, fmt.Errorf("invalid root of node %d: %v", i, err)
+		}
+		graffiti, err := toRoot(n.Graffiti)
+		if err != nil {
+			return nil, fmt.Errorf("invalid graffiti of node %d: %v", i, err)
+		}
+		if _, ok := store.nodesIndices[root]; ok {
+			return nil, fmt.Errorf("duplicate node %#x", root)
+		}
+		store.nodes = append(store.nodes, &Node{
+			slot:           n.Slot,
+			root:           root,
+			parent:         n.Parent,
+			justifiedEpoch: n.JustifiedEpoch,
+			finalizedEpoch: n.FinalizedEpoch,
+			weight:         n.Weight,
+			bestChild:      n.BestChild,
+			bestDescendant: n.BestDescendant,
+			graffiti:       graffiti,
+		})
+		store.nodesIndices[root] = uint64(i)
+	}
+	votes := make([]Vote, len(s.Votes))
+	for i, v := range s.Votes {
+		currentRoot, err := toRoot(v.CurrentRoot)
+		if err != nil {
+			return nil, fmt.Errorf("invalid current root of vote %d: %v", i, err)
+		}
+		nextRoot, err := toRoot(v.NextRoot)
+		if err != nil {
+			return nil, fmt.Errorf("invalid next root of vote %d: %v", i, err)
+		}
+		votes[i] = Vote{currentRoot: currentRoot, nextRoot: nextRoot, nextEpoch: v.NextEpoch}
+	}
+	return &ForkChoice{
+		store:    store,
+		votes:    votes,
+		balances: append([]uint64{}, s.Balances...),
+	}, nil
+}
+
+func copyRoot(root [32]byte) []byte {
+	return append([]byte{}, root[:]...)
+}
+
+func toRoot(b []byte) ([32]byte, error) {
+	var root [32]byte
+	if len(b) != len(root) {
+		return root, fmt.Errorf("wanted 32 bytes, got %d", len(b))
+	}
+	copy(root[:], b)
+	return root, nil
+}
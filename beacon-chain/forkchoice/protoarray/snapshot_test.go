@@ -0,0 +1,92 @@
+package protoarray
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestSnapshot_RoundTripKeepsHeadAndVotes(t *testing.T) {
+	ctx := context.Background()
+	balances := []uint64{1, 1}
+	f := setup(1, 1)
+	require.NoError(t, f.ProcessBlock(ctx, 1, indexToHash(1), params.BeaconConfig().ZeroHash, [32]byte{'a'}, 1, 1))
+	require.NoError(t, f.ProcessBlock(ctx, 1, indexToHash(2), params.BeaconConfig().ZeroHash, [32]byte{}, 1, 1))
+	f.ProcessAttestation(ctx, []uint64{0}, indexToHash(2), 2)
+	r, err := f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(2), r, "Incorrect head")
+
+	enc, err := f.Snapshot().Marshal()
+	require.NoError(t, err)
+	s := &dbpb.ForkChoiceSnapshot{}
+	require.NoError(t, s.Unmarshal(enc))
+	require.Equal(t, 3, len(s.Nodes))
+	for i, root := range [][32]byte{params.BeaconConfig().ZeroHash, indexToHash(1), indexToHash(2)} {
+		assert.DeepEqual(t, root[:], s.Nodes[i].Root)
+	}
+	restored, err := NewFromSnapshot(s)
+	require.NoError(t, err)
+	assert.DeepEqual(t, f.store.nodes, restored.store.nodes)
+	assert.DeepEqual(t, f.store.nodesIndices, restored.store.nodesIndices)
+	assert.DeepEqual(t, f.votes, restored.votes)
+	assert.DeepEqual(t, f.balances, restored.balances)
+
+	// The restored weights only change by the balance and vote deltas since the snapshot.
+	restored.ProcessAttestation(ctx, []uint64{1}, indexToHash(1), 2)
+	r, err = restored.Head(ctx, 1, params.BeaconConfig().ZeroHash, []uint64{1, 2}, 1)
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(1), r, "Incorrect head after restoring the snapshot")
+	assert.Equal(t, uint64(1), restored.Node(indexToHash(2)).Weight())
+	assert.Equal(t, uint64(2), restored.Node(indexToHash(1)).Weight())
+}
+
+func TestSnapshot_ConcurrentWithVotes(t *testing.T) {
+	ctx := context.Background()
+	f := setup(1, 1)
+	require.NoError(t, f.ProcessBlock(ctx, 1, indexToHash(1), params.BeaconConfig().ZeroHash, [32]byte{}, 1, 1))
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := uint64(0); i < 100; i++ {
+			f.ProcessAttestation(ctx, []uint64{i}, indexToHash(1), 2)
+			_, err := f.Head(ctx, 1, params.BeaconConfig().ZeroHash, make([]uint64, i+1), 1)
+			assert.NoError(t, err)
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			_, err := f.Snapshot().Marshal()
+			assert.NoError(t, err)
+		}
+	}()
+	wg.Wait()
+	assert.Equal(t, 100, len(f.Snapshot().Votes))
+}
+
+func TestNewFromSnapshot_RejectsInvalidTree(t *testing.T) {
+	s := setup(1, 1).Snapshot()
+	s.Nodes[0].Parent = 0
+	_, err := NewFromSnapshot(s)
+	assert.ErrorContains(t, errInvalidNodeIndex.Error(), err)
+
+	f := setup(1, 1)
+	require.NoError(t, f.ProcessBlock(context.Background(), 1, indexToHash(1), params.BeaconConfig().ZeroHash, [32]byte{}, 1, 1))
+	s = f.Snapshot()
+	s.Nodes[1].Root = s.Nodes[0].Root
+	_, err = NewFromSnapshot(s)
+	assert.ErrorContains(t, "duplicate node", err)
+
+	s = f.Snapshot()
+	s.Nodes[1].Root = s.Nodes[1].Root[:31]
+	_, err = NewFromSnapshot(s)
+	assert.ErrorContains(t, "invalid root of node 1", err)
+}
@@ -45,6 +45,10 @@ func (f *ForkChoice) Head(ctx context.Context, justifiedEpoch uint64, justifiedR
 	// The only time it writes to node indices is inserting and pruning blocks from the store.
 	f.store.nodeIndicesLock.RLock()
 	defer f.store.nodeIndicesLock.RUnlock()
+	f.votesLock.Lock()
+	defer f.votesLock.Unlock()
+	f.balancesLock.Lock()
+	defer f.balancesLock.Unlock()
 	deltas, newVotes, err := computeDeltas(ctx, f.store.nodesIndices, f.votes, f.balances, newBalances)
 	if err != nil {
 		return [32]byte{}, errors.Wrap(err, "Could not compute deltas")
@@ -65,6 +69,8 @@ func (f *ForkChoice) ProcessAttestation(ctx context.Context, validatorIndices []
 	ctx, span := trace.StartSpan(ctx, "protoArrayForkChoice.ProcessAttestation")
 	defer span.End()
 
+	f.votesLock.Lock()
+	defer f.votesLock.Unlock()
 	for _, index := range validatorIndices {
 		// Validator indices will grow the vote cache.
 		for index >= uint64(len(f.votes)) {
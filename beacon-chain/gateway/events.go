@@ -0,0 +1,55 @@
+package gateway
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1_gateway"
+)
+
+// chainEventsPath is the route serving the chain events stream of the debug service.
+const chainEventsPath = "/eth/v1alpha1/debug/chain/events"
+
+// chainEventsHandler serves the chain events stream of the debug service as server-sent events.
+// Each event is named after its type in lowercase, such as head or reorg, and carries the event
+// as JSON in its data.
+func (g *Gateway) chainEventsHandler(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "Streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+	stream, err := pbrpc.NewDebugClient(g.conn).StreamChainEvents(r.Context(), &empty.Empty{})
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Could not open chain events stream: %v", err), http.StatusBadGateway)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	marshaler := &gwruntime.JSONPb{OrigName: false, EmitDefaults: true}
+	for {
+		event, err := stream.Recv()
+		if err != nil {
+			// The stream ends when the client goes away or the beacon node shuts down.
+			log.WithError(err).Debug("Chain events stream closed")
+			return
+		}
+		data, err := marshaler.Marshal(event)
+		if err != nil {
+			log.WithError(err).Error("Could not marshal chain event")
+			return
+		}
+		if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", strings.ToLower(event.Type.String()), data); err != nil {
+			return
+		}
+		flusher.Flush()
+	}
+}
@@ -74,6 +74,10 @@ func (g *Gateway) Start() {
 		}
 	}
 
+	if g.enableDebugRPCEndpoints {
+		// Server-side streams are served as server-sent events rather than through the gateway mux.
+		g.mux.HandleFunc(chainEventsPath, g.chainEventsHandler)
+	}
 	g.mux.Handle("/", gwmux)
 
 	g.server = &http.Server{
@@ -10,6 +10,7 @@ import (
 	gethlog "github.com/ethereum/go-ethereum/log"
 	golog "github.com/ipfs/go-log/v2"
 	joonix "github.com/joonix/log"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/beacon-chain/node"
 	"github.com/prysmaticlabs/prysm/shared/cmd"
@@ -41,17 +42,38 @@ var appFlags = []cli.Flag{
 	flags.UnsafeSync,
 	flags.DisableSync,
 	flags.DisableDiscv5,
+	flags.P2PMaxPeersPerIP,
+	flags.P2PMaxPeersPerSubnet,
+	flags.P2PMinOutboundPeersRatio,
+	flags.P2PLongLivedSubnets,
+	flags.P2PMinPeersPerSubnet,
+	flags.P2PRecordDir,
+	flags.P2PRecordMaxFileSize,
+	flags.P2PRecordMaxFiles,
 	flags.BlockBatchLimit,
 	flags.BlockBatchLimitBurstFactor,
+	flags.RPCQuotas,
 	flags.InteropMockEth1DataVotesFlag,
 	flags.InteropGenesisStateFlag,
 	flags.InteropNumValidatorsFlag,
 	flags.InteropGenesisTimeFlag,
 	flags.SlotsPerArchivedPoint,
+	flags.SlotsPerArchiveSnapshot,
+	flags.HistoryRetentionEpochs,
 	flags.EnableDebugRPCEndpoints,
 	flags.HistoricalSlasherNode,
 	flags.ChainID,
 	flags.NetworkID,
+	flags.CheckpointStateFlag,
+	flags.CheckpointBlockFlag,
+	flags.DepositSnapshotFlag,
+	flags.Eth1SimulatorFlag,
+	flags.Eth1SimulatorBlockTimeFlag,
+	flags.Eth1SimulatorHTTPPortFlag,
+	flags.ExportFileFlag,
+	flags.ExportFileMaxSizeFlag,
+	flags.ExportFileMaxBackupsFlag,
+	flags.ExportSocketFlag,
 	cmd.MinimalConfigFlag,
 	cmd.E2EConfigFlag,
 	cmd.RPCMaxPageSizeFlag,
@@ -80,6 +102,9 @@ var appFlags = []cli.Flag{
 	cmd.DisableMonitoringFlag,
 	cmd.ClearDB,
 	cmd.ForceClearDB,
+	cmd.BackupIntervalFlag,
+	cmd.BackupRetentionFlag,
+	cmd.BackupIncrementalsFlag,
 	cmd.LogFormat,
 	cmd.MaxGoroutines,
 	debug.PProfFlag,
@@ -106,6 +131,9 @@ func main() {
 	app.Usage = "this is a beacon chain implementation for Ethereum 2.0"
 	app.Action = startNode
 	app.Version = version.GetVersion()
+	app.Commands = []*cli.Command{
+		db.Commands,
+	}
 
 	app.Flags = appFlags
 
@@ -14,28 +14,42 @@ import (
 	"strings"
 	"sync"
 	"syscall"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/exporter"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/memory"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/pruner"
 	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice"
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
 	"github.com/prysmaticlabs/prysm/beacon-chain/gateway"
 	interopcoldstart "github.com/prysmaticlabs/prysm/beacon-chain/interop-cold-start"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/persistence"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/powchain/simulator"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	regularsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync/backfill"
 	initialsync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync"
+	protodb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/shared/backup"
 	"github.com/prysmaticlabs/prysm/shared/cmd"
 	"github.com/prysmaticlabs/prysm/shared/debug"
 	"github.com/prysmaticlabs/prysm/shared/event"
@@ -52,7 +66,6 @@ import (
 
 var log = logrus.WithField("prefix", "node")
 
-const beaconChainDBName = "beaconchaindata"
 const testSkipPowFlag = "test-skip-pow"
 
 // BeaconNode defines a struct that handles the services running a random beacon chain
@@ -76,6 +89,8 @@ type BeaconNode struct {
 	opFeed            *event.Feed
 	forkChoiceStore   forkchoice.ForkChoicer
 	stateGen          *stategen.State
+	// tempDir holds the files of the node which are not kept with an in-memory database.
+	tempDir string
 }
 
 // NewBeaconNode creates a new node instance, sets up configuration options, and registers
@@ -122,6 +137,16 @@ func NewBeaconNode(cliCtx *cli.Context) (*BeaconNode, error) {
 		params.OverrideBeaconConfig(c)
 	}
 
+	if cliCtx.IsSet(flags.SlotsPerArchiveSnapshot.Name) {
+		c := params.BeaconConfig()
+		c.SlotsPerArchiveSnapshot = uint64(cliCtx.Int(flags.SlotsPerArchiveSnapshot.Name))
+		if c.SlotsPerArchiveSnapshot%c.SlotsPerArchivedPoint != 0 {
+			return nil, fmt.Errorf("--%s=%d is not a multiple of --%s=%d", flags.SlotsPerArchiveSnapshot.Name,
+				c.SlotsPerArchiveSnapshot, flags.SlotsPerArchivedPoint.Name, c.SlotsPerArchivedPoint)
+		}
+		params.OverrideBeaconConfig(c)
+	}
+
 	// Setting chain network specific flags.
 	if cliCtx.IsSet(flags.DepositContractFlag.Name) {
 		c := params.BeaconNetworkConfig()
@@ -171,12 +196,32 @@ func NewBeaconNode(cliCtx *cli.Context) (*BeaconNode, error) {
 		return nil, err
 	}
 
+	if err := beacon.registerExporterService(cliCtx); err != nil {
+		return nil, err
+	}
+
+	if err := beacon.startFromCheckpoint(cliCtx); err != nil {
+		return nil, err
+	}
+
 	beacon.startStateGen()
 
+	if err := beacon.registerBackupService(cliCtx); err != nil {
+		return nil, err
+	}
+
+	if err := beacon.registerPrunerService(); err != nil {
+		return nil, err
+	}
+
 	if err := beacon.registerP2P(cliCtx); err != nil {
 		return nil, err
 	}
 
+	if err := beacon.registerEth1Simulator(); err != nil {
+		return nil, err
+	}
+
 	if err := beacon.registerPOWChainService(); err != nil {
 		return nil, err
 	}
@@ -195,6 +240,10 @@ func NewBeaconNode(cliCtx *cli.Context) (*BeaconNode, error) {
 		return nil, err
 	}
 
+	if err := beacon.registerOperationPoolPersistence(); err != nil {
+		return nil, err
+	}
+
 	if err := beacon.registerInitialSyncService(); err != nil {
 		return nil, err
 	}
@@ -203,6 +252,10 @@ func NewBeaconNode(cliCtx *cli.Context) (*BeaconNode, error) {
 		return nil, err
 	}
 
+	if err := beacon.registerBackfillService(); err != nil {
+		return nil, err
+	}
+
 	if err := beacon.registerRPCService(); err != nil {
 		return nil, err
 	}
@@ -280,6 +333,11 @@ func (b *BeaconNode) Close() {
 	if err := b.db.Close(); err != nil {
 		log.Errorf("Failed to close database: %v", err)
 	}
+	if b.tempDir != "" {
+		if err := os.RemoveAll(b.tempDir); err != nil {
+			log.Errorf("Failed to remove temporary directory: %v", err)
+		}
+	}
 	close(b.stop)
 }
 
@@ -290,7 +348,11 @@ func (b *BeaconNode) startForkChoice() {
 
 func (b *BeaconNode) startDB(cliCtx *cli.Context) error {
 	baseDir := cliCtx.String(cmd.DataDirFlag.Name)
-	dbPath := filepath.Join(baseDir, beaconChainDBName)
+	dbPath := filepath.Join(baseDir, kv.BeaconNodeDbDirName)
+	if baseDir == memory.DataDir {
+		dbPath = memory.DataDir
+		log.Warn("Using an in-memory database, all chain data will be lost once the beacon node stops")
+	}
 	clearDB := cliCtx.Bool(cmd.ClearDB.Name)
 	forceClearDB := cliCtx.Bool(cmd.ForceClearDB.Name)
 
@@ -336,6 +398,114 @@ func (b *BeaconNode) startDB(cliCtx *cli.Context) error {
 	return nil
 }
 
+// registerExporterService exports chain events to the sinks set through the export flags. The
+// database is wrapped to export the blocks, head changes and finalized checkpoints saved to it.
+func (b *BeaconNode) registerExporterService(cliCtx *cli.Context) error {
+	cfg := &exporter.Config{
+		StateNotifier:         b,
+		OperationNotifier:     b,
+		FilePath:              cliCtx.String(flags.ExportFileFlag.Name),
+		FileMaxSize:           int64(cliCtx.Uint64(flags.ExportFileMaxSizeFlag.Name)) * 1024 * 1024,
+		FileMaxBackups:        cliCtx.Int(flags.ExportFileMaxBackupsFlag.Name),
+		SocketPath:            cliCtx.String(flags.ExportSocketFlag.Name),
+		KafkaBootstrapServers: featureconfig.Get().KafkaBootstrapServers,
+	}
+	if cfg.FilePath == "" && cfg.SocketPath == "" && cfg.KafkaBootstrapServers == "" {
+		return nil
+	}
+	svc, err := exporter.NewService(b.ctx, cfg)
+	if err != nil {
+		return errors.Wrap(err, "could not register exporter service")
+	}
+	b.db = exporter.Wrap(b.db, svc)
+	return b.services.RegisterService(svc)
+}
+
+// registerBackupService schedules backups of the database when a backup interval is set.
+func (b *BeaconNode) registerBackupService(cliCtx *cli.Context) error {
+	interval := cliCtx.Duration(cmd.BackupIntervalFlag.Name)
+	if interval <= 0 {
+		return nil
+	}
+	if b.db.DatabasePath() == memory.DataDir {
+		return errors.New("database backups are not supported with an in-memory database")
+	}
+	svc := backup.NewService(b.ctx, &backup.Config{
+		Database:     b.db,
+		Dir:          filepath.Join(b.db.DatabasePath(), backup.DirectoryName),
+		Prefix:       "prysm_beacondb",
+		Interval:     interval,
+		Retention:    cliCtx.Int(cmd.BackupRetentionFlag.Name),
+		Incrementals: cliCtx.Int(cmd.BackupIncrementalsFlag.Name),
+	})
+	return b.services.RegisterService(svc)
+}
+
+// registerPrunerService prunes the history below the retention window when a history retention
+// is set.
+func (b *BeaconNode) registerPrunerService() error {
+	retention := flags.Get().HistoryRetentionEpochs
+	if retention == 0 {
+		return nil
+	}
+	svc := pruner.NewService(b.ctx, &pruner.Config{
+		DB:              b.db,
+		RetentionEpochs: retention,
+	})
+	return b.services.RegisterService(svc)
+}
+
+// startFromCheckpoint seeds an empty database with the finalized state and block passed in
+// through the checkpoint sync flags. Initial sync then proceeds forward from that anchor.
+func (b *BeaconNode) startFromCheckpoint(cliCtx *cli.Context) error {
+	statePath := cliCtx.String(flags.CheckpointStateFlag.Name)
+	blockPath := cliCtx.String(flags.CheckpointBlockFlag.Name)
+	if statePath == "" && blockPath == "" {
+		return nil
+	}
+	if statePath == "" || blockPath == "" {
+		return fmt.Errorf("--%s and --%s must be used together", flags.CheckpointStateFlag.Name, flags.CheckpointBlockFlag.Name)
+	}
+
+	headBlock, err := b.db.HeadBlock(b.ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve head block")
+	}
+	if headBlock != nil {
+		log.Warn("Database already contains a chain, ignoring checkpoint sync flags")
+		return nil
+	}
+
+	stateBytes, err := ioutil.ReadFile(statePath)
+	if err != nil {
+		return errors.Wrap(err, "could not read checkpoint state")
+	}
+	st := &pb.BeaconState{}
+	if err := st.UnmarshalSSZ(stateBytes); err != nil {
+		return errors.Wrap(err, "could not unmarshal checkpoint state")
+	}
+	blockBytes, err := ioutil.ReadFile(blockPath)
+	if err != nil {
+		return errors.Wrap(err, "could not read checkpoint block")
+	}
+	blk := &ethpb.SignedBeaconBlock{}
+	if err := blk.UnmarshalSSZ(blockBytes); err != nil {
+		return errors.Wrap(err, "could not unmarshal checkpoint block")
+	}
+	stateTrie, err := state.InitializeFromProto(st)
+	if err != nil {
+		return errors.Wrap(err, "could not initialize checkpoint state")
+	}
+	if err := b.db.SaveOriginCheckpoint(b.ctx, stateTrie, blk); err != nil {
+		return errors.Wrap(err, "could not save checkpoint state and block")
+	}
+	log.WithFields(logrus.Fields{
+		"slot":  st.Slot,
+		"epoch": helpers.SlotToEpoch(st.Slot),
+	}).Info("Initialized database from checkpoint state")
+	return nil
+}
+
 func (b *BeaconNode) startStateGen() {
 	b.stateGen = stategen.New(b.db, b.stateSummaryCache)
 }
@@ -354,6 +524,11 @@ func readbootNodes(fileName string) ([]string, error) {
 }
 
 func (b *BeaconNode) registerP2P(cliCtx *cli.Context) error {
+	minOutboundRatio := cliCtx.Float64(flags.P2PMinOutboundPeersRatio.Name)
+	if err := checkMinOutboundPeersRatio(minOutboundRatio); err != nil {
+		return err
+	}
+
 	// Bootnode ENR may be a filepath to a YAML file
 	bootnodesTemp := params.BeaconNetworkConfig().BootstrapNodes //actual CLI values
 	bootnodeAddrs := make([]string, 0)                           //dest of final list of nodes
@@ -379,6 +554,16 @@ func (b *BeaconNode) registerP2P(cliCtx *cli.Context) error {
 			)
 		}
 	}
+	if datadir == memory.DataDir {
+		// Nothing is written to the data directory with an in-memory database, so the node key
+		// and metadata are kept in a temporary directory removed once the node stops.
+		tempDir, err := ioutil.TempDir("", "beacon-node")
+		if err != nil {
+			return errors.Wrap(err, "could not create temporary directory")
+		}
+		b.tempDir = tempDir
+		datadir = tempDir
+	}
 
 	svc, err := p2p.NewService(b.ctx, &p2p.Config{
 		NoDiscovery:       cliCtx.Bool(cmd.NoDiscovery.Name),
@@ -394,10 +579,18 @@ func (b *BeaconNode) registerP2P(cliCtx *cli.Context) error {
 		TCPPort:           cliCtx.Uint(cmd.P2PTCPPort.Name),
 		UDPPort:           cliCtx.Uint(cmd.P2PUDPPort.Name),
 		MaxPeers:          cliCtx.Uint(cmd.P2PMaxPeers.Name),
+		MaxPeersPerIP:     cliCtx.Uint(flags.P2PMaxPeersPerIP.Name),
+		MaxPeersPerSubnet: cliCtx.Uint(flags.P2PMaxPeersPerSubnet.Name),
+		MinOutboundRatio:  minOutboundRatio,
+		LongLivedSubnets:  cliCtx.Uint64(flags.P2PLongLivedSubnets.Name),
+		MinPeersPerSubnet: cliCtx.Uint64(flags.P2PMinPeersPerSubnet.Name),
 		AllowListCIDR:     cliCtx.String(cmd.P2PAllowList.Name),
 		DenyListCIDR:      sliceutil.SplitCommaSeparated(cliCtx.StringSlice(cmd.P2PDenyList.Name)),
 		EnableUPnP:        cliCtx.Bool(cmd.EnableUPnPFlag.Name),
 		DisableDiscv5:     cliCtx.Bool(flags.DisableDiscv5.Name),
+		RecordDir:         cliCtx.String(flags.P2PRecordDir.Name),
+		RecordMaxFileSize: cliCtx.Uint64(flags.P2PRecordMaxFileSize.Name) << 20,
+		RecordMaxFiles:    cliCtx.Uint(flags.P2PRecordMaxFiles.Name),
 		StateNotifier:     b,
 	})
 	if err != nil {
@@ -406,6 +599,15 @@ func (b *BeaconNode) registerP2P(cliCtx *cli.Context) error {
 	return b.services.RegisterService(svc)
 }
 
+// checkMinOutboundPeersRatio rejects shares of the peer limit reserved for outbound peers which
+// would leave inbound peers no slots at all, or let them take more slots than the peer limit.
+func checkMinOutboundPeersRatio(ratio float64) error {
+	if ratio < 0 || ratio >= 1 {
+		return fmt.Errorf("--%s=%v must be at least 0 and less than 1", flags.P2PMinOutboundPeersRatio.Name, ratio)
+	}
+	return nil
+}
+
 func (b *BeaconNode) fetchP2P() p2p.P2P {
 	var p *p2p.Service
 	if err := b.services.FetchService(&p); err != nil {
@@ -456,6 +658,26 @@ func (b *BeaconNode) registerBlockchainService() error {
 	return b.services.RegisterService(blockchainService)
 }
 
+// registerOperationPoolPersistence saves the operation pools to the DB, and restores them once
+// the blockchain service knows the head state.
+func (b *BeaconNode) registerOperationPoolPersistence() error {
+	var chainService *blockchain.Service
+	if err := b.services.FetchService(&chainService); err != nil {
+		return err
+	}
+
+	svc := persistence.NewService(b.ctx, &persistence.Config{
+		BeaconDB:      b.db,
+		HeadFetcher:   chainService,
+		TimeFetcher:   chainService,
+		StateNotifier: b,
+		AttPool:       b.attestationPool,
+		SlashingPool:  b.slashingsPool,
+		ExitPool:      b.exitPool,
+	})
+	return b.services.RegisterService(svc)
+}
+
 func (b *BeaconNode) registerPOWChainService() error {
 	if b.cliCtx.Bool(testSkipPowFlag) {
 		return b.services.RegisterService(&powchain.Service{})
@@ -469,17 +691,36 @@ func (b *BeaconNode) registerPOWChainService() error {
 		log.Fatalf("Invalid deposit contract address given: %s", depAddress)
 	}
 
-	if b.cliCtx.String(flags.HTTPWeb3ProviderFlag.Name) == "" {
+	var endpoints []string
+	for _, endpoint := range strings.Split(b.cliCtx.String(flags.HTTPWeb3ProviderFlag.Name), ",") {
+		if endpoint = strings.TrimSpace(endpoint); endpoint != "" {
+			endpoints = append(endpoints, endpoint)
+		}
+	}
+	if b.cliCtx.Bool(flags.Eth1SimulatorFlag.Name) {
+		var eth1Simulator *simulator.Simulator
+		if err := b.services.FetchService(&eth1Simulator); err != nil {
+			return err
+		}
+		endpoints = []string{eth1Simulator.Endpoint()}
+	}
+	if len(endpoints) == 0 {
 		log.Error("No ETH1 node specified to run with the beacon node. Please consider running your own ETH1 node for better uptime, security, and decentralization of ETH2. Visit https://docs.prylabs.network/docs/prysm-usage/setup-eth1 for more information.")
 		log.Error("You will need to specify --http-web3provider to attach an eth1 node to the prysm node. Without an eth1 node block proposals for your validator will be affected and the beacon node will not be able to initialize the genesis state.")
 	}
 
+	depositSnapshot, err := b.readDepositSnapshot()
+	if err != nil {
+		return err
+	}
+
 	cfg := &powchain.Web3ServiceConfig{
-		HTTPEndPoint:    b.cliCtx.String(flags.HTTPWeb3ProviderFlag.Name),
+		HTTPEndpoints:   endpoints,
 		DepositContract: common.HexToAddress(depAddress),
 		BeaconDB:        b.db,
 		DepositCache:    b.depositCache,
 		StateNotifier:   b,
+		DepositSnapshot: depositSnapshot,
 	}
 	web3Service, err := powchain.NewService(b.ctx, cfg)
 	if err != nil {
@@ -500,6 +741,79 @@ func (b *BeaconNode) registerPOWChainService() error {
 	return b.services.RegisterService(web3Service)
 }
 
+// registerEth1Simulator runs a simulated eth1 chain in process, and points the network config at
+// its deposit contract. The simulated chain restarts from genesis on every run, so it refuses a
+// database holding the eth1 data of a previous run, which would not match the new chain.
+func (b *BeaconNode) registerEth1Simulator() error {
+	if b.cliCtx.Bool(testSkipPowFlag) || !b.cliCtx.Bool(flags.Eth1SimulatorFlag.Name) {
+		return nil
+	}
+	eth1Data, err := b.db.PowchainData(b.ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve eth1 data")
+	}
+	if eth1Data != nil {
+		return fmt.Errorf(
+			"--%s cannot be used with a database holding eth1 data from a previous run, use --%s to start afresh",
+			flags.Eth1SimulatorFlag.Name,
+			cmd.ForceClearDB.Name,
+		)
+	}
+	eth1Simulator, err := simulator.New(b.ctx, &simulator.Config{
+		BlockTime: time.Duration(b.cliCtx.Uint64(flags.Eth1SimulatorBlockTimeFlag.Name)) * time.Second,
+		HTTPHost:  "127.0.0.1",
+		HTTPPort:  b.cliCtx.Int(flags.Eth1SimulatorHTTPPortFlag.Name),
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not create simulated eth1 chain")
+	}
+	c := params.BeaconNetworkConfig()
+	c.DepositContractAddress = eth1Simulator.DepositContractAddress().Hex()
+	c.ContractDeploymentBlock = eth1Simulator.DeployBlock()
+	c.ChainID = eth1Simulator.ChainID()
+	c.NetworkID = eth1Simulator.ChainID()
+	params.OverrideBeaconNetworkConfig(c)
+	return b.services.RegisterService(eth1Simulator)
+}
+
+// readDepositSnapshot reads the protobuf encoded deposit snapshot given by --deposit-snapshot, if
+// any. The snapshot only holds the finalized deposits, so it requires the finalized deposits cache.
+// It also holds none of the deposits the chain started from, so the node cannot build a genesis
+// state from it, and requires a beacon state to start from: one already in the database, which
+// includes a --checkpoint-state, or an --interop-genesis-state.
+func (b *BeaconNode) readDepositSnapshot() (*protodb.DepositSnapshot, error) {
+	path := b.cliCtx.String(flags.DepositSnapshotFlag.Name)
+	if path == "" {
+		return nil, nil
+	}
+	if !featureconfig.Get().EnableFinalizedDepositsCache {
+		return nil, fmt.Errorf("--%s requires the finalized deposits cache", flags.DepositSnapshotFlag.Name)
+	}
+	if b.cliCtx.String(flags.InteropGenesisStateFlag.Name) == "" {
+		headState, err := b.db.HeadState(b.ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get head state")
+		}
+		if headState == nil {
+			return nil, fmt.Errorf(
+				"--%s requires a beacon state to start from, either in the database or given by --%s or --%s",
+				flags.DepositSnapshotFlag.Name,
+				flags.CheckpointStateFlag.Name,
+				flags.InteropGenesisStateFlag.Name,
+			)
+		}
+	}
+	enc, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read deposit snapshot")
+	}
+	snapshot := &protodb.DepositSnapshot{}
+	if err := snapshot.Unmarshal(enc); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal deposit snapshot")
+	}
+	return snapshot, nil
+}
+
 func (b *BeaconNode) registerSyncService() error {
 	var web3Service *powchain.Service
 	if err := b.services.FetchService(&web3Service); err != nil {
@@ -516,9 +830,20 @@ func (b *BeaconNode) registerSyncService() error {
 		return err
 	}
 
+	var p2pService *p2p.Service
+	if err := b.services.FetchService(&p2pService); err != nil {
+		return err
+	}
+
+	quotas, err := b.rpcQuotas()
+	if err != nil {
+		return err
+	}
+
 	rs := regularsync.NewService(b.ctx, &regularsync.Config{
 		DB:                  b.db,
-		P2P:                 b.fetchP2P(),
+		P2P:                 p2pService,
+		Recorder:            p2pService.Recorder(),
 		Chain:               chainService,
 		InitialSync:         initSync,
 		StateNotifier:       b,
@@ -529,11 +854,33 @@ func (b *BeaconNode) registerSyncService() error {
 		SlashingPool:        b.slashingsPool,
 		StateSummaryCache:   b.stateSummaryCache,
 		StateGen:            b.stateGen,
+		RPCQuotas:           quotas,
 	})
 
 	return b.services.RegisterService(rs)
 }
 
+func (b *BeaconNode) registerBackfillService() error {
+	var initSync *initialsync.Service
+	if err := b.services.FetchService(&initSync); err != nil {
+		return err
+	}
+
+	quotas, err := b.rpcQuotas()
+	if err != nil {
+		return err
+	}
+
+	bs := backfill.NewService(b.ctx, &backfill.Config{
+		DB:                     b.db,
+		P2P:                    b.fetchP2P(),
+		InitialSync:            initSync,
+		HistoryRetentionEpochs: flags.Get().HistoryRetentionEpochs,
+		BlocksQuota:            quotas[p2p.RPCBlocksByRangeTopic].Outbound,
+	})
+	return b.services.RegisterService(bs)
+}
+
 func (b *BeaconNode) registerInitialSyncService() error {
 	wsp := b.cliCtx.String(flags.WeakSubjectivityCheckpt.Name)
 	bRoot, epoch, err := convertWspInput(wsp)
@@ -546,6 +893,11 @@ func (b *BeaconNode) registerInitialSyncService() error {
 		return err
 	}
 
+	quotas, err := b.rpcQuotas()
+	if err != nil {
+		return err
+	}
+
 	is := initialsync.NewService(b.ctx, &initialsync.Config{
 		DB:            b.db,
 		Chain:         chainService,
@@ -554,10 +906,17 @@ func (b *BeaconNode) registerInitialSyncService() error {
 		BlockNotifier: b,
 		WspBlockRoot:  bRoot,
 		WspEpoch:      epoch,
+		BlocksQuota:   quotas[p2p.RPCBlocksByRangeTopic].Outbound,
 	})
 	return b.services.RegisterService(is)
 }
 
+// rpcQuotas returns the per peer rate limits of rpc methods, with the overrides of --rpc-quota
+// applied. Initial sync and backfill request blocks within the outbound block quota as well.
+func (b *BeaconNode) rpcQuotas() (regularsync.Quotas, error) {
+	return regularsync.ParseQuotas(b.cliCtx.StringSlice(flags.RPCQuotas.Name))
+}
+
 func (b *BeaconNode) registerRPCService() error {
 	var chainService *blockchain.Service
 	if err := b.services.FetchService(&chainService); err != nil {
@@ -574,6 +933,16 @@ func (b *BeaconNode) registerRPCService() error {
 		return err
 	}
 
+	var backfillService *backfill.Service
+	if err := b.services.FetchService(&backfillService); err != nil {
+		return err
+	}
+
+	var regularSyncService *regularsync.Service
+	if err := b.services.FetchService(&regularSyncService); err != nil {
+		return err
+	}
+
 	genesisValidators := b.cliCtx.Uint64(flags.InteropNumValidatorsFlag.Name)
 	genesisStatePath := b.cliCtx.String(flags.InteropGenesisStateFlag.Name)
 	var depositFetcher depositcache.DepositFetcher
@@ -596,7 +965,10 @@ func (b *BeaconNode) registerRPCService() error {
 	key := b.cliCtx.String(flags.KeyFlag.Name)
 	mockEth1DataVotes := b.cliCtx.Bool(flags.InteropMockEth1DataVotesFlag.Name)
 	enableDebugRPCEndpoints := b.cliCtx.Bool(flags.EnableDebugRPCEndpoints.Name)
-	p2pService := b.fetchP2P()
+	var p2pService *p2p.Service
+	if err := b.services.FetchService(&p2pService); err != nil {
+		return err
+	}
 	rpcService := rpc.NewService(b.ctx, &rpc.Config{
 		Host:                    host,
 		Port:                    port,
@@ -606,6 +978,10 @@ func (b *BeaconNode) registerRPCService() error {
 		Broadcaster:             p2pService,
 		PeersFetcher:            p2pService,
 		PeerManager:             p2pService,
+		PeerController:          p2pService,
+		RateLimitsFetcher:       regularSyncService,
+		EndpointStatusFetcher:   web3Service,
+		DepositSnapshotFetcher:  web3Service,
 		HeadFetcher:             chainService,
 		ForkFetcher:             chainService,
 		FinalizationFetcher:     chainService,
@@ -620,6 +996,7 @@ func (b *BeaconNode) registerRPCService() error {
 		ChainStartFetcher:       chainStartFetcher,
 		MockEth1Votes:           mockEth1DataVotes,
 		SyncService:             syncService,
+		BackfillService:         backfillService,
 		DepositFetcher:          depositFetcher,
 		PendingDepositFetcher:   b.depositCache,
 		BlockNotifier:           b,
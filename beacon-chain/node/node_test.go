@@ -65,3 +65,10 @@ func TestBootStrapNodeFile(t *testing.T) {
 	assert.Equal(t, sampleNode1[2:], nodeList[1], "Unexpected nodes")
 	assert.Equal(t, sampleNode2[2:], nodeList[2], "Unexpected nodes")
 }
+
+func TestCheckMinOutboundPeersRatio(t *testing.T) {
+	assert.ErrorContains(t, "must be at least 0 and less than 1", checkMinOutboundPeersRatio(-0.01))
+	assert.NoError(t, checkMinOutboundPeersRatio(0))
+	assert.NoError(t, checkMinOutboundPeersRatio(0.99))
+	assert.ErrorContains(t, "must be at least 0 and less than 1", checkMinOutboundPeersRatio(1))
+}
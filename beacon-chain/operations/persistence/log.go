@@ -0,0 +1,7 @@
+package persistence
+
+import (
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "operation-pools")
@@ -0,0 +1,153 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// save saves the pending slashings and exits, along with the attestations of the current and
+// previous epochs, to the DB. Nothing is saved before the pools were restored, as that would
+// replace the saved pools with the empty pools of a node which just started.
+func (s *Service) save(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "persistence.save")
+	defer span.End()
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if !s.restored {
+		return nil
+	}
+	unaggregated, err := s.cfg.AttPool.UnaggregatedAttestations()
+	if err != nil {
+		s.err = errors.Wrap(err, "could not get unaggregated attestations")
+		return s.err
+	}
+	pools := &dbpb.OperationPools{
+		ProposerSlashings:        s.cfg.SlashingPool.AllProposerSlashings(),
+		AttesterSlashings:        s.cfg.SlashingPool.AllAttesterSlashings(),
+		VoluntaryExits:           s.cfg.ExitPool.AllExits(),
+		UnaggregatedAttestations: s.recentAttestations(unaggregated),
+		AggregatedAttestations:   s.recentAttestations(s.cfg.AttPool.AggregatedAttestations()),
+	}
+	s.err = s.cfg.BeaconDB.SaveOperationPools(ctx, pools)
+	return s.err
+}
+
+// restore inserts the operations saved to the DB into the pools. Every operation is validated
+// against the head state again, as it may have been included in a block or have expired while
+// the node was stopped, and invalid operations are dropped.
+func (s *Service) restore(ctx context.Context, headState *state.BeaconState) error {
+	ctx, span := trace.StartSpan(ctx, "persistence.restore")
+	defer span.End()
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	pools, err := s.cfg.BeaconDB.OperationPools(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get saved operation pools")
+	}
+	// The pools are saved from now on, even if none could be restored.
+	s.restored = true
+	if pools == nil {
+		return nil
+	}
+
+	unaggregated := s.recentAttestations(pools.UnaggregatedAttestations)
+	aggregated := s.recentAttestations(pools.AggregatedAttestations)
+	expired := len(pools.UnaggregatedAttestations) + len(pools.AggregatedAttestations) - len(unaggregated) - len(aggregated)
+	var restored, dropped int
+	drop := func(kind string, err error) {
+		log.WithError(err).Debugf("Dropping saved %s", kind)
+		dropped++
+	}
+	for _, slashing := range pools.ProposerSlashings {
+		if err := s.cfg.SlashingPool.InsertProposerSlashing(ctx, headState, slashing); err != nil {
+			drop("proposer slashing", err)
+			continue
+		}
+		restored++
+	}
+	for _, slashing := range pools.AttesterSlashings {
+		if err := s.cfg.SlashingPool.InsertAttesterSlashing(ctx, headState, slashing); err != nil {
+			drop("attester slashing", err)
+			continue
+		}
+		restored++
+	}
+	for _, exit := range pools.VoluntaryExits {
+		if err := verifyExit(headState, exit); err != nil {
+			drop("voluntary exit", err)
+			continue
+		}
+		s.cfg.ExitPool.InsertVoluntaryExit(ctx, headState, exit)
+		restored++
+	}
+	for _, att := range unaggregated {
+		if err := blocks.VerifyAttestationSignature(ctx, headState, att); err != nil {
+			drop("unaggregated attestation", err)
+			continue
+		}
+		if err := s.cfg.AttPool.SaveUnaggregatedAttestation(att); err != nil {
+			drop("unaggregated attestation", err)
+			continue
+		}
+		restored++
+	}
+	for _, att := range aggregated {
+		if err := blocks.VerifyAttestationSignature(ctx, headState, att); err != nil {
+			drop("aggregated attestation", err)
+			continue
+		}
+		if err := s.cfg.AttPool.SaveAggregatedAttestation(att); err != nil {
+			drop("aggregated attestation", err)
+			continue
+		}
+		restored++
+	}
+	log.WithFields(logrus.Fields{
+		"restored": restored,
+		"dropped":  dropped,
+		"expired":  expired,
+	}).Info("Restored operation pools")
+	return nil
+}
+
+// recentAttestations returns the attestations of the current and previous epochs, older ones
+// having expired.
+func (s *Service) recentAttestations(atts []*ethpb.Attestation) []*ethpb.Attestation {
+	currentEpoch := helpers.SlotToEpoch(s.cfg.TimeFetcher.CurrentSlot())
+	recent := make([]*ethpb.Attestation, 0, len(atts))
+	for _, att := range atts {
+		if att == nil || att.Data == nil {
+			continue
+		}
+		if epoch := helpers.SlotToEpoch(att.Data.Slot); epoch+1 >= currentEpoch && epoch <= currentEpoch {
+			recent = append(recent, att)
+		}
+	}
+	return recent
+}
+
+// verifyExit validates a voluntary exit the way exits received over gossip are.
+func verifyExit(headState *state.BeaconState, exit *ethpb.SignedVoluntaryExit) error {
+	if exit == nil || exit.Exit == nil {
+		return errors.New("nil exit")
+	}
+	if exit.Exit.ValidatorIndex >= uint64(headState.NumValidators()) {
+		return fmt.Errorf("validator index %d is out of range", exit.Exit.ValidatorIndex)
+	}
+	val, err := headState.ValidatorAtIndexReadOnly(exit.Exit.ValidatorIndex)
+	if err != nil {
+		return err
+	}
+	return blocks.VerifyExitAndSignature(val, headState.Slot(), headState.Fork(), exit, headState.GenesisValidatorRoot())
+}
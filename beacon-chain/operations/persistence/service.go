@@ -0,0 +1,131 @@
+// Package persistence saves the pending operations of the operation pools to the database, so
+// slashings, voluntary exits and recent attestations which may never be gossiped again survive a
+// restart of the node.
+package persistence
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+var _ = shared.Service(&Service{})
+
+// Config to set up the operation pool persistence service.
+type Config struct {
+	BeaconDB      db.HeadAccessDatabase
+	HeadFetcher   blockchain.HeadFetcher
+	TimeFetcher   blockchain.TimeFetcher
+	StateNotifier statefeed.Notifier
+	AttPool       attestations.Pool
+	SlashingPool  *slashings.Pool
+	ExitPool      *voluntaryexits.Pool
+}
+
+// Service restores the operation pools saved to the database once the head state is known, then
+// saves them once every epoch and when the node stops.
+type Service struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	cfg    *Config
+	// lock guards restored and err, and serializes the restoring and saving of the pools.
+	lock     sync.Mutex
+	restored bool
+	err      error
+}
+
+// NewService configures the operation pool persistence service.
+func NewService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		ctx:    ctx,
+		cancel: cancel,
+		cfg:    cfg,
+	}
+}
+
+// Start the operation pool persistence service.
+func (s *Service) Start() {
+	go s.run()
+}
+
+// Stop the service and save the operation pools. A failure to save them is logged rather than
+// returned, as it should not prevent the node from stopping.
+func (s *Service) Stop() error {
+	s.cancel()
+	// The context of the node is canceled before its services are stopped.
+	if err := s.save(context.Background()); err != nil {
+		log.WithError(err).Error("Could not save operation pools")
+	}
+	return nil
+}
+
+// Status returns the error of the last save of the operation pools, if it failed.
+func (s *Service) Status() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.err
+}
+
+func (s *Service) run() {
+	headState, err := s.waitForHeadState()
+	if err != nil {
+		log.WithError(err).Error("Could not get head state to restore operation pools")
+		return
+	}
+	if headState == nil {
+		log.Error("Could not restore operation pools without a head state")
+		return
+	}
+	if err := s.restore(s.ctx, headState); err != nil {
+		log.WithError(err).Error("Could not restore operation pools")
+	}
+
+	ticker := time.NewTicker(time.Duration(params.BeaconConfig().SecondsPerSlot*params.BeaconConfig().SlotsPerEpoch) * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := s.save(s.ctx); err != nil {
+				log.WithError(err).Error("Could not save operation pools")
+			}
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// waitForHeadState returns the head state, waiting for the chain to start if it did not yet.
+func (s *Service) waitForHeadState() (*state.BeaconState, error) {
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := s.cfg.StateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+	headState, err := s.cfg.HeadFetcher.HeadState(s.ctx)
+	if err != nil || headState != nil {
+		return headState, err
+	}
+	for {
+		select {
+		case event := <-stateChannel:
+			if event.Type == statefeed.Initialized {
+				return s.cfg.HeadFetcher.HeadState(s.ctx)
+			}
+		case <-s.ctx.Done():
+			return nil, errors.New("context closed")
+		case err := <-stateSub.Err():
+			return nil, err
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package persistence
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func signedExit(t *testing.T, beaconState *state.BeaconState, priv bls.SecretKey, validatorIndex uint64) *ethpb.SignedVoluntaryExit {
+	exit := &ethpb.SignedVoluntaryExit{Exit: &ethpb.VoluntaryExit{ValidatorIndex: validatorIndex}}
+	var err error
+	exit.Signature, err = helpers.ComputeDomainAndSign(beaconState, 0, exit.Exit, params.BeaconConfig().DomainVoluntaryExit, priv)
+	require.NoError(t, err)
+	return exit
+}
+
+func TestService_RestoreAndSave(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	conf := params.BeaconConfig()
+	conf.ShardCommitteePeriod = 0 // Lets the genesis validators exit.
+	params.OverrideBeaconConfig(conf)
+	ctx := context.Background()
+	beaconDB, _ := dbtest.SetupDB(t)
+	headState, privs := testutil.DeterministicGenesisState(t, 64)
+
+	proposerSlashing, err := testutil.GenerateProposerSlashingForValidator(headState, privs[1], 1)
+	require.NoError(t, err)
+	badProposerSlashing, err := testutil.GenerateProposerSlashingForValidator(headState, privs[3], 2)
+	require.NoError(t, err)
+	attesterSlashing, err := testutil.GenerateAttesterSlashingForValidator(headState, privs[4], 4)
+	require.NoError(t, err)
+	exit := signedExit(t, headState, privs[5], 5)
+	badExit := signedExit(t, headState, privs[7], 6)
+	unaggregated, err := testutil.GenerateAttestations(headState, privs, 2, 0, false)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(unaggregated))
+	aggregated, err := testutil.GenerateAttestations(headState, privs, 1, 0, false)
+	require.NoError(t, err)
+	badAtt := state.CopyAttestation(unaggregated[1])
+	badAtt.Signature = unaggregated[0].Signature
+	expiredAtt := state.CopyAttestation(unaggregated[1])
+	expiredAtt.Data.Slot = 2 * params.BeaconConfig().SlotsPerEpoch
+	require.NoError(t, beaconDB.SaveOperationPools(ctx, &dbpb.OperationPools{
+		ProposerSlashings:        []*ethpb.ProposerSlashing{proposerSlashing, badProposerSlashing},
+		AttesterSlashings:        []*ethpb.AttesterSlashing{attesterSlashing},
+		VoluntaryExits:           []*ethpb.SignedVoluntaryExit{exit, badExit},
+		UnaggregatedAttestations: []*ethpb.Attestation{unaggregated[0], badAtt, expiredAtt},
+		AggregatedAttestations:   aggregated,
+	}))
+
+	chain := &mock.ChainService{State: headState, Genesis: time.Now()}
+	s := NewService(ctx, &Config{
+		BeaconDB:      beaconDB,
+		HeadFetcher:   chain,
+		TimeFetcher:   chain,
+		StateNotifier: chain.StateNotifier(),
+		AttPool:       attestations.NewPool(),
+		SlashingPool:  slashings.NewPool(),
+		ExitPool:      voluntaryexits.NewPool(),
+	})
+	// Nothing is saved before the pools were restored.
+	require.NoError(t, s.save(ctx))
+	pools, err := beaconDB.OperationPools(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 3, len(pools.UnaggregatedAttestations))
+
+	require.NoError(t, s.restore(ctx, headState))
+	atts, err := s.cfg.AttPool.UnaggregatedAttestations()
+	require.NoError(t, err)
+	restored := &dbpb.OperationPools{
+		ProposerSlashings:        s.cfg.SlashingPool.AllProposerSlashings(),
+		AttesterSlashings:        s.cfg.SlashingPool.AllAttesterSlashings(),
+		VoluntaryExits:           s.cfg.ExitPool.AllExits(),
+		UnaggregatedAttestations: atts,
+		AggregatedAttestations:   s.cfg.AttPool.AggregatedAttestations(),
+	}
+	want := &dbpb.OperationPools{
+		ProposerSlashings:        []*ethpb.ProposerSlashing{proposerSlashing},
+		AttesterSlashings:        []*ethpb.AttesterSlashing{attesterSlashing},
+		VoluntaryExits:           []*ethpb.SignedVoluntaryExit{exit},
+		UnaggregatedAttestations: []*ethpb.Attestation{unaggregated[0]},
+		AggregatedAttestations:   aggregated,
+	}
+	assert.Equal(t, true, proto.Equal(want, restored), "Wanted %v, received %v", want, restored)
+
+	// The pools are saved from now on.
+	s.cfg.SlashingPool.MarkIncludedProposerSlashing(proposerSlashing)
+	require.NoError(t, s.save(ctx))
+	pools, err = beaconDB.OperationPools(ctx)
+	require.NoError(t, err)
+	want.ProposerSlashings = nil
+	assert.Equal(t, true, proto.Equal(want, pools), "Wanted %v, received %v", want, pools)
+}
+
+func TestService_RecentAttestations(t *testing.T) {
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	genesis := time.Now().Add(-time.Duration(3*slotsPerEpoch*params.BeaconConfig().SecondsPerSlot) * time.Second)
+	s := NewService(context.Background(), &Config{TimeFetcher: &mock.ChainService{Genesis: genesis}})
+	atts := make([]*ethpb.Attestation, 0, 5)
+	for epoch := uint64(0); epoch < 5; epoch++ {
+		atts = append(atts, &ethpb.Attestation{Data: &ethpb.AttestationData{Slot: epoch * slotsPerEpoch}})
+	}
+	// Only the attestations of the previous and current epochs are kept.
+	assert.DeepEqual(t, atts[2:4], s.recentAttestations(atts))
+}
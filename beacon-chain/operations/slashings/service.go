@@ -102,6 +102,33 @@ func (p *Pool) PendingProposerSlashings(ctx context.Context, state *beaconstate.
 	return pending
 }
 
+// AllAttesterSlashings returns every pending attester slashing of the pool, regardless of the
+// block limit, such as to save them. A slashing of several validators is returned once.
+func (p *Pool) AllAttesterSlashings() []*ethpb.AttesterSlashing {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	seen := make(map[*ethpb.AttesterSlashing]bool, len(p.pendingAttesterSlashing))
+	all := make([]*ethpb.AttesterSlashing, 0, len(p.pendingAttesterSlashing))
+	for _, slashing := range p.pendingAttesterSlashing {
+		if seen[slashing.attesterSlashing] {
+			continue
+		}
+		seen[slashing.attesterSlashing] = true
+		all = append(all, slashing.attesterSlashing)
+	}
+	return all
+}
+
+// AllProposerSlashings returns every pending proposer slashing of the pool, regardless of the
+// block limit, such as to save them.
+func (p *Pool) AllProposerSlashings() []*ethpb.ProposerSlashing {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	all := make([]*ethpb.ProposerSlashing, len(p.pendingProposerSlashing))
+	copy(all, p.pendingProposerSlashing)
+	return all
+}
+
 // InsertAttesterSlashing into the pool. This method is a no-op if the attester slashing already exists in the pool,
 // has been included into a block recently, or the validator is already exited.
 func (p *Pool) InsertAttesterSlashing(
@@ -587,3 +587,19 @@ func TestPool_PendingAttesterSlashings_NoDuplicates(t *testing.T) {
 	}
 	assert.DeepEqual(t, slashings[0:2], p.PendingAttesterSlashings(context.Background(), beaconState))
 }
+
+func TestPool_AllAttesterSlashings(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	conf := params.BeaconConfig()
+	conf.MaxAttesterSlashings = 1
+	params.OverrideBeaconConfig(conf)
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 64)
+	p := NewPool()
+	// A slashing of two validators is pending once for each of them.
+	multiple := validAttesterSlashingForValIdx(t, beaconState, privKeys, 1, 2)
+	single := validAttesterSlashingForValIdx(t, beaconState, privKeys, 3)
+	require.NoError(t, p.InsertAttesterSlashing(context.Background(), beaconState, multiple))
+	require.NoError(t, p.InsertAttesterSlashing(context.Background(), beaconState, single))
+	require.Equal(t, 3, len(p.pendingAttesterSlashing))
+	assert.DeepEqual(t, []*ethpb.AttesterSlashing{multiple, single}, p.AllAttesterSlashings())
+}
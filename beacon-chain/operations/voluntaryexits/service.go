@@ -53,6 +53,16 @@ func (p *Pool) PendingExits(state *beaconstate.BeaconState, slot uint64) []*ethp
 	return pending
 }
 
+// AllExits returns every pending exit of the pool, regardless of its exit epoch and of the block
+// limit, such as to save them.
+func (p *Pool) AllExits() []*ethpb.SignedVoluntaryExit {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	all := make([]*ethpb.SignedVoluntaryExit, len(p.pending))
+	copy(all, p.pending)
+	return all
+}
+
 // InsertVoluntaryExit into the pool. This method is a no-op if the pending exit already exists,
 // has been included recently, or the validator is already exited.
 func (p *Pool) InsertVoluntaryExit(ctx context.Context, state *beaconstate.BeaconState, exit *ethpb.SignedVoluntaryExit) {
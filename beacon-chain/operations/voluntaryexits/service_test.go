@@ -456,3 +456,16 @@ func TestPool_PendingExits(t *testing.T) {
 		})
 	}
 }
+
+func TestPool_AllExits(t *testing.T) {
+	pending := []*ethpb.SignedVoluntaryExit{
+		{Exit: &ethpb.VoluntaryExit{Epoch: 0, ValidatorIndex: 1}},
+		{Exit: &ethpb.VoluntaryExit{Epoch: 100, ValidatorIndex: 2}},
+	}
+	p := &Pool{pending: pending}
+	// Exits are returned regardless of their exit epoch.
+	all := p.AllExits()
+	assert.DeepEqual(t, pending, all)
+	all[0] = nil
+	assert.NotNil(t, p.pending[0], "Pending exits were modified")
+}
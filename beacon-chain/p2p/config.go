@@ -1,6 +1,9 @@
 package p2p
 
 import (
+	"github.com/ethereum/go-ethereum/p2p/dnsdisc"
+	"github.com/libp2p/go-libp2p-core/host"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 )
 
@@ -23,7 +26,21 @@ type Config struct {
 	TCPPort             uint
 	UDPPort             uint
 	MaxPeers            uint
+	MaxPeersPerIP       uint
+	MaxPeersPerSubnet   uint
+	MinOutboundRatio    float64
+	LongLivedSubnets    uint64
+	MinPeersPerSubnet   uint64
 	AllowListCIDR       string
 	DenyListCIDR        []string
 	StateNotifier       statefeed.Notifier
+	DNSResolver         dnsdisc.Resolver
+	RecordDir           string
+	RecordMaxFileSize   uint64
+	RecordMaxFiles      uint
+	// Host replaces the libp2p host the service would otherwise listen on, such as a host of
+	// a simulated network. The connection gater of the service is not installed on it.
+	Host host.Host
+	// PubSubTracer receives the trace events of the gossipsub router, if set.
+	PubSubTracer pubsub.EventTracer
 }
@@ -21,17 +21,33 @@ const ipBurst = 8
 
 // InterceptPeerDial tests whether we're permitted to Dial the specified peer.
 func (s *Service) InterceptPeerDial(p peer.ID) (allow bool) {
-	return true
+	return !s.peers.IsBanned(p)
 }
 
 // InterceptAddrDial tests whether we're permitted to dial the specified
 // multiaddr for the given peer.
-func (s *Service) InterceptAddrDial(_ peer.ID, m multiaddr.Multiaddr) (allow bool) {
+func (s *Service) InterceptAddrDial(pid peer.ID, m multiaddr.Multiaddr) (allow bool) {
+	if s.peers.IsAddrBanned(m) {
+		return false
+	}
+	// Dialing a peer we are already connected to does not add another peer.
+	if !s.peers.IsActive(pid) && !s.peers.IsTrusted(pid) {
+		if reason, ok := s.colocationLimitReached(m); ok {
+			rejectedConnections.WithLabelValues("colocation limit").Inc()
+			log.WithFields(logrus.Fields{"peer": m, "reason": reason}).Trace("Not dialing peer")
+			return false
+		}
+	}
 	return filterConnections(s.addrFilter, m)
 }
 
 // InterceptAccept tests whether an incipient inbound connection is allowed.
 func (s *Service) InterceptAccept(n network.ConnMultiaddrs) (allow bool) {
+	if s.peers.IsAddrBanned(n.RemoteMultiaddr()) {
+		log.WithFields(logrus.Fields{"peer": n.RemoteMultiaddr(),
+			"reason": "ip address is banned"}).Trace("Not accepting inbound dial")
+		return false
+	}
 	if !s.validateDial(n.RemoteMultiaddr()) {
 		// Allow other go-routines to run in the event
 		// we receive a large amount of junk connections.
@@ -41,17 +57,37 @@ func (s *Service) InterceptAccept(n network.ConnMultiaddrs) (allow bool) {
 		return false
 	}
 
-	if s.isPeerAtLimit() {
-		log.WithFields(logrus.Fields{"peer": n.RemoteMultiaddr(),
-			"reason": "at peer limit"}).Trace("Not accepting inbound dial")
-		return false
+	if !s.isTrustedAddr(n.RemoteMultiaddr()) {
+		if s.isPeerAtLimit() {
+			rejectedConnections.WithLabelValues("peer limit").Inc()
+			log.WithFields(logrus.Fields{"peer": n.RemoteMultiaddr(),
+				"reason": "at peer limit"}).Trace("Not accepting inbound dial")
+			return false
+		}
+		if s.isInboundAtLimit() {
+			rejectedConnections.WithLabelValues("inbound limit").Inc()
+			log.WithFields(logrus.Fields{"peer": n.RemoteMultiaddr(),
+				"reason": "at inbound peer limit"}).Trace("Not accepting inbound dial")
+			return false
+		}
+		if reason, ok := s.colocationLimitReached(n.RemoteMultiaddr()); ok {
+			rejectedConnections.WithLabelValues("colocation limit").Inc()
+			log.WithFields(logrus.Fields{"peer": n.RemoteMultiaddr(),
+				"reason": reason}).Trace("Not accepting inbound dial")
+			return false
+		}
 	}
 	return filterConnections(s.addrFilter, n.RemoteMultiaddr())
 }
 
 // InterceptSecured tests whether a given connection, now authenticated,
 // is allowed.
-func (s *Service) InterceptSecured(_ network.Direction, _ peer.ID, n network.ConnMultiaddrs) (allow bool) {
+func (s *Service) InterceptSecured(_ network.Direction, p peer.ID, n network.ConnMultiaddrs) (allow bool) {
+	if s.peers.IsBanned(p) {
+		log.WithFields(logrus.Fields{"peer": p,
+			"reason": "peer is banned"}).Trace("Not accepting connection")
+		return false
+	}
 	return true
 }
 
@@ -60,6 +96,17 @@ func (s *Service) InterceptUpgraded(n network.Conn) (allow bool, reason control.
 	return true, 0
 }
 
+// isTrustedAddr checks whether a remote address belongs to a trusted peer, which is exempt
+// from the peer limit. The peer ID of an inbound connection is not known yet when accepting it,
+// so the IP address is matched against the known addresses of trusted peers.
+func (s *Service) isTrustedAddr(addr multiaddr.Multiaddr) bool {
+	ip, err := manet.ToIP(addr)
+	if err != nil {
+		return false
+	}
+	return s.peers.IsTrustedIP(ip)
+}
+
 func (s *Service) validateDial(addr multiaddr.Multiaddr) bool {
 	ip, err := manet.ToIP(addr)
 	if err != nil {
@@ -3,10 +3,13 @@ package p2p
 import (
 	"context"
 	"fmt"
+	"net"
 	"testing"
+	"time"
 
 	"github.com/kevinms/leakybucket-go"
 	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p-core/network"
 	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/multiformats/go-multiaddr"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
@@ -141,6 +144,10 @@ func TestPeerAllowList(t *testing.T) {
 	require.NoError(t, err, "Failed to p2p listen")
 	s := &Service{
 		ipLimiter: leakybucket.NewCollector(ipLimit, ipBurst, false),
+		peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
+			ScorerParams: &peers.PeerScorerConfig{},
+		}),
+		cfg: &Config{},
 	}
 	s.addrFilter, err = configureFilter(&Config{AllowListCIDR: cidr})
 	require.NoError(t, err)
@@ -183,6 +190,10 @@ func TestPeerDenyList(t *testing.T) {
 	require.NoError(t, err, "Failed to p2p listen")
 	s := &Service{
 		ipLimiter: leakybucket.NewCollector(ipLimit, ipBurst, false),
+		peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
+			ScorerParams: &peers.PeerScorerConfig{},
+		}),
+		cfg: &Config{},
 	}
 	s.addrFilter, err = configureFilter(&Config{DenyListCIDR: []string{cidr}})
 	require.NoError(t, err)
@@ -214,6 +225,10 @@ func TestPeerDenyList(t *testing.T) {
 func TestService_InterceptAddrDial_Allow(t *testing.T) {
 	s := &Service{
 		ipLimiter: leakybucket.NewCollector(ipLimit, ipBurst, false),
+		peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
+			ScorerParams: &peers.PeerScorerConfig{},
+		}),
+		cfg: &Config{},
 	}
 	var err error
 	cidr := "212.67.89.112/16"
@@ -227,3 +242,147 @@ func TestService_InterceptAddrDial_Allow(t *testing.T) {
 		t.Errorf("Expected multiaddress with ip %s to not be rejected with an allow cidr mask of %s", ip, cidr)
 	}
 }
+
+func TestService_InterceptBannedPeers(t *testing.T) {
+	s := &Service{
+		ipLimiter: leakybucket.NewCollector(ipLimit, ipBurst, false),
+		peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
+			ScorerParams: &peers.PeerScorerConfig{},
+		}),
+		cfg: &Config{},
+	}
+	var err error
+	s.addrFilter, err = configureFilter(&Config{})
+	require.NoError(t, err)
+	ip := "212.67.10.122"
+	multiAddress, err := multiaddr.NewMultiaddr(fmt.Sprintf("/ip4/%s/tcp/%d", ip, 3000))
+	require.NoError(t, err)
+	pid := peer.ID("banned")
+
+	assert.Equal(t, true, s.InterceptPeerDial(pid))
+	assert.Equal(t, true, s.InterceptAddrDial(pid, multiAddress))
+	assert.Equal(t, true, s.InterceptSecured(network.DirInbound, pid, &maEndpoints{raddr: multiAddress}))
+
+	s.peers.BanPeer(pid, time.Time{})
+	assert.Equal(t, false, s.InterceptPeerDial(pid), "Dialed banned peer")
+	assert.Equal(t, false, s.InterceptSecured(network.DirInbound, pid, &maEndpoints{raddr: multiAddress}), "Accepted banned peer")
+	assert.Equal(t, true, s.InterceptAddrDial(pid, multiAddress))
+
+	s.peers.BanIP(net.ParseIP(ip), time.Now().Add(time.Hour))
+	assert.Equal(t, false, s.InterceptAddrDial(pid, multiAddress), "Dialed banned ip address")
+	assert.Equal(t, false, s.InterceptAccept(&maEndpoints{raddr: multiAddress}), "Accepted banned ip address")
+
+	// Expired bans are not enforced.
+	s.peers.BanPeer(pid, time.Now().Add(-time.Second))
+	assert.Equal(t, true, s.InterceptPeerDial(pid))
+}
+
+func TestService_ColocationLimits(t *testing.T) {
+	s := &Service{
+		peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
+			ScorerParams: &peers.PeerScorerConfig{},
+		}),
+		cfg: &Config{MaxPeersPerIP: 2, MaxPeersPerSubnet: 3},
+	}
+	var err error
+	s.addrFilter, err = configureFilter(&Config{})
+	require.NoError(t, err)
+	addPeerAt := func(addr string) peer.ID {
+		pid := peer.ID(addr)
+		address, err := multiaddr.NewMultiaddr(addr)
+		require.NoError(t, err)
+		s.peers.Add(nil, pid, address, network.DirInbound)
+		s.peers.SetConnectionState(pid, peers.PeerConnected)
+		return pid
+	}
+	sameIP, err := multiaddr.NewMultiaddr("/ip4/212.67.10.122/tcp/3000")
+	require.NoError(t, err)
+	sameSubnet, err := multiaddr.NewMultiaddr("/ip4/212.67.10.200/tcp/3000")
+	require.NoError(t, err)
+	otherSubnet, err := multiaddr.NewMultiaddr("/ip4/212.67.11.122/tcp/3000")
+	require.NoError(t, err)
+
+	addPeerAt("/ip4/212.67.10.122/tcp/13000")
+	_, reached := s.colocationLimitReached(sameIP)
+	assert.Equal(t, false, reached)
+	addPeerAt("/ip4/212.67.10.122/tcp/13001")
+	reason, reached := s.colocationLimitReached(sameIP)
+	assert.Equal(t, true, reached)
+	assert.Equal(t, "too many peers from ip address", reason)
+	assert.Equal(t, false, s.InterceptAddrDial("new", sameIP), "Dialed peer above ip limit")
+
+	_, reached = s.colocationLimitReached(sameSubnet)
+	assert.Equal(t, false, reached)
+	addPeerAt("/ip4/212.67.10.123/tcp/13000")
+	reason, reached = s.colocationLimitReached(sameSubnet)
+	assert.Equal(t, true, reached)
+	assert.Equal(t, "too many peers from subnet 212.67.10.0/24", reason)
+	_, reached = s.colocationLimitReached(otherSubnet)
+	assert.Equal(t, false, reached)
+
+	// Trusted peers are exempt from the limits.
+	s.peers.AddTrusted(peer.AddrInfo{ID: "trusted", Addrs: []multiaddr.Multiaddr{sameIP}})
+	assert.Equal(t, true, s.InterceptAddrDial("trusted", sameIP), "Did not dial trusted peer")
+
+	// Loopback and private addresses are exempt from the limits.
+	for _, addr := range []string{"/ip4/127.0.0.1/tcp/%d", "/ip4/192.168.1.10/tcp/%d", "/ip6/::1/tcp/%d", "/ip6/fd00::1/tcp/%d"} {
+		for i := 0; i < 4; i++ {
+			addPeerAt(fmt.Sprintf(addr, 13000+i))
+		}
+		local, err := multiaddr.NewMultiaddr(fmt.Sprintf(addr, 3000))
+		require.NoError(t, err)
+		_, reached = s.colocationLimitReached(local)
+		assert.Equal(t, false, reached, "Limited peers at %s", local)
+	}
+}
+
+func TestIsLocalIP(t *testing.T) {
+	for ip, local := range map[string]bool{
+		"127.0.0.1":      true,
+		"10.1.2.3":       true,
+		"172.16.0.1":     true,
+		"172.32.0.1":     false,
+		"192.168.0.1":    true,
+		"169.254.1.1":    true,
+		"212.67.10.122":  false,
+		"::1":            true,
+		"fd12:3456::1":   true,
+		"fe80::1":        true,
+		"2001:db8::1":    false,
+		"::ffff:8.8.8.8": false,
+	} {
+		assert.Equal(t, local, isLocalIP(net.ParseIP(ip)), "Wrong result for %s", ip)
+	}
+}
+
+func TestService_InboundLimit(t *testing.T) {
+	s := &Service{
+		peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
+			ScorerParams: &peers.PeerScorerConfig{},
+		}),
+		cfg: &Config{MaxPeers: 10, MinOutboundRatio: 0.25},
+	}
+	assert.Equal(t, 3, s.minOutboundPeers())
+	assert.Equal(t, 7, s.maxInboundPeers())
+	for i := 0; i < 7; i++ {
+		assert.Equal(t, false, s.isInboundAtLimit(), "Inbound limit reached with %d peers", i)
+		pid := peer.ID(fmt.Sprintf("inbound%d", i))
+		s.peers.Add(nil, pid, nil, network.DirInbound)
+		s.peers.SetConnectionState(pid, peers.PeerConnected)
+	}
+	assert.Equal(t, true, s.isInboundAtLimit())
+}
+
+// maEndpoints implements network.ConnMultiaddrs.
+type maEndpoints struct {
+	laddr multiaddr.Multiaddr
+	raddr multiaddr.Multiaddr
+}
+
+func (m *maEndpoints) LocalMultiaddr() multiaddr.Multiaddr {
+	return m.laddr
+}
+
+func (m *maEndpoints) RemoteMultiaddr() multiaddr.Multiaddr {
+	return m.raddr
+}
@@ -33,15 +33,16 @@ type Listener interface {
 }
 
 // RefreshENR uses an epoch to refresh the enr entry for our node
-// with the tracked committee ids for the epoch, allowing our node
-// to be dynamically discoverable by others given our tracked committee ids.
+// with the tracked committee ids for the epoch and the long-lived
+// subnets of the node, allowing our node to be dynamically discoverable
+// by others given our tracked committee ids.
 func (s *Service) RefreshENR() {
 	// return early if discv5 isnt running
 	if s.dv5Listener == nil {
 		return
 	}
 	bitV := bitfield.NewBitvector64()
-	committees := cache.SubnetIDs.GetAllSubnets()
+	committees := append(cache.SubnetIDs.GetAllSubnets(), cache.SubnetIDs.GetLongLivedSubnets()...)
 	for _, idx := range committees {
 		bitV.SetBitAt(idx, true)
 	}
@@ -61,8 +62,11 @@ func (s *Service) RefreshENR() {
 
 // listen for new nodes watches for new nodes in the network and adds them to the peerstore.
 func (s *Service) listenForNewNodes() {
-	iterator := s.dv5Listener.RandomNodes()
-	iterator = enode.Filter(iterator, s.filterPeer)
+	iterator, err := s.nodeIterator()
+	if err != nil {
+		log.WithError(err).Error("Could not start looking for new nodes")
+		return
+	}
 	defer iterator.Close()
 	for {
 		// Exit if service's context is canceled
@@ -271,18 +275,20 @@ func (s *Service) isPeerAtLimit() bool {
 	numOfConns := len(s.host.Network().Peers())
 	maxPeers := int(s.cfg.MaxPeers)
 	activePeers := len(s.Peers().Active())
+	// Trusted peers do not count towards the limit.
+	trustedPeers := s.connectedTrustedPeers()
 
-	return activePeers >= maxPeers || numOfConns >= maxPeers
+	return activePeers-trustedPeers >= maxPeers || numOfConns-trustedPeers >= maxPeers
 }
 
 // retrieve real local address of the node. In the event
 // that is not possible we return the provided ip.
 func (s *Service) localAddress(network string, addr net.IP) net.IP {
-	if len(s.cfg.BootstrapNodeAddr) == 0 {
+	if len(s.cfg.Discv5BootStrapAddr) == 0 {
 		return addr
 	}
 	// Dial the first bootnode to determine our 'real' local address.
-	bootNode, err := enode.Parse(enode.ValidSchemes, s.cfg.BootstrapNodeAddr[0])
+	bootNode, err := enode.Parse(enode.ValidSchemes, s.cfg.Discv5BootStrapAddr[0])
 	if err != nil {
 		log.Error("Could not parse bootnode address")
 		return addr
@@ -308,7 +314,7 @@ func (s *Service) localAddress(network string, addr net.IP) net.IP {
 
 func parseBootStrapAddrs(addrs []string) (discv5Nodes []string) {
 	discv5Nodes, _ = parseGenericAddrs(addrs)
-	if len(discv5Nodes) == 0 {
+	if len(discv5Nodes) == 0 && len(parseDNSNodeLists(addrs)) == 0 {
 		log.Warn("No bootstrap addresses supplied")
 	}
 	return discv5Nodes
@@ -316,8 +322,8 @@ func parseBootStrapAddrs(addrs []string) (discv5Nodes []string) {
 
 func parseGenericAddrs(addrs []string) (enodeString []string, multiAddrString []string) {
 	for _, addr := range addrs {
-		if addr == "" {
-			// Ignore empty entries
+		if addr == "" || isDNSNodeList(addr) {
+			// Ignore empty entries and DNS node lists, which are resolved separately.
 			continue
 		}
 		_, err := enode.Parse(enode.ValidSchemes, addr)
@@ -0,0 +1,68 @@
+package p2p
+
+import (
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/p2p/dnsdisc"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/pkg/errors"
+)
+
+// dnsNodeListScheme is the URL scheme of EIP-1459 DNS node lists.
+const dnsNodeListScheme = "enrtree://"
+
+// dnsRecheckInterval is how often the root of a DNS node list is checked for updates.
+const dnsRecheckInterval = 30 * time.Minute
+
+// discoveryMixTimeout is how long the discovery loop waits on one node source before trying
+// the next one.
+const discoveryMixTimeout = 5 * time.Second
+
+// parseDNSNodeLists returns the EIP-1459 DNS node list URLs among the given bootstrap addresses.
+func parseDNSNodeLists(addrs []string) []string {
+	var urls []string
+	for _, addr := range addrs {
+		if isDNSNodeList(addr) {
+			urls = append(urls, addr)
+		}
+	}
+	return urls
+}
+
+func isDNSNodeList(addr string) bool {
+	return strings.HasPrefix(addr, dnsNodeListScheme)
+}
+
+// dnsNodeIterator returns an endless iterator over the nodes of the configured DNS node lists.
+// Each tree is verified against the public key in its URL, and its root is re-checked for updates
+// periodically.
+func (s *Service) dnsNodeIterator() (enode.Iterator, error) {
+	client := dnsdisc.NewClient(dnsdisc.Config{
+		RecheckInterval: dnsRecheckInterval,
+		Resolver:        s.cfg.DNSResolver,
+	})
+	iterator, err := client.NewIterator(s.dnsNodeLists...)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse dns node list")
+	}
+	return iterator, nil
+}
+
+// nodeIterator returns an iterator over the nodes found by all discovery mechanisms, filtered by
+// the peer filter. Nodes are taken from discv5 and the DNS node lists in turn.
+func (s *Service) nodeIterator() (enode.Iterator, error) {
+	mix := enode.NewFairMix(discoveryMixTimeout)
+	if s.dv5Listener != nil {
+		mix.AddSource(s.dv5Listener.RandomNodes())
+	}
+	if len(s.dnsNodeLists) > 0 {
+		iterator, err := s.dnsNodeIterator()
+		if err != nil {
+			mix.Close()
+			return nil, err
+		}
+		mix.AddSource(iterator)
+	}
+	return enode.Filter(mix, s.filterPeer), nil
+}
@@ -0,0 +1,105 @@
+package p2p
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	gethCrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/p2p/dnsdisc"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestParseDNSNodeLists(t *testing.T) {
+	addrs := []string{
+		"enr:-Ku4QMKVC_MowDsmEa20d5uGjrChI0h8_KsKXDmgVQbIbngZV0idV6_RL7fEtZGo-kTNZ5o7_EJI_vCPJ6scrhwX0Z4Bh2F0dG5ldHOIAAAAAAAAAACEZXRoMpD1pf1CAAAAAP__________gmlkgnY0gmlwhBLf22SJc2VjcDI1NmsxoQJxCnE6v_x2ekgY_uoE1rtwzvGy40mq9eD66XfHPBWgIIN1ZHCCD6A",
+		"enrtree://AKA3AM6LPBYEUDMVNU3BSVQJ5AD45Y7YPOHJLEF6W26QOE4VTUDPE@nodes.example.org",
+		"",
+		"/ip4/127.0.0.1/tcp/13000/p2p/16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR",
+	}
+	assert.DeepEqual(t, []string{addrs[1]}, parseDNSNodeLists(addrs))
+	enodes, multiAddrs := parseGenericAddrs(addrs)
+	assert.DeepEqual(t, []string{addrs[0]}, enodes)
+	assert.DeepEqual(t, []string{addrs[3]}, multiAddrs)
+}
+
+func TestService_NodeIterator_DNSNodeList(t *testing.T) {
+	genesisTime := time.Now()
+	genesisValidatorsRoot := make([]byte, 32)
+	s := &Service{
+		host:                  p2ptest.NewTestP2P(t).BHost,
+		cfg:                   &Config{DataDir: testDataDir(t)},
+		genesisTime:           genesisTime,
+		genesisValidatorsRoot: genesisValidatorsRoot,
+		peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
+			ScorerParams: &peers.PeerScorerConfig{},
+		}),
+	}
+	nodes := make([]*enode.Node, 3)
+	for i := range nodes {
+		key, err := gethCrypto.GenerateKey()
+		require.NoError(t, err)
+		localNode, err := s.createLocalNode(key, net.IPv4(10, 0, 0, byte(i+1)), 12000, 13000)
+		require.NoError(t, err)
+		nodes[i] = localNode.Node()
+	}
+	// Nodes of other networks are filtered out like the ones found through discv5.
+	otherNetwork := &Service{
+		genesisTime:           genesisTime,
+		genesisValidatorsRoot: bytesutil.PadTo([]byte{'A'}, 32),
+	}
+	key, err := gethCrypto.GenerateKey()
+	require.NoError(t, err)
+	localNode, err := otherNetwork.createLocalNode(key, net.IPv4(10, 0, 1, 1), 12000, 13000)
+	require.NoError(t, err)
+	otherNode := localNode.Node()
+
+	tree, err := dnsdisc.MakeTree(1, append(nodes, otherNode), nil)
+	require.NoError(t, err)
+	signingKey, err := gethCrypto.GenerateKey()
+	require.NoError(t, err)
+	url, err := tree.Sign(signingKey, "nodes.example.org")
+	require.NoError(t, err)
+	s.cfg.DNSResolver = testutil.DNSResolver(tree.ToTXT("nodes.example.org"))
+	s.dnsNodeLists = []string{url}
+
+	iterator, err := s.nodeIterator()
+	require.NoError(t, err)
+	defer iterator.Close()
+	found := make(map[enode.ID]bool)
+	for len(found) < len(nodes) && iterator.Next() {
+		found[iterator.Node().ID()] = true
+	}
+	for _, node := range nodes {
+		assert.Equal(t, true, found[node.ID()], "Node %s of the DNS node list not found", node.ID())
+	}
+	assert.Equal(t, false, found[otherNode.ID()], "Node of another network not filtered out")
+}
+
+func TestService_NodeIterator_InvalidDNSNodeList(t *testing.T) {
+	s := &Service{
+		cfg:          &Config{DataDir: testDataDir(t), DNSResolver: testutil.DNSResolver{}},
+		dnsNodeLists: []string{"enrtree://invalid@nodes.example.org"},
+	}
+	_, err := s.nodeIterator()
+	assert.ErrorContains(t, "could not parse dns node list", err)
+}
+
+// testDataDir returns a data directory for the service, which is removed when the test completes.
+func testDataDir(t *testing.T) string {
+	dir, err := ioutil.TempDir(testutil.TempDir(), "p2p")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, os.RemoveAll(dir))
+	})
+	return dir
+}
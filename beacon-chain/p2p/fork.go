@@ -28,12 +28,18 @@ func (s *Service) forkDigest() ([4]byte, error) {
 // Compares fork ENRs between an incoming peer's record and our node's
 // local record values for current and next fork version/epoch.
 func (s *Service) compareForkENR(record *enr.Record) error {
-	currentRecord := s.dv5Listener.LocalNode().Node().Record()
 	peerForkENR, err := retrieveForkEntry(record)
 	if err != nil {
 		return err
 	}
-	currentForkENR, err := retrieveForkEntry(currentRecord)
+	var currentForkENR *pb.ENRForkID
+	// Without a discv5 listener, as when peers are only found through DNS node lists, there is no
+	// local record to read the fork entry from.
+	if s.dv5Listener != nil {
+		currentForkENR, err = retrieveForkEntry(s.dv5Listener.LocalNode().Node().Record())
+	} else {
+		currentForkENR, err = createENRForkID(s.genesisTime, s.genesisValidatorsRoot)
+	}
 	if err != nil {
 		return err
 	}
@@ -81,6 +87,22 @@ func addForkEntry(
 	genesisTime time.Time,
 	genesisValidatorsRoot []byte,
 ) (*enode.LocalNode, error) {
+	enrForkID, err := createENRForkID(genesisTime, genesisValidatorsRoot)
+	if err != nil {
+		return nil, err
+	}
+	enc, err := enrForkID.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	forkEntry := enr.WithEntry(eth2ENRKey, enc)
+	node.Set(forkEntry)
+	return node, nil
+}
+
+// Creates the enrForkID of the local node from the current fork digest, the next fork version
+// and the next fork epoch.
+func createENRForkID(genesisTime time.Time, genesisValidatorsRoot []byte) (*pb.ENRForkID, error) {
 	digest, err := p2putils.CreateForkDigest(genesisTime, genesisValidatorsRoot)
 	if err != nil {
 		return nil, err
@@ -101,18 +123,11 @@ func addForkEntry(
 	if nextForkEpoch == math.MaxUint64 {
 		nextForkVersion = fork.CurrentVersion
 	}
-	enrForkID := &pb.ENRForkID{
+	return &pb.ENRForkID{
 		CurrentForkDigest: digest[:],
 		NextForkVersion:   nextForkVersion,
 		NextForkEpoch:     nextForkEpoch,
-	}
-	enc, err := enrForkID.MarshalSSZ()
-	if err != nil {
-		return nil, err
-	}
-	forkEntry := enr.WithEntry(eth2ENRKey, enc)
-	node.Set(forkEntry)
-	return node, nil
+	}, nil
 }
 
 // Retrieves an enrForkID from an ENR record by key lookup
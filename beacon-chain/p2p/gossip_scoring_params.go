@@ -0,0 +1,201 @@
+package p2p
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+const (
+	// beaconBlockWeight specifies the scoring weight that we apply to our beacon block topic.
+	beaconBlockWeight = 0.8
+	// aggregateWeight specifies the scoring weight that we apply to our aggregate topic.
+	aggregateWeight = 0.5
+	// attestationTotalWeight specifies the scoring weight that we apply to all attestation
+	// subnets combined. It is split evenly between the subnets.
+	attestationTotalWeight = 1
+	// operationWeight specifies the scoring weight that we apply to each of the voluntary exit
+	// and slashing topics.
+	operationWeight = 0.05
+	// maxInMeshScore describes the max score a peer can attain from being in the mesh of a topic.
+	maxInMeshScore = 10
+	// maxFirstDeliveryScore describes the max score a peer can attain from first deliveries on a topic.
+	maxFirstDeliveryScore = 40
+	// maxInvalidMessages is the number of (decayed) invalid messages on a single topic that is
+	// enough for a peer to be graylisted.
+	maxInvalidMessages = 5
+	// decayToZero specifies the terminal value that we will use when decaying a value.
+	decayToZero = 0.01
+
+	// Peer score thresholds. A peer below the gossip threshold no longer receives gossip from us,
+	// below the publish threshold it is not sent our own messages and below the graylist threshold
+	// all of its messages are ignored.
+	gossipThreshold             = -4000
+	publishThreshold            = -8000
+	graylistThreshold           = -16000
+	acceptPXThreshold           = 100
+	opportunisticGraftThreshold = 5
+)
+
+// peerScoringParams returns the gossipsub peer score parameters and thresholds. Topic parameters
+// are set on every topic as it is joined, so the topics of any fork digest are scored.
+func peerScoringParams() (*pubsub.PeerScoreParams, *pubsub.PeerScoreThresholds) {
+	thresholds := &pubsub.PeerScoreThresholds{
+		GossipThreshold:             gossipThreshold,
+		PublishThreshold:            publishThreshold,
+		GraylistThreshold:           graylistThreshold,
+		AcceptPXThreshold:           acceptPXThreshold,
+		OpportunisticGraftThreshold: opportunisticGraftThreshold,
+	}
+	topicScoreCap := float64(maxInMeshScore + maxFirstDeliveryScore)
+	scoreParams := &pubsub.PeerScoreParams{
+		Topics: make(map[string]*pubsub.TopicScoreParams),
+		// A peer can not make up for penalties by being useful on more than one topic.
+		TopicScoreCap:     topicScoreCap,
+		AppSpecificScore:  func(peer.ID) float64 { return 0 },
+		AppSpecificWeight: 1,
+		// Every peer sharing an IP address beyond the threshold costs the score of a topic.
+		IPColocationFactorWeight:    -topicScoreCap,
+		IPColocationFactorThreshold: 10,
+		// Ten protocol violations, such as broken gossip promises, drop a peer below the gossip threshold.
+		BehaviourPenaltyWeight: gossipThreshold / 100,
+		BehaviourPenaltyDecay:  scoreDecay(10 * oneEpochDuration()),
+		DecayInterval:          oneSlotDuration(),
+		DecayToZero:            decayToZero,
+		RetainScore:            100 * oneEpochDuration(),
+	}
+	return scoreParams, thresholds
+}
+
+// topicScoreParams returns the score parameters of a gossip topic, given its topic format. Nil is
+// returned for topics which are not scored.
+func topicScoreParams(topicFormat string) *pubsub.TopicScoreParams {
+	cfg := params.BeaconConfig()
+	switch topicFormat {
+	case BlockSubnetTopicFormat:
+		return blockTopicParams()
+	case AggregateAndProofSubnetTopicFormat:
+		// An upper bound of the aggregates per slot, as the actual number depends on the
+		// active validator count.
+		return operationTopicParams(aggregateWeight, float64(cfg.MaxCommitteesPerSlot*cfg.TargetAggregatorsPerCommittee), oneEpochDuration())
+	case AttestationSubnetTopicFormat:
+		subnetWeight := attestationTotalWeight / float64(params.BeaconNetworkConfig().AttestationSubnetCount)
+		return operationTopicParams(subnetWeight, float64(cfg.MaxValidatorsPerCommittee), oneEpochDuration())
+	case ExitSubnetTopicFormat:
+		return operationTopicParams(operationWeight, float64(cfg.MaxVoluntaryExits), 100*oneEpochDuration())
+	case ProposerSlashingSubnetTopicFormat:
+		return operationTopicParams(operationWeight, float64(cfg.MaxProposerSlashings), 100*oneEpochDuration())
+	case AttesterSlashingSubnetTopicFormat:
+		return operationTopicParams(operationWeight, float64(cfg.MaxAttesterSlashings), 100*oneEpochDuration())
+	default:
+		return nil
+	}
+}
+
+// blockTopicParams scores the beacon block topic. As exactly one block is expected per slot, peers
+// in our mesh are also penalized for delivering too few blocks.
+func blockTopicParams() *pubsub.TopicScoreParams {
+	decayEpochs := time.Duration(5)
+	blocksPerEpoch := float64(params.BeaconConfig().SlotsPerEpoch)
+	meshDeliveriesThreshold := blocksPerEpoch * float64(decayEpochs) / 10
+	// The penalty is quadratic in the deficit, so a peer delivering no blocks at all loses the
+	// whole score it could earn on the topic.
+	meshWeight := -(maxInMeshScore + maxFirstDeliveryScore) / (meshDeliveriesThreshold * meshDeliveriesThreshold)
+	p := operationTopicParams(beaconBlockWeight, blocksPerEpoch, 20*oneEpochDuration())
+	p.MeshMessageDeliveriesWeight = meshWeight
+	p.MeshMessageDeliveriesDecay = scoreDecay(decayEpochs * oneEpochDuration())
+	p.MeshMessageDeliveriesCap = blocksPerEpoch * float64(decayEpochs)
+	p.MeshMessageDeliveriesThreshold = meshDeliveriesThreshold
+	p.MeshMessageDeliveriesWindow = 2 * time.Second
+	p.MeshMessageDeliveriesActivation = 4 * oneEpochDuration()
+	p.MeshFailurePenaltyWeight = meshWeight
+	p.MeshFailurePenaltyDecay = scoreDecay(decayEpochs * oneEpochDuration())
+	return p
+}
+
+// operationTopicParams scores a topic on the time peers spend in our mesh, their first deliveries,
+// up to the given cap, and invalid messages. Mesh delivery rates are not scored, as the expected
+// rate of attestations and operations depends on the active validator count and chain activity.
+func operationTopicParams(topicWeight, firstDeliveriesCap float64, firstDeliveriesDecay time.Duration) *pubsub.TopicScoreParams {
+	return &pubsub.TopicScoreParams{
+		TopicWeight:                    topicWeight,
+		TimeInMeshWeight:               maxInMeshScore / inMeshCap(),
+		TimeInMeshQuantum:              inMeshTime(),
+		TimeInMeshCap:                  inMeshCap(),
+		FirstMessageDeliveriesWeight:   maxFirstDeliveryScore / firstDeliveriesCap,
+		FirstMessageDeliveriesDecay:    scoreDecay(firstDeliveriesDecay),
+		FirstMessageDeliveriesCap:      firstDeliveriesCap,
+		InvalidMessageDeliveriesWeight: graylistThreshold / (topicWeight * maxInvalidMessages * maxInvalidMessages),
+		InvalidMessageDeliveriesDecay:  scoreDecay(50 * oneEpochDuration()),
+	}
+}
+
+// scoreParamsForTopic returns the score parameters of a gossip topic of any fork digest. Nil is
+// returned for topics which are not scored.
+func (s *Service) scoreParamsForTopic(topic string) *pubsub.TopicScoreParams {
+	digest, ok := topicForkDigest(topic)
+	if !ok {
+		return nil
+	}
+	suffix := s.Encoding().ProtocolSuffix()
+	for topicFormat := range GossipTopicMappings {
+		if topicFormat == AttestationSubnetTopicFormat {
+			for i := uint64(0); i < params.BeaconNetworkConfig().AttestationSubnetCount; i++ {
+				if fmt.Sprintf(topicFormat, digest, i)+suffix == topic {
+					return topicScoreParams(topicFormat)
+				}
+			}
+			continue
+		}
+		if fmt.Sprintf(topicFormat, digest)+suffix == topic {
+			return topicScoreParams(topicFormat)
+		}
+	}
+	return nil
+}
+
+// topicForkDigest parses the fork digest of an eth2 gossip topic.
+func topicForkDigest(topic string) ([4]byte, bool) {
+	digest := [4]byte{}
+	parts := strings.Split(topic, "/")
+	if len(parts) < 3 || parts[1] != "eth2" {
+		return digest, false
+	}
+	b, err := hex.DecodeString(parts[2])
+	if err != nil || len(b) != len(digest) {
+		return digest, false
+	}
+	copy(digest[:], b)
+	return digest, true
+}
+
+// scoreDecay returns the decay factor, applied every slot, for a counter to decay to zero over
+// the given duration.
+func scoreDecay(totalDuration time.Duration) float64 {
+	numOfTimes := totalDuration / oneSlotDuration()
+	return math.Pow(decayToZero, 1/float64(numOfTimes))
+}
+
+// inMeshTime is the time quantum of the time spent in the mesh of a topic.
+func inMeshTime() time.Duration {
+	return oneSlotDuration()
+}
+
+// inMeshCap is the number of quanta, worth an hour, after which time in the mesh stops counting.
+func inMeshCap() float64 {
+	return float64(time.Hour / inMeshTime())
+}
+
+func oneSlotDuration() time.Duration {
+	return time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+}
+
+func oneEpochDuration() time.Duration {
+	return time.Duration(params.BeaconConfig().SlotsPerEpoch) * oneSlotDuration()
+}
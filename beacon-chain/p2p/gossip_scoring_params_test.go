@@ -0,0 +1,65 @@
+package p2p
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestTopicScoreParams_AllGossipTopicsScored(t *testing.T) {
+	for topicFormat := range GossipTopicMappings {
+		p := topicScoreParams(topicFormat)
+		require.NotNil(t, p, "Topic %s is not scored", topicFormat)
+		assert.Equal(t, true, p.InvalidMessageDeliveriesWeight < 0, "Invalid messages are not penalized on %s", topicFormat)
+		assert.Equal(t, true, p.FirstMessageDeliveriesDecay > 0 && p.FirstMessageDeliveriesDecay < 1)
+		assert.Equal(t, true, p.InvalidMessageDeliveriesDecay > 0 && p.InvalidMessageDeliveriesDecay < 1)
+	}
+	// Only blocks have a known delivery rate.
+	assert.Equal(t, true, blockTopicParams().MeshMessageDeliveriesWeight < 0)
+	assert.Equal(t, 0.0, topicScoreParams(AggregateAndProofSubnetTopicFormat).MeshMessageDeliveriesWeight)
+}
+
+func TestTopicForkDigest(t *testing.T) {
+	digest, ok := topicForkDigest(fmt.Sprintf(BlockSubnetTopicFormat, [4]byte{1, 2, 3, 4}) + "/ssz_snappy")
+	assert.Equal(t, true, ok)
+	assert.Equal(t, [4]byte{1, 2, 3, 4}, digest)
+
+	_, ok = topicForkDigest("foo")
+	assert.Equal(t, false, ok)
+	_, ok = topicForkDigest("/eth2/zz/beacon_block")
+	assert.Equal(t, false, ok)
+}
+
+func TestService_ScoreParamsForTopic(t *testing.T) {
+	s, err := NewService(context.Background(), &Config{})
+	require.NoError(t, err)
+	suffix := s.Encoding().ProtocolSuffix()
+	for _, digest := range [][4]byte{{'a', 'b', 'c', 'd'}, {}} {
+		assert.DeepEqual(t, blockTopicParams(), s.scoreParamsForTopic(fmt.Sprintf(BlockSubnetTopicFormat, digest)+suffix))
+		subnetTopic := fmt.Sprintf(AttestationSubnetTopicFormat, digest, 3) + suffix
+		assert.DeepEqual(t, topicScoreParams(AttestationSubnetTopicFormat), s.scoreParamsForTopic(subnetTopic))
+	}
+	assert.Equal(t, true, s.scoreParamsForTopic("foo") == nil)
+	assert.Equal(t, true, s.scoreParamsForTopic(fmt.Sprintf(BlockSubnetTopicFormat, [4]byte{})) == nil, "Scored a topic without the protocol suffix")
+}
+
+func TestService_JoinTopic_SetsTopicScoreParams(t *testing.T) {
+	s, err := NewService(context.Background(), &Config{})
+	require.NoError(t, err)
+	suffix := s.Encoding().ProtocolSuffix()
+	// Topics of every fork digest are joined with valid score parameters.
+	for _, digest := range [][4]byte{{'a', 'b', 'c', 'd'}, {}} {
+		for topicFormat := range GossipTopicMappings {
+			topic := fmt.Sprintf(topicFormat, digest) + suffix
+			if topicFormat == AttestationSubnetTopicFormat {
+				topic = fmt.Sprintf(topicFormat, digest, params.BeaconNetworkConfig().AttestationSubnetCount-1) + suffix
+			}
+			_, err := s.JoinTopic(topic)
+			require.NoError(t, err, "Could not join topic %s", topic)
+		}
+	}
+}
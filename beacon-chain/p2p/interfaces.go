@@ -2,6 +2,8 @@ package p2p
 
 import (
 	"context"
+	"net"
+	"time"
 
 	"github.com/ethereum/go-ethereum/p2p/enr"
 	"github.com/gogo/protobuf/proto"
@@ -77,6 +79,17 @@ type PeerManager interface {
 	AddPingMethod(reqFunc func(ctx context.Context, id peer.ID) error)
 }
 
+// PeerController allows the node operator to act on the peer set at runtime.
+type PeerController interface {
+	AddTrustedPeer(info peer.AddrInfo) error
+	RemoveTrustedPeer(pid peer.ID) error
+	BanPeer(ctx context.Context, pid peer.ID, duration time.Duration) error
+	UnbanPeer(pid peer.ID) error
+	BanIP(ctx context.Context, ip net.IP, duration time.Duration) error
+	UnbanIP(ip net.IP) error
+	DisconnectPeer(ctx context.Context, pid peer.ID, reason uint64) error
+}
+
 // Sender abstracts the sending functionality from libp2p.
 type Sender interface {
 	Send(context.Context, interface{}, string, peer.ID) (network.Stream, error)
@@ -0,0 +1,119 @@
+package p2p
+
+import (
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peerdb"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+)
+
+// knownPeersSaveInterval is how often the known peers are written to the peer database.
+const knownPeersSaveInterval = 5 * time.Minute
+
+// openPeerDB opens the peer database in the data directory and restores the peers known before
+// the last shutdown, along with the trusted peers and bans. Bad peers are restored along with good
+// ones, so that they keep being refused.
+func (s *Service) openPeerDB() error {
+	if s.cfg.DataDir == "" {
+		return nil
+	}
+	store, err := peerdb.NewStore(s.cfg.DataDir)
+	if err != nil {
+		return err
+	}
+	records, err := store.Peers()
+	if err != nil {
+		if closeErr := store.Close(); closeErr != nil {
+			log.WithError(closeErr).Error("Could not close peer database")
+		}
+		return err
+	}
+	s.peerDB = store
+	s.peers.Restore(records)
+	s.knownPeers = records
+	log.WithField("peers", len(records)).Debug("Restored known peers")
+	return s.restoreRules()
+}
+
+// savePeers writes the records of all known peers to the peer database.
+func (s *Service) savePeers() {
+	if s.peerDB == nil {
+		return
+	}
+	if err := s.peerDB.SavePeers(s.peers.Records()); err != nil {
+		log.WithError(err).Error("Could not save known peers")
+	}
+}
+
+// connectToKnownPeers dials the best scored peers restored from the peer database, so that the
+// node does not have to wait for discovery to find useful peers after a restart.
+func (s *Service) connectToKnownPeers() {
+	infos := s.knownPeerAddrs(s.knownPeers, int(s.cfg.MaxPeers)/2)
+	s.knownPeers = nil
+	if len(infos) == 0 {
+		return
+	}
+	log.WithField("peers", len(infos)).Debug("Reconnecting to known peers")
+	for _, info := range infos {
+		// make each dial non-blocking
+		go func(info peer.AddrInfo) {
+			if err := s.connectWithPeer(s.ctx, info); err != nil {
+				log.WithError(err).Tracef("Could not connect with known peer %s", info.String())
+			}
+		}(info)
+	}
+}
+
+// knownPeerAddrs returns the addresses of at most limit peers from the given records, ordered by
+// their score. Bad peers and peers without a dialable address are skipped.
+func (s *Service) knownPeerAddrs(records []*peers.PeerRecord, limit int) []peer.AddrInfo {
+	scorers := s.peers.Scorers()
+	candidates := make([]*peers.PeerRecord, 0, len(records))
+	for _, record := range records {
+		if record.ID == s.host.ID() || s.peers.IsBad(record.ID) {
+			continue
+		}
+		candidates = append(candidates, record)
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return scorers.Score(candidates[i].ID) > scorers.Score(candidates[j].ID)
+	})
+
+	infos := make([]peer.AddrInfo, 0, limit)
+	for _, record := range candidates {
+		if len(infos) >= limit {
+			break
+		}
+		addr := knownPeerAddr(record)
+		if addr == nil {
+			continue
+		}
+		infos = append(infos, peer.AddrInfo{ID: record.ID, Addrs: []ma.Multiaddr{addr}})
+	}
+	return infos
+}
+
+// knownPeerAddr returns the address to dial a known peer on. The address of an inbound connection
+// uses an ephemeral port of the remote peer, so the ENR is preferred when the peer advertised one.
+func knownPeerAddr(record *peers.PeerRecord) ma.Multiaddr {
+	if record.ENR != nil {
+		node, err := enode.New(enode.ValidSchemes, record.ENR)
+		if err == nil && node.IP() != nil && node.TCP() != 0 {
+			addr, err := convertToSingleMultiAddr(node)
+			if err == nil {
+				transport, _ := peer.SplitAddr(addr)
+				return transport
+			}
+		}
+	}
+	if record.Address != nil && record.Direction == network.DirOutbound {
+		transport, _ := peer.SplitAddr(record.Address)
+		return transport
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package p2p
+
+import (
+	"context"
+	"testing"
+
+	gethCrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestService_KnownPeerAddrs(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	s := &Service{
+		host: p1.BHost,
+		cfg:  &Config{},
+		peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
+			ScorerParams: &peers.PeerScorerConfig{
+				BadResponsesScorerConfig: &peers.BadResponsesScorerConfig{
+					Threshold: 2,
+				},
+			},
+		}),
+	}
+
+	key, err := gethCrypto.GenerateKey()
+	require.NoError(t, err)
+	record := &enr.Record{}
+	record.Set(enr.IPv4{192, 168, 0, 1})
+	record.Set(enr.TCP(13000))
+	require.NoError(t, enode.SignV4(record, key))
+	outboundAddr, err := ma.NewMultiaddr("/ip4/10.0.0.1/tcp/13000")
+	require.NoError(t, err)
+	inboundAddr, err := ma.NewMultiaddr("/ip4/10.0.0.2/tcp/53124")
+	require.NoError(t, err)
+
+	records := []*peers.PeerRecord{
+		{ID: "outbound", Address: outboundAddr, Direction: network.DirOutbound},
+		{ID: "enr", Address: inboundAddr, Direction: network.DirInbound, ENR: record, GossipScore: 500},
+		{ID: "inbound", Address: inboundAddr, Direction: network.DirInbound},
+		{ID: "bad", Address: outboundAddr, Direction: network.DirOutbound, BadResponses: 2},
+		{ID: s.host.ID(), Address: outboundAddr, Direction: network.DirOutbound},
+	}
+	s.peers.Restore(records)
+
+	infos := s.knownPeerAddrs(records, 10)
+	require.Equal(t, 2, len(infos))
+	// The peer with the better gossip score comes first.
+	assert.Equal(t, peer.ID("enr"), infos[0].ID)
+	assert.Equal(t, "/ip4/192.168.0.1/tcp/13000", infos[0].Addrs[0].String())
+	assert.Equal(t, peer.ID("outbound"), infos[1].ID)
+	assert.Equal(t, outboundAddr.String(), infos[1].Addrs[0].String())
+
+	infos = s.knownPeerAddrs(records, 1)
+	require.Equal(t, 1, len(infos))
+	assert.Equal(t, peer.ID("enr"), infos[0].ID)
+}
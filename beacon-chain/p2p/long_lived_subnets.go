@@ -0,0 +1,72 @@
+package p2p
+
+import (
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/rand"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
+)
+
+// longLivedSubnet is an attestation subnet the node subscribes to on its own behalf, so that it
+// serves the subnet even with no validators attached, until its expiry epoch.
+type longLivedSubnet struct {
+	index  uint64
+	expiry uint64
+}
+
+// Replaces the long-lived subnets of the node which expired by the current epoch with random
+// subnets, and publishes the new set to the subnet cache. The ENR and metadata of the node pick
+// up the new subnets on their next refresh.
+func (s *Service) rotateLongLivedSubnets() {
+	epoch := slotutil.EpochsSinceGenesis(s.genesisTime)
+
+	s.longLivedSubnetsLock.Lock()
+	defer s.longLivedSubnetsLock.Unlock()
+	subnets, changed := rotateSubnets(s.longLivedSubnets, epoch, s.cfg.LongLivedSubnets, rand.NewGenerator())
+	if !changed {
+		return
+	}
+	s.longLivedSubnets = subnets
+
+	indices := make([]uint64, len(subnets))
+	for i, subnet := range subnets {
+		indices[i] = subnet.index
+	}
+	cache.SubnetIDs.SetLongLivedSubnets(indices)
+	log.WithField("subnets", indices).Debug("Rotated long-lived attestation subnets")
+}
+
+// rotateSubnets drops the subnets which expired by the given epoch, and tops the rest up to the
+// wanted count with random subnets not already in use. Each new subnet is kept between
+// EPOCHS_PER_RANDOM_SUBNET_SUBSCRIPTION and twice as many epochs, so that the subnets of a node
+// do not all rotate at once. It returns whether the subnets changed.
+func rotateSubnets(subnets []longLivedSubnet, epoch uint64, count uint64, gen *rand.Rand) ([]longLivedSubnet, bool) {
+	if count > attestationSubnetCount {
+		count = attestationSubnetCount
+	}
+	kept := make([]longLivedSubnet, 0, count)
+	used := make(map[uint64]bool, count)
+	for _, subnet := range subnets {
+		if subnet.expiry > epoch && uint64(len(kept)) < count {
+			kept = append(kept, subnet)
+			used[subnet.index] = true
+		}
+	}
+	changed := len(kept) != len(subnets)
+
+	period := params.BeaconNetworkConfig().EpochsPerRandomSubnetSubscription
+	for uint64(len(kept)) < count {
+		index := uint64(gen.Intn(int(attestationSubnetCount)))
+		if used[index] {
+			continue
+		}
+		used[index] = true
+		duration := period
+		if period > 0 {
+			duration += uint64(gen.Intn(int(period)))
+		}
+		kept = append(kept, longLivedSubnet{index: index, expiry: epoch + duration})
+		changed = true
+	}
+	return kept, changed
+}
@@ -0,0 +1,70 @@
+package p2p
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/rand"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestRotateSubnets(t *testing.T) {
+	period := params.BeaconNetworkConfig().EpochsPerRandomSubnetSubscription
+	gen := rand.NewDeterministicGenerator()
+
+	subnets, changed := rotateSubnets(nil, 10, 3, gen)
+	assert.Equal(t, true, changed)
+	require.Equal(t, 3, len(subnets))
+	seen := make(map[uint64]bool)
+	for _, subnet := range subnets {
+		assert.Equal(t, false, seen[subnet.index], "Subnet %d used twice", subnet.index)
+		seen[subnet.index] = true
+		assert.Equal(t, true, subnet.index < attestationSubnetCount)
+		assert.Equal(t, true, subnet.expiry >= 10+period && subnet.expiry < 10+2*period,
+			"Unexpected expiry %d", subnet.expiry)
+	}
+
+	// Nothing changes before the subnets expire.
+	kept, changed := rotateSubnets(subnets, 10+period-1, 3, gen)
+	assert.Equal(t, false, changed)
+	assert.DeepEqual(t, subnets, kept)
+
+	// Expired subnets are replaced.
+	subnets[0].expiry = 20
+	rotated, changed := rotateSubnets(subnets, 20, 3, gen)
+	assert.Equal(t, true, changed)
+	require.Equal(t, 3, len(rotated))
+	assert.DeepEqual(t, subnets[1:], rotated[:2])
+	assert.Equal(t, true, rotated[2].expiry >= 20+period)
+
+	// Lowering the count drops subnets.
+	shrunk, changed := rotateSubnets(rotated, 20, 1, gen)
+	assert.Equal(t, true, changed)
+	assert.DeepEqual(t, rotated[:1], shrunk)
+}
+
+func TestRotateSubnets_CountAboveSubnetCount(t *testing.T) {
+	subnets, _ := rotateSubnets(nil, 0, attestationSubnetCount+10, rand.NewDeterministicGenerator())
+	assert.Equal(t, int(attestationSubnetCount), len(subnets))
+}
+
+func TestService_RotateLongLivedSubnets(t *testing.T) {
+	defer cache.SubnetIDs.SetLongLivedSubnets(nil)
+	s := &Service{
+		cfg:         &Config{LongLivedSubnets: 2},
+		genesisTime: time.Now(),
+	}
+
+	s.rotateLongLivedSubnets()
+	subnets := cache.SubnetIDs.GetLongLivedSubnets()
+	require.Equal(t, 2, len(subnets))
+	assert.Equal(t, s.longLivedSubnets[0].index, subnets[0])
+	assert.Equal(t, s.longLivedSubnets[1].index, subnets[1])
+
+	// The subnets are kept until they expire.
+	s.rotateLongLivedSubnets()
+	assert.DeepEqual(t, subnets, cache.SubnetIDs.GetLongLivedSubnets())
+}
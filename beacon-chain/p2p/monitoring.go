@@ -11,6 +11,20 @@ var (
 		Help: "The number of peers in a given state.",
 	},
 		[]string{"state"})
+	p2pPeerDirectionCount = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "p2p_peer_direction_count",
+		Help: "The number of connected peers by the direction of their connection, trusted peers excluded.",
+	},
+		[]string{"direction"})
+	rejectedConnections = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "p2p_rejected_connections",
+		Help: "The number of connections rejected by the connection gater for exceeding a peer limit.",
+	},
+		[]string{"reason"})
+	prunedPeers = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "p2p_pruned_peers",
+		Help: "The number of connected peers disconnected to bring the peer count within the limits.",
+	})
 	repeatPeerConnections = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "p2p_repeat_attempts",
 		Help: "The number of repeat attempts the connection handler is triggered for a peer.",
@@ -25,6 +39,11 @@ var (
 		Name: "p2p_attestation_subnet_attempted_broadcasts",
 		Help: "The number of attestations that were attempted to be broadcast.",
 	})
+	subnetPeerCount = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "p2p_attestation_subnet_peers",
+		Help: "The number of peers subscribed to each attestation subnet the node needs.",
+	},
+		[]string{"subnet"})
 )
 
 func (s *Service) updateMetrics() {
@@ -33,4 +52,6 @@ func (s *Service) updateMetrics() {
 	p2pPeerCount.WithLabelValues("Connecting").Set(float64(len(s.peers.Connecting())))
 	p2pPeerCount.WithLabelValues("Disconnecting").Set(float64(len(s.peers.Disconnecting())))
 	p2pPeerCount.WithLabelValues("Bad").Set(float64(len(s.peers.Bad())))
+	p2pPeerDirectionCount.WithLabelValues("Inbound").Set(float64(len(s.peers.InboundConnected())))
+	p2pPeerDirectionCount.WithLabelValues("Outbound").Set(float64(len(s.peers.OutboundConnected())))
 }
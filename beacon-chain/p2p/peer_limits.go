@@ -0,0 +1,125 @@
+package p2p
+
+import (
+	"fmt"
+	"math"
+	"net"
+	"time"
+
+	ma "github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr-net"
+)
+
+// peerPruneInterval is how often the connected peers are checked against the peer limits.
+const peerPruneInterval = 30 * time.Second
+
+// GoodbyeCodeTooManyPeers is the client specific goodbye reason sent to peers which are
+// disconnected to bring the peer count back within the limits.
+const GoodbyeCodeTooManyPeers = 129
+
+// Colocated peers are limited per IPv4 /24 and per IPv6 /64 subnet.
+const (
+	ipv4SubnetBits = 24
+	ipv6SubnetBits = 64
+)
+
+// privateSubnets are the private address ranges of IPv4 and IPv6. Together with loopback and
+// link-local addresses, they are exempt from the colocation limits, as nodes on a local or
+// private network, such as a local testnet or peers behind a shared NAT, often share addresses.
+var privateSubnets = []*net.IPNet{
+	{IP: net.IP{10, 0, 0, 0}, Mask: net.CIDRMask(8, 8*net.IPv4len)},
+	{IP: net.IP{172, 16, 0, 0}, Mask: net.CIDRMask(12, 8*net.IPv4len)},
+	{IP: net.IP{192, 168, 0, 0}, Mask: net.CIDRMask(16, 8*net.IPv4len)},
+	{IP: net.IP{0xfc, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, Mask: net.CIDRMask(7, 8*net.IPv6len)},
+}
+
+// colocationLimitReached checks whether another peer at the given address would exceed the
+// configured per-IP or per-subnet peer limits, and returns the reason if it would. A limit of
+// zero disables the check. Loopback and private addresses are never limited.
+func (s *Service) colocationLimitReached(addr ma.Multiaddr) (string, bool) {
+	if s.cfg.MaxPeersPerIP == 0 && s.cfg.MaxPeersPerSubnet == 0 {
+		return "", false
+	}
+	ip, err := manet.ToIP(addr)
+	if err != nil || isLocalIP(ip) {
+		return "", false
+	}
+	if s.cfg.MaxPeersPerIP > 0 && s.peers.ActiveInSubnet(ipSubnet(ip, 8*net.IPv6len)) >= int(s.cfg.MaxPeersPerIP) {
+		return "too many peers from ip address", true
+	}
+	subnetBits := ipv6SubnetBits
+	if ip.To4() != nil {
+		subnetBits = ipv4SubnetBits
+	}
+	subnet := ipSubnet(ip, subnetBits)
+	if s.cfg.MaxPeersPerSubnet > 0 && s.peers.ActiveInSubnet(subnet) >= int(s.cfg.MaxPeersPerSubnet) {
+		return fmt.Sprintf("too many peers from subnet %s", subnet), true
+	}
+	return "", false
+}
+
+// minOutboundPeers returns the number of peer slots reserved for peers we dial, so that inbound
+// peers can not crowd them out.
+func (s *Service) minOutboundPeers() int {
+	return int(math.Ceil(float64(s.cfg.MaxPeers) * s.cfg.MinOutboundRatio))
+}
+
+// maxInboundPeers returns the number of peer slots inbound peers may take.
+func (s *Service) maxInboundPeers() int {
+	return int(s.cfg.MaxPeers) - s.minOutboundPeers()
+}
+
+// isInboundAtLimit checks whether inbound peers have taken all of the peer slots which are not
+// reserved for outbound peers.
+func (s *Service) isInboundAtLimit() bool {
+	return len(s.peers.InboundConnected()) >= s.maxInboundPeers()
+}
+
+// pruneConnectedPeers disconnects from peers while more peers are connected than the peer limit
+// allows, or inbound peers take slots reserved for outbound peers. The lowest scored inbound
+// peers are disconnected first. Trusted peers are never disconnected.
+func (s *Service) pruneConnectedPeers() {
+	inbound := len(s.peers.InboundConnected())
+	outbound := len(s.peers.OutboundConnected())
+	excess := inbound + outbound - int(s.cfg.MaxPeers)
+	toPrune := s.peers.PruneCandidates(excess, false)
+	if inboundExcess := inbound - s.maxInboundPeers(); inboundExcess > excess {
+		toPrune = s.peers.PruneCandidates(inboundExcess, true)
+	}
+	for _, pid := range toPrune {
+		if err := s.DisconnectPeer(s.ctx, pid, GoodbyeCodeTooManyPeers); err != nil {
+			log.WithError(err).WithField("peer", pid).Debug("Could not disconnect from pruned peer")
+			continue
+		}
+		prunedPeers.Inc()
+	}
+	if len(toPrune) > 0 {
+		log.WithField("peers", len(toPrune)).Debug("Pruned connected peers")
+	}
+}
+
+// ipSubnet returns the subnet of the given size containing the IP address.
+func ipSubnet(ip net.IP, bits int) *net.IPNet {
+	if ip4 := ip.To4(); ip4 != nil {
+		if bits > 8*net.IPv4len {
+			bits = 8 * net.IPv4len
+		}
+		mask := net.CIDRMask(bits, 8*net.IPv4len)
+		return &net.IPNet{IP: ip4.Mask(mask), Mask: mask}
+	}
+	mask := net.CIDRMask(bits, 8*net.IPv6len)
+	return &net.IPNet{IP: ip.Mask(mask), Mask: mask}
+}
+
+// isLocalIP checks whether the IP address is a loopback, link-local or private address.
+func isLocalIP(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsLinkLocalUnicast() {
+		return true
+	}
+	for _, subnet := range privateSubnets {
+		if subnet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
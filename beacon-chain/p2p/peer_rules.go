@@ -0,0 +1,237 @@
+package p2p
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/libp2p/go-libp2p-core/helpers"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p-core/peerstore"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// trustedPeersInterval is how often the connections to trusted peers are checked.
+const trustedPeersInterval = 30 * time.Second
+
+// GoodbyeCodeBanned is the client specific goodbye reason sent to peers which are banned.
+const GoodbyeCodeBanned = 251
+
+var (
+	// ErrPeerNotTrusted is returned when removing a peer which is not trusted.
+	ErrPeerNotTrusted = errors.New("peer is not trusted")
+	// ErrNotBanned is returned when lifting a ban which does not exist.
+	ErrNotBanned = errors.New("peer or ip address is not banned")
+)
+
+// ParsePeerAddr parses the address of a peer, given either as a multiaddress including the peer
+// ID or as an ENR.
+func ParsePeerAddr(address string) (*peer.AddrInfo, error) {
+	if node, err := enode.Parse(enode.ValidSchemes, address); err == nil {
+		info, _, err := convertToAddrInfo(node)
+		return info, err
+	}
+	addr, err := multiAddrFromString(address)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse peer address")
+	}
+	return peer.AddrInfoFromP2pAddr(addr)
+}
+
+// AddTrustedPeer marks a peer as trusted and connects to it. Trusted peers are reconnected to
+// whenever their connection drops and are exempt from the peer limit. Any ban of the peer ID is
+// lifted.
+func (s *Service) AddTrustedPeer(info peer.AddrInfo) error {
+	if info.ID == s.host.ID() {
+		return errors.New("can not trust the local peer")
+	}
+	if len(info.Addrs) == 0 {
+		return errors.New("no address given for trusted peer")
+	}
+	s.peers.UnbanPeer(info.ID)
+	s.peers.AddTrusted(info)
+	s.host.Peerstore().AddAddrs(info.ID, info.Addrs, peerstore.PermanentAddrTTL)
+	if err := s.saveRules(); err != nil {
+		return err
+	}
+	log.WithField("peer", info.ID).Info("Added trusted peer")
+	go s.connectToTrustedPeers()
+	return nil
+}
+
+// RemoveTrustedPeer removes the trusted mark of a peer. The peer stays connected, but is treated
+// as any other peer from now on.
+func (s *Service) RemoveTrustedPeer(pid peer.ID) error {
+	if !s.peers.RemoveTrusted(pid) {
+		return ErrPeerNotTrusted
+	}
+	if err := s.saveRules(); err != nil {
+		return err
+	}
+	log.WithField("peer", pid).Info("Removed trusted peer")
+	return nil
+}
+
+// BanPeer bans a peer ID for the given duration, or permanently if the duration is zero, and
+// disconnects from it. Banned peers are refused by the connection gater.
+func (s *Service) BanPeer(ctx context.Context, pid peer.ID, duration time.Duration) error {
+	if pid == s.host.ID() {
+		return errors.New("can not ban the local peer")
+	}
+	if s.peers.RemoveTrusted(pid) {
+		log.WithField("peer", pid).Info("Removed trusted peer")
+	}
+	s.peers.BanPeer(pid, banExpiry(duration))
+	if err := s.saveRules(); err != nil {
+		return err
+	}
+	log.WithFields(logrus.Fields{"peer": pid, "duration": duration}).Info("Banned peer")
+	if s.host.Network().Connectedness(pid) == network.Connected {
+		return s.DisconnectPeer(ctx, pid, GoodbyeCodeBanned)
+	}
+	return nil
+}
+
+// UnbanPeer lifts the ban of a peer ID.
+func (s *Service) UnbanPeer(pid peer.ID) error {
+	if !s.peers.UnbanPeer(pid) {
+		return ErrNotBanned
+	}
+	if err := s.saveRules(); err != nil {
+		return err
+	}
+	log.WithField("peer", pid).Info("Unbanned peer")
+	return nil
+}
+
+// BanIP bans an IP address for the given duration, or permanently if the duration is zero, and
+// disconnects from all peers connected from it.
+func (s *Service) BanIP(ctx context.Context, ip net.IP, duration time.Duration) error {
+	s.peers.BanIP(ip, banExpiry(duration))
+	if err := s.saveRules(); err != nil {
+		return err
+	}
+	log.WithFields(logrus.Fields{"ip": ip, "duration": duration}).Info("Banned ip address")
+	for _, conn := range s.host.Network().Conns() {
+		if !s.peers.IsAddrBanned(conn.RemoteMultiaddr()) {
+			continue
+		}
+		if err := s.DisconnectPeer(ctx, conn.RemotePeer(), GoodbyeCodeBanned); err != nil {
+			log.WithError(err).WithField("peer", conn.RemotePeer()).Debug("Could not disconnect from banned peer")
+		}
+	}
+	return nil
+}
+
+// UnbanIP lifts the ban of an IP address.
+func (s *Service) UnbanIP(ip net.IP) error {
+	if !s.peers.UnbanIP(ip) {
+		return ErrNotBanned
+	}
+	if err := s.saveRules(); err != nil {
+		return err
+	}
+	log.WithField("ip", ip).Info("Unbanned ip address")
+	return nil
+}
+
+// DisconnectPeer sends a goodbye message with the given reason to a peer and disconnects from it.
+// Failing to send the goodbye message does not prevent the disconnection.
+func (s *Service) DisconnectPeer(ctx context.Context, pid peer.ID, reason uint64) error {
+	if err := s.sendGoodbye(ctx, pid, reason); err != nil {
+		log.WithError(err).WithField("peer", pid).Debug("Could not send goodbye message to peer")
+	}
+	return s.Disconnect(pid)
+}
+
+func (s *Service) sendGoodbye(ctx context.Context, pid peer.ID, reason uint64) error {
+	ctx, cancel := context.WithTimeout(ctx, maxDialTimeout)
+	defer cancel()
+	stream, err := s.Send(ctx, &reason, RPCGoodByeTopic, pid)
+	if err != nil {
+		return err
+	}
+	return helpers.FullClose(stream)
+}
+
+// connectToTrustedPeers dials all trusted peers which are not connected.
+func (s *Service) connectToTrustedPeers() {
+	for _, info := range s.peers.Trusted() {
+		if s.host.Network().Connectedness(info.ID) == network.Connected {
+			continue
+		}
+		if err := s.connectWithPeer(s.ctx, info); err != nil {
+			log.WithError(err).WithField("peer", info.ID).Debug("Could not connect with trusted peer")
+		}
+	}
+}
+
+// connectedTrustedPeers returns the number of trusted peers we are connected to.
+func (s *Service) connectedTrustedPeers() int {
+	count := 0
+	for _, info := range s.peers.Trusted() {
+		if s.host.Network().Connectedness(info.ID) == network.Connected {
+			count++
+		}
+	}
+	return count
+}
+
+// trustStaticPeers marks the static peers from the node configuration as trusted.
+func (s *Service) trustStaticPeers(addrs []ma.Multiaddr) {
+	infos, err := peer.AddrInfosFromP2pAddrs(addrs...)
+	if err != nil {
+		log.WithError(err).Error("Could not convert static peer addresses")
+		return
+	}
+	for _, info := range infos {
+		s.staticPeers[info.ID] = true
+		s.peers.AddTrusted(info)
+	}
+}
+
+// restoreRules restores the trusted peers and bans from the peer database.
+func (s *Service) restoreRules() error {
+	if s.peerDB == nil {
+		return nil
+	}
+	trusted, err := s.peerDB.TrustedPeers()
+	if err != nil {
+		return err
+	}
+	bans, err := s.peerDB.Bans()
+	if err != nil {
+		return err
+	}
+	s.peers.RestoreRules(trusted, bans)
+	return nil
+}
+
+// saveRules persists the trusted peers and bans, so they are kept across restarts. Static peers
+// from the node configuration are not persisted.
+func (s *Service) saveRules() error {
+	if s.peerDB == nil {
+		return nil
+	}
+	trusted := make([]peer.AddrInfo, 0)
+	for _, info := range s.peers.Trusted() {
+		if !s.staticPeers[info.ID] {
+			trusted = append(trusted, info)
+		}
+	}
+	if err := s.peerDB.SaveTrustedPeers(trusted); err != nil {
+		return errors.Wrap(err, "could not save trusted peers")
+	}
+	return errors.Wrap(s.peerDB.SaveBans(s.peers.Bans()), "could not save bans")
+}
+
+func banExpiry(duration time.Duration) time.Time {
+	if duration == 0 {
+		return time.Time{}
+	}
+	return time.Now().Add(duration)
+}
@@ -0,0 +1,5 @@
+package peerdb
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "peerdb")
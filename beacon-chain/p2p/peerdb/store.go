@@ -0,0 +1,300 @@
+// Package peerdb persists the records of known peers across restarts of the beacon node, so
+// that it can reconnect to peers which served it well and keep refusing peers which did not.
+package peerdb
+
+import (
+	"bytes"
+	"encoding/json"
+	"net"
+	"os"
+	"path"
+	"time"
+
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	bolt "go.etcd.io/bbolt"
+)
+
+// DatabaseFileName is the name of the peer database file in the data directory.
+const DatabaseFileName = "peers.db"
+
+var (
+	peersBucket   = []byte("peers")
+	trustedBucket = []byte("trusted-peers")
+	bansBucket    = []byte("bans")
+)
+
+// Store is a bolt database of peer records.
+type Store struct {
+	db *bolt.DB
+}
+
+// record is the encoded form of a peer record.
+type record struct {
+	Address               []byte            `json:"address,omitempty"`
+	Direction             network.Direction `json:"direction"`
+	ENR                   []byte            `json:"enr,omitempty"`
+	ChainState            []byte            `json:"chain_state,omitempty"`
+	ChainStateLastUpdated time.Time         `json:"chain_state_last_updated"`
+	MetaData              []byte            `json:"metadata,omitempty"`
+	BadResponses          int               `json:"bad_responses"`
+	ProcessedBlocks       uint64            `json:"processed_blocks"`
+	GossipScore           float64           `json:"gossip_score"`
+}
+
+// ban is the encoded form of a ban.
+type ban struct {
+	PeerID string    `json:"peer_id,omitempty"`
+	IP     net.IP    `json:"ip,omitempty"`
+	Until  time.Time `json:"until"`
+}
+
+// NewStore opens the peer database in the given directory, creating it if needed.
+func NewStore(dirPath string) (*Store, error) {
+	if err := os.MkdirAll(dirPath, params.BeaconIoConfig().ReadWriteExecutePermissions); err != nil {
+		return nil, err
+	}
+	datafile := path.Join(dirPath, DatabaseFileName)
+	db, err := bolt.Open(datafile, params.BeaconIoConfig().ReadWritePermissions, &bolt.Options{Timeout: 1 * time.Second})
+	if err != nil {
+		if err == bolt.ErrTimeout {
+			return nil, errors.New("cannot obtain peer database lock, database may be in use by another process")
+		}
+		return nil, err
+	}
+	if err := db.Update(func(tx *bolt.Tx) error {
+		for _, bucket := range [][]byte{peersBucket, trustedBucket, bansBucket} {
+			if _, err := tx.CreateBucketIfNotExists(bucket); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return &Store{db: db}, nil
+}
+
+// Close the peer database.
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// SavePeers replaces the stored peer records with the given ones.
+func (s *Store) SavePeers(records []*peers.PeerRecord) error {
+	encoded := make(map[string][]byte, len(records))
+	for _, r := range records {
+		enc, err := encode(r)
+		if err != nil {
+			return errors.Wrapf(err, "could not encode record of peer %s", r.ID)
+		}
+		encoded[string(r.ID)] = enc
+	}
+	return s.replaceBucket(peersBucket, encoded)
+}
+
+// Peers returns the stored peer records. Records which can not be decoded are skipped, so a
+// single corrupt record does not prevent the node from starting.
+func (s *Store) Peers() ([]*peers.PeerRecord, error) {
+	var records []*peers.PeerRecord
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(peersBucket).ForEach(func(k, v []byte) error {
+			r, err := decode(peer.ID(k), v)
+			if err != nil {
+				log.WithError(err).WithField("peer", peer.ID(k)).Warn("Skipping corrupt peer record")
+				return nil
+			}
+			records = append(records, r)
+			return nil
+		})
+	})
+	return records, err
+}
+
+// SaveTrustedPeers replaces the stored trusted peers with the given ones.
+func (s *Store) SaveTrustedPeers(infos []peer.AddrInfo) error {
+	encoded := make(map[string][]byte, len(infos))
+	for _, info := range infos {
+		addrs := make([][]byte, len(info.Addrs))
+		for i, addr := range info.Addrs {
+			addrs[i] = addr.Bytes()
+		}
+		enc, err := json.Marshal(addrs)
+		if err != nil {
+			return errors.Wrapf(err, "could not encode addresses of peer %s", info.ID)
+		}
+		encoded[string(info.ID)] = enc
+	}
+	return s.replaceBucket(trustedBucket, encoded)
+}
+
+// TrustedPeers returns the stored trusted peers. Trusted peers whose addresses can not be decoded
+// are skipped.
+func (s *Store) TrustedPeers() ([]peer.AddrInfo, error) {
+	var infos []peer.AddrInfo
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(trustedBucket).ForEach(func(k, v []byte) error {
+			info, err := decodeTrustedPeer(peer.ID(k), v)
+			if err != nil {
+				log.WithError(err).WithField("peer", peer.ID(k)).Warn("Skipping corrupt trusted peer record")
+				return nil
+			}
+			infos = append(infos, info)
+			return nil
+		})
+	})
+	return infos, err
+}
+
+// SaveBans replaces the stored bans with the given ones.
+func (s *Store) SaveBans(bans []*peers.Ban) error {
+	encoded := make(map[string][]byte, len(bans))
+	for _, b := range bans {
+		enc, err := json.Marshal(&ban{PeerID: string(b.PeerID), IP: b.IP, Until: b.Until})
+		if err != nil {
+			return errors.Wrap(err, "could not encode ban")
+		}
+		// Peer IDs and IP addresses are kept apart by a prefix of the key.
+		key := "peer/" + string(b.PeerID)
+		if b.IP != nil {
+			key = "ip/" + b.IP.String()
+		}
+		encoded[key] = enc
+	}
+	return s.replaceBucket(bansBucket, encoded)
+}
+
+// Bans returns the stored bans. Bans which can not be decoded are skipped.
+func (s *Store) Bans() ([]*peers.Ban, error) {
+	var bans []*peers.Ban
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(bansBucket).ForEach(func(k, v []byte) error {
+			b := &ban{}
+			if err := json.Unmarshal(v, b); err != nil {
+				log.WithError(err).WithField("ban", string(k)).Warn("Skipping corrupt ban record")
+				return nil
+			}
+			bans = append(bans, &peers.Ban{PeerID: peer.ID(b.PeerID), IP: b.IP, Until: b.Until})
+			return nil
+		})
+	})
+	return bans, err
+}
+
+// replaceBucket replaces the contents of a bucket with the given encoded values.
+func (s *Store) replaceBucket(bucket []byte, encoded map[string][]byte) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		if err := tx.DeleteBucket(bucket); err != nil {
+			return err
+		}
+		bkt, err := tx.CreateBucket(bucket)
+		if err != nil {
+			return err
+		}
+		for key, enc := range encoded {
+			if err := bkt.Put([]byte(key), enc); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func encode(r *peers.PeerRecord) ([]byte, error) {
+	enc := &record{
+		Direction:             r.Direction,
+		ChainStateLastUpdated: r.ChainStateLastUpdated,
+		BadResponses:          r.BadResponses,
+		ProcessedBlocks:       r.ProcessedBlocks,
+		GossipScore:           r.GossipScore,
+	}
+	if r.Address != nil {
+		enc.Address = r.Address.Bytes()
+	}
+	if r.ENR != nil {
+		buf := bytes.NewBuffer([]byte{})
+		if err := r.ENR.EncodeRLP(buf); err != nil {
+			return nil, err
+		}
+		enc.ENR = buf.Bytes()
+	}
+	if r.ChainState != nil {
+		b, err := r.ChainState.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		enc.ChainState = b
+	}
+	if r.MetaData != nil {
+		b, err := r.MetaData.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		enc.MetaData = b
+	}
+	return json.Marshal(enc)
+}
+
+func decodeTrustedPeer(pid peer.ID, b []byte) (peer.AddrInfo, error) {
+	var addrs [][]byte
+	if err := json.Unmarshal(b, &addrs); err != nil {
+		return peer.AddrInfo{}, err
+	}
+	info := peer.AddrInfo{ID: pid, Addrs: make([]ma.Multiaddr, len(addrs))}
+	for i, enc := range addrs {
+		addr, err := ma.NewMultiaddrBytes(enc)
+		if err != nil {
+			return peer.AddrInfo{}, err
+		}
+		info.Addrs[i] = addr
+	}
+	return info, nil
+}
+
+func decode(pid peer.ID, b []byte) (*peers.PeerRecord, error) {
+	enc := &record{}
+	if err := json.Unmarshal(b, enc); err != nil {
+		return nil, err
+	}
+	r := &peers.PeerRecord{
+		ID:                    pid,
+		Direction:             enc.Direction,
+		ChainStateLastUpdated: enc.ChainStateLastUpdated,
+		BadResponses:          enc.BadResponses,
+		ProcessedBlocks:       enc.ProcessedBlocks,
+		GossipScore:           enc.GossipScore,
+	}
+	if len(enc.Address) > 0 {
+		addr, err := ma.NewMultiaddrBytes(enc.Address)
+		if err != nil {
+			return nil, err
+		}
+		r.Address = addr
+	}
+	if len(enc.ENR) > 0 {
+		r.ENR = &enr.Record{}
+		if err := rlp.DecodeBytes(enc.ENR, r.ENR); err != nil {
+			return nil, err
+		}
+	}
+	if len(enc.ChainState) > 0 {
+		r.ChainState = &pb.Status{}
+		if err := r.ChainState.Unmarshal(enc.ChainState); err != nil {
+			return nil, err
+		}
+	}
+	if len(enc.MetaData) > 0 {
+		r.MetaData = &pb.MetaData{}
+		if err := r.MetaData.Unmarshal(enc.MetaData); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
@@ -0,0 +1,184 @@
+package peerdb
+
+import (
+	"net"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	bolt "go.etcd.io/bbolt"
+)
+
+func TestStore_SavePeers_RoundTrip(t *testing.T) {
+	dir := path.Join(testutil.TempDir(), "peerdbtest")
+	defer func() {
+		require.NoError(t, os.RemoveAll(dir))
+	}()
+	s, err := NewStore(dir)
+	require.NoError(t, err)
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	record := &enr.Record{}
+	record.Set(enr.IPv4{127, 0, 0, 1})
+	record.Set(enr.TCP(13000))
+	require.NoError(t, enode.SignV4(record, key))
+	addr, err := ma.NewMultiaddr("/ip4/127.0.0.1/tcp/13000")
+	require.NoError(t, err)
+
+	records := []*peers.PeerRecord{
+		{
+			ID:                    "good",
+			Address:               addr,
+			Direction:             network.DirOutbound,
+			ENR:                   record,
+			ChainState:            &pb.Status{HeadSlot: 64, FinalizedEpoch: 1, FinalizedRoot: make([]byte, 32)},
+			ChainStateLastUpdated: time.Unix(1600000000, 0),
+			MetaData:              &pb.MetaData{SeqNumber: 2, Attnets: bitfield.NewBitvector64()},
+			ProcessedBlocks:       128,
+			GossipScore:           12.5,
+		},
+		{
+			ID:           "bad",
+			BadResponses: 10,
+			GossipScore:  -2000,
+		},
+	}
+	require.NoError(t, s.SavePeers(records))
+	require.NoError(t, s.Close())
+
+	s, err = NewStore(dir)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, s.Close())
+	}()
+	stored, err := s.Peers()
+	require.NoError(t, err)
+	require.Equal(t, 2, len(stored))
+	byID := make(map[peer.ID]*peers.PeerRecord)
+	for _, r := range stored {
+		byID[r.ID] = r
+	}
+
+	good := byID["good"]
+	require.NotNil(t, good)
+	assert.Equal(t, addr.String(), good.Address.String())
+	assert.Equal(t, network.DirOutbound, good.Direction)
+	assert.DeepEqual(t, record.Signature(), good.ENR.Signature())
+	assert.DeepEqual(t, records[0].ChainState, good.ChainState)
+	assert.Equal(t, true, records[0].ChainStateLastUpdated.Equal(good.ChainStateLastUpdated))
+	assert.DeepEqual(t, records[0].MetaData, good.MetaData)
+	assert.Equal(t, uint64(128), good.ProcessedBlocks)
+	assert.Equal(t, 12.5, good.GossipScore)
+
+	bad := byID["bad"]
+	require.NotNil(t, bad)
+	assert.Equal(t, 10, bad.BadResponses)
+	assert.Equal(t, (*enr.Record)(nil), bad.ENR)
+
+	// Saving replaces the previous records.
+	require.NoError(t, s.SavePeers(records[1:]))
+	stored, err = s.Peers()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(stored))
+	assert.Equal(t, peer.ID("bad"), stored[0].ID)
+}
+
+func TestStore_SkipsCorruptRecords(t *testing.T) {
+	dir := path.Join(testutil.TempDir(), "peerdbcorrupttest")
+	defer func() {
+		require.NoError(t, os.RemoveAll(dir))
+	}()
+	s, err := NewStore(dir)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, s.Close())
+	}()
+
+	require.NoError(t, s.SavePeers([]*peers.PeerRecord{{ID: "good", BadResponses: 1}}))
+	require.NoError(t, s.SaveTrustedPeers([]peer.AddrInfo{{ID: "trusted"}}))
+	require.NoError(t, s.SaveBans([]*peers.Ban{{PeerID: "banned"}}))
+	require.NoError(t, s.db.Update(func(tx *bolt.Tx) error {
+		for _, bucket := range [][]byte{peersBucket, trustedBucket, bansBucket} {
+			if err := tx.Bucket(bucket).Put([]byte("corrupt"), []byte("{")); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+
+	stored, err := s.Peers()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(stored))
+	assert.Equal(t, peer.ID("good"), stored[0].ID)
+	trusted, err := s.TrustedPeers()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(trusted))
+	assert.Equal(t, peer.ID("trusted"), trusted[0].ID)
+	bans, err := s.Bans()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(bans))
+	assert.Equal(t, peer.ID("banned"), bans[0].PeerID)
+}
+
+func TestStore_SaveRules_RoundTrip(t *testing.T) {
+	dir := path.Join(testutil.TempDir(), "peerdbrulestest")
+	defer func() {
+		require.NoError(t, os.RemoveAll(dir))
+	}()
+	s, err := NewStore(dir)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, s.Close())
+	}()
+
+	addr, err := ma.NewMultiaddr("/ip4/127.0.0.1/tcp/13000")
+	require.NoError(t, err)
+	trusted := []peer.AddrInfo{{ID: "trusted", Addrs: []ma.Multiaddr{addr}}}
+	require.NoError(t, s.SaveTrustedPeers(trusted))
+	stored, err := s.TrustedPeers()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(stored))
+	assert.Equal(t, peer.ID("trusted"), stored[0].ID)
+	require.Equal(t, 1, len(stored[0].Addrs))
+	assert.Equal(t, addr.String(), stored[0].Addrs[0].String())
+
+	until := time.Unix(1600000000, 0)
+	bans := []*peers.Ban{
+		{PeerID: "banned", Until: until},
+		{IP: net.ParseIP("10.0.0.1")},
+	}
+	require.NoError(t, s.SaveBans(bans))
+	storedBans, err := s.Bans()
+	require.NoError(t, err)
+	require.Equal(t, 2, len(storedBans))
+	for _, b := range storedBans {
+		if b.IP != nil {
+			assert.Equal(t, "10.0.0.1", b.IP.String())
+			assert.Equal(t, peer.ID(""), b.PeerID)
+			assert.Equal(t, true, b.Until.IsZero())
+			continue
+		}
+		assert.Equal(t, peer.ID("banned"), b.PeerID)
+		assert.Equal(t, true, until.Equal(b.Until))
+	}
+
+	// Saving replaces the previous bans.
+	require.NoError(t, s.SaveBans(nil))
+	storedBans, err = s.Bans()
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(storedBans))
+}
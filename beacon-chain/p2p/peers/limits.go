@@ -0,0 +1,88 @@
+package peers
+
+import (
+	"net"
+	"sort"
+
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+)
+
+// InboundConnected returns the connected peers which dialed us. Trusted peers are not included,
+// as they do not count towards the peer limits.
+func (p *Status) InboundConnected() []peer.ID {
+	return p.connectedWithDirection(network.DirInbound)
+}
+
+// OutboundConnected returns the connected peers which we dialed. Trusted peers are not included,
+// as they do not count towards the peer limits.
+func (p *Status) OutboundConnected() []peer.ID {
+	return p.connectedWithDirection(network.DirOutbound)
+}
+
+func (p *Status) connectedWithDirection(direction network.Direction) []peer.ID {
+	p.store.RLock()
+	defer p.store.RUnlock()
+	peers := make([]peer.ID, 0)
+	for pid, peerData := range p.store.peers {
+		if _, ok := p.store.trustedPeers[pid]; ok {
+			continue
+		}
+		if peerData.connState == PeerConnected && peerData.direction == direction {
+			peers = append(peers, pid)
+		}
+	}
+	return peers
+}
+
+// ActiveInSubnet returns the number of connecting or connected peers with an address in the
+// given subnet. Trusted peers are not counted.
+func (p *Status) ActiveInSubnet(subnet *net.IPNet) int {
+	p.store.RLock()
+	defer p.store.RUnlock()
+	count := 0
+	for pid, peerData := range p.store.peers {
+		if peerData.connState != PeerConnecting && peerData.connState != PeerConnected {
+			continue
+		}
+		if _, ok := p.store.trustedPeers[pid]; ok || peerData.address == nil {
+			continue
+		}
+		ip, err := ipFromMultiaddr(peerData.address)
+		if err == nil && subnet.Contains(ip) {
+			count++
+		}
+	}
+	return count
+}
+
+// PruneCandidates returns at most n connected peers to disconnect from, in the order they should
+// be disconnected. Inbound peers are picked before outbound ones, and lower scored peers before
+// higher scored ones. With inboundOnly set, outbound peers are never picked. Trusted peers are
+// never picked.
+func (p *Status) PruneCandidates(n int, inboundOnly bool) []peer.ID {
+	if n <= 0 {
+		return []peer.ID{}
+	}
+	candidates := p.InboundConnected()
+	inbound := len(candidates)
+	if !inboundOnly {
+		candidates = append(candidates, p.OutboundConnected()...)
+	}
+	scores := make(map[peer.ID]float64, len(candidates))
+	for _, pid := range candidates {
+		scores[pid] = p.scorers.Score(pid)
+	}
+	// Inbound peers come first in the candidates, sort each direction by ascending score.
+	byScore := func(peers []peer.ID) {
+		sort.SliceStable(peers, func(i, j int) bool {
+			return scores[peers[i]] < scores[peers[j]]
+		})
+	}
+	byScore(candidates[:inbound])
+	byScore(candidates[inbound:])
+	if n > len(candidates) {
+		n = len(candidates)
+	}
+	return candidates[:n]
+}
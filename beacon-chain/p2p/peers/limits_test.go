@@ -0,0 +1,81 @@
+package peers_test
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStatus_ConnectedByDirection(t *testing.T) {
+	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit:    30,
+		ScorerParams: &peers.PeerScorerConfig{},
+	})
+	inbound := addPeerWithAddr(t, p, "/ip4/10.0.0.1/tcp/13000", network.DirInbound)
+	outbound := addPeerWithAddr(t, p, "/ip4/10.0.0.2/tcp/13000", network.DirOutbound)
+	trusted := addPeerWithAddr(t, p, "/ip4/10.0.0.3/tcp/13000", network.DirInbound)
+	p.AddTrusted(peer.AddrInfo{ID: trusted})
+	disconnected := addPeerWithAddr(t, p, "/ip4/10.0.0.4/tcp/13000", network.DirOutbound)
+	p.SetConnectionState(disconnected, peers.PeerDisconnected)
+
+	assert.DeepEqual(t, []peer.ID{inbound}, p.InboundConnected())
+	assert.DeepEqual(t, []peer.ID{outbound}, p.OutboundConnected())
+}
+
+func TestStatus_ActiveInSubnet(t *testing.T) {
+	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit:    30,
+		ScorerParams: &peers.PeerScorerConfig{},
+	})
+	addPeerWithAddr(t, p, "/ip4/10.0.0.1/tcp/13000", network.DirInbound)
+	addPeerWithAddr(t, p, "/ip4/10.0.0.1/tcp/13001", network.DirInbound)
+	addPeerWithAddr(t, p, "/ip4/10.0.0.2/tcp/13000", network.DirOutbound)
+	addPeerWithAddr(t, p, "/ip4/10.0.1.1/tcp/13000", network.DirOutbound)
+	trusted := addPeerWithAddr(t, p, "/ip4/10.0.0.3/tcp/13000", network.DirInbound)
+	p.AddTrusted(peer.AddrInfo{ID: trusted})
+	disconnected := addPeerWithAddr(t, p, "/ip4/10.0.0.4/tcp/13000", network.DirOutbound)
+	p.SetConnectionState(disconnected, peers.PeerDisconnected)
+
+	ipNet := &net.IPNet{IP: net.ParseIP("10.0.0.1"), Mask: net.CIDRMask(32, 32)}
+	assert.Equal(t, 2, p.ActiveInSubnet(ipNet))
+	subnet := &net.IPNet{IP: net.ParseIP("10.0.0.0"), Mask: net.CIDRMask(24, 32)}
+	assert.Equal(t, 3, p.ActiveInSubnet(subnet))
+}
+
+func TestStatus_PruneCandidates(t *testing.T) {
+	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit:    30,
+		ScorerParams: &peers.PeerScorerConfig{},
+	})
+	goodInbound := addPeerWithAddr(t, p, "/ip4/10.0.0.1/tcp/13000", network.DirInbound)
+	badInbound := addPeerWithAddr(t, p, "/ip4/10.0.0.2/tcp/13000", network.DirInbound)
+	badOutbound := addPeerWithAddr(t, p, "/ip4/10.0.0.3/tcp/13000", network.DirOutbound)
+	trusted := addPeerWithAddr(t, p, "/ip4/10.0.0.4/tcp/13000", network.DirInbound)
+	p.AddTrusted(peer.AddrInfo{ID: trusted})
+	p.Scorers().GossipScorer().SetGossipScores(map[peer.ID]float64{
+		goodInbound: 10,
+		badInbound:  -10,
+		badOutbound: -20,
+		trusted:     -30,
+	})
+
+	assert.Equal(t, 0, len(p.PruneCandidates(0, false)))
+	assert.DeepEqual(t, []peer.ID{badInbound, goodInbound}, p.PruneCandidates(2, false))
+	assert.DeepEqual(t, []peer.ID{badInbound, goodInbound, badOutbound}, p.PruneCandidates(10, false))
+	assert.DeepEqual(t, []peer.ID{badInbound, goodInbound}, p.PruneCandidates(10, true))
+}
+
+func addPeerWithAddr(t *testing.T, p *peers.Status, addr string, direction network.Direction) peer.ID {
+	pid := addPeer(t, p, peers.PeerConnected)
+	address, err := ma.NewMultiaddr(addr)
+	require.NoError(t, err)
+	p.Add(nil, pid, address, direction)
+	return pid
+}
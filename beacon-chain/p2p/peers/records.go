@@ -0,0 +1,80 @@
+package peers
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/gogo/protobuf/proto"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+// PeerRecord holds the data of a peer which outlives a connection, so that it can be persisted
+// across restarts: how to reach the peer, what it last told us and how it behaved.
+type PeerRecord struct {
+	ID                    peer.ID
+	Address               ma.Multiaddr
+	Direction             network.Direction
+	ENR                   *enr.Record
+	ChainState            *pb.Status
+	ChainStateLastUpdated time.Time
+	MetaData              *pb.MetaData
+	BadResponses          int
+	ProcessedBlocks       uint64
+	GossipScore           float64
+}
+
+// Records returns the records of all known peers.
+func (p *Status) Records() []*PeerRecord {
+	p.store.RLock()
+	defer p.store.RUnlock()
+
+	records := make([]*PeerRecord, 0, len(p.store.peers))
+	for pid, peerData := range p.store.peers {
+		record := &PeerRecord{
+			ID:                    pid,
+			Address:               peerData.address,
+			Direction:             peerData.direction,
+			ENR:                   peerData.enr,
+			ChainStateLastUpdated: peerData.chainStateLastUpdated,
+			BadResponses:          peerData.badResponses,
+			ProcessedBlocks:       peerData.processedBlocks,
+			GossipScore:           peerData.gossipScore,
+		}
+		if peerData.chainState != nil {
+			record.ChainState = proto.Clone(peerData.chainState).(*pb.Status)
+		}
+		if peerData.metaData != nil {
+			record.MetaData = proto.Clone(peerData.metaData).(*pb.MetaData)
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+// Restore adds the peers of the given records as disconnected peers. Records of peers which are
+// already known are skipped, as the data held for them is more recent.
+func (p *Status) Restore(records []*PeerRecord) {
+	p.store.Lock()
+	defer p.store.Unlock()
+
+	for _, record := range records {
+		if _, ok := p.store.peers[record.ID]; ok {
+			continue
+		}
+		p.store.peers[record.ID] = &peerData{
+			address:               record.Address,
+			direction:             record.Direction,
+			connState:             PeerDisconnected,
+			enr:                   record.ENR,
+			chainState:            record.ChainState,
+			chainStateLastUpdated: record.ChainStateLastUpdated,
+			metaData:              record.MetaData,
+			badResponses:          record.BadResponses,
+			processedBlocks:       record.ProcessedBlocks,
+			gossipScore:           record.GossipScore,
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package peers_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStatus_RecordsRestore(t *testing.T) {
+	ctx := context.Background()
+	config := &peers.StatusConfig{
+		PeerLimit: 30,
+		ScorerParams: &peers.PeerScorerConfig{
+			BadResponsesScorerConfig: &peers.BadResponsesScorerConfig{
+				Threshold: 2,
+			},
+		},
+	}
+	p := peers.NewStatus(ctx, config)
+	good := addPeer(t, p, peers.PeerConnected)
+	p.SetChainState(good, &pb.Status{HeadSlot: 64})
+	p.Scorers().BlockProviderScorer().IncrementProcessedBlocks(good, 32)
+	bad := addPeer(t, p, peers.PeerDisconnected)
+	p.Scorers().BadResponsesScorer().Increment(bad)
+	p.Scorers().BadResponsesScorer().Increment(bad)
+	require.Equal(t, true, p.IsBad(bad))
+
+	records := p.Records()
+	require.Equal(t, 2, len(records))
+
+	restored := peers.NewStatus(ctx, config)
+	restored.Restore(records)
+	assert.Equal(t, 2, len(restored.All()))
+	assert.Equal(t, 0, len(restored.Connected()), "Restored peers must be disconnected")
+	chainState, err := restored.ChainState(good)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(64), chainState.HeadSlot)
+	assert.Equal(t, uint64(32), restored.Scorers().BlockProviderScorer().ProcessedBlocks(good))
+	assert.Equal(t, true, restored.IsBad(bad), "Bad peer history was not restored")
+
+	// Records of known peers do not overwrite the current peer data.
+	p.SetChainState(good, &pb.Status{HeadSlot: 128})
+	p.Restore(records)
+	chainState, err = p.ChainState(good)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(128), chainState.HeadSlot)
+	state, err := p.ConnectionState(good)
+	require.NoError(t, err)
+	assert.Equal(t, peers.PeerConnected, state)
+
+	// Records are copies of the peer data.
+	for _, record := range p.Records() {
+		if record.ID == good {
+			record.ChainState.HeadSlot = 1
+		}
+	}
+	chainState, err = p.ChainState(good)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(128), chainState.HeadSlot)
+}
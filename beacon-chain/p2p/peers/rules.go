@@ -0,0 +1,206 @@
+package peers
+
+import (
+	"net"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+)
+
+// Ban is a ban of either a peer ID or an IP address, set by the node operator. A zero Until
+// time stands for a permanent ban.
+type Ban struct {
+	PeerID peer.ID
+	IP     net.IP
+	Until  time.Time
+}
+
+// expired checks whether a ban ending at the given time is no longer in effect.
+func expired(until time.Time, now time.Time) bool {
+	return !until.IsZero() && !now.Before(until)
+}
+
+// AddTrusted marks a peer as trusted. Trusted peers are always reconnected to, do not count
+// towards the peer limit and are never considered bad for their responses.
+func (p *Status) AddTrusted(info peer.AddrInfo) {
+	p.store.Lock()
+	defer p.store.Unlock()
+	p.store.trustedPeers[info.ID] = info.Addrs
+}
+
+// RemoveTrusted removes the trusted mark of a peer. It returns false if the peer was not trusted.
+func (p *Status) RemoveTrusted(pid peer.ID) bool {
+	p.store.Lock()
+	defer p.store.Unlock()
+	if _, ok := p.store.trustedPeers[pid]; !ok {
+		return false
+	}
+	delete(p.store.trustedPeers, pid)
+	return true
+}
+
+// IsTrusted checks whether a peer is trusted.
+func (p *Status) IsTrusted(pid peer.ID) bool {
+	p.store.RLock()
+	defer p.store.RUnlock()
+	_, ok := p.store.trustedPeers[pid]
+	return ok
+}
+
+// IsTrustedIP checks whether any of the trusted peers is known to be reachable at the given IP.
+func (p *Status) IsTrustedIP(ip net.IP) bool {
+	p.store.RLock()
+	defer p.store.RUnlock()
+	for _, addrs := range p.store.trustedPeers {
+		for _, addr := range addrs {
+			if addrIP, err := ipFromMultiaddr(addr); err == nil && addrIP.Equal(ip) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Trusted returns the address information of all trusted peers.
+func (p *Status) Trusted() []peer.AddrInfo {
+	p.store.RLock()
+	defer p.store.RUnlock()
+	infos := make([]peer.AddrInfo, 0, len(p.store.trustedPeers))
+	for pid, addrs := range p.store.trustedPeers {
+		infos = append(infos, peer.AddrInfo{ID: pid, Addrs: addrs})
+	}
+	return infos
+}
+
+// BanPeer bans a peer ID until the given time. A zero time bans the peer permanently.
+func (p *Status) BanPeer(pid peer.ID, until time.Time) {
+	p.store.Lock()
+	defer p.store.Unlock()
+	p.store.bannedPeers[pid] = until
+}
+
+// UnbanPeer lifts the ban of a peer ID. It returns false if the peer was not banned.
+func (p *Status) UnbanPeer(pid peer.ID) bool {
+	p.store.Lock()
+	defer p.store.Unlock()
+	if _, ok := p.store.bannedPeers[pid]; !ok {
+		return false
+	}
+	delete(p.store.bannedPeers, pid)
+	return true
+}
+
+// IsBanned checks whether a peer ID is currently banned.
+func (p *Status) IsBanned(pid peer.ID) bool {
+	p.store.RLock()
+	defer p.store.RUnlock()
+	return p.isBanned(pid)
+}
+
+// isBanned is a lock-free version of IsBanned.
+func (p *Status) isBanned(pid peer.ID) bool {
+	until, ok := p.store.bannedPeers[pid]
+	return ok && !expired(until, time.Now())
+}
+
+// BanIP bans an IP address until the given time. A zero time bans the address permanently.
+func (p *Status) BanIP(ip net.IP, until time.Time) {
+	p.store.Lock()
+	defer p.store.Unlock()
+	p.store.bannedIPs[ip.String()] = until
+}
+
+// UnbanIP lifts the ban of an IP address. It returns false if the address was not banned.
+func (p *Status) UnbanIP(ip net.IP) bool {
+	p.store.Lock()
+	defer p.store.Unlock()
+	if _, ok := p.store.bannedIPs[ip.String()]; !ok {
+		return false
+	}
+	delete(p.store.bannedIPs, ip.String())
+	return true
+}
+
+// IsIPBanned checks whether an IP address is currently banned.
+func (p *Status) IsIPBanned(ip net.IP) bool {
+	p.store.RLock()
+	defer p.store.RUnlock()
+	until, ok := p.store.bannedIPs[ip.String()]
+	return ok && !expired(until, time.Now())
+}
+
+// IsAddrBanned checks whether the IP address of a multiaddress is currently banned. Addresses
+// without an IP address are never banned.
+func (p *Status) IsAddrBanned(addr ma.Multiaddr) bool {
+	ip, err := ipFromMultiaddr(addr)
+	if err != nil {
+		return false
+	}
+	return p.IsIPBanned(ip)
+}
+
+// Bans returns all bans which are in effect.
+func (p *Status) Bans() []*Ban {
+	p.store.RLock()
+	defer p.store.RUnlock()
+	now := time.Now()
+	bans := make([]*Ban, 0, len(p.store.bannedPeers)+len(p.store.bannedIPs))
+	for pid, until := range p.store.bannedPeers {
+		if !expired(until, now) {
+			bans = append(bans, &Ban{PeerID: pid, Until: until})
+		}
+	}
+	for ip, until := range p.store.bannedIPs {
+		if !expired(until, now) {
+			bans = append(bans, &Ban{IP: net.ParseIP(ip), Until: until})
+		}
+	}
+	return bans
+}
+
+// RestoreRules restores the trusted peers and bans persisted before the last shutdown.
+func (p *Status) RestoreRules(trusted []peer.AddrInfo, bans []*Ban) {
+	p.store.Lock()
+	defer p.store.Unlock()
+	for _, info := range trusted {
+		p.store.trustedPeers[info.ID] = info.Addrs
+	}
+	now := time.Now()
+	for _, ban := range bans {
+		if expired(ban.Until, now) {
+			continue
+		}
+		if ban.IP != nil {
+			p.store.bannedIPs[ban.IP.String()] = ban.Until
+		} else {
+			p.store.bannedPeers[ban.PeerID] = ban.Until
+		}
+	}
+}
+
+// pruneBans removes the bans which are no longer in effect.
+func (p *Status) pruneBans() {
+	now := time.Now()
+	for pid, until := range p.store.bannedPeers {
+		if expired(until, now) {
+			delete(p.store.bannedPeers, pid)
+		}
+	}
+	for ip, until := range p.store.bannedIPs {
+		if expired(until, now) {
+			delete(p.store.bannedIPs, ip)
+		}
+	}
+}
+
+func ipFromMultiaddr(addr ma.Multiaddr) (net.IP, error) {
+	if ip, err := addr.ValueForProtocol(ma.P_IP4); err == nil {
+		return net.ParseIP(ip), nil
+	}
+	ip, err := addr.ValueForProtocol(ma.P_IP6)
+	if err != nil {
+		return nil, err
+	}
+	return net.ParseIP(ip), nil
+}
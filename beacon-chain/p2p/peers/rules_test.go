@@ -0,0 +1,91 @@
+package peers_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStatus_Trusted(t *testing.T) {
+	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit: 30,
+		ScorerParams: &peers.PeerScorerConfig{
+			BadResponsesScorerConfig: &peers.BadResponsesScorerConfig{
+				Threshold: 1,
+			},
+		},
+	})
+	pid := addPeer(t, p, peers.PeerConnected)
+	addr, err := ma.NewMultiaddr("/ip4/10.0.0.1/tcp/13000")
+	require.NoError(t, err)
+	p.Scorers().BadResponsesScorer().Increment(pid)
+	require.Equal(t, true, p.IsBad(pid))
+
+	p.AddTrusted(peer.AddrInfo{ID: pid, Addrs: []ma.Multiaddr{addr}})
+	assert.Equal(t, true, p.IsTrusted(pid))
+	assert.Equal(t, true, p.IsTrustedIP(net.ParseIP("10.0.0.1")))
+	assert.Equal(t, false, p.IsTrustedIP(net.ParseIP("10.0.0.2")))
+	assert.Equal(t, false, p.IsBad(pid), "Trusted peer is bad")
+	require.Equal(t, 1, len(p.Trusted()))
+	assert.Equal(t, pid, p.Trusted()[0].ID)
+
+	assert.Equal(t, true, p.RemoveTrusted(pid))
+	assert.Equal(t, false, p.RemoveTrusted(pid))
+	assert.Equal(t, false, p.IsTrusted(pid))
+	assert.Equal(t, true, p.IsBad(pid))
+}
+
+func TestStatus_Bans(t *testing.T) {
+	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit:    30,
+		ScorerParams: &peers.PeerScorerConfig{},
+	})
+	pid := addPeer(t, p, peers.PeerConnected)
+	ip := net.ParseIP("10.0.0.1")
+	addr, err := ma.NewMultiaddr("/ip4/10.0.0.1/tcp/13000")
+	require.NoError(t, err)
+
+	p.BanPeer(pid, time.Time{})
+	p.BanIP(ip, time.Now().Add(time.Hour))
+	assert.Equal(t, true, p.IsBanned(pid))
+	assert.Equal(t, true, p.IsBad(pid), "Banned peer is not bad")
+	assert.Equal(t, true, p.IsIPBanned(ip))
+	assert.Equal(t, true, p.IsAddrBanned(addr))
+	assert.Equal(t, 2, len(p.Bans()))
+
+	// A trusted peer which is banned is still bad.
+	p.AddTrusted(peer.AddrInfo{ID: pid, Addrs: []ma.Multiaddr{addr}})
+	assert.Equal(t, true, p.IsBad(pid))
+
+	// Bans are restored, except for the expired ones.
+	restored := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit:    30,
+		ScorerParams: &peers.PeerScorerConfig{},
+	})
+	expired := &peers.Ban{PeerID: "expired", Until: time.Now().Add(-time.Minute)}
+	restored.RestoreRules(p.Trusted(), append(p.Bans(), expired))
+	assert.Equal(t, true, restored.IsBanned(pid))
+	assert.Equal(t, true, restored.IsIPBanned(ip))
+	assert.Equal(t, true, restored.IsTrusted(pid))
+	assert.Equal(t, false, restored.IsBanned("expired"))
+	assert.Equal(t, 2, len(restored.Bans()))
+
+	assert.Equal(t, true, p.UnbanPeer(pid))
+	assert.Equal(t, false, p.UnbanPeer(pid))
+	assert.Equal(t, true, p.UnbanIP(ip))
+	assert.Equal(t, false, p.IsBanned(pid))
+	assert.Equal(t, false, p.IsIPBanned(ip))
+	assert.Equal(t, 0, len(p.Bans()))
+
+	// Bans expire.
+	p.BanPeer(pid, time.Now().Add(-time.Second))
+	assert.Equal(t, false, p.IsBanned(pid))
+	assert.Equal(t, 0, len(p.Bans()))
+}
@@ -0,0 +1,87 @@
+package peers
+
+import (
+	"context"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+)
+
+const (
+	// DefaultGossipScoreWeight is a default weight of the gossipsub score. Gossipsub scores range over
+	// thousands on the negative side, so they are scaled down to be comparable with other scorers.
+	DefaultGossipScoreWeight = 0.01
+)
+
+// GossipScorer represents gossipsub scoring service. It does not compute scores itself, but keeps
+// track of the scores that the gossipsub router periodically reports for each peer.
+type GossipScorer struct {
+	ctx    context.Context
+	config *GossipScorerConfig
+	store  *peerDataStore
+}
+
+// GossipScorerConfig holds configuration parameters for gossip scoring service.
+type GossipScorerConfig struct {
+	// Weight defines weight of the gossipsub score on overall score.
+	Weight float64
+}
+
+// newGossipScorer creates new gossip scoring service.
+func newGossipScorer(ctx context.Context, store *peerDataStore, config *GossipScorerConfig) *GossipScorer {
+	if config == nil {
+		config = &GossipScorerConfig{}
+	}
+	scorer := &GossipScorer{
+		ctx:    ctx,
+		config: config,
+		store:  store,
+	}
+	if scorer.config.Weight == 0.0 {
+		scorer.config.Weight = DefaultGossipScoreWeight
+	}
+	return scorer
+}
+
+// Score returns weighted gossipsub score of a given peer.
+func (s *GossipScorer) Score(pid peer.ID) float64 {
+	s.store.RLock()
+	defer s.store.RUnlock()
+	return s.score(pid)
+}
+
+// score is a lock-free version of Score.
+func (s *GossipScorer) score(pid peer.ID) float64 {
+	peerData, ok := s.store.peers[pid]
+	if !ok {
+		return 0
+	}
+	return peerData.gossipScore * s.config.Weight
+}
+
+// Params exposes scorer's parameters.
+func (s *GossipScorer) Params() *GossipScorerConfig {
+	return s.config
+}
+
+// GossipScore returns the last gossipsub score reported for a given peer.
+func (s *GossipScorer) GossipScore(pid peer.ID) (float64, error) {
+	s.store.RLock()
+	defer s.store.RUnlock()
+	if peerData, ok := s.store.peers[pid]; ok {
+		return peerData.gossipScore, nil
+	}
+	return 0, ErrPeerUnknown
+}
+
+// SetGossipScores updates peers with the scores reported by the gossipsub router. Unknown peers
+// are ignored, as the router retains scores of peers for a while after they disconnect.
+func (s *GossipScorer) SetGossipScores(scores map[peer.ID]float64) {
+	s.store.Lock()
+	defer s.store.Unlock()
+
+	for pid, score := range scores {
+		if peerData, ok := s.store.peers[pid]; ok {
+			peerData.gossipScore = score
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package peers_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestPeerScorer_Gossip_Score(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	peerStatuses := peers.NewStatus(ctx, &peers.StatusConfig{
+		PeerLimit: 30,
+		ScorerParams: &peers.PeerScorerConfig{
+			GossipScorerConfig: &peers.GossipScorerConfig{
+				Weight: 0.1,
+			},
+		},
+	})
+	scorer := peerStatuses.Scorers().GossipScorer()
+	peerStatuses.Add(nil, "peer1", nil, network.DirUnknown)
+
+	assert.Equal(t, 0.0, scorer.Score("peer1"), "Unexpected score for peer without gossip score")
+	scorer.SetGossipScores(map[peer.ID]float64{"peer1": -20, "peer2": 5})
+	assert.Equal(t, -2.0, scorer.Score("peer1"))
+	score, err := scorer.GossipScore("peer1")
+	require.NoError(t, err)
+	assert.Equal(t, -20.0, score)
+
+	// Scores of peers unknown to the store are dropped.
+	assert.Equal(t, 0.0, scorer.Score("peer2"))
+	_, err = scorer.GossipScore("peer2")
+	assert.ErrorContains(t, peers.ErrPeerUnknown.Error(), err)
+}
@@ -19,6 +19,7 @@ type PeerScorerManager struct {
 	scorers struct {
 		badResponsesScorer  *BadResponsesScorer
 		blockProviderScorer *BlockProviderScorer
+		gossipScorer        *GossipScorer
 	}
 }
 
@@ -26,6 +27,7 @@ type PeerScorerManager struct {
 type PeerScorerConfig struct {
 	BadResponsesScorerConfig  *BadResponsesScorerConfig
 	BlockProviderScorerConfig *BlockProviderScorerConfig
+	GossipScorerConfig        *GossipScorerConfig
 }
 
 // newPeerScorerManager provides fully initialized peer scoring service.
@@ -36,6 +38,7 @@ func newPeerScorerManager(ctx context.Context, store *peerDataStore, config *Pee
 	}
 	mgr.scorers.badResponsesScorer = newBadResponsesScorer(ctx, store, config.BadResponsesScorerConfig)
 	mgr.scorers.blockProviderScorer = newBlockProviderScorer(ctx, store, config.BlockProviderScorerConfig)
+	mgr.scorers.gossipScorer = newGossipScorer(ctx, store, config.GossipScorerConfig)
 	go mgr.loop(mgr.ctx)
 
 	return mgr
@@ -51,6 +54,11 @@ func (m *PeerScorerManager) BlockProviderScorer() *BlockProviderScorer {
 	return m.scorers.blockProviderScorer
 }
 
+// GossipScorer exposes gossipsub scoring service.
+func (m *PeerScorerManager) GossipScorer() *GossipScorer {
+	return m.scorers.gossipScorer
+}
+
 // Score returns calculated peer score across all tracked metrics.
 func (m *PeerScorerManager) Score(pid peer.ID) float64 {
 	m.store.RLock()
@@ -62,6 +70,7 @@ func (m *PeerScorerManager) Score(pid peer.ID) float64 {
 	}
 	score += m.scorers.badResponsesScorer.score(pid)
 	score += m.scorers.blockProviderScorer.score(pid)
+	score += m.scorers.gossipScorer.score(pid)
 	return math.Round(score*ScoreRoundingFactor) / ScoreRoundingFactor
 }
 
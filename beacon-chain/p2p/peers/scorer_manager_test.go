@@ -39,6 +39,11 @@ func TestPeerScorer_PeerScorerManager_Init(t *testing.T) {
 			assert.Equal(t, peers.DefaultBlockProviderDecay, params.Decay)
 			assert.Equal(t, peers.DefaultBlockProviderStalePeerRefreshInterval, params.StalePeerRefreshInterval)
 		})
+
+		t.Run("gossip scorer", func(t *testing.T) {
+			params := peerStatuses.Scorers().GossipScorer().Params()
+			assert.Equal(t, peers.DefaultGossipScoreWeight, params.Weight)
+		})
 	})
 
 	t.Run("explicit config", func(t *testing.T) {
@@ -205,6 +210,10 @@ func TestPeerScorer_PeerScorerManager_Score(t *testing.T) {
 		assert.Equal(t, -0.6, s2.Score("peer1"), "Unexpected bad responses score")
 		assert.Equal(t, roundScore(batchWeight*5), s1.Score("peer1"), "Unexpected block provider score")
 		assert.Equal(t, roundScore(batchWeight*5-0.6), s.Score("peer1"), "Unexpected overall score")
+		// Gossip penalties are added on top.
+		s.GossipScorer().SetGossipScores(map[peer.ID]float64{"peer1": -100})
+		assert.Equal(t, -1.0, s.GossipScorer().Score("peer1"), "Unexpected gossip score")
+		assert.Equal(t, roundScore(batchWeight*5-1.6), s.Score("peer1"), "Unexpected overall score")
 	})
 }
 
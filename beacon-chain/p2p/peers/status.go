@@ -284,8 +284,17 @@ func (p *Status) ChainStateLastUpdated(pid peer.ID) (time.Time, error) {
 
 // IsBad states if the peer is to be considered bad.
 // If the peer is unknown this will return `false`, which makes using this function easier than returning an error.
+// Banned peers are always bad, while trusted peers are never bad for their responses.
 func (p *Status) IsBad(pid peer.ID) bool {
-	return p.scorers.BadResponsesScorer().IsBadPeer(pid)
+	p.store.RLock()
+	defer p.store.RUnlock()
+	if p.isBanned(pid) {
+		return true
+	}
+	if _, ok := p.store.trustedPeers[pid]; ok {
+		return false
+	}
+	return p.scorers.BadResponsesScorer().isBadPeer(pid)
 }
 
 // Connecting returns the peers that are connecting.
@@ -387,6 +396,8 @@ func (p *Status) Prune() {
 	p.store.Lock()
 	defer p.store.Unlock()
 
+	p.pruneBans()
+
 	// Exit early if there is nothing to prune.
 	if len(p.store.peers) <= p.store.config.maxPeers {
 		return
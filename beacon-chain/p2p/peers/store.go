@@ -20,6 +20,10 @@ type peerDataStore struct {
 	ctx    context.Context
 	config *peerDataStoreConfig
 	peers  map[peer.ID]*peerData
+	// Access rules set by the node operator.
+	trustedPeers map[peer.ID][]ma.Multiaddr
+	bannedPeers  map[peer.ID]time.Time
+	bannedIPs    map[string]time.Time
 }
 
 // peerDataStoreConfig holds peer store parameters.
@@ -39,6 +43,7 @@ type peerData struct {
 	badResponses          int
 	processedBlocks       uint64
 	blockProviderUpdated  time.Time
+	gossipScore           float64
 }
 
 // newPeerDataStore creates peer store.
@@ -47,5 +52,9 @@ func newPeerDataStore(ctx context.Context, config *peerDataStoreConfig) *peerDat
 		ctx:    ctx,
 		config: config,
 		peers:  make(map[peer.ID]*peerData),
+
+		trustedPeers: make(map[peer.ID][]ma.Multiaddr),
+		bannedPeers:  make(map[peer.ID]time.Time),
+		bannedIPs:    make(map[string]time.Time),
 	}
 }
@@ -6,6 +6,7 @@ import (
 
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	pubsub_pb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 )
@@ -20,6 +21,17 @@ func (s *Service) JoinTopic(topic string, opts ...pubsub.TopicOpt) (*pubsub.Topi
 		if err != nil {
 			return nil, err
 		}
+		// The gossipsub router validates the topic score parameters and applies them under its
+		// own lock, so every topic is scored from the moment it is joined. Routers which were not
+		// created with peer scoring enabled have no parameters to set.
+		if p := s.scoreParamsForTopic(topic); p != nil && s.peerScoring {
+			if err := topicHandle.SetScoreParams(p); err != nil {
+				if closeErr := topicHandle.Close(); closeErr != nil {
+					log.WithError(closeErr).Debug("Could not close topic")
+				}
+				return nil, errors.Wrapf(err, "could not set score parameters of topic %s", topic)
+			}
+		}
 		s.joinedTopics[topic] = topicHandle
 	}
 
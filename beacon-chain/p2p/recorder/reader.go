@@ -0,0 +1,87 @@
+package recorder
+
+import (
+	"bufio"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// maxEntrySize bounds the size of a single encoded entry when reading a recording. Req/resp
+// exchanges of block ranges are the largest entries.
+const maxEntrySize = 1 << 30
+
+// Files returns the paths of the recording files in the directory, oldest first.
+func Files(dir string) ([]string, error) {
+	infos, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var files []string
+	for _, info := range infos {
+		name := info.Name()
+		if info.IsDir() || !strings.HasPrefix(name, filePrefix) || !strings.HasSuffix(name, fileSuffix) {
+			continue
+		}
+		files = append(files, path.Join(dir, name))
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+// ReadFile reads the entries of a recording file in recorded order. A truncated last line, as
+// left behind when the node is killed while writing, is skipped.
+func ReadFile(name string) ([]*Entry, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.WithError(err).Debug("Could not close recording file")
+		}
+	}()
+	var entries []*Entry
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, maxEntrySize)
+	line := 0
+	var pending error
+	for scanner.Scan() {
+		line++
+		if pending != nil {
+			return nil, pending
+		}
+		entry := &Entry{}
+		if err := json.Unmarshal(scanner.Bytes(), entry); err != nil {
+			pending = errors.Wrapf(err, "could not decode entry at %s:%d", name, line)
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrapf(err, "could not read %s", name)
+	}
+	return entries, nil
+}
+
+// ReadDir reads the entries of all recording files in the directory in recorded order.
+func ReadDir(dir string) ([]*Entry, error) {
+	files, err := Files(dir)
+	if err != nil {
+		return nil, err
+	}
+	var entries []*Entry
+	for _, name := range files {
+		fileEntries, err := ReadFile(name)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, fileEntries...)
+	}
+	return entries, nil
+}
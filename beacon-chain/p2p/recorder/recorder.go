@@ -0,0 +1,201 @@
+// Package recorder records the gossip messages and req/resp exchanges of the beacon node to
+// rotating files, so that the traffic seen by a node can be inspected and replayed offline.
+//
+// Every file holds one JSON encoded entry per line, and files are named after the time they
+// were created at, so that reading them in name order yields the entries in recorded order.
+package recorder
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "p2p")
+
+const (
+	filePrefix = "recording-"
+	fileSuffix = ".jsonl"
+)
+
+// Kind of a recorded entry.
+type Kind string
+
+const (
+	// KindGossip is a gossip message received on a pubsub topic.
+	KindGossip Kind = "gossip"
+	// KindRPC is a req/resp exchange with a peer.
+	KindRPC Kind = "rpc"
+)
+
+// Validation results of recorded gossip messages.
+const (
+	ResultAccept = "accept"
+	ResultReject = "reject"
+	ResultIgnore = "ignore"
+)
+
+// Entry is a single recorded gossip message or req/resp exchange. The data is recorded as it was
+// sent over the wire, that is snappy compressed SSZ for gossip messages, and the raw stream
+// contents including response codes for req/resp exchanges.
+type Entry struct {
+	Kind  Kind      `json:"kind"`
+	Time  time.Time `json:"time"`
+	Topic string    `json:"topic"`
+	Peer  peer.ID   `json:"peer,omitempty"`
+	// Outbound is set for req/resp exchanges initiated by this node.
+	Outbound bool `json:"outbound,omitempty"`
+	// Data is the gossip message, or the request of a req/resp exchange.
+	Data []byte `json:"data,omitempty"`
+	// Response is the response of a req/resp exchange.
+	Response []byte `json:"response,omitempty"`
+	// Result is the validation result of a gossip message.
+	Result string `json:"result,omitempty"`
+}
+
+// Config for the recorder.
+type Config struct {
+	// Dir is the directory the recording is written to.
+	Dir string
+	// MaxFileSize is the size in bytes after which the recording moves on to a new file.
+	MaxFileSize uint64
+	// MaxFiles is the number of files kept, the oldest files are deleted once it is exceeded.
+	// Zero keeps all files.
+	MaxFiles int
+}
+
+// Recorder writes recorded entries to rotating files. It is safe for concurrent use.
+type Recorder struct {
+	cfg      *Config
+	lock     sync.Mutex
+	file     *os.File
+	fileSize uint64
+	closed   bool
+}
+
+// New creates a recorder writing to the configured directory, creating it if needed.
+func New(cfg *Config) (*Recorder, error) {
+	if cfg.MaxFileSize == 0 {
+		return nil, errors.New("max file size of recording must be greater than zero")
+	}
+	if err := os.MkdirAll(cfg.Dir, params.BeaconIoConfig().ReadWriteExecutePermissions); err != nil {
+		return nil, errors.Wrap(err, "could not create recording directory")
+	}
+	return &Recorder{cfg: cfg}, nil
+}
+
+// Record writes the entry to the current recording file. Failures are logged rather than
+// returned, as recording must never interfere with the processing of the traffic.
+func (r *Recorder) Record(entry *Entry) {
+	enc, err := json.Marshal(entry)
+	if err != nil {
+		log.WithError(err).Error("Could not encode recorded entry")
+		return
+	}
+	enc = append(enc, '\n')
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.closed {
+		return
+	}
+	if r.file == nil || (r.fileSize > 0 && r.fileSize+uint64(len(enc)) > r.cfg.MaxFileSize) {
+		if err := r.rotate(); err != nil {
+			log.WithError(err).Error("Could not rotate recording file")
+			return
+		}
+	}
+	n, err := r.file.Write(enc)
+	r.fileSize += uint64(n)
+	if err != nil {
+		log.WithError(err).Error("Could not write recorded entry")
+	}
+}
+
+// Close closes the current recording file. Entries recorded after closing are dropped.
+func (r *Recorder) Close() error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.closed = true
+	if r.file == nil {
+		return nil
+	}
+	err := r.file.Close()
+	r.file = nil
+	return err
+}
+
+// rotate closes the current file, opens a new one and deletes the oldest files beyond the
+// configured number of files.
+func (r *Recorder) rotate() error {
+	if r.file != nil {
+		if err := r.file.Close(); err != nil {
+			return err
+		}
+		r.file = nil
+	}
+	name := path.Join(r.cfg.Dir, fmt.Sprintf("%s%020d%s", filePrefix, timeutils.Now().UnixNano(), fileSuffix))
+	f, err := os.OpenFile(name, os.O_CREATE|os.O_WRONLY|os.O_APPEND, params.BeaconIoConfig().ReadWritePermissions)
+	if err != nil {
+		return err
+	}
+	r.file = f
+	r.fileSize = 0
+	if r.cfg.MaxFiles <= 0 {
+		return nil
+	}
+	files, err := Files(r.cfg.Dir)
+	if err != nil {
+		return err
+	}
+	for len(files) > r.cfg.MaxFiles {
+		if err := os.Remove(files[0]); err != nil {
+			return err
+		}
+		files = files[1:]
+	}
+	return nil
+}
+
+// WrapValidator returns a pubsub validator which records every message of the topic along
+// with the result of the given validator.
+func (r *Recorder) WrapValidator(topic string, v pubsub.ValidatorEx) pubsub.ValidatorEx {
+	return func(ctx context.Context, pid peer.ID, msg *pubsub.Message) pubsub.ValidationResult {
+		received := timeutils.Now()
+		result := v(ctx, pid, msg)
+		r.Record(&Entry{
+			Kind:   KindGossip,
+			Time:   received,
+			Topic:  topic,
+			Peer:   pid,
+			Data:   msg.Data,
+			Result: ResultName(result),
+		})
+		return result
+	}
+}
+
+// ResultName returns the name a validation result is recorded under.
+func ResultName(result pubsub.ValidationResult) string {
+	switch result {
+	case pubsub.ValidationAccept:
+		return ResultAccept
+	case pubsub.ValidationReject:
+		return ResultReject
+	case pubsub.ValidationIgnore:
+		return ResultIgnore
+	default:
+		return fmt.Sprintf("unknown(%d)", result)
+	}
+}
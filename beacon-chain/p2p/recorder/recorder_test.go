@@ -0,0 +1,205 @@
+package recorder
+
+import (
+	"context"
+	"crypto/rand"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/libp2p/go-libp2p-core/helpers"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p-core/protocol"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
+	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestRecorder_RecordAndRead(t *testing.T) {
+	pid1, pid2 := testPeer(t), testPeer(t)
+	dir := path.Join(testutil.TempDir(), "recordertest")
+	defer func() {
+		require.NoError(t, os.RemoveAll(dir))
+	}()
+	r, err := New(&Config{Dir: dir, MaxFileSize: 1 << 20})
+	require.NoError(t, err)
+
+	entries := []*Entry{
+		{
+			Kind:   KindGossip,
+			Time:   time.Unix(1606824023, 0).UTC(),
+			Topic:  "/eth2/b5303f2a/beacon_block/ssz_snappy",
+			Peer:   pid1,
+			Data:   []byte{'a', 'b', 'c'},
+			Result: ResultAccept,
+		},
+		{
+			Kind:     KindRPC,
+			Time:     time.Unix(1606824024, 0).UTC(),
+			Topic:    "/eth2/beacon_chain/req/status/1/ssz_snappy",
+			Peer:     pid2,
+			Outbound: true,
+			Data:     []byte{1},
+			Response: []byte{0, 2},
+		},
+	}
+	for _, entry := range entries {
+		r.Record(entry)
+	}
+	require.NoError(t, r.Close())
+	// Entries recorded after closing are dropped.
+	r.Record(&Entry{Kind: KindGossip})
+
+	read, err := ReadDir(dir)
+	require.NoError(t, err)
+	assert.DeepEqual(t, entries, read)
+}
+
+func TestRecorder_Rotate(t *testing.T) {
+	dir := path.Join(testutil.TempDir(), "recordertest")
+	defer func() {
+		require.NoError(t, os.RemoveAll(dir))
+	}()
+	r, err := New(&Config{Dir: dir, MaxFileSize: 1, MaxFiles: 3})
+	require.NoError(t, err)
+	for i := 0; i < 5; i++ {
+		r.Record(&Entry{Kind: KindGossip, Data: []byte{byte(i)}})
+		// Keep file names apart on platforms with a coarse clock.
+		time.Sleep(time.Millisecond)
+	}
+	require.NoError(t, r.Close())
+
+	files, err := Files(dir)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(files))
+	read, err := ReadDir(dir)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(read))
+	for i, entry := range read {
+		assert.DeepEqual(t, []byte{byte(i + 2)}, entry.Data, "Oldest files not deleted first")
+	}
+}
+
+func TestReadFile_TruncatedLastLine(t *testing.T) {
+	dir := path.Join(testutil.TempDir(), "recordertest")
+	require.NoError(t, os.MkdirAll(dir, 0700))
+	defer func() {
+		require.NoError(t, os.RemoveAll(dir))
+	}()
+	name := path.Join(dir, filePrefix+"1"+fileSuffix)
+	contents := `{"kind":"gossip","topic":"a"}` + "\n" + `{"kind":"gossip","to`
+	require.NoError(t, ioutil.WriteFile(name, []byte(contents), 0600))
+	entries, err := ReadFile(name)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(entries))
+	assert.Equal(t, "a", entries[0].Topic)
+
+	contents = `{"kind":"gossip","to` + "\n" + `{"kind":"gossip","topic":"a"}` + "\n"
+	require.NoError(t, ioutil.WriteFile(name, []byte(contents), 0600))
+	_, err = ReadFile(name)
+	assert.ErrorContains(t, "could not decode entry", err)
+}
+
+func TestRecorder_WrapValidator(t *testing.T) {
+	pid1, pid2 := testPeer(t), testPeer(t)
+	dir := path.Join(testutil.TempDir(), "recordertest")
+	defer func() {
+		require.NoError(t, os.RemoveAll(dir))
+	}()
+	r, err := New(&Config{Dir: dir, MaxFileSize: 1 << 20})
+	require.NoError(t, err)
+
+	topic := "/eth2/b5303f2a/voluntary_exit/ssz_snappy"
+	validator := r.WrapValidator(topic, func(_ context.Context, _ peer.ID, msg *pubsub.Message) pubsub.ValidationResult {
+		if len(msg.Data) == 0 {
+			return pubsub.ValidationReject
+		}
+		return pubsub.ValidationAccept
+	})
+	msg := func(data []byte) *pubsub.Message {
+		return &pubsub.Message{Message: &pubsubpb.Message{Data: data, Topic: &topic}}
+	}
+	assert.Equal(t, pubsub.ValidationAccept, validator(context.Background(), pid1, msg([]byte{'a'})))
+	assert.Equal(t, pubsub.ValidationReject, validator(context.Background(), pid2, msg(nil)))
+	require.NoError(t, r.Close())
+
+	read, err := ReadDir(dir)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(read))
+	assert.Equal(t, KindGossip, read[0].Kind)
+	assert.Equal(t, topic, read[0].Topic)
+	assert.Equal(t, pid1, read[0].Peer)
+	assert.DeepEqual(t, []byte{'a'}, read[0].Data)
+	assert.Equal(t, ResultAccept, read[0].Result)
+	assert.Equal(t, pid2, read[1].Peer)
+	assert.Equal(t, ResultReject, read[1].Result)
+}
+
+func TestRecorder_WrapStreams(t *testing.T) {
+	dir := path.Join(testutil.TempDir(), "recordertest")
+	defer func() {
+		require.NoError(t, os.RemoveAll(dir))
+	}()
+	r, err := New(&Config{Dir: dir, MaxFileSize: 1 << 20})
+	require.NoError(t, err)
+
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+	topic := "/testing/echo/1/ssz_snappy"
+	handled := make(chan struct{})
+	p2.BHost.SetStreamHandler(protocol.ID(topic), r.WrapStreamHandler(topic, func(stream network.Stream) {
+		defer close(handled)
+		req, err := ioutil.ReadAll(stream)
+		require.NoError(t, err)
+		_, err = stream.Write(append(req, req...))
+		require.NoError(t, err)
+		require.NoError(t, helpers.FullClose(stream))
+	}))
+
+	stream, err := p1.BHost.NewStream(context.Background(), p2.BHost.ID(), protocol.ID(topic))
+	require.NoError(t, err)
+	stream = r.WrapStream(topic, stream)
+	_, err = stream.Write([]byte("ping"))
+	require.NoError(t, err)
+	require.NoError(t, stream.Close())
+	resp, err := ioutil.ReadAll(stream)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte("pingping"), resp)
+	<-handled
+	require.NoError(t, r.Close())
+
+	read, err := ReadDir(dir)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(read))
+	byDirection := make(map[bool]*Entry)
+	for _, entry := range read {
+		byDirection[entry.Outbound] = entry
+	}
+	outbound, inbound := byDirection[true], byDirection[false]
+	require.NotNil(t, outbound)
+	require.NotNil(t, inbound)
+	assert.Equal(t, KindRPC, outbound.Kind)
+	assert.Equal(t, topic, outbound.Topic)
+	assert.Equal(t, p2.BHost.ID(), outbound.Peer)
+	assert.DeepEqual(t, []byte("ping"), outbound.Data)
+	assert.DeepEqual(t, []byte("pingping"), outbound.Response)
+	assert.Equal(t, p1.BHost.ID(), inbound.Peer)
+	assert.DeepEqual(t, []byte("ping"), inbound.Data)
+	assert.DeepEqual(t, []byte("pingping"), inbound.Response)
+}
+
+func testPeer(t *testing.T) peer.ID {
+	_, pub, err := crypto.GenerateSecp256k1Key(rand.Reader)
+	require.NoError(t, err)
+	pid, err := peer.IDFromPublicKey(pub)
+	require.NoError(t, err)
+	return pid
+}
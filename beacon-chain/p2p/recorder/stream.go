@@ -0,0 +1,114 @@
+package recorder
+
+import (
+	"bytes"
+	"io"
+	"sync"
+
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+)
+
+// recordingStream copies everything read from and written to a stream, and records the exchange
+// once the stream is done with. That is when it is reset, when it has been closed for writing and
+// read to the end, or when the handler of an inbound stream returns.
+type recordingStream struct {
+	network.Stream
+	recorder *Recorder
+	entry    Entry
+	lock     sync.Mutex
+	sent     bytes.Buffer
+	received bytes.Buffer
+	closed   bool
+	eof      bool
+	once     sync.Once
+}
+
+// WrapStreamHandler returns a stream handler which records the exchanges of the given handler on
+// inbound streams of the topic.
+func (r *Recorder) WrapStreamHandler(topic string, handler network.StreamHandler) network.StreamHandler {
+	return func(stream network.Stream) {
+		rs := r.newRecordingStream(topic, stream, false)
+		defer rs.finish()
+		handler(rs)
+	}
+}
+
+// WrapStream returns a stream which records the exchange on an outbound stream of the topic.
+func (r *Recorder) WrapStream(topic string, stream network.Stream) network.Stream {
+	return r.newRecordingStream(topic, stream, true)
+}
+
+func (r *Recorder) newRecordingStream(topic string, stream network.Stream, outbound bool) *recordingStream {
+	return &recordingStream{
+		Stream:   stream,
+		recorder: r,
+		entry: Entry{
+			Kind:     KindRPC,
+			Time:     timeutils.Now(),
+			Topic:    topic,
+			Peer:     stream.Conn().RemotePeer(),
+			Outbound: outbound,
+		},
+	}
+}
+
+// Read reads from the stream, copying the data read.
+func (s *recordingStream) Read(b []byte) (int, error) {
+	n, err := s.Stream.Read(b)
+	s.lock.Lock()
+	s.received.Write(b[:n])
+	if err == io.EOF {
+		s.eof = true
+	}
+	done := s.closed && s.eof
+	s.lock.Unlock()
+	if done {
+		s.finish()
+	}
+	return n, err
+}
+
+// Write writes to the stream, copying the data written.
+func (s *recordingStream) Write(b []byte) (int, error) {
+	n, err := s.Stream.Write(b)
+	s.lock.Lock()
+	s.sent.Write(b[:n])
+	s.lock.Unlock()
+	return n, err
+}
+
+// Close closes the stream for writing.
+func (s *recordingStream) Close() error {
+	err := s.Stream.Close()
+	s.lock.Lock()
+	s.closed = true
+	done := s.eof
+	s.lock.Unlock()
+	if done {
+		s.finish()
+	}
+	return err
+}
+
+// Reset closes both ends of the stream.
+func (s *recordingStream) Reset() error {
+	err := s.Stream.Reset()
+	s.finish()
+	return err
+}
+
+// finish records the exchange, only the first call has any effect.
+func (s *recordingStream) finish() {
+	s.once.Do(func() {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+		entry := s.entry
+		if entry.Outbound {
+			entry.Data, entry.Response = s.sent.Bytes(), s.received.Bytes()
+		} else {
+			entry.Data, entry.Response = s.received.Bytes(), s.sent.Bytes()
+		}
+		s.recorder.Record(&entry)
+	})
+}
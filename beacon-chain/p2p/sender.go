@@ -30,6 +30,9 @@ func (s *Service) Send(ctx context.Context, message interface{}, baseTopic strin
 		traceutil.AnnotateError(span, err)
 		return nil, err
 	}
+	if s.recorder != nil {
+		stream = s.recorder.WrapStream(topic, stream)
+	}
 	// do not encode anything if we are sending a metadata request
 	if baseTopic == RPCMetaDataTopic {
 		return stream, nil
@@ -1,13 +1,18 @@
 package p2p
 
 import (
+	"bytes"
 	"context"
+	"os"
+	"path"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/gogo/protobuf/proto"
+	"github.com/libp2p/go-libp2p-core/helpers"
 	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/recorder"
 	testp2p "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
 	testpb "github.com/prysmaticlabs/prysm/proto/testing"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
@@ -58,3 +63,54 @@ func TestService_Send(t *testing.T) {
 		t.Errorf("Expected identical message to be received. got %v want %v", rcvd, msg)
 	}
 }
+
+func TestService_Send_Recorded(t *testing.T) {
+	dir := path.Join(testutil.TempDir(), "sendrecordtest")
+	defer func() {
+		require.NoError(t, os.RemoveAll(dir))
+	}()
+	rec, err := recorder.New(&recorder.Config{Dir: dir, MaxFileSize: 1 << 20})
+	require.NoError(t, err)
+	p1 := testp2p.NewTestP2P(t)
+	p2 := testp2p.NewTestP2P(t)
+	p1.Connect(p2)
+	svc := &Service{
+		host:     p1.BHost,
+		cfg:      &Config{},
+		recorder: rec,
+	}
+
+	topic := "/testing/1"
+	RPCTopicMappings[topic] = new(testpb.TestSimpleMessage)
+	defer func() {
+		delete(RPCTopicMappings, topic)
+	}()
+	p2.SetStreamHandler(topic+"/ssz_snappy", func(stream network.Stream) {
+		rcvd := &testpb.TestSimpleMessage{}
+		require.NoError(t, svc.Encoding().DecodeWithMaxLength(stream, rcvd))
+		_, err := svc.Encoding().EncodeWithMaxLength(stream, rcvd)
+		require.NoError(t, err)
+		assert.NoError(t, stream.Close())
+	})
+
+	msg := &testpb.TestSimpleMessage{Foo: []byte("hello"), Bar: 55}
+	stream, err := svc.Send(context.Background(), msg, topic, p2.BHost.ID())
+	require.NoError(t, err)
+	rcvd := &testpb.TestSimpleMessage{}
+	require.NoError(t, svc.Encoding().DecodeWithMaxLength(stream, rcvd))
+	require.NoError(t, helpers.FullClose(stream))
+	require.NoError(t, rec.Close())
+
+	entries, err := recorder.ReadDir(dir)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(entries))
+	assert.Equal(t, recorder.KindRPC, entries[0].Kind)
+	assert.Equal(t, topic+"/ssz_snappy", entries[0].Topic)
+	assert.Equal(t, p2.BHost.ID(), entries[0].Peer)
+	assert.Equal(t, true, entries[0].Outbound)
+	request := new(bytes.Buffer)
+	_, err = svc.Encoding().EncodeWithMaxLength(request, msg)
+	require.NoError(t, err)
+	assert.DeepEqual(t, request.Bytes(), entries[0].Data)
+	assert.DeepEqual(t, request.Bytes(), entries[0].Response)
+}
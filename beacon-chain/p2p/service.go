@@ -28,7 +28,9 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peerdb"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/recorder"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared"
 	"github.com/prysmaticlabs/prysm/shared/params"
@@ -67,17 +69,27 @@ type Service struct {
 	cancel                context.CancelFunc
 	cfg                   *Config
 	peers                 *peers.Status
+	peerDB                *peerdb.Store
+	knownPeers            []*peers.PeerRecord
+	staticPeers           map[peer.ID]bool
 	addrFilter            *filter.Filters
 	ipLimiter             *leakybucket.Collector
 	privKey               *ecdsa.PrivateKey
 	exclusionList         *ristretto.Cache
 	metaData              *pb.MetaData
 	pubsub                *pubsub.PubSub
+	peerScoring           bool
 	joinedTopics          map[string]*pubsub.Topic
 	joinedTopicsLock      sync.Mutex
 	subnetsLock           map[uint64]*sync.RWMutex
 	subnetsLockLock       sync.Mutex // Lock access to subnetsLock
+	longLivedSubnets      []longLivedSubnet
+	longLivedSubnetsLock  sync.Mutex
+	subnetSearches        map[uint64]bool
+	subnetSearchesLock    sync.Mutex
 	dv5Listener           Listener
+	dnsNodeLists          []string
+	recorder              *recorder.Recorder
 	startupErr            error
 	stateNotifier         statefeed.Notifier
 	ctx                   context.Context
@@ -102,19 +114,22 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 	}
 
 	s := &Service{
-		ctx:           ctx,
-		stateNotifier: cfg.StateNotifier,
-		cancel:        cancel,
-		cfg:           cfg,
-		exclusionList: cache,
-		isPreGenesis:  true,
-		joinedTopics:  make(map[string]*pubsub.Topic, len(GossipTopicMappings)),
-		subnetsLock:   make(map[uint64]*sync.RWMutex),
+		ctx:            ctx,
+		stateNotifier:  cfg.StateNotifier,
+		cancel:         cancel,
+		cfg:            cfg,
+		exclusionList:  cache,
+		isPreGenesis:   true,
+		joinedTopics:   make(map[string]*pubsub.Topic, len(GossipTopicMappings)),
+		subnetsLock:    make(map[uint64]*sync.RWMutex),
+		subnetSearches: make(map[uint64]bool),
+		staticPeers:    make(map[peer.ID]bool),
 	}
 
 	dv5Nodes := parseBootStrapAddrs(s.cfg.BootstrapNodeAddr)
 
 	cfg.Discv5BootStrapAddr = dv5Nodes
+	s.dnsNodeLists = parseDNSNodeLists(s.cfg.BootstrapNodeAddr)
 
 	ipAddr := ipAddr()
 	s.privKey, err = privKey(s.cfg)
@@ -133,24 +148,60 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 		return nil, err
 	}
 	s.ipLimiter = leakybucket.NewCollector(ipLimit, ipBurst, true /* deleteEmptyBuckets */)
+	if s.cfg.RecordDir != "" {
+		s.recorder, err = recorder.New(&recorder.Config{
+			Dir:         s.cfg.RecordDir,
+			MaxFileSize: s.cfg.RecordMaxFileSize,
+			MaxFiles:    int(s.cfg.RecordMaxFiles),
+		})
+		if err != nil {
+			log.WithError(err).Error("Failed to create traffic recorder")
+			return nil, err
+		}
+		log.WithField("dir", s.cfg.RecordDir).Info("Recording gossip and req/resp traffic")
+	}
 
-	opts := s.buildOptions(ipAddr, s.privKey)
-	h, err := libp2p.New(s.ctx, opts...)
-	if err != nil {
-		log.WithError(err).Error("Failed to create p2p host")
-		return nil, err
+	if s.cfg.Host != nil {
+		s.host = s.cfg.Host
+	} else {
+		opts := s.buildOptions(ipAddr, s.privKey)
+		h, err := libp2p.New(s.ctx, opts...)
+		if err != nil {
+			log.WithError(err).Error("Failed to create p2p host")
+			return nil, err
+		}
+		s.host = h
 	}
 
-	s.host = h
+	s.peers = peers.NewStatus(ctx, &peers.StatusConfig{
+		PeerLimit: int(s.cfg.MaxPeers),
+		ScorerParams: &peers.PeerScorerConfig{
+			BadResponsesScorerConfig: &peers.BadResponsesScorerConfig{
+				Threshold:     maxBadResponses,
+				Weight:        -100,
+				DecayInterval: time.Hour,
+			},
+		},
+	})
+	if err := s.openPeerDB(); err != nil {
+		log.WithError(err).Error("Failed to open peer database")
+		return nil, err
+	}
 
 	// Gossipsub registration is done before we add in any new peers
 	// due to libp2p's gossipsub implementation not taking into
 	// account previously added peers when creating the gossipsub
 	// object.
+	scoreParams, scoreThresholds := peerScoringParams()
 	psOpts := []pubsub.Option{
 		pubsub.WithMessageSignaturePolicy(pubsub.LaxNoSign),
 		pubsub.WithNoAuthor(),
 		pubsub.WithMessageIdFn(msgIDFunction),
+		pubsub.WithPeerScore(scoreParams, scoreThresholds),
+		pubsub.WithPeerScoreInspect(s.peers.Scorers().GossipScorer().SetGossipScores, oneSlotDuration()),
+	}
+	if s.cfg.PubSubTracer != nil {
+		psOpts = append(psOpts, pubsub.WithEventTracer(s.cfg.PubSubTracer))
 	}
 	// Set the pubsub global parameters that we require.
 	setPubSubParameters()
@@ -161,17 +212,7 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 		return nil, err
 	}
 	s.pubsub = gs
-
-	s.peers = peers.NewStatus(ctx, &peers.StatusConfig{
-		PeerLimit: int(s.cfg.MaxPeers),
-		ScorerParams: &peers.PeerScorerConfig{
-			BadResponsesScorerConfig: &peers.BadResponsesScorerConfig{
-				Threshold:     maxBadResponses,
-				Weight:        -100,
-				DecayInterval: time.Hour,
-			},
-		},
-	})
+	s.peerScoring = true
 
 	return s, nil
 }
@@ -214,6 +255,8 @@ func (s *Service) Start() {
 			return
 		}
 		s.dv5Listener = listener
+	}
+	if !s.cfg.NoDiscovery && (s.dv5Listener != nil || len(s.dnsNodeLists) > 0) {
 		go s.listenForNewNodes()
 	}
 
@@ -224,18 +267,26 @@ func (s *Service) Start() {
 		if err != nil {
 			log.Errorf("Could not connect to static peer: %v", err)
 		}
+		s.trustStaticPeers(addrs)
 		s.connectWithAllPeers(addrs)
 	}
+	s.connectToKnownPeers()
+	go s.connectToTrustedPeers()
 
 	// Periodic functions.
 	runutil.RunEvery(s.ctx, params.BeaconNetworkConfig().TtfbTimeout, func() {
 		ensurePeerConnections(s.ctx, s.host, peersToWatch...)
 	})
+	runutil.RunEvery(s.ctx, trustedPeersInterval, s.connectToTrustedPeers)
+	runutil.RunEvery(s.ctx, peerPruneInterval, s.pruneConnectedPeers)
 	runutil.RunEvery(s.ctx, 30*time.Minute, s.Peers().Prune)
+	runutil.RunEvery(s.ctx, knownPeersSaveInterval, s.savePeers)
 	runutil.RunEvery(s.ctx, params.BeaconNetworkConfig().RespTimeout, s.updateMetrics)
 	runutil.RunEvery(s.ctx, refreshRate, func() {
+		s.rotateLongLivedSubnets()
 		s.RefreshENR()
 	})
+	runutil.RunEvery(s.ctx, time.Duration(params.BeaconConfig().SecondsPerSlot)*time.Second, s.monitorSubnetPeers)
 
 	multiAddrs := s.host.Network().ListenAddresses()
 	logIPAddr(s.host.ID(), multiAddrs...)
@@ -261,6 +312,17 @@ func (s *Service) Stop() error {
 	if s.dv5Listener != nil {
 		s.dv5Listener.Close()
 	}
+	if s.peerDB != nil {
+		s.savePeers()
+		if err := s.peerDB.Close(); err != nil {
+			return err
+		}
+	}
+	if s.recorder != nil {
+		if err := s.recorder.Close(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -301,11 +363,21 @@ func (s *Service) Host() host.Host {
 }
 
 // SetStreamHandler sets the protocol handler on the p2p host multiplexer.
-// This method is a pass through to libp2pcore.Host.SetStreamHandler.
+// This method is a pass through to libp2pcore.Host.SetStreamHandler. When recording is enabled,
+// the exchanges handled are recorded.
 func (s *Service) SetStreamHandler(topic string, handler network.StreamHandler) {
+	if s.recorder != nil {
+		handler = s.recorder.WrapStreamHandler(topic, handler)
+	}
 	s.host.SetStreamHandler(protocol.ID(topic), handler)
 }
 
+// Recorder returns the recorder of the gossip and req/resp traffic, which is nil unless
+// recording is enabled.
+func (s *Service) Recorder() *recorder.Recorder {
+	return s.recorder
+}
+
 // PeerID returns the Peer ID of the local peer.
 func (s *Service) PeerID() peer.ID {
 	return s.host.ID()
@@ -11,8 +11,11 @@ import (
 	"github.com/ethereum/go-ethereum/p2p/discover"
 	"github.com/ethereum/go-ethereum/p2p/enode"
 	"github.com/libp2p/go-libp2p"
+	bh "github.com/libp2p/go-libp2p-blankhost"
 	"github.com/libp2p/go-libp2p-core/host"
 	"github.com/libp2p/go-libp2p-core/peer"
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
+	swarmt "github.com/libp2p/go-libp2p-swarm/testing"
 	"github.com/multiformats/go-multiaddr"
 	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
@@ -269,3 +272,34 @@ func TestService_JoinLeaveTopic(t *testing.T) {
 	sub.Cancel()
 	assert.NoError(t, s.LeaveTopic(topic))
 }
+
+type eventTracer struct {
+	events []*pubsubpb.TraceEvent
+}
+
+func (t *eventTracer) Trace(evt *pubsubpb.TraceEvent) {
+	t.events = append(t.events, evt)
+}
+
+func TestService_ConfiguredHost(t *testing.T) {
+	ctx := context.Background()
+	h := bh.NewBlankHost(swarmt.GenSwarm(t, ctx))
+	defer func() {
+		if err := h.Close(); err != nil {
+			t.Log(err)
+		}
+	}()
+	tracer := &eventTracer{}
+	s, err := NewService(ctx, &Config{DataDir: testDataDir(t), Host: h, PubSubTracer: tracer})
+	require.NoError(t, err)
+	assert.Equal(t, h, s.Host(), "Service did not use the configured host")
+
+	// Subscribing joins the topic on the gossipsub router before it returns.
+	topic := fmt.Sprintf(AttestationSubnetTopicFormat, 42, 42)
+	sub, err := s.SubscribeToTopic(topic)
+	require.NoError(t, err)
+	defer sub.Cancel()
+	require.Equal(t, 1, len(tracer.events))
+	assert.Equal(t, pubsubpb.TraceEvent_JOIN, tracer.events[0].GetType())
+	assert.Equal(t, topic, tracer.events[0].GetJoin().GetTopic())
+}
@@ -0,0 +1,67 @@
+package p2p
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/shared/sliceutil"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
+)
+
+// Checks the number of peers on every attestation subnet the node needs, being its long-lived
+// subnets and the subnets of its validators, and searches the network for more peers on the
+// subnets which fall below the configured minimum before they run out of peers entirely.
+func (s *Service) monitorSubnetPeers() {
+	if s.cfg.MinPeersPerSubnet == 0 || s.pubsub == nil {
+		return
+	}
+	digest, err := s.forkDigest()
+	if err != nil {
+		log.WithError(err).Debug("Could not compute fork digest")
+		return
+	}
+	slot := slotutil.SlotsSinceGenesis(s.genesisTime)
+
+	subnetPeerCount.Reset()
+	for _, idx := range neededSubnets(slot) {
+		topic := fmt.Sprintf(AttestationSubnetTopicFormat, digest, idx) + s.Encoding().ProtocolSuffix()
+		count := uint64(len(s.pubsub.ListPeers(topic)))
+		subnetPeerCount.WithLabelValues(strconv.FormatUint(idx, 10)).Set(float64(count))
+		if count < s.cfg.MinPeersPerSubnet {
+			s.searchSubnet(idx, count)
+		}
+	}
+}
+
+// neededSubnets returns the attestation subnets the node needs peers on at the given slot.
+func neededSubnets(slot uint64) []uint64 {
+	subnets := cache.SubnetIDs.GetLongLivedSubnets()
+	subnets = append(subnets, cache.SubnetIDs.GetAllSubnets()...)
+	subnets = append(subnets, cache.SubnetIDs.GetAttesterSubnetIDs(slot)...)
+	subnets = append(subnets, cache.SubnetIDs.GetAggregatorSubnetIDs(slot)...)
+	return sliceutil.SetUint64(subnets)
+}
+
+// Searches the network for peers on a subnet in the background, unless a search for the subnet
+// is already running.
+func (s *Service) searchSubnet(idx uint64, count uint64) {
+	s.subnetSearchesLock.Lock()
+	defer s.subnetSearchesLock.Unlock()
+	if s.subnetSearches[idx] {
+		return
+	}
+	s.subnetSearches[idx] = true
+
+	log.WithField("subnet", idx).WithField("peers", count).Debug("Searching for peers on attestation subnet")
+	go func() {
+		defer func() {
+			s.subnetSearchesLock.Lock()
+			delete(s.subnetSearches, idx)
+			s.subnetSearchesLock.Unlock()
+		}()
+		if _, err := s.FindPeersWithSubnet(s.ctx, idx); err != nil {
+			log.WithError(err).Debug("Could not search for peers")
+		}
+	}()
+}
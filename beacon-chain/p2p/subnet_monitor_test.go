@@ -0,0 +1,36 @@
+package p2p
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/shared/sliceutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+)
+
+func TestNeededSubnets(t *testing.T) {
+	defer cache.SubnetIDs.SetLongLivedSubnets(nil)
+	cache.SubnetIDs.SetLongLivedSubnets([]uint64{1, 2})
+	cache.SubnetIDs.AddAttesterSubnetID(1000, 2)
+	cache.SubnetIDs.AddAttesterSubnetID(1000, 7)
+	cache.SubnetIDs.AddAggregatorSubnetID(1000, 9)
+
+	subnets := neededSubnets(1000)
+	for _, idx := range []uint64{1, 2, 7, 9} {
+		assert.Equal(t, true, sliceutil.IsInUint64(idx, subnets), "Subnet %d is missing", idx)
+	}
+	assert.Equal(t, len(sliceutil.SetUint64(subnets)), len(subnets), "Subnets are not unique")
+}
+
+func TestSearchSubnet_SkipsRunningSearch(t *testing.T) {
+	s := &Service{
+		ctx:            context.Background(),
+		subnetSearches: map[uint64]bool{3: true},
+	}
+
+	// A search for the subnet is already running, so none is started.
+	s.searchSubnet(3, 0)
+	assert.Equal(t, true, s.subnetSearches[3])
+	assert.Equal(t, 1, len(s.subnetSearches))
+}
@@ -0,0 +1,69 @@
+package testing
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+)
+
+// MockPeerController is mock of the PeerController interface, which applies the trusted peers
+// and bans to the given peer status and records the disconnected peers.
+type MockPeerController struct {
+	PeerStatus   *peers.Status
+	Disconnected map[peer.ID]uint64
+}
+
+// AddTrustedPeer .
+func (m *MockPeerController) AddTrustedPeer(info peer.AddrInfo) error {
+	m.PeerStatus.AddTrusted(info)
+	return nil
+}
+
+// RemoveTrustedPeer .
+func (m *MockPeerController) RemoveTrustedPeer(pid peer.ID) error {
+	m.PeerStatus.RemoveTrusted(pid)
+	return nil
+}
+
+// BanPeer .
+func (m *MockPeerController) BanPeer(_ context.Context, pid peer.ID, duration time.Duration) error {
+	m.PeerStatus.BanPeer(pid, banExpiry(duration))
+	return nil
+}
+
+// UnbanPeer .
+func (m *MockPeerController) UnbanPeer(pid peer.ID) error {
+	m.PeerStatus.UnbanPeer(pid)
+	return nil
+}
+
+// BanIP .
+func (m *MockPeerController) BanIP(_ context.Context, ip net.IP, duration time.Duration) error {
+	m.PeerStatus.BanIP(ip, banExpiry(duration))
+	return nil
+}
+
+// UnbanIP .
+func (m *MockPeerController) UnbanIP(ip net.IP) error {
+	m.PeerStatus.UnbanIP(ip)
+	return nil
+}
+
+// DisconnectPeer .
+func (m *MockPeerController) DisconnectPeer(_ context.Context, pid peer.ID, reason uint64) error {
+	if m.Disconnected == nil {
+		m.Disconnected = make(map[peer.ID]uint64)
+	}
+	m.Disconnected[pid] = reason
+	return nil
+}
+
+func banExpiry(duration time.Duration) time.Time {
+	if duration == 0 {
+		return time.Time{}
+	}
+	return time.Now().Add(duration)
+}
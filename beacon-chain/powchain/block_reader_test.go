@@ -33,7 +33,7 @@ func TestLatestMainchainInfo_OK(t *testing.T) {
 
 	beaconDB, _ := dbutil.SetupDB(t)
 	web3Service, err := NewService(context.Background(), &Web3ServiceConfig{
-		HTTPEndPoint:    endpoint,
+		HTTPEndpoints:   []string{endpoint},
 		DepositContract: testAcc.ContractAddr,
 		BeaconDB:        beaconDB,
 	})
@@ -77,8 +77,8 @@ func TestLatestMainchainInfo_OK(t *testing.T) {
 func TestBlockHashByHeight_ReturnsHash(t *testing.T) {
 	beaconDB, _ := dbutil.SetupDB(t)
 	web3Service, err := NewService(context.Background(), &Web3ServiceConfig{
-		HTTPEndPoint: endpoint,
-		BeaconDB:     beaconDB,
+		HTTPEndpoints: []string{endpoint},
+		BeaconDB:      beaconDB,
 	})
 	require.NoError(t, err, "unable to setup web3 ETH1.0 chain service")
 
@@ -104,8 +104,8 @@ func TestBlockHashByHeight_ReturnsHash(t *testing.T) {
 func TestBlockHashByHeight_ReturnsError_WhenNoEth1Client(t *testing.T) {
 	beaconDB, _ := dbutil.SetupDB(t)
 	web3Service, err := NewService(context.Background(), &Web3ServiceConfig{
-		HTTPEndPoint: endpoint,
-		BeaconDB:     beaconDB,
+		HTTPEndpoints: []string{endpoint},
+		BeaconDB:      beaconDB,
 	})
 	require.NoError(t, err, "unable to setup web3 ETH1.0 chain service")
 
@@ -120,8 +120,8 @@ func TestBlockHashByHeight_ReturnsError_WhenNoEth1Client(t *testing.T) {
 func TestBlockExists_ValidHash(t *testing.T) {
 	beaconDB, _ := dbutil.SetupDB(t)
 	web3Service, err := NewService(context.Background(), &Web3ServiceConfig{
-		HTTPEndPoint: endpoint,
-		BeaconDB:     beaconDB,
+		HTTPEndpoints: []string{endpoint},
+		BeaconDB:      beaconDB,
 	})
 	require.NoError(t, err, "unable to setup web3 ETH1.0 chain service")
 
@@ -151,8 +151,8 @@ func TestBlockExists_ValidHash(t *testing.T) {
 func TestBlockExists_InvalidHash(t *testing.T) {
 	beaconDB, _ := dbutil.SetupDB(t)
 	web3Service, err := NewService(context.Background(), &Web3ServiceConfig{
-		HTTPEndPoint: endpoint,
-		BeaconDB:     beaconDB,
+		HTTPEndpoints: []string{endpoint},
+		BeaconDB:      beaconDB,
 	})
 	require.NoError(t, err, "unable to setup web3 ETH1.0 chain service")
 
@@ -165,8 +165,8 @@ func TestBlockExists_InvalidHash(t *testing.T) {
 func TestBlockExists_UsesCachedBlockInfo(t *testing.T) {
 	beaconDB, _ := dbutil.SetupDB(t)
 	web3Service, err := NewService(context.Background(), &Web3ServiceConfig{
-		HTTPEndPoint: endpoint,
-		BeaconDB:     beaconDB,
+		HTTPEndpoints: []string{endpoint},
+		BeaconDB:      beaconDB,
 	})
 	require.NoError(t, err, "unable to setup web3 ETH1.0 chain service")
 	// nil eth1DataFetcher would panic if cached value not used
@@ -188,8 +188,8 @@ func TestBlockExists_UsesCachedBlockInfo(t *testing.T) {
 func TestBlockNumberByTimestamp(t *testing.T) {
 	beaconDB, _ := dbutil.SetupDB(t)
 	web3Service, err := NewService(context.Background(), &Web3ServiceConfig{
-		HTTPEndPoint: endpoint,
-		BeaconDB:     beaconDB,
+		HTTPEndpoints: []string{endpoint},
+		BeaconDB:      beaconDB,
 	})
 	require.NoError(t, err)
 	web3Service = setDefaultMocks(web3Service)
@@ -205,8 +205,8 @@ func TestBlockNumberByTimestamp(t *testing.T) {
 func TestService_BlockTimeByHeight_ReturnsError_WhenNoEth1Client(t *testing.T) {
 	beaconDB, _ := dbutil.SetupDB(t)
 	web3Service, err := NewService(context.Background(), &Web3ServiceConfig{
-		HTTPEndPoint: endpoint,
-		BeaconDB:     beaconDB,
+		HTTPEndpoints: []string{endpoint},
+		BeaconDB:      beaconDB,
 	})
 	require.NoError(t, err, "unable to setup web3 ETH1.0 chain service")
 
@@ -0,0 +1,322 @@
+package powchain
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	contracts "github.com/prysmaticlabs/prysm/contracts/deposit-contract"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+	"github.com/sirupsen/logrus"
+)
+
+// time to wait between health checks of the eth1 endpoints while connected.
+var endpointCheckPeriod = time.Minute
+
+// time to wait for an eth1 endpoint to answer a health check.
+var endpointCheckTimeout = 10 * time.Second
+
+// Number of blocks an eth1 endpoint may trail the best endpoint by before it is rejected.
+var maxEndpointHeadLag = uint64(10)
+
+// EndpointStatus describes the health of an eth1 endpoint as of its last check.
+type EndpointStatus struct {
+	Endpoint    string
+	Active      bool
+	Healthy     bool
+	HeadBlock   uint64
+	Reason      string // Why the endpoint was rejected, if it is not healthy.
+	LastChecked time.Time
+}
+
+// EndpointStatusFetcher retrieves the health of the eth1 endpoints the node can connect to.
+type EndpointStatusFetcher interface {
+	EndpointStatuses() []*EndpointStatus
+}
+
+// healthChecker defines the calls made to an eth1 node to decide whether it can be used.
+type healthChecker interface {
+	ChainID(ctx context.Context) (*big.Int, error)
+	NetworkID(ctx context.Context) (*big.Int, error)
+	SyncProgress(ctx context.Context) (*ethereum.SyncProgress, error)
+	HeaderByNumber(ctx context.Context, number *big.Int) (*gethTypes.Header, error)
+}
+
+// eth1Endpoint is one of the eth1 endpoints of the node, along with its clients and the
+// result of its last health check.
+type eth1Endpoint struct {
+	// checkLock is held for the whole of a health check, so that the checks made by the endpoint
+	// monitor and by a reconnection after an error do not dial the endpoint twice.
+	checkLock   sync.Mutex
+	url         string
+	httpClient  *ethclient.Client
+	rpcClient   *gethRPC.Client
+	healthy     bool
+	head        uint64
+	reason      string
+	lastChecked time.Time
+}
+
+// EndpointStatuses returns the status of every eth1 endpoint, in order of priority. The
+// endpoint URLs are redacted, as they may hold credentials or API keys.
+func (s *Service) EndpointStatuses() []*EndpointStatus {
+	s.endpointsLock.RLock()
+	defer s.endpointsLock.RUnlock()
+	statuses := make([]*EndpointStatus, len(s.endpoints))
+	for i, e := range s.endpoints {
+		statuses[i] = &EndpointStatus{
+			Endpoint:    redactURL(e.url),
+			Active:      i == s.activeEndpoint,
+			Healthy:     e.healthy,
+			HeadBlock:   e.head,
+			Reason:      e.reason,
+			LastChecked: e.lastChecked,
+		}
+	}
+	return statuses
+}
+
+// endpointDecision is the outcome of a round of health checks of the eth1 endpoints: the index
+// of the endpoint to use, or the reason no endpoint can be used.
+type endpointDecision struct {
+	idx int
+	err error
+}
+
+// checkEndpoints health checks every eth1 endpoint, and connects to the first healthy one in
+// order of priority if the node is not already using it. This fails over to the next endpoint
+// when the active one becomes unhealthy, and fails back once a preferred endpoint recovers.
+func (s *Service) checkEndpoints() error {
+	return s.useEndpoint(s.healthCheckEndpoints())
+}
+
+// monitorEndpoints health checks the eth1 endpoints every endpointCheckPeriod, and hands the
+// outcome of every round to the run loop. It runs on its own, so that slow endpoints do not hold
+// up the processing of eth1 blocks.
+func (s *Service) monitorEndpoints(done <-chan struct{}, decisions chan<- endpointDecision) {
+	ticker := time.NewTicker(endpointCheckPeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			decision := s.healthCheckEndpoints()
+			select {
+			case decisions <- decision:
+			case <-done:
+				return
+			}
+		}
+	}
+}
+
+// healthCheckEndpoints checks every eth1 endpoint in parallel, so a round of checks takes at
+// most endpointCheckTimeout, and decides on the first healthy endpoint in order of priority.
+func (s *Service) healthCheckEndpoints() endpointDecision {
+	var wg sync.WaitGroup
+	for i := range s.endpoints {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			s.checkEndpoint(i)
+		}(i)
+	}
+	wg.Wait()
+
+	s.endpointsLock.Lock()
+	defer s.endpointsLock.Unlock()
+	rejectLaggingEndpoints(s.endpoints)
+	for i, e := range s.endpoints {
+		healthy := 0.0
+		if e.healthy {
+			healthy = 1
+		}
+		endpointHealthGauge.WithLabelValues(strconv.Itoa(i)).Set(healthy)
+	}
+
+	idx := firstHealthyEndpoint(s.endpoints)
+	if idx < 0 {
+		reasons := make([]string, len(s.endpoints))
+		for i, e := range s.endpoints {
+			reasons[i] = fmt.Sprintf("%s: %s", redactURL(e.url), e.reason)
+		}
+		return endpointDecision{idx: -1, err: fmt.Errorf("no healthy eth1 endpoint: %s", strings.Join(reasons, "; "))}
+	}
+	return endpointDecision{idx: idx}
+}
+
+// useEndpoint connects to the endpoint decided on by a round of health checks, if the node is not
+// already using it. The clients of the unhealthy endpoints the node used before are closed once it
+// has switched away from them.
+func (s *Service) useEndpoint(decision endpointDecision) error {
+	s.endpointsLock.Lock()
+	defer s.endpointsLock.Unlock()
+	if decision.err != nil {
+		s.activeEndpoint = -1
+		activeEndpointGauge.Set(-1)
+		return decision.err
+	}
+	idx := decision.idx
+	if idx == s.activeEndpoint {
+		return nil
+	}
+
+	e := s.endpoints[idx]
+	if e.httpClient == nil {
+		return fmt.Errorf("eth1 endpoint %s is no longer healthy: %s", redactURL(e.url), e.reason)
+	}
+	depositContractCaller, err := contracts.NewDepositContractCaller(s.depositContractAddress, e.httpClient)
+	if err != nil {
+		return errors.Wrap(err, "could not create deposit contract caller")
+	}
+	s.initializeConnection(e.httpClient, e.rpcClient, depositContractCaller)
+	for i, other := range s.endpoints {
+		if i != idx && !other.healthy && other.rpcClient != nil {
+			other.rpcClient.Close()
+			other.httpClient, other.rpcClient = nil, nil
+		}
+	}
+
+	previous := s.activeEndpoint
+	s.activeEndpoint = idx
+	activeEndpointGauge.Set(float64(idx))
+	if previous < 0 {
+		log.WithFields(logrus.Fields{
+			"endpoint": redactURL(e.url),
+		}).Info("Connected to eth1 proof-of-work chain")
+		return nil
+	}
+	endpointSwitchCount.Inc()
+	log.WithFields(logrus.Fields{
+		"previousEndpoint": redactURL(s.endpoints[previous].url),
+		"endpoint":         redactURL(e.url),
+	}).Warn("Switched eth1 endpoint")
+	return nil
+}
+
+// Health checks the eth1 endpoint at the given index, dialing it first if needed. The clients of
+// an endpoint failing its check are closed, so that it is dialed anew on its next check, unless
+// the node is still using them. Those are closed by useEndpoint once the node switches away.
+func (s *Service) checkEndpoint(idx int) {
+	s.endpointsLock.RLock()
+	e := s.endpoints[idx]
+	s.endpointsLock.RUnlock()
+	e.checkLock.Lock()
+	defer e.checkLock.Unlock()
+
+	s.endpointsLock.RLock()
+	url, httpClient, rpcClient := e.url, e.httpClient, e.rpcClient
+	s.endpointsLock.RUnlock()
+
+	ctx, cancel := context.WithTimeout(s.ctx, endpointCheckTimeout)
+	defer cancel()
+	var head uint64
+	var err error
+	if rpcClient == nil {
+		rpcClient, err = gethRPC.DialContext(ctx, url)
+		if err == nil {
+			httpClient = ethclient.NewClient(rpcClient)
+		}
+	}
+	if err == nil {
+		head, err = checkHealth(ctx, httpClient)
+	}
+
+	s.endpointsLock.Lock()
+	defer s.endpointsLock.Unlock()
+	e.lastChecked = timeutils.Now()
+	if err != nil {
+		if rpcClient != nil && s.rpcClient != rpcClient {
+			rpcClient.Close()
+			e.httpClient, e.rpcClient = nil, nil
+		}
+		e.healthy, e.head, e.reason = false, 0, err.Error()
+		log.WithError(err).WithField("endpoint", redactURL(url)).Debug("Eth1 endpoint failed health check")
+		return
+	}
+	e.httpClient, e.rpcClient = httpClient, rpcClient
+	e.healthy, e.head, e.reason = true, head, ""
+}
+
+// redactURL strips the credentials, path and query from an eth1 endpoint URL, which may hold
+// API keys. Paths to IPC endpoints are kept as they are.
+func redactURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "[invalid url]"
+	}
+	if u.Host == "" {
+		return rawURL
+	}
+	return u.Scheme + "://" + u.Host
+}
+
+// checkHealth checks that an eth1 node follows the chain and network of the beacon node and is
+// done syncing, and returns the number of its head block.
+func checkHealth(ctx context.Context, client healthChecker) (uint64, error) {
+	cID, err := client.ChainID(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not get chain id")
+	}
+	if cID.Uint64() != params.BeaconNetworkConfig().ChainID {
+		return 0, fmt.Errorf("eth1 node using incorrect chain id, %d != %d", cID.Uint64(), params.BeaconNetworkConfig().ChainID)
+	}
+	nID, err := client.NetworkID(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not get network id")
+	}
+	if nID.Uint64() != params.BeaconNetworkConfig().NetworkID {
+		return 0, fmt.Errorf("eth1 node using incorrect network id, %d != %d", nID.Uint64(), params.BeaconNetworkConfig().NetworkID)
+	}
+	syncProg, err := client.SyncProgress(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not get sync status")
+	}
+	if syncProg != nil {
+		return 0, fmt.Errorf("eth1 node is syncing, at block %d of %d", syncProg.CurrentBlock, syncProg.HighestBlock)
+	}
+	header, err := client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not get latest header")
+	}
+	return header.Number.Uint64(), nil
+}
+
+// rejectLaggingEndpoints marks the healthy endpoints whose head trails the best head among the
+// healthy endpoints by more than maxEndpointHeadLag blocks as unhealthy.
+func rejectLaggingEndpoints(endpoints []*eth1Endpoint) {
+	best := uint64(0)
+	for _, e := range endpoints {
+		if e.healthy && e.head > best {
+			best = e.head
+		}
+	}
+	for _, e := range endpoints {
+		if e.healthy && best-e.head > maxEndpointHeadLag {
+			e.healthy = false
+			e.reason = fmt.Sprintf("eth1 node head is %d blocks behind the best endpoint", best-e.head)
+		}
+	}
+}
+
+// firstHealthyEndpoint returns the index of the first healthy endpoint, or -1 if there is none.
+func firstHealthyEndpoint(endpoints []*eth1Endpoint) int {
+	for i, e := range endpoints {
+		if e.healthy {
+			return i
+		}
+	}
+	return -1
+}
@@ -87,6 +87,7 @@ func (s *Service) ProcessLog(ctx context.Context, depositLog gethTypes.Log) erro
 				BeaconState:       s.preGenesisState.InnerStateUnsafe(), // I promise not to mutate it!
 				Trie:              s.depositTrie.ToProto(),
 				DepositContainers: s.depositCache.AllDepositContainers(ctx),
+				DepositSnapshot:   s.depositSnapshot,
 			}
 			return s.beaconDB.SavePowchainData(ctx, eth1Data)
 		}
@@ -5,7 +5,6 @@ package powchain
 
 import (
 	"context"
-	"fmt"
 	"math/big"
 	"reflect"
 	"runtime/debug"
@@ -52,6 +51,18 @@ var (
 		Name: "powchain_missed_deposit_logs",
 		Help: "The number of times a missed deposit log is detected",
 	})
+	activeEndpointGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "powchain_active_endpoint",
+		Help: "The index of the eth1 endpoint in use, or -1 if no endpoint is healthy",
+	})
+	endpointHealthGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "powchain_endpoint_healthy",
+		Help: "Whether the eth1 endpoint at the given index passed its last health check",
+	}, []string{"endpoint"})
+	endpointSwitchCount = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "powchain_endpoint_switches",
+		Help: "The number of times the node switched from one eth1 endpoint to another",
+	})
 )
 
 // time to wait before trying to reconnect with the eth1 node.
@@ -127,7 +138,9 @@ type Service struct {
 	cancel                  context.CancelFunc
 	headerChan              chan *gethTypes.Header
 	headTicker              *time.Ticker
-	httpEndpoint            string
+	endpoints               []*eth1Endpoint // In order of priority.
+	activeEndpoint          int
+	endpointsLock           sync.RWMutex
 	stateNotifier           statefeed.Notifier
 	httpLogger              bind.ContractFilterer
 	eth1DataFetcher         RPCDataFetcher
@@ -137,6 +150,8 @@ type Service struct {
 	depositContractCaller   *contracts.DepositContractCaller
 	depositRoot             []byte
 	depositTrie             *trieutil.SparseMerkleTrie
+	depositSnapshot         *protodb.DepositSnapshot // The snapshot the deposit trie was started from, if any.
+	depositSnapshotVerified bool                     // Whether the snapshot was checked against the deposit contract.
 	chainStartData          *protodb.ChainStartData
 	beaconDB                db.HeadAccessDatabase // Circular dep if using HeadFetcher.
 	depositCache            *depositcache.DepositCache
@@ -147,11 +162,12 @@ type Service struct {
 
 // Web3ServiceConfig defines a config struct for web3 service to use through its life cycle.
 type Web3ServiceConfig struct {
-	HTTPEndPoint    string
+	HTTPEndpoints   []string
 	DepositContract common.Address
 	BeaconDB        db.HeadAccessDatabase
 	DepositCache    *depositcache.DepositCache
 	StateNotifier   statefeed.Notifier
+	DepositSnapshot *protodb.DepositSnapshot
 }
 
 // NewService sets up a new instance with an ethclient when
@@ -170,10 +186,10 @@ func NewService(ctx context.Context, config *Web3ServiceConfig) (*Service, error
 	}
 
 	s := &Service{
-		ctx:          ctx,
-		cancel:       cancel,
-		headerChan:   make(chan *gethTypes.Header),
-		httpEndpoint: config.HTTPEndPoint,
+		ctx:            ctx,
+		cancel:         cancel,
+		headerChan:     make(chan *gethTypes.Header),
+		activeEndpoint: -1,
 		latestEth1Data: &protodb.LatestETH1Data{
 			BlockHeight:        0,
 			BlockTime:          0,
@@ -194,12 +210,29 @@ func NewService(ctx context.Context, config *Web3ServiceConfig) (*Service, error
 		preGenesisState:         genState,
 		headTicker:              time.NewTicker(time.Duration(params.BeaconConfig().SecondsPerETH1Block) * time.Second),
 	}
+	for _, endpoint := range config.HTTPEndpoints {
+		s.endpoints = append(s.endpoints, &eth1Endpoint{url: endpoint})
+	}
 
 	eth1Data, err := config.BeaconDB.PowchainData(ctx)
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to retrieve eth1 data")
 	}
+	if eth1Data == nil && config.DepositSnapshot != nil {
+		if err := s.importDepositSnapshot(ctx, config.DepositSnapshot); err != nil {
+			return nil, errors.Wrap(err, "could not import deposit snapshot")
+		}
+		return s, nil
+	}
+	if eth1Data != nil && config.DepositSnapshot != nil {
+		log.Warn("Eth1 data already exists in DB, ignoring deposit snapshot")
+	}
 	if eth1Data != nil {
+		if eth1Data.DepositSnapshot != nil {
+			if _, err := s.applyDepositSnapshot(ctx, eth1Data.DepositSnapshot); err != nil {
+				return nil, errors.Wrap(err, "could not apply deposit snapshot")
+			}
+		}
 		s.depositTrie = trieutil.CreateTrieFromProto(eth1Data.Trie)
 		s.chainStartData = eth1Data.ChainstartData
 		if !reflect.ValueOf(eth1Data.BeaconState).IsZero() {
@@ -221,20 +254,25 @@ func NewService(ctx context.Context, config *Web3ServiceConfig) (*Service, error
 func (s *Service) Start() {
 	// If the chain has not started already and we don't have access to eth1 nodes, we will not be
 	// able to generate the genesis state.
-	if !s.chainStartData.Chainstarted && s.httpEndpoint == "" {
+	if !s.chainStartData.Chainstarted && len(s.endpoints) == 0 {
 		// check for genesis state before shutting down the node,
 		// if a genesis state exists, we can continue on.
 		genState, err := s.beaconDB.GenesisState(s.ctx)
 		if err != nil {
 			log.Fatal(err)
 		}
-		if genState == nil {
+		// A node started from a checkpoint state does not need a genesis state either.
+		originRoot, err := s.beaconDB.OriginBlockRoot(s.ctx)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if genState == nil && originRoot == params.BeaconConfig().ZeroHash {
 			log.Fatal("cannot create genesis state: no eth1 http endpoint defined")
 		}
 	}
 
 	// Exit early if eth1 endpoint is not set.
-	if s.httpEndpoint == "" {
+	if len(s.endpoints) == 0 {
 		return
 	}
 	go func() {
@@ -360,51 +398,6 @@ func (s *Service) followBlockHeight(ctx context.Context) (uint64, error) {
 	return latestValidBlock, nil
 }
 
-func (s *Service) connectToPowChain() error {
-	httpClient, rpcClient, err := s.dialETH1Nodes()
-	if err != nil {
-		return errors.Wrap(err, "could not dial eth1 nodes")
-	}
-
-	depositContractCaller, err := contracts.NewDepositContractCaller(s.depositContractAddress, httpClient)
-	if err != nil {
-		return errors.Wrap(err, "could not create deposit contract caller")
-	}
-
-	if httpClient == nil || rpcClient == nil || depositContractCaller == nil {
-		return errors.New("eth1 client is nil")
-	}
-
-	s.initializeConnection(httpClient, rpcClient, depositContractCaller)
-	return nil
-}
-
-func (s *Service) dialETH1Nodes() (*ethclient.Client, *gethRPC.Client, error) {
-	httpRPCClient, err := gethRPC.Dial(s.httpEndpoint)
-	if err != nil {
-		return nil, nil, err
-	}
-	httpClient := ethclient.NewClient(httpRPCClient)
-
-	// Make a simple call to ensure we are actually connected to a working node.
-	cID, err := httpClient.ChainID(s.ctx)
-	if err != nil {
-		return nil, nil, err
-	}
-	nID, err := httpClient.NetworkID(s.ctx)
-	if err != nil {
-		return nil, nil, err
-	}
-	if cID.Uint64() != params.BeaconNetworkConfig().ChainID {
-		return nil, nil, fmt.Errorf("eth1 node using incorrect chain id, %d != %d", cID.Uint64(), params.BeaconNetworkConfig().ChainID)
-	}
-	if nID.Uint64() != params.BeaconNetworkConfig().NetworkID {
-		return nil, nil, fmt.Errorf("eth1 node using incorrect network id, %d != %d", nID.Uint64(), params.BeaconNetworkConfig().NetworkID)
-	}
-
-	return httpClient, httpRPCClient, nil
-}
-
 func (s *Service) initializeConnection(
 	httpClient *ethclient.Client,
 	rpcClient *gethRPC.Client,
@@ -417,24 +410,12 @@ func (s *Service) initializeConnection(
 }
 
 func (s *Service) waitForConnection() {
-	errConnect := s.connectToPowChain()
-	if errConnect == nil {
-		synced, errSynced := s.isEth1NodeSynced()
-		// Resume if eth1 node is synced.
-		if synced {
-			s.connectedETH1 = true
-			log.WithFields(logrus.Fields{
-				"endpoint": s.httpEndpoint,
-			}).Info("Connected to eth1 proof-of-work chain")
-			return
-		}
-		if errSynced != nil {
-			log.WithError(errSynced).Error("Could not check sync status of eth1 chain")
-		}
-	}
-	if errConnect != nil {
-		log.WithError(errConnect).Error("Could not connect to powchain endpoint")
+	err := s.checkEndpoints()
+	if err == nil {
+		s.connectedETH1 = true
+		return
 	}
+	log.WithError(err).Error("Could not connect to powchain endpoint")
 	// Use a custom logger to only log errors
 	// once in  a while.
 	logCounter := 0
@@ -450,24 +431,12 @@ func (s *Service) waitForConnection() {
 	for {
 		select {
 		case <-ticker.C:
-			errConnect := s.connectToPowChain()
-			if errConnect != nil {
-				errorLogger(errConnect, "Could not connect to powchain endpoint")
+			if err := s.checkEndpoints(); err != nil {
+				errorLogger(err, "Could not connect to powchain endpoint")
 				continue
 			}
-			synced, errSynced := s.isEth1NodeSynced()
-			if errSynced != nil {
-				errorLogger(errSynced, "Could not check sync status of eth1 chain")
-				continue
-			}
-			if synced {
-				s.connectedETH1 = true
-				log.WithFields(logrus.Fields{
-					"endpoint": s.httpEndpoint,
-				}).Info("Connected to eth1 proof-of-work chain")
-				return
-			}
-			log.Debug("Eth1 node is currently syncing")
+			s.connectedETH1 = true
+			return
 		case <-s.ctx.Done():
 			log.Debug("Received cancelled context,closing existing powchain service")
 			return
@@ -475,16 +444,6 @@ func (s *Service) waitForConnection() {
 	}
 }
 
-// checks if the eth1 node is healthy and ready to serve before
-// fetching data from  it.
-func (s *Service) isEth1NodeSynced() (bool, error) {
-	syncProg, err := s.eth1DataFetcher.SyncProgress(s.ctx)
-	if err != nil {
-		return false, err
-	}
-	return syncProg == nil, nil
-}
-
 // Reconnect to eth1 node in case of any failure.
 func (s *Service) retryETH1Node(err error) {
 	s.runError = err
@@ -526,10 +485,10 @@ func (s *Service) initDepositCaches(ctx context.Context, ctrs []*protodb.Deposit
 	currIndex := currentState.Eth1DepositIndex()
 	validDepositsCount.Add(float64(currIndex + 1))
 
-	// Only add pending deposits if the container slice length
-	// is more than the current index in state.
-	if uint64(len(ctrs)) > currIndex {
-		for _, c := range ctrs[currIndex:] {
+	// Only add the deposits which are not yet included in state as pending deposits. The
+	// containers do not start at index 0 when the deposit trie was started from a snapshot.
+	for _, c := range ctrs {
+		if c.Index >= int64(currIndex) {
 			s.depositCache.InsertPendingDeposit(ctx, c.Deposit, c.Eth1BlockHeight, c.Index, bytesutil.ToBytes32(c.DepositRoot))
 		}
 	}
@@ -659,6 +618,18 @@ func (s *Service) initPOWService() {
 				continue
 			}
 
+			if s.depositSnapshot != nil && !s.depositSnapshotVerified {
+				if err := s.verifyDepositSnapshot(ctx); err != nil {
+					if errors.Is(err, errSnapshotMismatch) {
+						log.WithError(err).Fatal("Refusing to use deposit snapshot")
+					}
+					log.Errorf("Unable to verify deposit snapshot %v", err)
+					s.retryETH1Node(err)
+					continue
+				}
+				s.depositSnapshotVerified = true
+			}
+
 			header, err := s.eth1DataFetcher.HeaderByNumber(ctx, nil)
 			if err != nil {
 				log.Errorf("Unable to retrieve latest ETH1.0 chain header: %v", err)
@@ -687,6 +658,8 @@ func (s *Service) run(done <-chan struct{}) {
 
 	s.initPOWService()
 
+	decisions := make(chan endpointDecision, 1)
+	go s.monitorEndpoints(done, decisions)
 	for {
 		select {
 		case <-done:
@@ -704,6 +677,11 @@ func (s *Service) run(done <-chan struct{}) {
 			}
 			s.processBlockHeader(head)
 			s.handleETH1FollowDistance()
+		case decision := <-decisions:
+			if err := s.useEndpoint(decision); err != nil {
+				log.WithError(err).Debug("Could not find a healthy eth1 endpoint")
+				s.retryETH1Node(err)
+			}
 		}
 	}
 }
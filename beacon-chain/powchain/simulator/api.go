@@ -0,0 +1,213 @@
+package simulator
+
+import (
+	"context"
+	"math/big"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+)
+
+// ethAPI serves the methods of the eth JSON-RPC namespace used by the powchain service, by
+// ethclient backed contract bindings and by deposit tools.
+type ethAPI struct {
+	s *Simulator
+}
+
+// netAPI serves the net JSON-RPC namespace.
+type netAPI struct {
+	s *Simulator
+}
+
+// callArgs are the arguments of a contract call or gas estimation, as sent by ethclient.
+type callArgs struct {
+	From     *common.Address `json:"from"`
+	To       *common.Address `json:"to"`
+	Gas      *hexutil.Uint64 `json:"gas"`
+	GasPrice *hexutil.Big    `json:"gasPrice"`
+	Value    *hexutil.Big    `json:"value"`
+	Data     *hexutil.Bytes  `json:"data"`
+}
+
+// filterQuery is a log filter query, as sent by ethclient.
+type filterQuery struct {
+	BlockHash *common.Hash         `json:"blockHash"`
+	FromBlock *gethRPC.BlockNumber `json:"fromBlock"`
+	ToBlock   *gethRPC.BlockNumber `json:"toBlock"`
+	Addresses []common.Address     `json:"address"`
+	Topics    [][]common.Hash      `json:"topics"`
+}
+
+// Version returns the network id of the simulated chain.
+func (api *netAPI) Version() string {
+	return strconv.FormatUint(api.s.ChainID(), 10)
+}
+
+// ChainId returns the chain id of the simulated chain.
+func (api *ethAPI) ChainId() *hexutil.Big {
+	return (*hexutil.Big)(new(big.Int).SetUint64(api.s.ChainID()))
+}
+
+// BlockNumber returns the number of the head block.
+func (api *ethAPI) BlockNumber() hexutil.Uint64 {
+	return hexutil.Uint64(api.s.backend.Blockchain().CurrentHeader().Number.Uint64())
+}
+
+// Syncing returns false, as the simulated chain is always synced.
+func (api *ethAPI) Syncing() bool {
+	return false
+}
+
+// GasPrice returns the suggested gas price.
+func (api *ethAPI) GasPrice(ctx context.Context) (*hexutil.Big, error) {
+	price, err := api.s.backend.SuggestGasPrice(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return (*hexutil.Big)(price), nil
+}
+
+// GetBlockByNumber returns the header of the block at the given number. Transactions are never
+// included, the pending block is served as the head block.
+func (api *ethAPI) GetBlockByNumber(ctx context.Context, number gethRPC.BlockNumber, _ bool) (*gethTypes.Header, error) {
+	header, err := api.s.HeaderByNumber(ctx, blockNumber(number))
+	if err == ethereum.NotFound {
+		return nil, nil
+	}
+	return header, err
+}
+
+// GetBlockByHash returns the header of the block with the given hash. Transactions are never
+// included.
+func (api *ethAPI) GetBlockByHash(ctx context.Context, hash common.Hash, _ bool) (*gethTypes.Header, error) {
+	header, err := api.s.HeaderByHash(ctx, hash)
+	if err == ethereum.NotFound {
+		return nil, nil
+	}
+	return header, err
+}
+
+// GetLogs returns the logs matching the given filter query.
+func (api *ethAPI) GetLogs(ctx context.Context, query filterQuery) ([]gethTypes.Log, error) {
+	q := ethereum.FilterQuery{
+		BlockHash: query.BlockHash,
+		Addresses: query.Addresses,
+		Topics:    query.Topics,
+	}
+	if query.FromBlock != nil {
+		q.FromBlock = blockNumber(*query.FromBlock)
+		if q.FromBlock == nil {
+			q.FromBlock = api.s.backend.Blockchain().CurrentHeader().Number
+		}
+	}
+	if query.ToBlock != nil {
+		q.ToBlock = blockNumber(*query.ToBlock)
+	}
+	logs, err := api.s.backend.FilterLogs(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	if logs == nil {
+		return []gethTypes.Log{}, nil
+	}
+	return logs, nil
+}
+
+// Call executes a contract call at the head or pending block.
+func (api *ethAPI) Call(ctx context.Context, args callArgs, number gethRPC.BlockNumber) (hexutil.Bytes, error) {
+	if number == gethRPC.PendingBlockNumber {
+		return api.s.backend.PendingCallContract(ctx, args.toCallMsg())
+	}
+	if number != gethRPC.LatestBlockNumber && uint64(number) != api.s.backend.Blockchain().CurrentHeader().Number.Uint64() {
+		return nil, errors.New("calls are only supported at the latest and pending blocks")
+	}
+	return api.s.backend.CallContract(ctx, args.toCallMsg(), nil)
+}
+
+// EstimateGas estimates the gas needed to execute a transaction on the pending block.
+func (api *ethAPI) EstimateGas(ctx context.Context, args callArgs) (hexutil.Uint64, error) {
+	gas, err := api.s.backend.EstimateGas(ctx, args.toCallMsg())
+	return hexutil.Uint64(gas), err
+}
+
+// GetCode returns the code of the given account at the head or pending block.
+func (api *ethAPI) GetCode(ctx context.Context, account common.Address, number gethRPC.BlockNumber) (hexutil.Bytes, error) {
+	if number == gethRPC.PendingBlockNumber {
+		return api.s.backend.PendingCodeAt(ctx, account)
+	}
+	return api.s.backend.CodeAt(ctx, account, nil)
+}
+
+// GetBalance returns the balance of the given account at the head block.
+func (api *ethAPI) GetBalance(ctx context.Context, account common.Address, _ gethRPC.BlockNumber) (*hexutil.Big, error) {
+	balance, err := api.s.backend.BalanceAt(ctx, account, nil)
+	if err != nil {
+		return nil, err
+	}
+	return (*hexutil.Big)(balance), nil
+}
+
+// GetTransactionCount returns the nonce of the given account at the head or pending block.
+func (api *ethAPI) GetTransactionCount(ctx context.Context, account common.Address, number gethRPC.BlockNumber) (hexutil.Uint64, error) {
+	var nonce uint64
+	var err error
+	if number == gethRPC.PendingBlockNumber {
+		nonce, err = api.s.backend.PendingNonceAt(ctx, account)
+	} else {
+		nonce, err = api.s.backend.NonceAt(ctx, account, nil)
+	}
+	return hexutil.Uint64(nonce), err
+}
+
+// SendRawTransaction adds a signed transaction to the pending block, which is mined at the next
+// block time.
+func (api *ethAPI) SendRawTransaction(ctx context.Context, encodedTx hexutil.Bytes) (common.Hash, error) {
+	tx := new(gethTypes.Transaction)
+	if err := rlp.DecodeBytes(encodedTx, tx); err != nil {
+		return common.Hash{}, errors.Wrap(err, "could not decode transaction")
+	}
+	if err := api.s.backend.SendTransaction(ctx, tx); err != nil {
+		return common.Hash{}, err
+	}
+	return tx.Hash(), nil
+}
+
+// GetTransactionReceipt returns the receipt of a mined transaction.
+func (api *ethAPI) GetTransactionReceipt(ctx context.Context, hash common.Hash) (*gethTypes.Receipt, error) {
+	return api.s.backend.TransactionReceipt(ctx, hash)
+}
+
+func (args callArgs) toCallMsg() ethereum.CallMsg {
+	msg := ethereum.CallMsg{To: args.To}
+	if args.From != nil {
+		msg.From = *args.From
+	}
+	if args.Gas != nil {
+		msg.Gas = uint64(*args.Gas)
+	}
+	if args.GasPrice != nil {
+		msg.GasPrice = args.GasPrice.ToInt()
+	}
+	if args.Value != nil {
+		msg.Value = args.Value.ToInt()
+	}
+	if args.Data != nil {
+		msg.Data = *args.Data
+	}
+	return msg
+}
+
+// Converts a JSON-RPC block number to the block number of a go-ethereum query, where nil stands
+// for the head block.
+func blockNumber(number gethRPC.BlockNumber) *big.Int {
+	if number < 0 {
+		return nil
+	}
+	return big.NewInt(number.Int64())
+}
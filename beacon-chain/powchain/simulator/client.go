@@ -0,0 +1,68 @@
+package simulator
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+)
+
+// HeaderByNumber returns the header of the canonical block at the given number, or of the head
+// block if the number is nil.
+func (s *Simulator) HeaderByNumber(_ context.Context, number *big.Int) (*gethTypes.Header, error) {
+	chain := s.backend.Blockchain()
+	if number == nil {
+		return chain.CurrentHeader(), nil
+	}
+	header := chain.GetHeaderByNumber(number.Uint64())
+	if header == nil {
+		return nil, ethereum.NotFound
+	}
+	return header, nil
+}
+
+// HeaderByHash returns the header of the block with the given hash.
+func (s *Simulator) HeaderByHash(_ context.Context, hash common.Hash) (*gethTypes.Header, error) {
+	header := s.backend.Blockchain().GetHeaderByHash(hash)
+	if header == nil {
+		return nil, ethereum.NotFound
+	}
+	return header, nil
+}
+
+// SyncProgress returns nil, as the simulated chain is always synced.
+func (s *Simulator) SyncProgress(_ context.Context) (*ethereum.SyncProgress, error) {
+	return nil, nil
+}
+
+// FilterLogs returns the logs matching the given filter query.
+func (s *Simulator) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]gethTypes.Log, error) {
+	return s.backend.FilterLogs(ctx, query)
+}
+
+// SubscribeFilterLogs subscribes to the logs matching the given filter query.
+func (s *Simulator) SubscribeFilterLogs(
+	ctx context.Context,
+	query ethereum.FilterQuery,
+	ch chan<- gethTypes.Log,
+) (ethereum.Subscription, error) {
+	return s.backend.SubscribeFilterLogs(ctx, query, ch)
+}
+
+// CodeAt returns the code of the given account at the head block.
+func (s *Simulator) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	return s.backend.CodeAt(ctx, contract, blockNumber)
+}
+
+// CallContract executes a contract call at the head block.
+func (s *Simulator) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	return s.backend.CallContract(ctx, call, blockNumber)
+}
+
+// BatchCall sends a batch of JSON-RPC requests to the simulated chain.
+func (s *Simulator) BatchCall(b []gethRPC.BatchElem) error {
+	return s.rpcClient.BatchCall(b)
+}
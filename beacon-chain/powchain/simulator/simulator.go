@@ -0,0 +1,238 @@
+// Package simulator runs an eth1 chain in process, with the deposit contract deployed, so that a
+// beacon node can be run for development without an external eth1 node. The chain is kept in
+// memory by the go-ethereum simulated backend, and mines a block at every block time.
+//
+// The simulator implements the eth1 interfaces consumed by the powchain service, and serves the
+// part of the eth1 JSON-RPC API used by the powchain service and by deposit tools over HTTP, so
+// that deposits sent with tools/sendDepositTx or the validator accounts-v2 deposit command are
+// mined and processed by the beacon node like on a real eth1 chain.
+package simulator
+
+import (
+	"context"
+	"math/big"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/crypto"
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	contracts "github.com/prysmaticlabs/prysm/contracts/deposit-contract"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "eth1-simulator")
+
+// DevPrivateKey is the private key of the account funded at the genesis of the simulated chain,
+// which deploys the deposit contract and can pay for deposits. It is public, and must only ever
+// be used for development.
+const DevPrivateKey = "243eb77266a0fd39abdaac6d3edbd82af25e1353dad868a7c2f6b5eb8e6f9764"
+
+// Gas limit of the blocks of the simulated chain.
+const blockGasLimit = 210000000000
+
+// Number of seconds the simulated backend puts between a block and its parent by default.
+const defaultBlockGap = 10
+
+// Config of the simulated eth1 chain.
+type Config struct {
+	// BlockTime is the time between two blocks, of at least a second.
+	BlockTime time.Duration
+	// HTTPHost and HTTPPort define the address the JSON-RPC API is served at. A port of 0 serves
+	// the API at a free port.
+	HTTPHost string
+	HTTPPort int
+}
+
+// Simulator mines an in-memory eth1 chain holding the deposit contract.
+type Simulator struct {
+	cfg             *Config
+	ctx             context.Context
+	cancel          context.CancelFunc
+	backend         *backends.SimulatedBackend
+	devAccount      common.Address
+	depositContract common.Address
+	deployBlock     uint64
+	rpcServer       *gethRPC.Server
+	rpcClient       *gethRPC.Client
+	listener        net.Listener
+	httpServer      *http.Server
+	runErrorLock    sync.RWMutex
+	runError        error
+}
+
+// New creates the genesis of the simulated chain, deploys the deposit contract and listens for
+// JSON-RPC requests. The chain only starts mining further blocks once started.
+func New(ctx context.Context, cfg *Config) (*Simulator, error) {
+	if cfg.BlockTime < time.Second {
+		return nil, errors.New("block time must be at least a second")
+	}
+	key, err := crypto.HexToECDSA(DevPrivateKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode development key")
+	}
+	devAccount := crypto.PubkeyToAddress(key.PublicKey)
+	balance, ok := new(big.Int).SetString("100000000000000000000000000000000000000", 10)
+	if !ok {
+		return nil, errors.New("could not set genesis balance")
+	}
+	backend := backends.NewSimulatedBackend(core.GenesisAlloc{
+		devAccount: {Balance: balance},
+	}, blockGasLimit)
+	ctx, cancel := context.WithCancel(ctx)
+	s := &Simulator{
+		cfg:        cfg,
+		ctx:        ctx,
+		cancel:     cancel,
+		backend:    backend,
+		devAccount: devAccount,
+		rpcServer:  gethRPC.NewServer(),
+	}
+	// Blocks holding transactions are timestamped by the simulated backend at a fixed gap after
+	// their parent, so the block before the deployment is timestamped that gap in the past.
+	if err := s.alignTime(uint64(timeutils.Now().Unix()) - defaultBlockGap); err != nil {
+		cancel()
+		return nil, err
+	}
+	backend.Commit()
+	s.depositContract, _, _, err = contracts.DeployDepositContract(bind.NewKeyedTransactor(key), backend, devAccount)
+	if err != nil {
+		cancel()
+		return nil, errors.Wrap(err, "could not deploy deposit contract")
+	}
+	s.Commit()
+	s.deployBlock = backend.Blockchain().CurrentHeader().Number.Uint64()
+	if err := s.rpcServer.RegisterName("eth", &ethAPI{s: s}); err != nil {
+		cancel()
+		return nil, errors.Wrap(err, "could not register eth api")
+	}
+	if err := s.rpcServer.RegisterName("net", &netAPI{s: s}); err != nil {
+		cancel()
+		return nil, errors.Wrap(err, "could not register net api")
+	}
+	s.rpcClient = gethRPC.DialInProc(s.rpcServer)
+	s.listener, err = net.Listen("tcp", net.JoinHostPort(cfg.HTTPHost, strconv.Itoa(cfg.HTTPPort)))
+	if err != nil {
+		cancel()
+		return nil, errors.Wrap(err, "could not listen for json-rpc requests")
+	}
+	s.httpServer = &http.Server{Handler: s.rpcServer}
+	return s, nil
+}
+
+// Start serving the JSON-RPC API and mining blocks.
+func (s *Simulator) Start() {
+	log.WithFields(logrus.Fields{
+		"endpoint":        s.Endpoint(),
+		"chainID":         s.ChainID(),
+		"depositContract": s.depositContract.Hex(),
+		"devAccount":      s.devAccount.Hex(),
+		"blockTime":       s.cfg.BlockTime,
+	}).Info("Starting simulated eth1 chain")
+	log.Warnf("The simulated eth1 chain is kept in memory and funds a development account whose private "+
+		"key %s is public, it must only be used for development", DevPrivateKey)
+	go func() {
+		if err := s.httpServer.Serve(s.listener); err != nil && err != http.ErrServerClosed {
+			log.WithError(err).Error("Could not serve json-rpc requests")
+			s.setRunError(err)
+		}
+	}()
+	go s.run()
+}
+
+// Stop mining blocks and serving the JSON-RPC API.
+func (s *Simulator) Stop() error {
+	s.cancel()
+	s.rpcClient.Close()
+	s.rpcServer.Stop()
+	return s.httpServer.Close()
+}
+
+// Status returns an error if the JSON-RPC API could not be served.
+func (s *Simulator) Status() error {
+	s.runErrorLock.RLock()
+	defer s.runErrorLock.RUnlock()
+	return s.runError
+}
+
+// Endpoint returns the HTTP endpoint of the JSON-RPC API.
+func (s *Simulator) Endpoint() string {
+	return "http://" + s.listener.Addr().String()
+}
+
+// ChainID returns the chain id of the simulated chain, which is also its network id.
+func (s *Simulator) ChainID() uint64 {
+	return s.backend.Blockchain().Config().ChainID.Uint64()
+}
+
+// DepositContractAddress returns the address of the deposit contract.
+func (s *Simulator) DepositContractAddress() common.Address {
+	return s.depositContract
+}
+
+// DeployBlock returns the number of the block the deposit contract is deployed in.
+func (s *Simulator) DeployBlock() uint64 {
+	return s.deployBlock
+}
+
+// Backend returns the simulated backend the chain is kept in.
+func (s *Simulator) Backend() *backends.SimulatedBackend {
+	return s.backend
+}
+
+// Mine a block at every block time.
+func (s *Simulator) run() {
+	ticker := time.NewTicker(s.cfg.BlockTime)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+			s.Commit()
+		}
+	}
+}
+
+// Commit mines the pending transactions into a new block.
+func (s *Simulator) Commit() {
+	if err := s.alignTime(uint64(timeutils.Now().Unix())); err != nil {
+		// The simulated backend may refuse to adjust the time of a block holding transactions,
+		// which is then timestamped at a fixed gap after its parent. The time of the next empty
+		// block catches up with the wall clock.
+		log.WithError(err).Debug("Could not align pending block time")
+	}
+	s.backend.Commit()
+	head := s.backend.Blockchain().CurrentHeader()
+	log.WithFields(logrus.Fields{
+		"number": head.Number.Uint64(),
+		"hash":   head.Hash().Hex(),
+		"time":   head.Time,
+	}).Debug("Mined eth1 block")
+}
+
+// Sets the time of the pending block to the given unix time, rather than the fixed gap after its
+// parent used by the simulated backend, so that block times follow the wall clock like on a real
+// eth1 chain. Block times are kept strictly increasing.
+func (s *Simulator) alignTime(blockTime uint64) error {
+	parent := s.backend.Blockchain().CurrentHeader().Time
+	if blockTime <= parent {
+		blockTime = parent + 1
+	}
+	offset := time.Duration(int64(blockTime)-int64(parent+defaultBlockGap)) * time.Second
+	return errors.Wrap(s.backend.AdjustTime(offset), "could not adjust pending block time")
+}
+
+func (s *Simulator) setRunError(err error) {
+	s.runErrorLock.Lock()
+	defer s.runErrorLock.Unlock()
+	s.runError = err
+}
@@ -0,0 +1,130 @@
+package simulator
+
+import (
+	"context"
+	"encoding/binary"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
+	contracts "github.com/prysmaticlabs/prysm/contracts/deposit-contract"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+var _ = powchain.Client(&Simulator{})
+var _ = powchain.RPCClient(&Simulator{})
+
+func setupSimulator(t *testing.T) *Simulator {
+	// Blocks are mined by the test, rather than at every block time.
+	sim, err := New(context.Background(), &Config{BlockTime: time.Hour, HTTPHost: "127.0.0.1"})
+	require.NoError(t, err)
+	sim.Start()
+	t.Cleanup(func() {
+		require.NoError(t, sim.Stop())
+	})
+	return sim
+}
+
+func TestNew_InvalidBlockTime(t *testing.T) {
+	_, err := New(context.Background(), &Config{BlockTime: time.Millisecond})
+	assert.ErrorContains(t, "block time must be at least a second", err)
+}
+
+func TestSimulator_DepositOverHTTP(t *testing.T) {
+	sim := setupSimulator(t)
+	ctx := context.Background()
+	rpcClient, err := gethRPC.Dial(sim.Endpoint())
+	require.NoError(t, err)
+	defer rpcClient.Close()
+	client := ethclient.NewClient(rpcClient)
+
+	chainID, err := client.ChainID(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, sim.ChainID(), chainID.Uint64())
+	networkID, err := client.NetworkID(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, sim.ChainID(), networkID.Uint64())
+
+	// Send a deposit the way deposit tools do.
+	deposits, _, err := testutil.DeterministicDepositsAndKeys(1)
+	require.NoError(t, err)
+	_, depositRoots, err := testutil.DeterministicDepositTrie(len(deposits))
+	require.NoError(t, err)
+	key, err := crypto.HexToECDSA(DevPrivateKey)
+	require.NoError(t, err)
+	txOpts := bind.NewKeyedTransactor(key)
+	txOpts.Value = contracts.Amount32Eth()
+	depositContract, err := contracts.NewDepositContract(sim.DepositContractAddress(), client)
+	require.NoError(t, err)
+	data := deposits[0].Data
+	tx, err := depositContract.Deposit(txOpts, data.PublicKey, data.WithdrawalCredentials, data.Signature, depositRoots[0])
+	require.NoError(t, err)
+	sim.Commit()
+
+	receipt, err := client.TransactionReceipt(ctx, tx.Hash())
+	require.NoError(t, err)
+	assert.Equal(t, gethTypes.ReceiptStatusSuccessful, receipt.Status)
+	head, err := client.HeaderByNumber(ctx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, receipt.BlockNumber.Uint64(), head.Number.Uint64())
+	if diff := time.Since(time.Unix(int64(head.Time), 0)); diff < -time.Minute || diff > time.Minute {
+		t.Errorf("Block time %d is not close to the current time", head.Time)
+	}
+
+	logs, err := client.FilterLogs(ctx, ethereum.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(sim.DeployBlock()),
+		Addresses: []common.Address{sim.DepositContractAddress()},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(logs))
+	assert.Equal(t, tx.Hash(), logs[0].TxHash)
+
+	caller, err := contracts.NewDepositContractCaller(sim.DepositContractAddress(), client)
+	require.NoError(t, err)
+	count, err := caller.GetDepositCount(&bind.CallOpts{})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), binary.LittleEndian.Uint64(count))
+}
+
+func TestSimulator_BatchCall(t *testing.T) {
+	sim := setupSimulator(t)
+	sim.Commit()
+	sim.Commit()
+
+	head, err := sim.HeaderByNumber(context.Background(), nil)
+	require.NoError(t, err)
+	headers := make([]*gethTypes.Header, head.Number.Uint64()+1)
+	elems := make([]gethRPC.BatchElem, len(headers))
+	for i := range elems {
+		headers[i] = &gethTypes.Header{}
+		elems[i] = gethRPC.BatchElem{
+			Method: "eth_getBlockByNumber",
+			Args:   []interface{}{hexutil.EncodeBig(big.NewInt(int64(i))), false},
+			Result: headers[i],
+		}
+	}
+	require.NoError(t, sim.BatchCall(elems))
+	for i, header := range headers {
+		require.NoError(t, elems[i].Error)
+		assert.Equal(t, uint64(i), header.Number.Uint64())
+		if i > 0 {
+			assert.Equal(t, headers[i-1].Hash(), header.ParentHash)
+			assert.Equal(t, true, header.Time > headers[i-1].Time, "Block times are not increasing")
+		}
+	}
+	assert.Equal(t, head.Hash(), headers[len(headers)-1].Hash())
+
+	_, err = sim.HeaderByNumber(context.Background(), new(big.Int).Add(head.Number, big.NewInt(1)))
+	assert.Equal(t, ethereum.NotFound, err)
+}
@@ -0,0 +1,168 @@
+package powchain
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/pkg/errors"
+	protodb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// errSnapshotMismatch is returned when a deposit snapshot does not match the deposit contract, as
+// opposed to when the eth1 node could not be queried.
+var errSnapshotMismatch = errors.New("deposit snapshot does not match the deposit contract")
+
+// DepositSnapshotFetcher retrieves a snapshot of the finalized deposits, which a node can start
+// from instead of replaying every deposit log of the deposit contract.
+type DepositSnapshotFetcher interface {
+	DepositSnapshot(ctx context.Context) (*protodb.DepositSnapshot, error)
+}
+
+// DepositSnapshot returns a snapshot of the deposit trie holding the finalized deposits, along
+// with the eth1 block including the last of them.
+func (s *Service) DepositSnapshot(ctx context.Context) (*protodb.DepositSnapshot, error) {
+	ctx, span := trace.StartSpan(ctx, "powchain.DepositSnapshot")
+	defer span.End()
+
+	if s.depositCache == nil {
+		return nil, errors.New("no deposit cache")
+	}
+	finalizedDeposits := s.depositCache.FinalizedDeposits(ctx)
+	count := uint64(len(finalizedDeposits.Deposits.Items()))
+	if finalizedDeposits.MerkleTrieIndex < 0 || count == 0 {
+		return nil, errors.New("no finalized deposits")
+	}
+	if uint64(finalizedDeposits.MerkleTrieIndex+1) < count {
+		count = uint64(finalizedDeposits.MerkleTrieIndex + 1)
+	}
+	finalized, err := finalizedDeposits.Deposits.FinalizedBranch(count)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get finalized branch of the deposit trie")
+	}
+	// The snapshot root is computed from the finalized branch alone, as the finalized deposits
+	// trie may hold more deposits than are finalized.
+	snapshotTrie, err := trieutil.CreateTrieFromSnapshot(finalized, count, int(params.BeaconConfig().DepositContractTreeDepth))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create deposit trie from finalized branch")
+	}
+	root := snapshotTrie.HashTreeRoot()
+
+	snapshot := &protodb.DepositSnapshot{
+		Finalized:    finalized,
+		DepositRoot:  root[:],
+		DepositCount: count,
+	}
+	if s.depositSnapshot != nil && s.depositSnapshot.DepositCount == count {
+		snapshot.Eth1BlockHash = s.depositSnapshot.Eth1BlockHash
+		snapshot.Eth1BlockHeight = s.depositSnapshot.Eth1BlockHeight
+		return snapshot, nil
+	}
+	found := false
+	for _, ctr := range s.depositCache.AllDepositContainers(ctx) {
+		if ctr.Index == int64(count)-1 {
+			snapshot.Eth1BlockHeight = ctr.Eth1BlockHeight
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("could not find the eth1 block of deposit %d", count-1)
+	}
+	hash, err := s.BlockHashByHeight(ctx, new(big.Int).SetUint64(snapshot.Eth1BlockHeight))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get eth1 block hash")
+	}
+	snapshot.Eth1BlockHash = hash.Bytes()
+	return snapshot, nil
+}
+
+// importDepositSnapshot starts the deposit trie and deposit cache from a snapshot, and resumes
+// processing the deposit logs from the eth1 block of the snapshot.
+func (s *Service) importDepositSnapshot(ctx context.Context, snapshot *protodb.DepositSnapshot) error {
+	depositTrie, err := s.applyDepositSnapshot(ctx, snapshot)
+	if err != nil {
+		return err
+	}
+	s.depositTrie = depositTrie
+	s.lastReceivedMerkleIndex = int64(snapshot.DepositCount) - 1
+	s.latestEth1Data.LastRequestedBlock = snapshot.Eth1BlockHeight
+	// The deposits of a snapshot are finalized, so the chain has started already.
+	s.chainStartData.Chainstarted = true
+
+	eth1Data := &protodb.ETH1ChainData{
+		CurrentEth1Data:   s.latestEth1Data,
+		ChainstartData:    s.chainStartData,
+		BeaconState:       s.preGenesisState.InnerStateUnsafe(),
+		Trie:              s.depositTrie.ToProto(),
+		DepositContainers: s.depositCache.AllDepositContainers(ctx),
+		DepositSnapshot:   s.depositSnapshot,
+	}
+	if err := s.beaconDB.SavePowchainData(ctx, eth1Data); err != nil {
+		return errors.Wrap(err, "could not save eth1 data")
+	}
+	log.WithFields(logrus.Fields{
+		"depositCount": snapshot.DepositCount,
+		"depositRoot":  fmt.Sprintf("%#x", snapshot.DepositRoot),
+		"eth1Block":    snapshot.Eth1BlockHeight,
+	}).Info("Imported deposit snapshot")
+	return nil
+}
+
+// applyDepositSnapshot verifies a snapshot against its deposit root, sets the finalized deposits
+// of the deposit cache from it and returns the deposit trie of the snapshot.
+func (s *Service) applyDepositSnapshot(ctx context.Context, snapshot *protodb.DepositSnapshot) (*trieutil.SparseMerkleTrie, error) {
+	depositTrie, err := trieutil.CreateTrieFromSnapshot(
+		snapshot.Finalized,
+		snapshot.DepositCount,
+		int(params.BeaconConfig().DepositContractTreeDepth),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create deposit trie from snapshot")
+	}
+	root := depositTrie.HashTreeRoot()
+	if !bytes.Equal(root[:], snapshot.DepositRoot) {
+		return nil, fmt.Errorf("snapshot deposit root %#x does not match the root of its finalized branch %#x", snapshot.DepositRoot, root)
+	}
+	s.depositCache.InsertDepositSnapshot(ctx, depositTrie, snapshot.DepositCount, snapshot.Eth1BlockHeight)
+	s.depositSnapshot = snapshot
+	return depositTrie, nil
+}
+
+// verifyDepositSnapshot checks the snapshot the deposit trie was started from against the deposit
+// contract, as the snapshot is only self-consistent until the deposit root and count it claims are
+// those of the contract at its eth1 block. The eth1 node must serve the state of that block.
+func (s *Service) verifyDepositSnapshot(ctx context.Context) error {
+	snapshot := s.depositSnapshot
+	height := new(big.Int).SetUint64(snapshot.Eth1BlockHeight)
+	header, err := s.eth1DataFetcher.HeaderByNumber(ctx, height)
+	if err != nil {
+		return errors.Wrap(err, "could not get eth1 block of deposit snapshot")
+	}
+	if !bytes.Equal(header.Hash().Bytes(), snapshot.Eth1BlockHash) {
+		return errors.Wrapf(errSnapshotMismatch, "eth1 block %d has hash %#x, not %#x", snapshot.Eth1BlockHeight, header.Hash(), snapshot.Eth1BlockHash)
+	}
+	opts := &bind.CallOpts{Context: ctx, BlockNumber: height}
+	root, err := s.depositContractCaller.GetDepositRoot(opts)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve deposit root")
+	}
+	if !bytes.Equal(root[:], snapshot.DepositRoot) {
+		return errors.Wrapf(errSnapshotMismatch, "deposit contract root %#x at eth1 block %d, not %#x", root, snapshot.Eth1BlockHeight, snapshot.DepositRoot)
+	}
+	countBytes, err := s.depositContractCaller.GetDepositCount(opts)
+	if err != nil {
+		return errors.Wrap(err, "could not get deposit count")
+	}
+	if count := bytesutil.FromBytes8(countBytes); count != snapshot.DepositCount {
+		return errors.Wrapf(errSnapshotMismatch, "deposit contract count %d at eth1 block %d, not %d", count, snapshot.Eth1BlockHeight, snapshot.DepositCount)
+	}
+	return nil
+}
@@ -0,0 +1,199 @@
+package powchain
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
+	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	contracts "github.com/prysmaticlabs/prysm/contracts/deposit-contract"
+	protodb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
+)
+
+var _ = DepositSnapshotFetcher(&Service{})
+
+func TestService_DepositSnapshot(t *testing.T) {
+	depositCache, err := depositcache.New()
+	require.NoError(t, err)
+	s := &Service{
+		depositCache:    depositCache,
+		headerCache:     newHeaderCache(),
+		eth1DataFetcher: &goodFetcher{},
+	}
+	_, err = s.DepositSnapshot(context.Background())
+	assert.ErrorContains(t, "no finalized deposits", err)
+
+	var ctrs []*protodb.DepositContainer
+	var items [][]byte
+	for i := 0; i < 5; i++ {
+		data := &ethpb.Deposit_Data{
+			PublicKey:             bytesutil.PadTo([]byte{byte(i)}, 48),
+			WithdrawalCredentials: make([]byte, 32),
+			Signature:             make([]byte, 96),
+		}
+		hash, err := data.HashTreeRoot()
+		require.NoError(t, err)
+		items = append(items, hash[:])
+		ctrs = append(ctrs, &protodb.DepositContainer{
+			Deposit:         &ethpb.Deposit{Data: data},
+			Eth1BlockHeight: uint64(10 + i),
+			Index:           int64(i),
+		})
+	}
+	depositCache.InsertDepositContainers(context.Background(), ctrs)
+	depositCache.InsertFinalizedDeposits(context.Background(), 2)
+
+	snapshot, err := s.DepositSnapshot(context.Background())
+	require.NoError(t, err)
+	depositTrie, err := trieutil.GenerateTrieFromItems(items[:3], int(params.BeaconConfig().DepositContractTreeDepth))
+	require.NoError(t, err)
+	root := depositTrie.HashTreeRoot()
+	assert.Equal(t, uint64(3), snapshot.DepositCount)
+	assert.DeepEqual(t, root[:], snapshot.DepositRoot)
+	assert.Equal(t, uint64(12), snapshot.Eth1BlockHeight)
+	header, err := s.eth1DataFetcher.HeaderByNumber(context.Background(), big.NewInt(12))
+	require.NoError(t, err)
+	assert.DeepEqual(t, header.Hash().Bytes(), snapshot.Eth1BlockHash)
+}
+
+func TestService_ImportDepositSnapshot(t *testing.T) {
+	beaconDB, _ := dbutil.SetupDB(t)
+	var items [][]byte
+	for i := 0; i < 5; i++ {
+		items = append(items, bytesutil.PadTo([]byte{byte(i)}, 32))
+	}
+	depth := int(params.BeaconConfig().DepositContractTreeDepth)
+	fullTrie, err := trieutil.GenerateTrieFromItems(items, depth)
+	require.NoError(t, err)
+	finalized, err := fullTrie.FinalizedBranch(uint64(len(items)))
+	require.NoError(t, err)
+	root := fullTrie.HashTreeRoot()
+	snapshot := &protodb.DepositSnapshot{
+		Finalized:       finalized,
+		DepositRoot:     root[:],
+		DepositCount:    uint64(len(items)),
+		Eth1BlockHash:   bytesutil.PadTo([]byte("hash"), 32),
+		Eth1BlockHeight: 100,
+	}
+
+	depositCache, err := depositcache.New()
+	require.NoError(t, err)
+	s, err := NewService(context.Background(), &Web3ServiceConfig{
+		BeaconDB:        beaconDB,
+		DepositCache:    depositCache,
+		DepositSnapshot: snapshot,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), s.lastReceivedMerkleIndex)
+	assert.Equal(t, uint64(100), s.latestEth1Data.LastRequestedBlock)
+	assert.Equal(t, true, s.chainStartData.Chainstarted)
+	assert.Equal(t, root, s.depositTrie.HashTreeRoot())
+	assert.Equal(t, int64(4), depositCache.FinalizedDeposits(context.Background()).MerkleTrieIndex)
+
+	// New deposits are inserted after the snapshot.
+	s.depositTrie.Insert(bytesutil.PadTo([]byte{5}, 32), 5)
+	fullTrie.Insert(bytesutil.PadTo([]byte{5}, 32), 5)
+	assert.Equal(t, fullTrie.HashTreeRoot(), s.depositTrie.HashTreeRoot())
+	proof, err := s.depositTrie.MerkleProof(5)
+	require.NoError(t, err)
+	trieRoot := fullTrie.Root()
+	assert.Equal(t, true, trieutil.VerifyMerkleBranch(trieRoot[:], bytesutil.PadTo([]byte{5}, 32), 5, proof, params.BeaconConfig().DepositContractTreeDepth))
+
+	// The snapshot is applied again on restart.
+	eth1Data, err := beaconDB.PowchainData(context.Background())
+	require.NoError(t, err)
+	assert.DeepEqual(t, snapshot, eth1Data.DepositSnapshot)
+	depositCache, err = depositcache.New()
+	require.NoError(t, err)
+	s, err = NewService(context.Background(), &Web3ServiceConfig{
+		BeaconDB:     beaconDB,
+		DepositCache: depositCache,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), s.lastReceivedMerkleIndex)
+	assert.Equal(t, int64(4), depositCache.FinalizedDeposits(context.Background()).MerkleTrieIndex)
+	exported, err := s.DepositSnapshot(context.Background())
+	require.NoError(t, err)
+	assert.DeepEqual(t, snapshot, exported)
+}
+
+func TestService_ImportDepositSnapshot_InvalidRoot(t *testing.T) {
+	beaconDB, _ := dbutil.SetupDB(t)
+	depositCache, err := depositcache.New()
+	require.NoError(t, err)
+	depth := int(params.BeaconConfig().DepositContractTreeDepth)
+	depositTrie, err := trieutil.GenerateTrieFromItems([][]byte{bytesutil.PadTo([]byte{1}, 32)}, depth)
+	require.NoError(t, err)
+	finalized, err := depositTrie.FinalizedBranch(1)
+	require.NoError(t, err)
+	_, err = NewService(context.Background(), &Web3ServiceConfig{
+		BeaconDB:     beaconDB,
+		DepositCache: depositCache,
+		DepositSnapshot: &protodb.DepositSnapshot{
+			Finalized:    finalized,
+			DepositRoot:  make([]byte, 32),
+			DepositCount: 1,
+		},
+	})
+	assert.ErrorContains(t, "does not match the root of its finalized branch", err)
+}
+
+func TestService_VerifyDepositSnapshot(t *testing.T) {
+	testAcc, err := contracts.Setup()
+	require.NoError(t, err, "Unable to set up simulated backend")
+	testutil.ResetCache()
+	deposits, _, err := testutil.DeterministicDepositsAndKeys(1)
+	require.NoError(t, err)
+	_, depositRoots, err := testutil.DeterministicDepositTrie(len(deposits))
+	require.NoError(t, err)
+	data := deposits[0].Data
+	testAcc.TxOpts.Value = contracts.Amount32Eth()
+	testAcc.TxOpts.GasLimit = 1000000
+	_, err = testAcc.Contract.Deposit(testAcc.TxOpts, data.PublicKey, data.WithdrawalCredentials, data.Signature, depositRoots[0])
+	require.NoError(t, err, "Could not deposit to deposit contract")
+	testAcc.Backend.Commit()
+
+	caller, err := contracts.NewDepositContractCaller(testAcc.ContractAddr, testAcc.Backend)
+	require.NoError(t, err)
+	root, err := caller.GetDepositRoot(&bind.CallOpts{})
+	require.NoError(t, err)
+	header := testAcc.Backend.Blockchain().CurrentHeader()
+	s := &Service{
+		eth1DataFetcher:       &goodFetcher{backend: testAcc.Backend},
+		depositContractCaller: caller,
+		depositSnapshot: &protodb.DepositSnapshot{
+			DepositRoot:     root[:],
+			DepositCount:    1,
+			Eth1BlockHash:   header.Hash().Bytes(),
+			Eth1BlockHeight: header.Number.Uint64(),
+		},
+	}
+	require.NoError(t, s.verifyDepositSnapshot(context.Background()))
+
+	s.depositSnapshot.DepositCount = 2
+	err = s.verifyDepositSnapshot(context.Background())
+	assert.Equal(t, true, errors.Is(err, errSnapshotMismatch))
+	assert.ErrorContains(t, "deposit contract count 1", err)
+
+	s.depositSnapshot.DepositCount = 1
+	s.depositSnapshot.DepositRoot = make([]byte, 32)
+	err = s.verifyDepositSnapshot(context.Background())
+	assert.Equal(t, true, errors.Is(err, errSnapshotMismatch))
+	assert.ErrorContains(t, "deposit contract root", err)
+
+	s.depositSnapshot.DepositRoot = root[:]
+	s.depositSnapshot.Eth1BlockHash = make([]byte, 32)
+	err = s.verifyDepositSnapshot(context.Background())
+	assert.Equal(t, true, errors.Is(err, errSnapshotMismatch))
+	assert.ErrorContains(t, "has hash", err)
+}
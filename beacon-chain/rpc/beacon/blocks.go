@@ -25,7 +25,8 @@ import (
 // The server may return multiple blocks in the case that a slot or epoch is
 // provided as the filter criteria. The server may return an empty list when
 // no blocks in their database match the filter criteria. This RPC should
-// not return NOT_FOUND. Only one filter criteria should be used.
+// not return NOT_FOUND. Only one filter criteria should be used. OUT_OF_RANGE is
+// returned when the requested blocks were pruned from the database.
 func (bs *Server) ListBlocks(
 	ctx context.Context, req *ethpb.ListBlocksRequest,
 ) (*ethpb.ListBlocksResponse, error) {
@@ -36,6 +37,13 @@ func (bs *Server) ListBlocks(
 
 	switch q := req.QueryFilter.(type) {
 	case *ethpb.ListBlocksRequest_Epoch:
+		startSlot, err := helpers.StartSlot(q.Epoch)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Could not compute start slot of epoch %d: %v", q.Epoch, err)
+		}
+		if err := bs.checkHistoryRetained(ctx, startSlot); err != nil {
+			return nil, err
+		}
 		blks, err := bs.BeaconDB.Blocks(ctx, filters.NewFilter().SetStartEpoch(q.Epoch).SetEndEpoch(q.Epoch))
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "Failed to get blocks: %v", err)
@@ -79,6 +87,9 @@ func (bs *Server) ListBlocks(
 			return nil, status.Errorf(codes.Internal, "Could not retrieve block: %v", err)
 		}
 		if blk == nil {
+			if err := bs.checkRootRetained(ctx, q.Root); err != nil {
+				return nil, err
+			}
 			return &ethpb.ListBlocksResponse{
 				BlockContainers: make([]*ethpb.BeaconBlockContainer, 0),
 				TotalSize:       0,
@@ -99,6 +110,12 @@ func (bs *Server) ListBlocks(
 		}, nil
 
 	case *ethpb.ListBlocksRequest_Slot:
+		// The genesis block is never pruned.
+		if q.Slot != 0 {
+			if err := bs.checkHistoryRetained(ctx, q.Slot); err != nil {
+				return nil, err
+			}
+		}
 		blks, err := bs.BeaconDB.Blocks(ctx, filters.NewFilter().SetStartSlot(q.Slot).SetEndSlot(q.Slot))
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "Could not retrieve blocks for slot %d: %v", q.Slot, err)
@@ -165,6 +182,37 @@ func (bs *Server) ListBlocks(
 	return nil, status.Error(codes.InvalidArgument, "Must specify a filter criteria for fetching blocks")
 }
 
+// checkHistoryRetained returns an OUT_OF_RANGE error if the blocks at the slot were pruned from
+// the database.
+func (bs *Server) checkHistoryRetained(ctx context.Context, slot uint64) error {
+	lowest, err := bs.BeaconDB.LowestRetainedSlot(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "Could not retrieve lowest retained slot: %v", err)
+	}
+	if slot < lowest {
+		return status.Errorf(codes.OutOfRange, "Blocks at slot %d were pruned, lowest retained slot is %d", slot, lowest)
+	}
+	return nil
+}
+
+// checkRootRetained returns an OUT_OF_RANGE error for the root of a block missing from a
+// database whose history was pruned, as the block cannot be told apart from a pruned one. The
+// zero root and the roots of blocks with a state or state summary were never pruned.
+func (bs *Server) checkRootRetained(ctx context.Context, root []byte) error {
+	blockRoot := bytesutil.ToBytes32(root)
+	if blockRoot == params.BeaconConfig().ZeroHash || bs.BeaconDB.HasState(ctx, blockRoot) || bs.BeaconDB.HasStateSummary(ctx, blockRoot) {
+		return nil
+	}
+	lowest, err := bs.BeaconDB.LowestRetainedSlot(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "Could not retrieve lowest retained slot: %v", err)
+	}
+	if lowest > 0 {
+		return status.Errorf(codes.OutOfRange, "Block %#x not found, blocks below slot %d were pruned", root, lowest)
+	}
+	return nil
+}
+
 // GetChainHead retrieves information about the head of the beacon chain from
 // the view of the beacon chain node.
 //
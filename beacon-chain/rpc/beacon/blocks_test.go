@@ -27,6 +27,8 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestServer_ListBlocks_NoResults(t *testing.T) {
@@ -287,6 +289,64 @@ func TestServer_ListBlocks_Pagination(t *testing.T) {
 	}
 }
 
+func TestServer_ListBlocks_Pruned(t *testing.T) {
+	db, _ := dbTest.SetupDB(t)
+	ctx := context.Background()
+
+	genesis := testutil.NewBeaconBlock()
+	parentRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, genesis))
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, parentRoot))
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	var prunedRoot [32]byte
+	for i := uint64(1); i <= 2*slotsPerEpoch; i++ {
+		b := testutil.NewBeaconBlock()
+		b.Block.Slot = i
+		b.Block.ParentRoot = parentRoot[:]
+		require.NoError(t, db.SaveBlock(ctx, b))
+		parentRoot, err = b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		if i == 1 {
+			prunedRoot = parentRoot
+		}
+		if i == slotsPerEpoch {
+			st := testutil.NewBeaconState()
+			require.NoError(t, st.SetSlot(i))
+			require.NoError(t, db.SaveState(ctx, st, parentRoot))
+		}
+	}
+	require.NoError(t, db.SaveStateSummary(ctx, &pbp2p.StateSummary{Slot: 2 * slotsPerEpoch, Root: parentRoot[:]}))
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 2, Root: parentRoot[:]}))
+	lowest, err := db.PruneHistory(ctx, slotsPerEpoch)
+	require.NoError(t, err)
+	require.Equal(t, slotsPerEpoch, lowest)
+
+	bs := &Server{BeaconDB: db}
+	_, err = bs.ListBlocks(ctx, &ethpb.ListBlocksRequest{QueryFilter: &ethpb.ListBlocksRequest_Slot{Slot: 1}})
+	assert.Equal(t, codes.OutOfRange, status.Code(err))
+	_, err = bs.ListBlocks(ctx, &ethpb.ListBlocksRequest{QueryFilter: &ethpb.ListBlocksRequest_Epoch{Epoch: 0}})
+	assert.Equal(t, codes.OutOfRange, status.Code(err))
+	_, err = bs.ListBlocks(ctx, &ethpb.ListBlocksRequest{QueryFilter: &ethpb.ListBlocksRequest_Root{Root: prunedRoot[:]}})
+	assert.Equal(t, codes.OutOfRange, status.Code(err))
+
+	// The zero root and roots with a state summary were never pruned, so they are not found.
+	summaryRoot := [32]byte{'a'}
+	require.NoError(t, db.SaveStateSummary(ctx, &pbp2p.StateSummary{Slot: 2*slotsPerEpoch + 1, Root: summaryRoot[:]}))
+	for _, root := range [][32]byte{params.BeaconConfig().ZeroHash, summaryRoot} {
+		res, err := bs.ListBlocks(ctx, &ethpb.ListBlocksRequest{QueryFilter: &ethpb.ListBlocksRequest_Root{Root: root[:]}})
+		require.NoError(t, err)
+		assert.Equal(t, 0, len(res.BlockContainers))
+	}
+
+	res, err := bs.ListBlocks(ctx, &ethpb.ListBlocksRequest{QueryFilter: &ethpb.ListBlocksRequest_Slot{Slot: slotsPerEpoch}})
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(res.BlockContainers))
+	res, err = bs.ListBlocks(ctx, &ethpb.ListBlocksRequest{QueryFilter: &ethpb.ListBlocksRequest_Epoch{Epoch: 1}})
+	require.NoError(t, err)
+	assert.Equal(t, int32(slotsPerEpoch), res.TotalSize)
+}
+
 func TestServer_ListBlocks_Errors(t *testing.T) {
 	db, _ := dbTest.SetupDB(t)
 	ctx := context.Background()
@@ -31,8 +31,9 @@ func TestServer_SubmitProposerSlashing_DontBroadcast(t *testing.T) {
 		HeadFetcher: &mock.ChainService{
 			State: st,
 		},
-		SlashingsPool: slashings.NewPool(),
-		Broadcaster:   mb,
+		SlashingsPool:       slashings.NewPool(),
+		Broadcaster:         mb,
+		AttestationNotifier: (&mock.ChainService{}).OperationNotifier(),
 	}
 
 	// We want a proposer slashing for validator with index 2 to
@@ -75,8 +76,9 @@ func TestServer_SubmitProposerSlashing(t *testing.T) {
 		HeadFetcher: &mock.ChainService{
 			State: st,
 		},
-		SlashingsPool: slashings.NewPool(),
-		Broadcaster:   mb,
+		SlashingsPool:       slashings.NewPool(),
+		Broadcaster:         mb,
+		AttestationNotifier: (&mock.ChainService{}).OperationNotifier(),
 	}
 
 	// We want a proposer slashing for validator with index 2 to
@@ -107,8 +109,9 @@ func TestServer_SubmitAttesterSlashing_DontBroadcast(t *testing.T) {
 		HeadFetcher: &mock.ChainService{
 			State: st,
 		},
-		SlashingsPool: slashings.NewPool(),
-		Broadcaster:   mb,
+		SlashingsPool:       slashings.NewPool(),
+		Broadcaster:         mb,
+		AttestationNotifier: (&mock.ChainService{}).OperationNotifier(),
 	}
 
 	slashing, err := testutil.GenerateAttesterSlashingForValidator(st, privs[2], uint64(2))
@@ -151,8 +154,9 @@ func TestServer_SubmitAttesterSlashing(t *testing.T) {
 		HeadFetcher: &mock.ChainService{
 			State: st,
 		},
-		SlashingsPool: slashings.NewPool(),
-		Broadcaster:   mb,
+		SlashingsPool:       slashings.NewPool(),
+		Broadcaster:         mb,
+		AttestationNotifier: (&mock.ChainService{}).OperationNotifier(),
 	}
 
 	slashing, err := testutil.GenerateAttesterSlashingForValidator(st, privs[2], uint64(2))
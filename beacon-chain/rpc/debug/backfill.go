@@ -0,0 +1,23 @@
+package debug
+
+import (
+	"context"
+
+	ptypes "github.com/gogo/protobuf/types"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetBackfillStatus returns the slot of the lowest block saved by the historical block backfill
+// and whether every block back to genesis, or to the start of the retention window, is saved.
+func (ds *Server) GetBackfillStatus(_ context.Context, _ *ptypes.Empty) (*pbrpc.BackfillStatusResponse, error) {
+	if ds.BackfillFetcher == nil {
+		return nil, status.Error(codes.Unavailable, "Backfill status is not available")
+	}
+	lowestSlot, complete := ds.BackfillFetcher.BackfillProgress()
+	return &pbrpc.BackfillStatusResponse{
+		LowestSlot: lowestSlot,
+		Complete:   complete,
+	}, nil
+}
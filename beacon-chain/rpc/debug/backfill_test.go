@@ -0,0 +1,36 @@
+package debug
+
+import (
+	"context"
+	"testing"
+
+	ptypes "github.com/gogo/protobuf/types"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+type mockBackfillFetcher struct {
+	lowestSlot uint64
+	complete   bool
+}
+
+func (m *mockBackfillFetcher) BackfillProgress() (uint64, bool) {
+	return m.lowestSlot, m.complete
+}
+
+func TestDebugServer_GetBackfillStatus(t *testing.T) {
+	ds := &Server{}
+	_, err := ds.GetBackfillStatus(context.Background(), &ptypes.Empty{})
+	assert.ErrorContains(t, "Backfill status is not available", err)
+
+	ds.BackfillFetcher = &mockBackfillFetcher{lowestSlot: 640}
+	res, err := ds.GetBackfillStatus(context.Background(), &ptypes.Empty{})
+	require.NoError(t, err)
+	assert.DeepEqual(t, &pbrpc.BackfillStatusResponse{LowestSlot: 640, Complete: false}, res)
+
+	ds.BackfillFetcher = &mockBackfillFetcher{complete: true}
+	res, err = ds.GetBackfillStatus(context.Background(), &ptypes.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, true, res.Complete)
+}
@@ -0,0 +1,141 @@
+package debug
+
+import (
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Number of chain events buffered for a stream. A client falling further behind is disconnected.
+const chainEventBufferSize = 64
+
+// StreamChainEvents streams head changes, reorgs, justified and finalized checkpoint updates of the
+// chain as they happen. The current sync status is sent first, and again every time it changes.
+// Events are buffered for each stream, as the state feed blocks the chain service until every
+// subscriber has received an event. A client too slow to keep up with the buffer is disconnected.
+func (ds *Server) StreamChainEvents(_ *ptypes.Empty, stream pbrpc.Debug_StreamChainEventsServer) error {
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := ds.StateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+
+	events := make(chan *pbrpc.ChainEvent, chainEventBufferSize)
+	errs := make(chan error, 1)
+	done := make(chan struct{})
+	defer close(done)
+
+	syncing := ds.SyncChecker.Syncing()
+	events <- syncStatusEvent(syncing)
+	go func() {
+		enqueue := func(res *pbrpc.ChainEvent) bool {
+			select {
+			case events <- res:
+				return true
+			default:
+				errs <- status.Error(codes.ResourceExhausted, "Client is too slow to keep up with chain events")
+				return false
+			}
+		}
+		for {
+			select {
+			case event := <-stateChannel:
+				// The sync service does not announce every change of its status, so it is checked
+				// whenever the chain moves.
+				if s := ds.SyncChecker.Syncing(); s != syncing {
+					syncing = s
+					if !enqueue(syncStatusEvent(syncing)) {
+						return
+					}
+				}
+				if res := chainEvent(event); res != nil && !enqueue(res) {
+					return
+				}
+			case <-stateSub.Err():
+				errs <- status.Error(codes.Aborted, "Subscriber closed, exiting goroutine")
+				return
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case res := <-events:
+			if err := stream.Send(res); err != nil {
+				return status.Errorf(codes.Unavailable, "Could not send over stream: %v", err)
+			}
+		case err := <-errs:
+			return err
+		case <-ds.Ctx.Done():
+			return status.Error(codes.Canceled, "Context canceled")
+		case <-stream.Context().Done():
+			return status.Error(codes.Canceled, "Context canceled")
+		}
+	}
+}
+
+// chainEvent converts a state feed event to its rpc representation, or returns nil if the event
+// is not streamed.
+func chainEvent(event *feed.Event) *pbrpc.ChainEvent {
+	switch event.Type {
+	case statefeed.NewHead:
+		data, ok := event.Data.(*statefeed.NewHeadData)
+		if !ok {
+			return nil
+		}
+		return &pbrpc.ChainEvent{
+			Type: pbrpc.ChainEvent_HEAD,
+			Head: &pbrpc.HeadEvent{
+				Slot:              data.Slot,
+				BlockRoot:         data.BlockRoot[:],
+				PreviousBlockRoot: data.PreviousBlockRoot[:],
+				EpochTransition:   data.EpochTransition,
+			},
+		}
+	case statefeed.Reorg:
+		data, ok := event.Data.(*statefeed.ReorgData)
+		if !ok {
+			return nil
+		}
+		return &pbrpc.ChainEvent{
+			Type: pbrpc.ChainEvent_REORG,
+			Reorg: &pbrpc.ReorgEvent{
+				OldHeadRoot:        data.OldHeadRoot[:],
+				OldHeadSlot:        data.OldSlot,
+				NewHeadRoot:        data.NewHeadRoot[:],
+				NewHeadSlot:        data.NewSlot,
+				CommonAncestorRoot: data.CommonAncestorRoot[:],
+				CommonAncestorSlot: data.CommonAncestorSlot,
+				Depth:              data.Depth,
+			},
+		}
+	case statefeed.FinalizedCheckpoint, statefeed.JustifiedCheckpoint:
+		data, ok := event.Data.(*statefeed.CheckpointData)
+		if !ok {
+			return nil
+		}
+		eventType := pbrpc.ChainEvent_JUSTIFIED_CHECKPOINT
+		if event.Type == statefeed.FinalizedCheckpoint {
+			eventType = pbrpc.ChainEvent_FINALIZED_CHECKPOINT
+		}
+		return &pbrpc.ChainEvent{
+			Type: eventType,
+			Checkpoint: &pbrpc.CheckpointEvent{
+				Epoch: data.Epoch,
+				Root:  data.Root[:],
+			},
+		}
+	default:
+		return nil
+	}
+}
+
+func syncStatusEvent(syncing bool) *pbrpc.ChainEvent {
+	return &pbrpc.ChainEvent{
+		Type:       pbrpc.ChainEvent_SYNC_STATUS,
+		SyncStatus: &pbrpc.SyncStatusEvent{Syncing: syncing},
+	}
+}
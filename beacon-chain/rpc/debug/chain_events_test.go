@@ -0,0 +1,194 @@
+package debug
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ptypes "github.com/gogo/protobuf/types"
+	chainMock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc"
+)
+
+type mockChainEventsStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *pbrpc.ChainEvent
+}
+
+func (m *mockChainEventsStream) Send(e *pbrpc.ChainEvent) error {
+	m.sent <- e
+	return nil
+}
+
+func (m *mockChainEventsStream) Context() context.Context {
+	return m.ctx
+}
+
+func TestDebugServer_StreamChainEvents(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	chainService := &chainMock.ChainService{}
+	ds := &Server{
+		Ctx:           context.Background(),
+		StateNotifier: chainService.StateNotifier(),
+		SyncChecker:   &mockSync.Sync{IsSyncing: true},
+	}
+	stream := &mockChainEventsStream{ctx: ctx, sent: make(chan *pbrpc.ChainEvent, 1)}
+	done := make(chan error)
+	go func() {
+		done <- ds.StreamChainEvents(&ptypes.Empty{}, stream)
+	}()
+
+	assert.DeepEqual(t, &pbrpc.ChainEvent{
+		Type:       pbrpc.ChainEvent_SYNC_STATUS,
+		SyncStatus: &pbrpc.SyncStatusEvent{Syncing: true},
+	}, <-stream.sent)
+
+	// Events of other types are not streamed.
+	for sent := 0; sent == 0; {
+		sent = ds.StateNotifier.StateFeed().Send(&feed.Event{
+			Type: statefeed.BlockProcessed,
+			Data: &statefeed.BlockProcessedData{},
+		})
+	}
+	ds.StateNotifier.StateFeed().Send(&feed.Event{
+		Type: statefeed.NewHead,
+		Data: &statefeed.NewHeadData{
+			Slot:              33,
+			BlockRoot:         [32]byte{'b'},
+			PreviousBlockRoot: [32]byte{'a'},
+			EpochTransition:   true,
+		},
+	})
+	assert.DeepEqual(t, &pbrpc.ChainEvent{
+		Type: pbrpc.ChainEvent_HEAD,
+		Head: &pbrpc.HeadEvent{
+			Slot:              33,
+			BlockRoot:         bytesutil.PadTo([]byte{'b'}, 32),
+			PreviousBlockRoot: bytesutil.PadTo([]byte{'a'}, 32),
+			EpochTransition:   true,
+		},
+	}, <-stream.sent)
+
+	cancel()
+	assert.ErrorContains(t, "Context canceled", <-done)
+}
+
+func TestDebugServer_StreamChainEvents_DisconnectsSlowClient(t *testing.T) {
+	chainService := &chainMock.ChainService{}
+	ds := &Server{
+		Ctx:           context.Background(),
+		StateNotifier: chainService.StateNotifier(),
+		SyncChecker:   &mockSync.Sync{},
+	}
+	// The client never reads the events, so the first send hangs.
+	stream := &mockChainEventsStream{ctx: context.Background(), sent: make(chan *pbrpc.ChainEvent)}
+	done := make(chan error)
+	go func() {
+		done <- ds.StreamChainEvents(&ptypes.Empty{}, stream)
+	}()
+
+	for sent := 0; sent == 0; {
+		sent = ds.StateNotifier.StateFeed().Send(&feed.Event{
+			Type: statefeed.BlockProcessed,
+			Data: &statefeed.BlockProcessedData{},
+		})
+	}
+	// Sending to the feed never blocks on the client, until it is disconnected.
+	for i := 0; i <= chainEventBufferSize; i++ {
+		ds.StateNotifier.StateFeed().Send(&feed.Event{
+			Type: statefeed.NewHead,
+			Data: &statefeed.NewHeadData{Slot: uint64(i)},
+		})
+	}
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case <-stream.sent:
+		case err := <-done:
+			assert.ErrorContains(t, "too slow to keep up", err)
+			return
+		case <-timeout:
+			t.Fatal("Slow client was not disconnected")
+		}
+	}
+}
+
+func TestChainEvent(t *testing.T) {
+	tests := []struct {
+		name  string
+		event *feed.Event
+		want  *pbrpc.ChainEvent
+	}{
+		{
+			name: "reorg",
+			event: &feed.Event{
+				Type: statefeed.Reorg,
+				Data: &statefeed.ReorgData{
+					NewSlot:            10,
+					OldSlot:            9,
+					OldHeadRoot:        [32]byte{'a'},
+					NewHeadRoot:        [32]byte{'b'},
+					CommonAncestorRoot: [32]byte{'c'},
+					CommonAncestorSlot: 7,
+					Depth:              2,
+				},
+			},
+			want: &pbrpc.ChainEvent{
+				Type: pbrpc.ChainEvent_REORG,
+				Reorg: &pbrpc.ReorgEvent{
+					OldHeadRoot:        bytesutil.PadTo([]byte{'a'}, 32),
+					OldHeadSlot:        9,
+					NewHeadRoot:        bytesutil.PadTo([]byte{'b'}, 32),
+					NewHeadSlot:        10,
+					CommonAncestorRoot: bytesutil.PadTo([]byte{'c'}, 32),
+					CommonAncestorSlot: 7,
+					Depth:              2,
+				},
+			},
+		},
+		{
+			name: "finalized checkpoint",
+			event: &feed.Event{
+				Type: statefeed.FinalizedCheckpoint,
+				Data: &statefeed.CheckpointData{Epoch: 3, Root: [32]byte{'f'}},
+			},
+			want: &pbrpc.ChainEvent{
+				Type:       pbrpc.ChainEvent_FINALIZED_CHECKPOINT,
+				Checkpoint: &pbrpc.CheckpointEvent{Epoch: 3, Root: bytesutil.PadTo([]byte{'f'}, 32)},
+			},
+		},
+		{
+			name: "justified checkpoint",
+			event: &feed.Event{
+				Type: statefeed.JustifiedCheckpoint,
+				Data: &statefeed.CheckpointData{Epoch: 4, Root: [32]byte{'j'}},
+			},
+			want: &pbrpc.ChainEvent{
+				Type:       pbrpc.ChainEvent_JUSTIFIED_CHECKPOINT,
+				Checkpoint: &pbrpc.CheckpointEvent{Epoch: 4, Root: bytesutil.PadTo([]byte{'j'}, 32)},
+			},
+		},
+		{
+			name:  "unexpected data",
+			event: &feed.Event{Type: statefeed.NewHead, Data: &statefeed.ReorgData{}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := chainEvent(tt.event)
+			if tt.want == nil {
+				require.Equal(t, (*pbrpc.ChainEvent)(nil), got)
+				return
+			}
+			assert.DeepEqual(t, tt.want, got)
+		})
+	}
+}
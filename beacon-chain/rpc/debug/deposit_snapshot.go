@@ -0,0 +1,33 @@
+package debug
+
+import (
+	"context"
+
+	ptypes "github.com/gogo/protobuf/types"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetDepositSnapshot returns a snapshot of the deposit trie holding the finalized deposits, which
+// another beacon node can start from rather than replaying every deposit log.
+func (ds *Server) GetDepositSnapshot(ctx context.Context, _ *ptypes.Empty) (*pbrpc.DepositSnapshotResponse, error) {
+	if ds.DepositSnapshotFetcher == nil {
+		return nil, status.Error(codes.Unavailable, "Deposit snapshot is not available")
+	}
+	snapshot, err := ds.DepositSnapshotFetcher.DepositSnapshot(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "Could not get deposit snapshot: %v", err)
+	}
+	enc, err := snapshot.Marshal()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not marshal deposit snapshot: %v", err)
+	}
+	return &pbrpc.DepositSnapshotResponse{
+		Snapshot:        enc,
+		DepositCount:    snapshot.DepositCount,
+		DepositRoot:     snapshot.DepositRoot,
+		Eth1BlockHash:   snapshot.Eth1BlockHash,
+		Eth1BlockHeight: snapshot.Eth1BlockHeight,
+	}, nil
+}
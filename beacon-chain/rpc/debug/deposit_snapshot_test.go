@@ -0,0 +1,51 @@
+package debug
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	ptypes "github.com/gogo/protobuf/types"
+	protodb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+type mockDepositSnapshotFetcher struct {
+	snapshot *protodb.DepositSnapshot
+	err      error
+}
+
+func (m *mockDepositSnapshotFetcher) DepositSnapshot(_ context.Context) (*protodb.DepositSnapshot, error) {
+	return m.snapshot, m.err
+}
+
+func TestDebugServer_GetDepositSnapshot(t *testing.T) {
+	ds := &Server{}
+	_, err := ds.GetDepositSnapshot(context.Background(), &ptypes.Empty{})
+	assert.ErrorContains(t, "Deposit snapshot is not available", err)
+
+	ds.DepositSnapshotFetcher = &mockDepositSnapshotFetcher{err: errors.New("no finalized deposits")}
+	_, err = ds.GetDepositSnapshot(context.Background(), &ptypes.Empty{})
+	assert.ErrorContains(t, "no finalized deposits", err)
+
+	snapshot := &protodb.DepositSnapshot{
+		Finalized:       [][]byte{bytesutil.PadTo([]byte("a"), 32), bytesutil.PadTo([]byte("b"), 32)},
+		DepositRoot:     bytesutil.PadTo([]byte("root"), 32),
+		DepositCount:    3,
+		Eth1BlockHash:   bytesutil.PadTo([]byte("hash"), 32),
+		Eth1BlockHeight: 100,
+	}
+	ds.DepositSnapshotFetcher = &mockDepositSnapshotFetcher{snapshot: snapshot}
+	res, err := ds.GetDepositSnapshot(context.Background(), &ptypes.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), res.DepositCount)
+	assert.DeepEqual(t, snapshot.DepositRoot, res.DepositRoot)
+	assert.DeepEqual(t, snapshot.Eth1BlockHash, res.Eth1BlockHash)
+	assert.Equal(t, uint64(100), res.Eth1BlockHeight)
+
+	decoded := &protodb.DepositSnapshot{}
+	require.NoError(t, decoded.Unmarshal(res.Snapshot))
+	assert.DeepEqual(t, snapshot, decoded)
+}
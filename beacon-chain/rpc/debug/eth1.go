@@ -0,0 +1,49 @@
+package debug
+
+import (
+	"context"
+
+	ptypes "github.com/gogo/protobuf/types"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetEth1ConnectionStatus returns the eth1 endpoint in use by the beacon node, along with the
+// health of every configured eth1 endpoint as of its last check.
+func (ds *Server) GetEth1ConnectionStatus(_ context.Context, _ *ptypes.Empty) (*pbrpc.Eth1ConnectionStatus, error) {
+	if ds.EndpointStatusFetcher == nil {
+		return nil, status.Error(codes.Unavailable, "Eth1 connection status is not available")
+	}
+	res := &pbrpc.Eth1ConnectionStatus{
+		Endpoints: make([]*pbrpc.Eth1EndpointStatus, 0),
+	}
+	for _, s := range ds.EndpointStatusFetcher.EndpointStatuses() {
+		if s.Active {
+			res.ActiveEndpoint = s.Endpoint
+		}
+		lastChecked := uint64(0)
+		if !s.LastChecked.IsZero() {
+			lastChecked = uint64(s.LastChecked.Unix())
+		}
+		res.Endpoints = append(res.Endpoints, &pbrpc.Eth1EndpointStatus{
+			Endpoint:        s.Endpoint,
+			Active:          s.Active,
+			Healthy:         s.Healthy,
+			HeadBlock:       s.HeadBlock,
+			RejectionReason: s.Reason,
+			LastChecked:     lastChecked,
+		})
+	}
+	return res, nil
+}
+
+// GetEth1DataVotes returns the eth1 data votes of the current eth1 voting period along with why
+// they are counted or not by the majority vote, the vote the next proposer would cast, and the
+// slot at which every pending deposit is estimated to become includable.
+func (ds *Server) GetEth1DataVotes(ctx context.Context, _ *ptypes.Empty) (*pbrpc.Eth1DataVotesResponse, error) {
+	if ds.Eth1DataVoteInspector == nil {
+		return nil, status.Error(codes.Unavailable, "Eth1 data votes are not available")
+	}
+	return ds.Eth1DataVoteInspector.InspectEth1DataVotes(ctx)
+}
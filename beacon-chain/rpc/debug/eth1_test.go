@@ -0,0 +1,74 @@
+package debug
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+type mockEndpointStatusFetcher struct {
+	statuses []*powchain.EndpointStatus
+}
+
+func (m *mockEndpointStatusFetcher) EndpointStatuses() []*powchain.EndpointStatus {
+	return m.statuses
+}
+
+type mockEth1DataVoteInspector struct {
+	res *pbrpc.Eth1DataVotesResponse
+}
+
+func (m *mockEth1DataVoteInspector) InspectEth1DataVotes(_ context.Context) (*pbrpc.Eth1DataVotesResponse, error) {
+	return m.res, nil
+}
+
+func TestDebugServer_GetEth1ConnectionStatus(t *testing.T) {
+	ds := &Server{}
+	_, err := ds.GetEth1ConnectionStatus(context.Background(), &ptypes.Empty{})
+	assert.ErrorContains(t, "Eth1 connection status is not available", err)
+
+	checked := time.Unix(1600000000, 0)
+	ds.EndpointStatusFetcher = &mockEndpointStatusFetcher{statuses: []*powchain.EndpointStatus{
+		{Endpoint: "http://primary:8545", Reason: "eth1 node is syncing, at block 5 of 10", LastChecked: checked},
+		{Endpoint: "http://fallback:8545", Active: true, Healthy: true, HeadBlock: 10, LastChecked: checked},
+		{Endpoint: "http://unchecked:8545"},
+	}}
+	res, err := ds.GetEth1ConnectionStatus(context.Background(), &ptypes.Empty{})
+	require.NoError(t, err)
+	assert.DeepEqual(t, &pbrpc.Eth1ConnectionStatus{
+		ActiveEndpoint: "http://fallback:8545",
+		Endpoints: []*pbrpc.Eth1EndpointStatus{
+			{
+				Endpoint:        "http://primary:8545",
+				RejectionReason: "eth1 node is syncing, at block 5 of 10",
+				LastChecked:     1600000000,
+			},
+			{
+				Endpoint:    "http://fallback:8545",
+				Active:      true,
+				Healthy:     true,
+				HeadBlock:   10,
+				LastChecked: 1600000000,
+			},
+			{Endpoint: "http://unchecked:8545"},
+		},
+	}, res)
+}
+
+func TestDebugServer_GetEth1DataVotes(t *testing.T) {
+	ds := &Server{}
+	_, err := ds.GetEth1DataVotes(context.Background(), &ptypes.Empty{})
+	assert.ErrorContains(t, "Eth1 data votes are not available", err)
+
+	want := &pbrpc.Eth1DataVotesResponse{NextProposalSlot: 10, VotesRequired: 513}
+	ds.Eth1DataVoteInspector = &mockEth1DataVoteInspector{res: want}
+	res, err := ds.GetEth1DataVotes(context.Background(), &ptypes.Empty{})
+	require.NoError(t, err)
+	assert.DeepEqual(t, want, res)
+}
@@ -81,6 +81,10 @@ func (ds *Server) getPeer(pid peer.ID) (*pbrpc.DebugPeerResponse, error) {
 	if err != nil {
 		return nil, status.Errorf(codes.NotFound, "Requested peer does not exist: %v", err)
 	}
+	gossipScore, err := peers.Scorers().GossipScorer().GossipScore(pid)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "Requested peer does not exist: %v", err)
+	}
 
 	rawPversion, err := peerStore.Get(pid, "ProtocolVersion")
 	pVersion, ok := rawPversion.(string)
@@ -99,6 +103,8 @@ func (ds *Server) getPeer(pid peer.ID) (*pbrpc.DebugPeerResponse, error) {
 		ProtocolVersion: pVersion,
 		AgentVersion:    aVersion,
 		PeerLatency:     uint64(peerStore.LatencyEWMA(pid).Milliseconds()),
+		Score:           peers.Scorers().Score(pid),
+		GossipScore:     gossipScore,
 	}
 	addresses := peerStore.Addrs(pid)
 	stringAddrs := []string{}
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	ptypes "github.com/gogo/protobuf/types"
+	"github.com/libp2p/go-libp2p-core/peer"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	mockP2p "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
@@ -19,6 +20,7 @@ func TestDebugServer_GetPeer(t *testing.T) {
 		PeerManager:  &mockP2p.MockPeerManager{BHost: mP2P.BHost},
 	}
 	firstPeer := peersProvider.Peers().All()[0]
+	peersProvider.Peers().Scorers().GossipScorer().SetGossipScores(map[peer.ID]float64{firstPeer: -200})
 
 	res, err := ds.GetPeer(context.Background(), &ethpb.PeerRequest{PeerId: firstPeer.String()})
 	require.NoError(t, err)
@@ -26,6 +28,8 @@ func TestDebugServer_GetPeer(t *testing.T) {
 
 	assert.Equal(t, int(ethpb.PeerDirection_INBOUND), int(res.Direction), "Expected 1st peer to be an inbound connection")
 	assert.Equal(t, ethpb.ConnectionState_CONNECTED, res.ConnectionState, "Expected peer to be connected")
+	assert.Equal(t, -200.0, res.PeerInfo.GossipScore, "Unexpected gossip score")
+	assert.Equal(t, peersProvider.Peers().Scorers().Score(firstPeer), res.PeerInfo.Score, "Unexpected peer score")
 }
 
 func TestDebugServer_ListPeers(t *testing.T) {
@@ -0,0 +1,147 @@
+package debug
+
+import (
+	"context"
+	"net"
+	"time"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/libp2p/go-libp2p-core/peer"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AddTrustedPeer marks the peer at the provided multiaddress or ENR as trusted. Trusted peers
+// are always reconnected to and are exempt from the peer limit.
+func (ds *Server) AddTrustedPeer(ctx context.Context, req *pbrpc.TrustedPeerRequest) (*ptypes.Empty, error) {
+	info, err := p2p.ParsePeerAddr(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to parse provided peer address: %v", err)
+	}
+	if err := ds.PeerController.AddTrustedPeer(*info); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not add trusted peer: %v", err)
+	}
+	return &ptypes.Empty{}, nil
+}
+
+// RemoveTrustedPeer removes the trusted mark of the peer defined by the provided peer id.
+func (ds *Server) RemoveTrustedPeer(ctx context.Context, req *ethpb.PeerRequest) (*ptypes.Empty, error) {
+	pid, err := peer.Decode(req.PeerId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to parse provided peer id: %v", err)
+	}
+	if err := ds.PeerController.RemoveTrustedPeer(pid); err != nil {
+		if err == p2p.ErrPeerNotTrusted {
+			return nil, status.Error(codes.NotFound, "Requested peer is not trusted")
+		}
+		return nil, status.Errorf(codes.Internal, "Could not remove trusted peer: %v", err)
+	}
+	return &ptypes.Empty{}, nil
+}
+
+// ListTrustedPeers returns the multiaddresses of all trusted peers.
+func (ds *Server) ListTrustedPeers(ctx context.Context, _ *ptypes.Empty) (*pbrpc.TrustedPeersResponse, error) {
+	addrs := make([]string, 0)
+	for _, info := range ds.PeersFetcher.Peers().Trusted() {
+		p2pAddrs, err := peer.AddrInfoToP2pAddrs(&info)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not convert trusted peer address: %v", err)
+		}
+		for _, addr := range p2pAddrs {
+			addrs = append(addrs, addr.String())
+		}
+	}
+	return &pbrpc.TrustedPeersResponse{Addresses: addrs}, nil
+}
+
+// BanPeer bans either the provided peer id or ip address for the requested number of seconds,
+// or permanently if no duration is given, and disconnects from the affected peers.
+func (ds *Server) BanPeer(ctx context.Context, req *pbrpc.BanPeerRequest) (*ptypes.Empty, error) {
+	pid, ip, err := banTarget(req)
+	if err != nil {
+		return nil, err
+	}
+	duration := time.Duration(req.DurationSeconds) * time.Second
+	if ip != nil {
+		err = ds.PeerController.BanIP(ctx, ip, duration)
+	} else {
+		err = ds.PeerController.BanPeer(ctx, pid, duration)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not ban peer: %v", err)
+	}
+	return &ptypes.Empty{}, nil
+}
+
+// UnbanPeer lifts the ban of either the provided peer id or ip address.
+func (ds *Server) UnbanPeer(ctx context.Context, req *pbrpc.BanPeerRequest) (*ptypes.Empty, error) {
+	pid, ip, err := banTarget(req)
+	if err != nil {
+		return nil, err
+	}
+	if ip != nil {
+		err = ds.PeerController.UnbanIP(ip)
+	} else {
+		err = ds.PeerController.UnbanPeer(pid)
+	}
+	if err != nil {
+		if err == p2p.ErrNotBanned {
+			return nil, status.Error(codes.NotFound, "Requested peer or ip address is not banned")
+		}
+		return nil, status.Errorf(codes.Internal, "Could not unban peer: %v", err)
+	}
+	return &ptypes.Empty{}, nil
+}
+
+// ListPeerBans returns all peer id and ip address bans which are in effect.
+func (ds *Server) ListPeerBans(ctx context.Context, _ *ptypes.Empty) (*pbrpc.PeerBansResponse, error) {
+	bans := make([]*pbrpc.PeerBan, 0)
+	for _, ban := range ds.PeersFetcher.Peers().Bans() {
+		pbBan := &pbrpc.PeerBan{}
+		if ban.IP != nil {
+			pbBan.Ip = ban.IP.String()
+		} else {
+			pbBan.PeerId = ban.PeerID.String()
+		}
+		if !ban.Until.IsZero() {
+			pbBan.ExpiresAt = uint64(ban.Until.Unix())
+		}
+		bans = append(bans, pbBan)
+	}
+	return &pbrpc.PeerBansResponse{Bans: bans}, nil
+}
+
+// DisconnectPeer sends a goodbye message with the provided reason to the peer defined by the
+// provided peer id and disconnects from it.
+func (ds *Server) DisconnectPeer(ctx context.Context, req *pbrpc.DisconnectPeerRequest) (*ptypes.Empty, error) {
+	pid, err := peer.Decode(req.PeerId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to parse provided peer id: %v", err)
+	}
+	if err := ds.PeerController.DisconnectPeer(ctx, pid, req.Reason); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not disconnect peer: %v", err)
+	}
+	return &ptypes.Empty{}, nil
+}
+
+// banTarget returns either the peer id or the ip address of a ban request.
+func banTarget(req *pbrpc.BanPeerRequest) (peer.ID, net.IP, error) {
+	if (req.PeerId == "") == (req.Ip == "") {
+		return "", nil, status.Error(codes.InvalidArgument, "Expected either a peer id or an ip address")
+	}
+	if req.Ip != "" {
+		ip := net.ParseIP(req.Ip)
+		if ip == nil {
+			return "", nil, status.Errorf(codes.InvalidArgument, "Unable to parse provided ip address %s", req.Ip)
+		}
+		return "", ip, nil
+	}
+	pid, err := peer.Decode(req.PeerId)
+	if err != nil {
+		return "", nil, status.Errorf(codes.InvalidArgument, "Unable to parse provided peer id: %v", err)
+	}
+	return pid, nil, nil
+}
@@ -0,0 +1,97 @@
+package debug
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/libp2p/go-libp2p-core/peer"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mockP2p "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestDebugServer_TrustedPeers(t *testing.T) {
+	peersProvider := &mockP2p.MockPeersProvider{}
+	ds := &Server{
+		PeersFetcher:   peersProvider,
+		PeerController: &mockP2p.MockPeerController{PeerStatus: peersProvider.Peers()},
+	}
+	addr := "/ip4/127.0.0.1/tcp/13000/p2p/16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR"
+
+	_, err := ds.AddTrustedPeer(context.Background(), &pbrpc.TrustedPeerRequest{Address: "not an address"})
+	assert.ErrorContains(t, "Unable to parse provided peer address", err)
+
+	_, err = ds.AddTrustedPeer(context.Background(), &pbrpc.TrustedPeerRequest{Address: addr})
+	require.NoError(t, err)
+	res, err := ds.ListTrustedPeers(context.Background(), &ptypes.Empty{})
+	require.NoError(t, err)
+	assert.DeepEqual(t, []string{addr}, res.Addresses)
+
+	_, err = ds.RemoveTrustedPeer(context.Background(), &ethpb.PeerRequest{PeerId: "16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR"})
+	require.NoError(t, err)
+	res, err = ds.ListTrustedPeers(context.Background(), &ptypes.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(res.Addresses))
+}
+
+func TestDebugServer_BanPeer(t *testing.T) {
+	peersProvider := &mockP2p.MockPeersProvider{}
+	ds := &Server{
+		PeersFetcher:   peersProvider,
+		PeerController: &mockP2p.MockPeerController{PeerStatus: peersProvider.Peers()},
+	}
+	pid := peersProvider.Peers().All()[0]
+
+	_, err := ds.BanPeer(context.Background(), &pbrpc.BanPeerRequest{})
+	assert.ErrorContains(t, "Expected either a peer id or an ip address", err)
+	_, err = ds.BanPeer(context.Background(), &pbrpc.BanPeerRequest{PeerId: pid.String(), Ip: "10.0.0.1"})
+	assert.ErrorContains(t, "Expected either a peer id or an ip address", err)
+	_, err = ds.BanPeer(context.Background(), &pbrpc.BanPeerRequest{Ip: "10.0.0"})
+	assert.ErrorContains(t, "Unable to parse provided ip address", err)
+
+	_, err = ds.BanPeer(context.Background(), &pbrpc.BanPeerRequest{PeerId: pid.String(), DurationSeconds: 60})
+	require.NoError(t, err)
+	_, err = ds.BanPeer(context.Background(), &pbrpc.BanPeerRequest{Ip: "10.0.0.1"})
+	require.NoError(t, err)
+	assert.Equal(t, true, peersProvider.Peers().IsBanned(pid))
+	assert.Equal(t, true, peersProvider.Peers().IsIPBanned(net.ParseIP("10.0.0.1")))
+
+	res, err := ds.ListPeerBans(context.Background(), &ptypes.Empty{})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(res.Bans))
+	for _, ban := range res.Bans {
+		if ban.Ip != "" {
+			assert.Equal(t, "10.0.0.1", ban.Ip)
+			assert.Equal(t, uint64(0), ban.ExpiresAt, "Expected a permanent ip ban")
+		} else {
+			assert.Equal(t, pid.String(), ban.PeerId)
+			assert.NotEqual(t, uint64(0), ban.ExpiresAt, "Expected a temporary peer ban")
+		}
+	}
+
+	_, err = ds.UnbanPeer(context.Background(), &pbrpc.BanPeerRequest{PeerId: pid.String()})
+	require.NoError(t, err)
+	_, err = ds.UnbanPeer(context.Background(), &pbrpc.BanPeerRequest{Ip: "10.0.0.1"})
+	require.NoError(t, err)
+	res, err = ds.ListPeerBans(context.Background(), &ptypes.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(res.Bans))
+}
+
+func TestDebugServer_DisconnectPeer(t *testing.T) {
+	controller := &mockP2p.MockPeerController{}
+	ds := &Server{PeerController: controller}
+	pid, err := peer.Decode("16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR")
+	require.NoError(t, err)
+
+	_, err = ds.DisconnectPeer(context.Background(), &pbrpc.DisconnectPeerRequest{PeerId: "bad"})
+	assert.ErrorContains(t, "Unable to parse provided peer id", err)
+
+	_, err = ds.DisconnectPeer(context.Background(), &pbrpc.DisconnectPeerRequest{PeerId: pid.String(), Reason: 2})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), controller.Disconnected[pid])
+}
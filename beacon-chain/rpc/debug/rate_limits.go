@@ -0,0 +1,45 @@
+package debug
+
+import (
+	"context"
+	"sort"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListRateLimits returns the fill levels of the inbound and outbound req/resp rate limiting
+// buckets of the peer defined by the provided peer id, or of all connected peers if none is given.
+func (ds *Server) ListRateLimits(ctx context.Context, req *pbrpc.RateLimitsRequest) (*pbrpc.RateLimitsResponse, error) {
+	if ds.RateLimitsFetcher == nil {
+		return nil, status.Error(codes.Unavailable, "Rate limits are not available")
+	}
+	var pids []peer.ID
+	if req.PeerId != "" {
+		pid, err := peer.Decode(req.PeerId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Unable to parse provided peer id: %v", err)
+		}
+		pids = append(pids, pid)
+	}
+	peers := make([]*pbrpc.PeerRateLimits, 0)
+	for pid, buckets := range ds.RateLimitsFetcher.RateLimits(pids...) {
+		pbBuckets := make([]*pbrpc.RateLimitBucket, 0, len(buckets))
+		for _, bucket := range buckets {
+			pbBuckets = append(pbBuckets, &pbrpc.RateLimitBucket{
+				Topic:     bucket.Topic,
+				Outbound:  bucket.Outbound,
+				Capacity:  bucket.Capacity,
+				Remaining: bucket.Remaining,
+				Rate:      bucket.Rate,
+			})
+		}
+		peers = append(peers, &pbrpc.PeerRateLimits{PeerId: pid.String(), Buckets: pbBuckets})
+	}
+	sort.Slice(peers, func(i, j int) bool {
+		return peers[i].PeerId < peers[j].PeerId
+	})
+	return &pbrpc.RateLimitsResponse{Peers: peers}, nil
+}
@@ -0,0 +1,66 @@
+package debug
+
+import (
+	"context"
+	"testing"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	chainSync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+type mockRateLimitsFetcher struct {
+	limits map[peer.ID][]*chainSync.RateLimitBucket
+}
+
+func (m *mockRateLimitsFetcher) RateLimits(pids ...peer.ID) map[peer.ID][]*chainSync.RateLimitBucket {
+	if len(pids) == 0 {
+		return m.limits
+	}
+	limits := make(map[peer.ID][]*chainSync.RateLimitBucket)
+	for _, pid := range pids {
+		limits[pid] = m.limits[pid]
+	}
+	return limits
+}
+
+func TestDebugServer_ListRateLimits(t *testing.T) {
+	pid1, err := peer.Decode("16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR")
+	require.NoError(t, err)
+	pid2, err := peer.Decode("16Uiu2HAm7yD5fhhw1Kihg5pffaGbvKV3k7sqxRGHMZzkb7u9UUxQ")
+	require.NoError(t, err)
+	topic := "/eth2/beacon_chain/req/status/1/ssz_snappy"
+	ds := &Server{
+		RateLimitsFetcher: &mockRateLimitsFetcher{limits: map[peer.ID][]*chainSync.RateLimitBucket{
+			pid1: {
+				{Topic: topic, Capacity: 5, Remaining: 4, Rate: 1},
+				{Topic: topic, Outbound: true, Capacity: 5, Remaining: 5, Rate: 1},
+			},
+			pid2: {
+				{Topic: topic, Capacity: 5, Remaining: 0, Rate: 1},
+			},
+		}},
+	}
+
+	res, err := ds.ListRateLimits(context.Background(), &pbrpc.RateLimitsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(res.Peers))
+	for _, p := range res.Peers {
+		if p.PeerId == pid1.String() {
+			require.Equal(t, 2, len(p.Buckets))
+			assert.DeepEqual(t, &pbrpc.RateLimitBucket{Topic: topic, Capacity: 5, Remaining: 4, Rate: 1}, p.Buckets[0])
+			assert.Equal(t, true, p.Buckets[1].Outbound)
+		}
+	}
+
+	res, err = ds.ListRateLimits(context.Background(), &pbrpc.RateLimitsRequest{PeerId: pid2.String()})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.Peers))
+	assert.Equal(t, pid2.String(), res.Peers[0].PeerId)
+	assert.Equal(t, int64(0), res.Peers[0].Buckets[0].Remaining)
+
+	_, err = ds.ListRateLimits(context.Background(), &pbrpc.RateLimitsRequest{PeerId: "not a peer id"})
+	assert.ErrorContains(t, "Unable to parse provided peer id", err)
+}
@@ -11,9 +11,14 @@ import (
 	ptypes "github.com/gogo/protobuf/types"
 	golog "github.com/ipfs/go-log/v2"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/validator"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	chainSync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync/backfill"
 	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
@@ -24,12 +29,21 @@ import (
 // providing RPC endpoints for runtime debugging of a node, this server is
 // gated behind the feature flag --enable-debug-rpc-endpoints.
 type Server struct {
-	BeaconDB           db.NoHeadAccessDatabase
-	GenesisTimeFetcher blockchain.TimeFetcher
-	StateGen           *stategen.State
-	HeadFetcher        blockchain.HeadFetcher
-	PeerManager        p2p.PeerManager
-	PeersFetcher       p2p.PeersProvider
+	Ctx                    context.Context
+	BeaconDB               db.NoHeadAccessDatabase
+	GenesisTimeFetcher     blockchain.TimeFetcher
+	StateGen               *stategen.State
+	HeadFetcher            blockchain.HeadFetcher
+	PeerManager            p2p.PeerManager
+	PeersFetcher           p2p.PeersProvider
+	PeerController         p2p.PeerController
+	RateLimitsFetcher      chainSync.RateLimitsFetcher
+	StateNotifier          statefeed.Notifier
+	SyncChecker            chainSync.Checker
+	BackfillFetcher        backfill.ProgressFetcher
+	EndpointStatusFetcher  powchain.EndpointStatusFetcher
+	DepositSnapshotFetcher powchain.DepositSnapshotFetcher
+	Eth1DataVoteInspector  validator.Eth1DataVoteInspector
 }
 
 // SetLoggingLevel of a beacon node according to a request type,
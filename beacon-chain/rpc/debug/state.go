@@ -3,8 +3,11 @@ package debug
 import (
 	"context"
 
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -29,6 +32,9 @@ func (ds *Server) GetBeaconState(
 		}
 
 		st, err := ds.StateGen.StateBySlot(ctx, q.Slot)
+		if errors.Is(err, db.ErrHistoryPruned) {
+			return nil, status.Errorf(codes.OutOfRange, "Could not compute state by slot: %v", err)
+		}
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "Could not compute state by slot: %v", err)
 		}
@@ -40,7 +46,11 @@ func (ds *Server) GetBeaconState(
 			Encoded: encoded,
 		}, nil
 	case *pbrpc.BeaconStateRequest_BlockRoot:
-		st, err := ds.StateGen.StateByRoot(ctx, bytesutil.ToBytes32(q.BlockRoot))
+		blockRoot := bytesutil.ToBytes32(q.BlockRoot)
+		if err := ds.checkRootRetained(ctx, blockRoot); err != nil {
+			return nil, err
+		}
+		st, err := ds.StateGen.StateByRoot(ctx, blockRoot)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "Could not compute state by block root: %v", err)
 		}
@@ -55,3 +65,20 @@ func (ds *Server) GetBeaconState(
 		return nil, status.Error(codes.InvalidArgument, "Need to specify either a block root or slot to request state")
 	}
 }
+
+// checkRootRetained returns an OUT_OF_RANGE error if the state summary of the block root is
+// missing from a database whose history was pruned, as the state cannot be told apart from a
+// pruned one.
+func (ds *Server) checkRootRetained(ctx context.Context, blockRoot [32]byte) error {
+	if blockRoot == params.BeaconConfig().ZeroHash || ds.BeaconDB.HasState(ctx, blockRoot) || ds.StateGen.StateSummaryExists(ctx, blockRoot) {
+		return nil
+	}
+	lowest, err := ds.BeaconDB.LowestRetainedSlot(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "Could not retrieve lowest retained slot: %v", err)
+	}
+	if lowest > 0 {
+		return status.Errorf(codes.OutOfRange, "State of block %#x not found, states below slot %d were pruned", blockRoot, lowest)
+	}
+	return nil
+}
@@ -4,13 +4,18 @@ import (
 	"context"
 	"testing"
 
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
 	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestServer_GetBeaconState(t *testing.T) {
@@ -29,6 +34,7 @@ func TestServer_GetBeaconState(t *testing.T) {
 	require.NoError(t, gen.SaveState(ctx, gRoot, st))
 	require.NoError(t, db.SaveState(ctx, st, gRoot))
 	bs := &Server{
+		BeaconDB:           db,
 		StateGen:           gen,
 		GenesisTimeFetcher: &mock.ChainService{},
 	}
@@ -54,6 +60,49 @@ func TestServer_GetBeaconState(t *testing.T) {
 	assert.DeepEqual(t, wanted, res.Encoded)
 }
 
+func TestServer_GetBeaconState_Pruned(t *testing.T) {
+	db, sc := dbTest.SetupDB(t)
+	ctx := context.Background()
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	var roots [][32]byte
+	parentRoot := [32]byte{}
+	for i := uint64(0); i <= 2*slotsPerEpoch; i++ {
+		b := testutil.NewBeaconBlock()
+		b.Block.Slot = i
+		b.Block.ParentRoot = parentRoot[:]
+		require.NoError(t, db.SaveBlock(ctx, b))
+		root, err := b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, db.SaveStateSummary(ctx, &pbp2p.StateSummary{Slot: i, Root: root[:]}))
+		if i == 0 || i == slotsPerEpoch {
+			st := testutil.NewBeaconState()
+			require.NoError(t, st.SetSlot(i))
+			require.NoError(t, db.SaveState(ctx, st, root))
+		}
+		roots = append(roots, root)
+		parentRoot = root
+	}
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, roots[0]))
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 2, Root: parentRoot[:]}))
+	ds := &Server{
+		BeaconDB:           db,
+		StateGen:           stategen.New(db, sc),
+		GenesisTimeFetcher: &mock.ChainService{},
+	}
+	lowest, err := db.PruneHistory(ctx, slotsPerEpoch)
+	require.NoError(t, err)
+	require.Equal(t, slotsPerEpoch, lowest)
+
+	req := &pbrpc.BeaconStateRequest{
+		QueryFilter: &pbrpc.BeaconStateRequest_BlockRoot{BlockRoot: roots[1][:]},
+	}
+	_, err = ds.GetBeaconState(ctx, req)
+	assert.Equal(t, codes.OutOfRange, status.Code(err))
+	req.QueryFilter = &pbrpc.BeaconStateRequest_BlockRoot{BlockRoot: roots[slotsPerEpoch][:]}
+	_, err = ds.GetBeaconState(ctx, req)
+	require.NoError(t, err)
+}
+
 func TestServer_GetBeaconState_RequestFutureSlot(t *testing.T) {
 
 	ds := &Server{GenesisTimeFetcher: &mock.ChainService{}}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"strconv"
 	"time"
 
 	ptypes "github.com/gogo/protobuf/types"
@@ -17,9 +18,11 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync/backfill"
 	"github.com/prysmaticlabs/prysm/shared/version"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
@@ -28,6 +31,7 @@ import (
 // version information, and services the node implements and runs.
 type Server struct {
 	SyncChecker        sync.Checker
+	BackfillFetcher    backfill.ProgressFetcher
 	Server             *grpc.Server
 	BeaconDB           db.ReadOnlyDatabase
 	PeersFetcher       p2p.PeersProvider
@@ -36,8 +40,28 @@ type Server struct {
 	GenesisFetcher     blockchain.GenesisFetcher
 }
 
-// GetSyncStatus checks the current network sync status of the node.
+const (
+	// BackfillLowestSlotHeader is the response header reporting the slot of the lowest
+	// block available in the database.
+	BackfillLowestSlotHeader = "x-backfill-lowest-slot"
+	// BackfillCompleteHeader is the response header reporting whether every block back
+	// to genesis, or to the start of the history retention window, is available in the
+	// database.
+	BackfillCompleteHeader = "x-backfill-complete"
+)
+
+// GetSyncStatus checks the current network sync status of the node. The progress of the
+// historical block backfill of a checkpoint-synced node is reported in the response headers.
 func (ns *Server) GetSyncStatus(ctx context.Context, _ *ptypes.Empty) (*ethpb.SyncStatus, error) {
+	if ns.BackfillFetcher != nil {
+		lowestSlot, complete := ns.BackfillFetcher.BackfillProgress()
+		if err := grpc.SetHeader(ctx, metadata.Pairs(
+			BackfillLowestSlotHeader, strconv.FormatUint(lowestSlot, 10),
+			BackfillCompleteHeader, strconv.FormatBool(complete),
+		)); err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not set backfill status headers: %v", err)
+		}
+	}
 	return &ethpb.SyncStatus{
 		Syncing: ns.SyncChecker.Syncing(),
 	}, nil
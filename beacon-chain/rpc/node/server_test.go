@@ -21,6 +21,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 	"github.com/prysmaticlabs/prysm/shared/version"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/reflection"
 )
 
@@ -38,6 +39,50 @@ func TestNodeServer_GetSyncStatus(t *testing.T) {
 	assert.Equal(t, true, res.Syncing)
 }
 
+type mockBackfill struct {
+	lowestSlot uint64
+	complete   bool
+}
+
+func (m *mockBackfill) BackfillProgress() (uint64, bool) {
+	return m.lowestSlot, m.complete
+}
+
+type mockTransportStream struct {
+	header metadata.MD
+}
+
+func (m *mockTransportStream) Method() string {
+	return "/ethereum.eth.v1alpha1.Node/GetSyncStatus"
+}
+
+func (m *mockTransportStream) SetHeader(md metadata.MD) error {
+	m.header = metadata.Join(m.header, md)
+	return nil
+}
+
+func (m *mockTransportStream) SendHeader(md metadata.MD) error {
+	return m.SetHeader(md)
+}
+
+func (m *mockTransportStream) SetTrailer(_ metadata.MD) error {
+	return nil
+}
+
+func TestNodeServer_GetSyncStatus_BackfillHeaders(t *testing.T) {
+	ns := &Server{
+		SyncChecker:     &mockSync.Sync{IsSyncing: false},
+		BackfillFetcher: &mockBackfill{lowestSlot: 640, complete: false},
+	}
+	stream := &mockTransportStream{}
+	ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+	res, err := ns.GetSyncStatus(ctx, &ptypes.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, false, res.Syncing)
+	assert.DeepEqual(t, []string{"640"}, stream.header.Get(BackfillLowestSlotHeader))
+	assert.DeepEqual(t, []string{"false"}, stream.header.Get(BackfillCompleteHeader))
+}
+
 func TestNodeServer_GetGenesis(t *testing.T) {
 	db, _ := dbutil.SetupDB(t)
 	ctx := context.Background()
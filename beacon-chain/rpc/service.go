@@ -31,6 +31,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/validator"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	chainSync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync/backfill"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
@@ -74,6 +75,7 @@ type Service struct {
 	exitPool                *voluntaryexits.Pool
 	slashingsPool           *slashings.Pool
 	syncService             chainSync.Checker
+	backfillService         backfill.ProgressFetcher
 	host                    string
 	port                    string
 	listener                net.Listener
@@ -86,6 +88,10 @@ type Service struct {
 	p2p                     p2p.Broadcaster
 	peersFetcher            p2p.PeersProvider
 	peerManager             p2p.PeerManager
+	peerController          p2p.PeerController
+	rateLimitsFetcher       chainSync.RateLimitsFetcher
+	endpointStatusFetcher   powchain.EndpointStatusFetcher
+	depositSnapshotFetcher  powchain.DepositSnapshotFetcher
 	depositFetcher          depositcache.DepositFetcher
 	pendingDepositFetcher   depositcache.PendingDepositsFetcher
 	stateNotifier           statefeed.Notifier
@@ -123,9 +129,14 @@ type Config struct {
 	ExitPool                *voluntaryexits.Pool
 	SlashingsPool           *slashings.Pool
 	SyncService             chainSync.Checker
+	BackfillService         backfill.ProgressFetcher
 	Broadcaster             p2p.Broadcaster
 	PeersFetcher            p2p.PeersProvider
 	PeerManager             p2p.PeerManager
+	PeerController          p2p.PeerController
+	RateLimitsFetcher       chainSync.RateLimitsFetcher
+	EndpointStatusFetcher   powchain.EndpointStatusFetcher
+	DepositSnapshotFetcher  powchain.DepositSnapshotFetcher
 	DepositFetcher          depositcache.DepositFetcher
 	PendingDepositFetcher   depositcache.PendingDepositsFetcher
 	SlasherProvider         string
@@ -154,6 +165,10 @@ func NewService(ctx context.Context, cfg *Config) *Service {
 		p2p:                     cfg.Broadcaster,
 		peersFetcher:            cfg.PeersFetcher,
 		peerManager:             cfg.PeerManager,
+		peerController:          cfg.PeerController,
+		rateLimitsFetcher:       cfg.RateLimitsFetcher,
+		endpointStatusFetcher:   cfg.EndpointStatusFetcher,
+		depositSnapshotFetcher:  cfg.DepositSnapshotFetcher,
 		powChainService:         cfg.POWChainService,
 		chainStartFetcher:       cfg.ChainStartFetcher,
 		mockEth1Votes:           cfg.MockEth1Votes,
@@ -161,6 +176,7 @@ func NewService(ctx context.Context, cfg *Config) *Service {
 		exitPool:                cfg.ExitPool,
 		slashingsPool:           cfg.SlashingsPool,
 		syncService:             cfg.SyncService,
+		backfillService:         cfg.BackfillService,
 		host:                    cfg.Host,
 		port:                    cfg.Port,
 		withCert:                cfg.CertFlag,
@@ -255,6 +271,7 @@ func (s *Service) Start() {
 		BeaconDB:           s.beaconDB,
 		Server:             s.grpcServer,
 		SyncChecker:        s.syncService,
+		BackfillFetcher:    s.backfillService,
 		GenesisTimeFetcher: s.genesisTimeFetcher,
 		PeersFetcher:       s.peersFetcher,
 		PeerManager:        s.peerManager,
@@ -286,12 +303,21 @@ func (s *Service) Start() {
 	if s.enableDebugRPCEndpoints {
 		log.Info("Enabled debug RPC endpoints")
 		debugServer := &debug.Server{
-			GenesisTimeFetcher: s.genesisTimeFetcher,
-			BeaconDB:           s.beaconDB,
-			StateGen:           s.stateGen,
-			HeadFetcher:        s.headFetcher,
-			PeerManager:        s.peerManager,
-			PeersFetcher:       s.peersFetcher,
+			Ctx:                    s.ctx,
+			GenesisTimeFetcher:     s.genesisTimeFetcher,
+			BeaconDB:               s.beaconDB,
+			StateGen:               s.stateGen,
+			HeadFetcher:            s.headFetcher,
+			PeerManager:            s.peerManager,
+			PeersFetcher:           s.peersFetcher,
+			PeerController:         s.peerController,
+			RateLimitsFetcher:      s.rateLimitsFetcher,
+			StateNotifier:          s.stateNotifier,
+			SyncChecker:            s.syncService,
+			BackfillFetcher:        s.backfillService,
+			EndpointStatusFetcher:  s.endpointStatusFetcher,
+			DepositSnapshotFetcher: s.depositSnapshotFetcher,
+			Eth1DataVoteInspector:  validatorServer,
 		}
 		pbrpc.RegisterDebugServer(s.grpcServer, debugServer)
 	}
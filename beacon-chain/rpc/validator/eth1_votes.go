@@ -0,0 +1,239 @@
+package validator
+
+import (
+	"context"
+	"math/big"
+	"reflect"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Eth1DataVoteInspector inspects the eth1 data votes of the current eth1 voting period.
+type Eth1DataVoteInspector interface {
+	InspectEth1DataVotes(ctx context.Context) (*pbrpc.Eth1DataVotesResponse, error)
+}
+
+// InspectEth1DataVotes returns the eth1 data votes of the eth1 voting period of the next block
+// proposal along with why they are counted or not by the majority vote, the vote the next
+// proposer would cast, and the slot at which every pending deposit is estimated to become
+// includable in a block.
+func (vs *Server) InspectEth1DataVotes(ctx context.Context) (*pbrpc.Eth1DataVotesResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "ProposerServer.InspectEth1DataVotes")
+	defer span.End()
+
+	if vs.MockEth1Votes {
+		return nil, status.Error(codes.FailedPrecondition, "Eth1 data votes are mocked")
+	}
+	if !vs.Eth1InfoFetcher.IsConnectedToETH1() {
+		return nil, status.Error(codes.FailedPrecondition, "Not connected to an eth1 node")
+	}
+
+	headState, err := vs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	nextSlot := headState.Slot() + 1
+	if currentSlot := vs.GenesisTimeFetcher.CurrentSlot(); currentSlot > headState.Slot() {
+		nextSlot = currentSlot
+	}
+	beaconState, err := state.ProcessSlots(ctx, headState, nextSlot)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not advance head state to slot %d: %v", nextSlot, err)
+	}
+	proposerIndex, err := helpers.BeaconProposerIndex(beaconState)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not calculate proposer index: %v", err)
+	}
+	var nextVote *ethpb.Eth1Data
+	if featureconfig.Get().EnableEth1DataMajorityVote {
+		nextVote, err = vs.eth1DataMajorityVote(ctx, beaconState)
+	} else {
+		nextVote, err = vs.eth1Data(ctx, nextSlot)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get eth1 data vote: %v", err)
+	}
+
+	slotsPerVotingPeriod := params.BeaconConfig().EpochsPerEth1VotingPeriod * params.BeaconConfig().SlotsPerEpoch
+	votingPeriodStartSlot := nextSlot - nextSlot%slotsPerVotingPeriod
+	window, err := vs.eth1VotingWindow(ctx, vs.slotStartTime(nextSlot))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get eth1 voting window: %v", err)
+	}
+	res := &pbrpc.Eth1DataVotesResponse{
+		VotingPeriodStartSlot: votingPeriodStartSlot,
+		VotesRequired:         slotsPerVotingPeriod/2 + 1,
+		EarliestValidTime:     window.earliestValidTime,
+		LatestValidTime:       window.latestValidTime,
+		FirstValidBlock:       window.firstBlock.Uint64(),
+		LastValidBlock:        window.lastBlock.Uint64(),
+		CurrentEth1Data:       vs.HeadFetcher.HeadETH1Data(),
+		NextProposalSlot:      nextSlot,
+		NextProposerIndex:     proposerIndex,
+		NextVote:              nextVote,
+	}
+
+	res.Candidates = vs.eth1DataCandidates(ctx, beaconState, window)
+	lastBlockHash, err := vs.Eth1BlockFetcher.BlockHashByHeight(ctx, window.lastBlock)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get hash of last block by latest valid time: %v", err)
+	}
+	lastBlockDepositCount, lastBlockDepositRoot := vs.DepositFetcher.DepositsNumberAndRootAtHeight(ctx, window.lastBlock)
+	followDistanceData := &ethpb.Eth1Data{
+		BlockHash:    lastBlockHash.Bytes(),
+		DepositCount: lastBlockDepositCount,
+		DepositRoot:  lastBlockDepositRoot[:],
+	}
+	for _, candidate := range res.Candidates {
+		if reflect.DeepEqual(candidate.Eth1Data, followDistanceData) {
+			res.FollowDistanceBlock = candidate
+		}
+	}
+	if res.FollowDistanceBlock == nil {
+		res.FollowDistanceBlock = vs.eth1DataCandidate(ctx, followDistanceData, 0, window)
+	}
+
+	res.PendingDeposits, err = vs.pendingDepositInclusions(ctx, beaconState, res.Candidates, window)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not estimate inclusion of pending deposits: %v", err)
+	}
+	return res, nil
+}
+
+// eth1DataCandidates aggregates the eth1 data votes of a state, in order of first vote, and checks
+// them the way the majority vote does.
+func (vs *Server) eth1DataCandidates(
+	ctx context.Context,
+	beaconState *stateTrie.BeaconState,
+	window *eth1VotingWindow,
+) []*pbrpc.Eth1DataCandidate {
+	candidates := make([]*pbrpc.Eth1DataCandidate, 0)
+	for _, eth1Data := range beaconState.Eth1DataVotes() {
+		newVote := true
+		for _, candidate := range candidates {
+			if reflect.DeepEqual(eth1Data, candidate.Eth1Data) {
+				candidate.Votes++
+				newVote = false
+				break
+			}
+		}
+		if !newVote {
+			continue
+		}
+		candidate := vs.eth1DataCandidate(ctx, eth1Data, 1, window)
+		candidates = append(candidates, candidate)
+	}
+	return candidates
+}
+
+// eth1DataCandidate checks an eth1 data vote the way the majority vote does.
+func (vs *Server) eth1DataCandidate(
+	ctx context.Context,
+	eth1Data *ethpb.Eth1Data,
+	votes uint64,
+	window *eth1VotingWindow,
+) *pbrpc.Eth1DataCandidate {
+	height, rejection := vs.checkEth1DataVote(ctx, eth1Data, vs.HeadFetcher.HeadETH1Data(), window.firstBlock, window.lastBlock)
+	candidate := &pbrpc.Eth1DataCandidate{
+		Eth1Data:        eth1Data,
+		Votes:           votes,
+		Counted:         rejection == "",
+		RejectionReason: rejection,
+	}
+	if height != nil {
+		candidate.BlockHeight = height.Uint64()
+	}
+	return candidate
+}
+
+// pendingDepositInclusions estimates the slot at which every deposit not yet included in the
+// beacon chain becomes includable. A deposit is includable once the eth1 data of the state counts
+// it, which happens when a vote counting it gets a majority during an eth1 voting period its
+// eth1 block is in range of. The estimate assumes a block at every slot, with a vote for the
+// candidate counting the deposit during the current voting period or for the latest block in
+// range during later voting periods, and at most MAX_DEPOSITS deposits per block.
+func (vs *Server) pendingDepositInclusions(
+	ctx context.Context,
+	beaconState *stateTrie.BeaconState,
+	candidates []*pbrpc.Eth1DataCandidate,
+	window *eth1VotingWindow,
+) ([]*pbrpc.PendingDepositInclusion, error) {
+	var pendingDeps []*dbpb.DepositContainer
+	for _, dep := range vs.PendingDepositsFetcher.PendingContainers(ctx, nil) {
+		if uint64(dep.Index) >= beaconState.Eth1DepositIndex() {
+			pendingDeps = append(pendingDeps, dep)
+		}
+	}
+
+	cfg := params.BeaconConfig()
+	slotsPerVotingPeriod := cfg.EpochsPerEth1VotingPeriod * cfg.SlotsPerEpoch
+	votesRequired := slotsPerVotingPeriod/2 + 1
+	nextSlot := beaconState.Slot()
+	nextVotingPeriodSlot := nextSlot - nextSlot%slotsPerVotingPeriod + slotsPerVotingPeriod
+	genesisTime := uint64(vs.GenesisTimeFetcher.GenesisTime().Unix())
+	followDistanceTime := cfg.SecondsPerETH1Block * cfg.Eth1FollowDistance
+
+	inclusions := make([]*pbrpc.PendingDepositInclusion, 0, len(pendingDeps))
+	slot, depositsInSlot := uint64(0), uint64(0)
+	for _, dep := range pendingDeps {
+		index := uint64(dep.Index)
+		includableSlot := nextSlot
+		if index >= beaconState.Eth1Data().DepositCount {
+			blockTime, err := vs.Eth1BlockFetcher.BlockTimeByHeight(ctx, new(big.Int).SetUint64(dep.Eth1BlockHeight))
+			if err != nil {
+				return nil, err
+			}
+			// Votes cast during the current voting period, which need to reach a majority
+			// before the period ends.
+			var votes uint64
+			for _, candidate := range candidates {
+				if candidate.Counted && candidate.Eth1Data.DepositCount > index && candidate.Votes > votes {
+					votes = candidate.Votes
+				}
+			}
+			if votes >= votesRequired {
+				votes = votesRequired - 1
+			}
+			adoptionSlot := nextSlot + votesRequired - votes - 1
+			if (votes == 0 && blockTime > window.latestValidTime) || adoptionSlot >= nextVotingPeriodSlot {
+				// The first later voting period starting at least the follow distance after
+				// the eth1 block of the deposit.
+				votingPeriodSlot := nextVotingPeriodSlot
+				if minTime := blockTime + followDistanceTime; minTime > genesisTime {
+					periodTime := slotsPerVotingPeriod * cfg.SecondsPerSlot
+					periods := (minTime - genesisTime + periodTime - 1) / periodTime
+					if periods*slotsPerVotingPeriod > votingPeriodSlot {
+						votingPeriodSlot = periods * slotsPerVotingPeriod
+					}
+				}
+				adoptionSlot = votingPeriodSlot + votesRequired - 1
+			}
+			includableSlot = adoptionSlot + 1
+		}
+
+		if includableSlot > slot {
+			slot, depositsInSlot = includableSlot, 0
+		}
+		if depositsInSlot == cfg.MaxDeposits {
+			slot, depositsInSlot = slot+1, 0
+		}
+		depositsInSlot++
+		inclusions = append(inclusions, &pbrpc.PendingDepositInclusion{
+			Index:           index,
+			PublicKey:       dep.Deposit.Data.PublicKey,
+			Eth1BlockHeight: dep.Eth1BlockHeight,
+			EstimatedSlot:   slot,
+		})
+	}
+	return inclusions, nil
+}
@@ -0,0 +1,166 @@
+package validator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
+	mockPOW "github.com/prysmaticlabs/prysm/beacon-chain/powchain/testing"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_InspectEth1DataVotes(t *testing.T) {
+	resetCfg := featureconfig.InitWithReset(&featureconfig.Flags{EnableEth1DataMajorityVote: true})
+	defer resetCfg()
+	// The proposal slot lies within the first voting period of the mainnet config. Deposits
+	// cached by other tests are signed for the fork version of the minimal config.
+	params.SetupTestConfigCleanup(t)
+	params.UseMainnetConfig()
+	testutil.ResetCache()
+	ctx := context.Background()
+
+	slot := uint64(64)
+	earliestValidTime, latestValidTime := majorityVoteBoundaryTime(slot)
+	p := mockPOW.NewPOWChain().
+		InsertBlock(50, earliestValidTime, []byte("earliest")).
+		InsertBlock(51, earliestValidTime+1, []byte("first")).
+		InsertBlock(52, earliestValidTime+2, []byte("second")).
+		InsertBlock(100, latestValidTime, []byte("latest")).
+		InsertBlock(200, latestValidTime+1000, []byte("future"))
+
+	depositCache, err := depositcache.New()
+	require.NoError(t, err)
+	for i, height := range []uint64{50, 51, 200} {
+		dep := &ethpb.Deposit{Data: &ethpb.Deposit_Data{
+			PublicKey:             bytesutil.PadTo([]byte{byte(i)}, 48),
+			WithdrawalCredentials: make([]byte, 32),
+			Signature:             make([]byte, 96),
+		}}
+		root := bytesutil.ToBytes32([]byte{byte(i + 1)})
+		depositCache.InsertDeposit(ctx, dep, height, int64(i), root)
+		depositCache.InsertPendingDeposit(ctx, dep, height, int64(i), root)
+	}
+
+	headState, _ := testutil.DeterministicGenesisState(t, 64)
+	require.NoError(t, headState.SetSlot(slot-1))
+	require.NoError(t, headState.SetEth1DepositIndex(0))
+	eth1Data := func(hash string, depositCount uint64) *ethpb.Eth1Data {
+		return &ethpb.Eth1Data{
+			BlockHash:    bytesutil.PadTo([]byte(hash), 32),
+			DepositRoot:  make([]byte, 32),
+			DepositCount: depositCount,
+		}
+	}
+	require.NoError(t, headState.SetEth1Data(eth1Data("earliest", 1)))
+	first := eth1Data("first", 1)
+	second := eth1Data("second", 2)
+	unknown := eth1Data("unknown", 1)
+	behind := eth1Data("earliest", 0)
+	for _, vote := range []*ethpb.Eth1Data{first, second, first, unknown, behind} {
+		require.NoError(t, headState.AppendEth1DataVotes(vote))
+	}
+
+	currentEth1Data := &ethpb.Eth1Data{DepositCount: 1}
+	vs := &Server{
+		ChainStartFetcher:      p,
+		Eth1InfoFetcher:        p,
+		Eth1BlockFetcher:       p,
+		BlockFetcher:           p,
+		DepositFetcher:         depositCache,
+		PendingDepositsFetcher: depositCache,
+		GenesisTimeFetcher:     &mock.ChainService{Genesis: time.Now()},
+		HeadFetcher:            &mock.ChainService{State: headState, ETH1Data: currentEth1Data},
+	}
+	// Inspecting votes leaves the proposer's warning latch alone.
+	eth1DataNotification = true
+	defer func() { eth1DataNotification = false }()
+	res, err := vs.InspectEth1DataVotes(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, true, eth1DataNotification)
+
+	slotsPerVotingPeriod := params.BeaconConfig().EpochsPerEth1VotingPeriod * params.BeaconConfig().SlotsPerEpoch
+	votesRequired := slotsPerVotingPeriod/2 + 1
+	assert.Equal(t, uint64(0), res.VotingPeriodStartSlot)
+	assert.Equal(t, votesRequired, res.VotesRequired)
+	assert.Equal(t, earliestValidTime, res.EarliestValidTime)
+	assert.Equal(t, latestValidTime, res.LatestValidTime)
+	assert.Equal(t, uint64(50), res.FirstValidBlock)
+	assert.Equal(t, uint64(100), res.LastValidBlock)
+	assert.Equal(t, slot, res.NextProposalSlot)
+	assert.DeepEqual(t, currentEth1Data, res.CurrentEth1Data)
+	assert.DeepEqual(t, first, res.NextVote)
+
+	latestRoot := bytesutil.ToBytes32([]byte{2})
+	assert.DeepEqual(t, &pbrpc.Eth1DataCandidate{
+		Eth1Data: &ethpb.Eth1Data{
+			BlockHash:    bytesutil.PadTo([]byte("latest"), 32),
+			DepositCount: 2,
+			DepositRoot:  latestRoot[:],
+		},
+		BlockHeight: 100,
+		Counted:     true,
+	}, res.FollowDistanceBlock)
+	assert.DeepEqual(t, []*pbrpc.Eth1DataCandidate{
+		{Eth1Data: first, Votes: 2, BlockHeight: 51, Counted: true},
+		{Eth1Data: second, Votes: 1, BlockHeight: 52, Counted: true},
+		{Eth1Data: unknown, Votes: 1, RejectionReason: voteUnknownBlock},
+		{Eth1Data: behind, Votes: 1, BlockHeight: 50, RejectionReason: voteBelowDepositCount},
+	}, res.Candidates)
+
+	require.Equal(t, 3, len(res.PendingDeposits))
+	// Counted by the eth1 data of the state.
+	assert.Equal(t, slot, res.PendingDeposits[0].EstimatedSlot)
+	// Counted by a vote of the current voting period, which needs a majority.
+	assert.Equal(t, slot+votesRequired-1, res.PendingDeposits[1].EstimatedSlot)
+	// Counted by a vote of the next voting period.
+	assert.Equal(t, slotsPerVotingPeriod+votesRequired, res.PendingDeposits[2].EstimatedSlot)
+	assert.Equal(t, uint64(200), res.PendingDeposits[2].Eth1BlockHeight)
+
+	vs.MockEth1Votes = true
+	_, err = vs.InspectEth1DataVotes(ctx)
+	assert.ErrorContains(t, "Eth1 data votes are mocked", err)
+}
+
+func TestServer_PendingDepositInclusions_ChainGenesisTime(t *testing.T) {
+	ctx := context.Background()
+	cfg := params.BeaconConfig()
+	slotsPerVotingPeriod := cfg.EpochsPerEth1VotingPeriod * cfg.SlotsPerEpoch
+	votesRequired := slotsPerVotingPeriod/2 + 1
+	periodTime := slotsPerVotingPeriod * cfg.SecondsPerSlot
+	// The chain started long after the genesis known to the eth1 chain service, as it does for a
+	// node started from a checkpoint.
+	genesisTime := uint64(mockPOW.GenesisTime) + 10*periodTime
+	// The deposit block is the follow distance before the start of the third voting period.
+	blockTime := genesisTime + 3*periodTime - cfg.SecondsPerETH1Block*cfg.Eth1FollowDistance
+	p := mockPOW.NewPOWChain().InsertBlock(100, blockTime, []byte("deposit"))
+
+	depositCache, err := depositcache.New()
+	require.NoError(t, err)
+	dep := &ethpb.Deposit{Data: &ethpb.Deposit_Data{
+		PublicKey:             bytesutil.PadTo([]byte{1}, 48),
+		WithdrawalCredentials: make([]byte, 32),
+		Signature:             make([]byte, 96),
+	}}
+	depositCache.InsertPendingDeposit(ctx, dep, 100, 0, bytesutil.ToBytes32([]byte{1}))
+
+	beaconState := testutil.NewBeaconState()
+	require.NoError(t, beaconState.SetEth1Data(&ethpb.Eth1Data{DepositRoot: make([]byte, 32), BlockHash: make([]byte, 32)}))
+	vs := &Server{
+		Eth1BlockFetcher:       p,
+		PendingDepositsFetcher: depositCache,
+		GenesisTimeFetcher:     &mock.ChainService{Genesis: time.Unix(int64(genesisTime), 0)},
+	}
+	inclusions, err := vs.pendingDepositInclusions(ctx, beaconState, nil, &eth1VotingWindow{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(inclusions))
+	assert.Equal(t, 3*slotsPerVotingPeriod+votesRequired, inclusions[0].EstimatedSlot)
+}
@@ -48,6 +48,25 @@ type eth1DataAggregatedVote struct {
 	votes int
 }
 
+// eth1VotingWindow is the range of eth1 blocks which may be voted for during an eth1 voting period.
+type eth1VotingWindow struct {
+	earliestValidTime uint64
+	latestValidTime   uint64
+	// firstBlock is the first block not before the earliest valid time.
+	firstBlock *big.Int
+	// lastBlock is the last block not after the latest valid time, which may be before the
+	// earliest valid time if no block is in range.
+	lastBlock     *big.Int
+	lastBlockTime uint64
+}
+
+// Reasons an eth1 data vote is not counted by the majority vote.
+const (
+	voteBelowDepositCount = "deposit count is below the deposit count of the head state"
+	voteUnknownBlock      = "eth1 block is unknown"
+	voteOutOfRange        = "eth1 block is outside of the voting window"
+)
+
 // GetBlock is called by a proposer during its assigned slot to request a block to sign
 // by passing in the slot and the signed randao reveal of the slot.
 func (vs *Server) GetBlock(ctx context.Context, req *ethpb.BlockRequest) (*ethpb.BeaconBlock, error) {
@@ -74,6 +93,7 @@ func (vs *Server) GetBlock(ctx context.Context, req *ethpb.BlockRequest) (*ethpb
 		return nil, status.Errorf(codes.Internal, "Could not advance slot to calculate proposer index: %v", err)
 	}
 
+	vs.notifyEth1DataVotes()
 	var eth1Data *ethpb.Eth1Data
 	if featureconfig.Get().EnableEth1DataMajorityVote {
 		eth1Data, err = vs.eth1DataMajorityVote(ctx, head)
@@ -186,7 +206,6 @@ func (vs *Server) eth1Data(ctx context.Context, slot uint64) (*ethpb.Eth1Data, e
 	if !vs.Eth1InfoFetcher.IsConnectedToETH1() {
 		return vs.randomETH1DataVote(ctx)
 	}
-	eth1DataNotification = false
 
 	eth1VotingPeriodStartTime := vs.slotStartTime(slot)
 
@@ -230,54 +249,28 @@ func (vs *Server) eth1DataMajorityVote(ctx context.Context, beaconState *stateTr
 	if !vs.Eth1InfoFetcher.IsConnectedToETH1() {
 		return vs.randomETH1DataVote(ctx)
 	}
-	eth1DataNotification = false
-
-	eth1FollowDistance := int64(params.BeaconConfig().Eth1FollowDistance)
-	earliestValidTime := votingPeriodStartTime - 2*params.BeaconConfig().SecondsPerETH1Block*uint64(eth1FollowDistance)
-	latestValidTime := votingPeriodStartTime - params.BeaconConfig().SecondsPerETH1Block*uint64(eth1FollowDistance)
-
-	lastBlockByEarliestValidTime, err := vs.Eth1BlockFetcher.BlockNumberByTimestamp(ctx, earliestValidTime)
-	if err != nil {
-		log.WithError(err).Error("Failed to get last block by earliest valid time")
-		return vs.randomETH1DataVote(ctx)
-	}
-	timeOfLastBlockByEarliestValidTime, err := vs.Eth1BlockFetcher.BlockTimeByHeight(ctx, lastBlockByEarliestValidTime)
-	if err != nil {
-		log.WithError(err).Error("Failed to get time of last block by earliest valid time")
-		return vs.randomETH1DataVote(ctx)
-	}
-	// Increment the earliest block if the original block's time is before valid time.
-	// This is very likely to happen because BlockTimeByHeight returns the last block AT OR BEFORE the specified time.
-	if timeOfLastBlockByEarliestValidTime < earliestValidTime {
-		lastBlockByEarliestValidTime = big.NewInt(0).Add(lastBlockByEarliestValidTime, big.NewInt(1))
-	}
 
-	lastBlockByLatestValidTime, err := vs.Eth1BlockFetcher.BlockNumberByTimestamp(ctx, latestValidTime)
-	if err != nil {
-		log.WithError(err).Error("Failed to get last block by latest valid time")
-		return vs.randomETH1DataVote(ctx)
-	}
-	timeOfLastBlockByLatestValidTime, err := vs.Eth1BlockFetcher.BlockTimeByHeight(ctx, lastBlockByLatestValidTime)
+	window, err := vs.eth1VotingWindow(ctx, votingPeriodStartTime)
 	if err != nil {
-		log.WithError(err).Error("Failed to get time of last block by latest valid time")
+		log.WithError(err).Error("Failed to get eth1 voting window")
 		return vs.randomETH1DataVote(ctx)
 	}
-	if timeOfLastBlockByLatestValidTime < earliestValidTime {
+	if window.lastBlockTime < window.earliestValidTime {
 		return vs.HeadFetcher.HeadETH1Data(), nil
 	}
 
-	lastBlockDepositCount, lastBlockDepositRoot := vs.DepositFetcher.DepositsNumberAndRootAtHeight(ctx, lastBlockByLatestValidTime)
+	lastBlockDepositCount, lastBlockDepositRoot := vs.DepositFetcher.DepositsNumberAndRootAtHeight(ctx, window.lastBlock)
 	if lastBlockDepositCount == 0 {
 		return vs.ChainStartFetcher.ChainStartEth1Data(), nil
 	}
 
-	inRangeVotes, err := vs.inRangeVotes(ctx, beaconState, lastBlockByEarliestValidTime, lastBlockByLatestValidTime)
+	inRangeVotes, err := vs.inRangeVotes(ctx, beaconState, window.firstBlock, window.lastBlock)
 	if err != nil {
 		return nil, err
 	}
 	if len(inRangeVotes) == 0 {
 		if lastBlockDepositCount >= vs.HeadFetcher.HeadETH1Data().DepositCount {
-			hash, err := vs.Eth1BlockFetcher.BlockHashByHeight(ctx, lastBlockByLatestValidTime)
+			hash, err := vs.Eth1BlockFetcher.BlockHashByHeight(ctx, window.lastBlock)
 			if err != nil {
 				log.WithError(err).Error("Failed to get hash of last block by latest valid time")
 				return vs.randomETH1DataVote(ctx)
@@ -303,6 +296,41 @@ func (vs *Server) slotStartTime(slot uint64) uint64 {
 	return startTime
 }
 
+// eth1VotingWindow determines the range of eth1 blocks which may be voted for during the eth1
+// voting period starting at the given time.
+func (vs *Server) eth1VotingWindow(ctx context.Context, votingPeriodStartTime uint64) (*eth1VotingWindow, error) {
+	eth1FollowDistance := params.BeaconConfig().Eth1FollowDistance
+	window := &eth1VotingWindow{
+		earliestValidTime: votingPeriodStartTime - 2*params.BeaconConfig().SecondsPerETH1Block*eth1FollowDistance,
+		latestValidTime:   votingPeriodStartTime - params.BeaconConfig().SecondsPerETH1Block*eth1FollowDistance,
+	}
+
+	lastBlockByEarliestValidTime, err := vs.Eth1BlockFetcher.BlockNumberByTimestamp(ctx, window.earliestValidTime)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get last block by earliest valid time")
+	}
+	timeOfLastBlockByEarliestValidTime, err := vs.Eth1BlockFetcher.BlockTimeByHeight(ctx, lastBlockByEarliestValidTime)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get time of last block by earliest valid time")
+	}
+	// Increment the earliest block if the original block's time is before valid time.
+	// This is very likely to happen because BlockTimeByHeight returns the last block AT OR BEFORE the specified time.
+	if timeOfLastBlockByEarliestValidTime < window.earliestValidTime {
+		lastBlockByEarliestValidTime = big.NewInt(0).Add(lastBlockByEarliestValidTime, big.NewInt(1))
+	}
+	window.firstBlock = lastBlockByEarliestValidTime
+
+	window.lastBlock, err = vs.Eth1BlockFetcher.BlockNumberByTimestamp(ctx, window.latestValidTime)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get last block by latest valid time")
+	}
+	window.lastBlockTime, err = vs.Eth1BlockFetcher.BlockTimeByHeight(ctx, window.lastBlock)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get time of last block by latest valid time")
+	}
+	return window, nil
+}
+
 func (vs *Server) inRangeVotes(ctx context.Context,
 	beaconState *stateTrie.BeaconState,
 	firstValidBlockNumber *big.Int,
@@ -312,18 +340,8 @@ func (vs *Server) inRangeVotes(ctx context.Context,
 
 	var inRangeVotes []eth1DataSingleVote
 	for _, eth1Data := range beaconState.Eth1DataVotes() {
-		ok, height, err := vs.BlockFetcher.BlockExists(ctx, bytesutil.ToBytes32(eth1Data.BlockHash))
-		if err != nil {
-			log.WithError(err).Warning("Could not fetch eth1data height for received eth1data vote")
-		}
-		// Make sure we don't "undo deposit progress". See https://github.com/ethereum/eth2.0-specs/pull/1836
-		if eth1Data.DepositCount < currentETH1Data.DepositCount {
-			continue
-		}
-		// firstValidBlockNumber.Cmp(height) < 1 filters out all blocks before firstValidBlockNumber
-		// lastValidBlockNumber.Cmp(height) > -1 filters out all blocks after lastValidBlockNumber
-		// These filters result in the range [firstValidBlockNumber, lastValidBlockNumber]
-		if ok && firstValidBlockNumber.Cmp(height) < 1 && lastValidBlockNumber.Cmp(height) > -1 {
+		height, rejection := vs.checkEth1DataVote(ctx, eth1Data, currentETH1Data, firstValidBlockNumber, lastValidBlockNumber)
+		if rejection == "" {
 			inRangeVotes = append(inRangeVotes, eth1DataSingleVote{eth1Data: *eth1Data, blockHeight: height})
 		}
 	}
@@ -331,6 +349,37 @@ func (vs *Server) inRangeVotes(ctx context.Context,
 	return inRangeVotes, nil
 }
 
+// checkEth1DataVote returns the height of the eth1 block of a vote, nil if the block is unknown,
+// and the reason the vote is not counted by the majority vote, empty if it is counted.
+func (vs *Server) checkEth1DataVote(ctx context.Context,
+	eth1Data *ethpb.Eth1Data,
+	currentETH1Data *ethpb.Eth1Data,
+	firstValidBlockNumber *big.Int,
+	lastValidBlockNumber *big.Int) (*big.Int, string) {
+
+	ok, height, err := vs.BlockFetcher.BlockExists(ctx, bytesutil.ToBytes32(eth1Data.BlockHash))
+	if err != nil {
+		log.WithError(err).Warning("Could not fetch eth1data height for received eth1data vote")
+	}
+	if !ok {
+		height = nil
+	}
+	// Make sure we don't "undo deposit progress". See https://github.com/ethereum/eth2.0-specs/pull/1836
+	if eth1Data.DepositCount < currentETH1Data.DepositCount {
+		return height, voteBelowDepositCount
+	}
+	if height == nil {
+		return nil, voteUnknownBlock
+	}
+	// firstValidBlockNumber.Cmp(height) < 1 filters out all blocks before firstValidBlockNumber
+	// lastValidBlockNumber.Cmp(height) > -1 filters out all blocks after lastValidBlockNumber
+	// These filters result in the range [firstValidBlockNumber, lastValidBlockNumber]
+	if firstValidBlockNumber.Cmp(height) < 1 && lastValidBlockNumber.Cmp(height) > -1 {
+		return height, ""
+	}
+	return height, voteOutOfRange
+}
+
 func chosenEth1DataMajorityVote(votes []eth1DataSingleVote) eth1DataAggregatedVote {
 	var voteCount []eth1DataAggregatedVote
 	for _, singleVote := range votes {
@@ -363,10 +412,6 @@ func chosenEth1DataMajorityVote(votes []eth1DataSingleVote) eth1DataAggregatedVo
 }
 
 func (vs *Server) mockETH1DataVote(ctx context.Context, slot uint64) (*ethpb.Eth1Data, error) {
-	if !eth1DataNotification {
-		log.Warn("Beacon Node is no longer connected to an ETH1 chain, so ETH1 data votes are now mocked.")
-		eth1DataNotification = true
-	}
 	// If a mock eth1 data votes is specified, we use the following for the
 	// eth1data we provide to every proposer based on https://github.com/ethereum/eth2.0-pm/issues/62:
 	//
@@ -392,11 +437,26 @@ func (vs *Server) mockETH1DataVote(ctx context.Context, slot uint64) (*ethpb.Eth
 	}, nil
 }
 
-func (vs *Server) randomETH1DataVote(ctx context.Context) (*ethpb.Eth1Data, error) {
-	if !eth1DataNotification {
-		log.Warn("Beacon Node is no longer connected to an ETH1 chain, so ETH1 data votes are now random.")
-		eth1DataNotification = true
+// notifyEth1DataVotes warns once when the ETH1 data votes of proposed blocks stop following the
+// ETH1 chain. It is only called when proposing, so that inspecting votes leaves the latch alone.
+func (vs *Server) notifyEth1DataVotes() {
+	switch {
+	case vs.MockEth1Votes:
+		if !eth1DataNotification {
+			log.Warn("Beacon Node is no longer connected to an ETH1 chain, so ETH1 data votes are now mocked.")
+			eth1DataNotification = true
+		}
+	case !vs.Eth1InfoFetcher.IsConnectedToETH1():
+		if !eth1DataNotification {
+			log.Warn("Beacon Node is no longer connected to an ETH1 chain, so ETH1 data votes are now random.")
+			eth1DataNotification = true
+		}
+	default:
+		eth1DataNotification = false
 	}
+}
+
+func (vs *Server) randomETH1DataVote(ctx context.Context) (*ethpb.Eth1Data, error) {
 	headState, err := vs.HeadFetcher.HeadState(ctx)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,101 @@
+package sim
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+)
+
+// Clock is the slot clock shared by all nodes of a simulation. The simulation moves it from one
+// delivery of a message to the next, and from one slot to the next, so slot boundaries do not
+// depend on how long processing takes.
+//
+// Between two moves, every read of the clock is a nanosecond later than the one before it. The
+// nodes compare the times at which they handled their peers, which would otherwise be equal.
+type Clock struct {
+	lock    sync.Mutex
+	genesis time.Time
+	now     time.Time
+	last    time.Time
+	reset   func()
+}
+
+// newClock starts a clock at genesis and installs it as the clock read by timeutils.Now.
+func newClock(genesis time.Time) *Clock {
+	c := &Clock{genesis: genesis, now: genesis}
+	c.reset = timeutils.SetNow(c.Now)
+	return c
+}
+
+// Now returns the current time of the simulation.
+func (c *Clock) Now() time.Time {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.last.IsZero() || c.last.Before(c.now) {
+		c.last = c.now
+	} else {
+		c.last = c.last.Add(time.Nanosecond)
+	}
+	return c.last
+}
+
+// Genesis returns the genesis time of the simulated chain.
+func (c *Clock) Genesis() time.Time {
+	return c.genesis
+}
+
+// Advance moves the clock forward by the provided duration.
+func (c *Clock) Advance(d time.Duration) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.now = c.now.Add(d)
+}
+
+// Slot returns the current slot of the simulation.
+func (c *Clock) Slot() uint64 {
+	return uint64(c.time().Sub(c.genesis) / slotDuration())
+}
+
+// SetSlot moves the clock to the start of the provided slot.
+func (c *Clock) SetSlot(slot uint64) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.now = c.slotStart(slot)
+}
+
+// time returns the time the clock was last moved to, which the simulated network schedules
+// messages from.
+func (c *Clock) time() time.Time {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.now
+}
+
+// advanceTo moves the clock to the provided time, unless it is already past it.
+func (c *Clock) advanceTo(t time.Time) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if t.After(c.now) {
+		c.now = t
+	}
+}
+
+// nextSlotStart returns the start of the slot after the current one.
+func (c *Clock) nextSlotStart() time.Time {
+	return c.slotStart(c.Slot() + 1)
+}
+
+func (c *Clock) slotStart(slot uint64) time.Time {
+	return c.genesis.Add(time.Duration(slot) * slotDuration())
+}
+
+// stop restores the clock read by timeutils.Now.
+func (c *Clock) stop() {
+	c.reset()
+}
+
+func slotDuration() time.Duration {
+	return time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+}
@@ -0,0 +1,538 @@
+package sim
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	ggio "github.com/gogo/protobuf/io"
+	"github.com/libp2p/go-libp2p-core/host"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p-core/protocol"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
+	mocknet "github.com/libp2p/go-libp2p/p2p/net/mock"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+)
+
+// maxRPCSize is the maximum size of a pubsub rpc read off a gossip stream, the default of the
+// pubsub router.
+const maxRPCSize = 1 << 20
+
+// gossipProtocols are the protocols of the pubsub routers, whose rpcs are scheduled by the
+// simulated network.
+var gossipProtocols = map[protocol.ID]bool{
+	pubsub.GossipSubID_v11: true,
+	pubsub.GossipSubID_v10: true,
+	pubsub.FloodSubID:      true,
+}
+
+type link struct {
+	from, to int
+}
+
+// linkState accounts for the rpcs of the pubsub router of one node to another, so that the
+// simulation can tell when none of them are in flight.
+type linkState struct {
+	// stream is the gossip stream the receiving node currently reads the rpcs of the link from.
+	stream *gossipStream
+	// sent is the number of rpcs the sending router queued for the link, from its traces.
+	sent int
+	// captured is the number of rpcs read off the stream, not counting the untraced hello rpc
+	// each stream starts with.
+	captured int
+	// released is the number of rpcs delivered to the receiving router.
+	released int
+	// received is the number of rpcs the receiving router took in, from its traces.
+	received int
+	// seq numbers the rpcs of the link, in the order they were sent.
+	seq uint64
+}
+
+// delivery is an rpc waiting to be delivered by the simulated network.
+type delivery struct {
+	link   link
+	seq    uint64
+	at     time.Time
+	rpc    *pubsubpb.RPC
+	stream *gossipStream
+}
+
+// transport is the simulated network between the nodes of a simulation. Nodes in the same
+// partition group are linked and connected to each other, nodes in different groups are not.
+//
+// The rpcs of the pubsub routers are read off the network as soon as they are sent, and held
+// until the simulation delivers them, once the slot clock reaches their sending time plus the
+// latency of their link. Requests and responses of the sync protocols are not delayed.
+type transport struct {
+	mn       mocknet.Mocknet
+	hosts    []*simHost
+	indices  map[peer.ID]int
+	clock    *Clock
+	seed     int64
+	events   *signal
+	lock     sync.Mutex
+	groups   []int
+	latency  map[link]time.Duration
+	dropRate map[link]float64
+	links    map[link]*linkState
+	queue    []*delivery
+	routers  []*routerState
+}
+
+func newTransport(ctx context.Context, nodes int, latency time.Duration, seed int64, clock *Clock, events *signal) (*transport, error) {
+	n := &transport{
+		mn:       mocknet.New(ctx),
+		indices:  make(map[peer.ID]int, nodes),
+		clock:    clock,
+		seed:     seed,
+		events:   events,
+		groups:   make([]int, nodes),
+		latency:  make(map[link]time.Duration),
+		dropRate: make(map[link]float64),
+		links:    make(map[link]*linkState),
+	}
+	for i := 0; i < nodes; i++ {
+		h, err := n.mn.GenPeer()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not create host")
+		}
+		n.hosts = append(n.hosts, &simHost{Host: h, transport: n, index: i})
+		n.indices[h.ID()] = i
+		n.routers = append(n.routers, newRouterState())
+		for j := 0; j < i; j++ {
+			n.latency[link{from: i, to: j}] = latency
+			n.latency[link{from: j, to: i}] = latency
+		}
+	}
+	return n, nil
+}
+
+// connectAll links and connects every pair of nodes in the same partition group. It is called
+// once all nodes have registered their connection handlers.
+func (n *transport) connectAll() error {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	for i := range n.hosts {
+		for j := 0; j < i; j++ {
+			if n.groups[i] != n.groups[j] {
+				continue
+			}
+			if err := n.connect(i, j); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// partition regroups the nodes, disconnecting nodes which end up in different groups and
+// connecting nodes which end up in the same one.
+func (n *transport) partition(groups []int) error {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	prev := n.groups
+	n.groups = groups
+	for i := range n.hosts {
+		for j := 0; j < i; j++ {
+			wasLinked, linked := prev[i] == prev[j], groups[i] == groups[j]
+			switch {
+			case wasLinked && !linked:
+				if err := n.disconnect(i, j); err != nil {
+					return err
+				}
+			case !wasLinked && linked:
+				if err := n.connect(i, j); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (n *transport) connect(i, j int) error {
+	a, b := n.hosts[i].ID(), n.hosts[j].ID()
+	if _, err := n.mn.LinkPeers(a, b); err != nil {
+		return errors.Wrapf(err, "could not link nodes %d and %d", i, j)
+	}
+	if _, err := n.mn.ConnectPeers(a, b); err != nil {
+		return errors.Wrapf(err, "could not connect nodes %d and %d", i, j)
+	}
+	return nil
+}
+
+func (n *transport) disconnect(i, j int) error {
+	a, b := n.hosts[i].ID(), n.hosts[j].ID()
+	// The nodes are unlinked first, so that they can not dial each other again, such as to
+	// open a stream, once disconnected.
+	if err := n.mn.UnlinkPeers(a, b); err != nil {
+		return errors.Wrapf(err, "could not unlink nodes %d and %d", i, j)
+	}
+	if err := n.mn.DisconnectPeers(a, b); err != nil {
+		return errors.Wrapf(err, "could not disconnect nodes %d and %d", i, j)
+	}
+	return nil
+}
+
+// redial connects two nodes of the same partition group which are not connected, such as after
+// one of them dropped the other during the status handshake.
+func (n *transport) redial(i, j int) error {
+	a, b := n.hosts[i], n.hosts[j]
+	if a.Network().Connectedness(b.ID()) == network.Connected {
+		return nil
+	}
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	if n.groups[i] != n.groups[j] {
+		return nil
+	}
+	if _, err := n.mn.ConnectPeers(a.ID(), b.ID()); err != nil {
+		return errors.Wrapf(err, "could not connect nodes %d and %d", i, j)
+	}
+	return nil
+}
+
+// setLatency sets the latency of messages between two nodes, in both directions. It applies to
+// the messages sent from then on.
+func (n *transport) setLatency(i, j int, latency time.Duration) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	n.latency[link{from: i, to: j}] = latency
+	n.latency[link{from: j, to: i}] = latency
+}
+
+// setDropRate sets the share of gossip messages from one node which the other node drops.
+func (n *transport) setDropRate(from, to int, rate float64) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	n.dropRate[link{from: from, to: to}] = rate
+}
+
+// reachable returns whether two nodes are in the same partition group.
+func (n *transport) reachable(i, j int) bool {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	return n.groups[i] == n.groups[j]
+}
+
+// dropped decides whether a message is dropped on a link. The decision only depends on the seed
+// of the simulation, the link and the message, so that it does not depend on the order in which
+// messages are sent, and a message resent on the same link is dropped again.
+func (n *transport) dropped(l link, msg *pubsubpb.Message) bool {
+	rate := n.dropRate[l]
+	if rate <= 0 {
+		return false
+	}
+	buf := append(bytesutil.Bytes8(uint64(n.seed)), bytesutil.Bytes8(uint64(l.from))...)
+	buf = append(buf, bytesutil.Bytes8(uint64(l.to))...)
+	h := hashutil.Hash(append(buf, msg.Data...))
+	return float64(binary.LittleEndian.Uint64(h[:8]))/math.MaxUint64 < rate
+}
+
+// linkState returns the state of a link, creating it if needed.
+func (n *transport) linkState(l link) *linkState {
+	s, ok := n.links[l]
+	if !ok {
+		s = &linkState{}
+		n.links[l] = s
+	}
+	return s
+}
+
+// openStream registers the gossip stream a node reads the rpcs of a peer from, and reads them
+// off the network in the background.
+func (n *transport) openStream(stream network.Stream, to int) network.Stream {
+	from, ok := n.index(stream.Conn().RemotePeer())
+	if !ok {
+		return stream
+	}
+	l := link{from: from, to: to}
+	gs := newGossipStream(stream)
+	n.lock.Lock()
+	state := n.linkState(l)
+	state.stream = gs
+	state.captured = 0
+	state.released = 0
+	n.lock.Unlock()
+	go n.capture(gs, l)
+	return gs
+}
+
+// capture reads the rpcs of a gossip stream, and schedules their delivery. The first rpc of a
+// stream is the hello rpc of the router, which it does not trace.
+func (n *transport) capture(gs *gossipStream, l link) {
+	reader := ggio.NewDelimitedReader(gs.Stream, maxRPCSize)
+	for hello := true; ; hello = false {
+		rpc := new(pubsubpb.RPC)
+		if err := reader.ReadMsg(rpc); err != nil {
+			n.closeStream(gs, l, err)
+			return
+		}
+		n.lock.Lock()
+		state := n.linkState(l)
+		if state.stream != gs {
+			n.lock.Unlock()
+			return
+		}
+		if !hello {
+			state.captured++
+		}
+		n.queue = append(n.queue, &delivery{
+			link:   l,
+			seq:    state.seq,
+			at:     n.clock.time().Add(n.latency[l]),
+			rpc:    rpc,
+			stream: gs,
+		})
+		state.seq++
+		n.lock.Unlock()
+		n.events.notify()
+	}
+}
+
+// closeStream drops the rpcs of a stream which were not delivered before it closed.
+func (n *transport) closeStream(gs *gossipStream, l link, err error) {
+	n.lock.Lock()
+	if state := n.linkState(l); state.stream == gs {
+		state.stream = nil
+		state.captured = 0
+		state.released = 0
+	}
+	queue := n.queue[:0]
+	for _, d := range n.queue {
+		if d.stream != gs {
+			queue = append(queue, d)
+		}
+	}
+	n.queue = queue
+	n.lock.Unlock()
+	gs.closeWithError(err)
+	n.events.notify()
+}
+
+// next returns the delivery which is due first, without removing it. Deliveries due at the same
+// time are ordered by link and sending order.
+func (n *transport) next() *delivery {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	if len(n.queue) == 0 {
+		return nil
+	}
+	sort.Slice(n.queue, func(i, j int) bool {
+		a, b := n.queue[i], n.queue[j]
+		switch {
+		case !a.at.Equal(b.at):
+			return a.at.Before(b.at)
+		case a.link.from != b.link.from:
+			return a.link.from < b.link.from
+		case a.link.to != b.link.to:
+			return a.link.to < b.link.to
+		default:
+			return a.seq < b.seq
+		}
+	})
+	return n.queue[0]
+}
+
+// release delivers an rpc to the router of the receiving node, without the messages dropped on
+// its link. It returns the messages which were delivered.
+func (n *transport) release(d *delivery) ([]*pubsubpb.Message, error) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	for i, queued := range n.queue {
+		if queued == d {
+			n.queue = append(n.queue[:i], n.queue[i+1:]...)
+			break
+		}
+	}
+	state := n.linkState(d.link)
+	if state.stream != d.stream {
+		return nil, nil
+	}
+	kept := d.rpc.Publish[:0]
+	for _, msg := range d.rpc.Publish {
+		if !n.dropped(d.link, msg) {
+			kept = append(kept, msg)
+		}
+	}
+	d.rpc.Publish = kept
+	buf := new(bytes.Buffer)
+	if err := ggio.NewDelimitedWriter(buf).WriteMsg(d.rpc); err != nil {
+		return nil, errors.Wrap(err, "could not encode rpc")
+	}
+	d.stream.deliver(buf.Bytes())
+	state.released++
+	return kept, nil
+}
+
+// idle returns whether no rpc of the pubsub routers is in flight, and every message they took
+// in has been validated.
+func (n *transport) idle() bool {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	for _, state := range n.links {
+		if state.sent != state.captured || state.released != state.received {
+			return false
+		}
+	}
+	for _, r := range n.routers {
+		if r.pending != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// meshed returns whether a node has a peer in its mesh of a topic.
+func (n *transport) meshed(i, j int, topic string) bool {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	return n.routers[i].mesh[topic][j]
+}
+
+// routerPeer returns whether the router of a node has a peer.
+func (n *transport) routerPeer(i, j int) bool {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	return n.routers[i].peers[j]
+}
+
+// streamOpen returns whether a node reads gossip from a peer.
+func (n *transport) streamOpen(from, to int) bool {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	state, ok := n.links[link{from: from, to: to}]
+	return ok && state.stream != nil
+}
+
+func (n *transport) index(id peer.ID) (int, bool) {
+	i, ok := n.indices[id]
+	return i, ok
+}
+
+func (n *transport) close() error {
+	for _, h := range n.mn.Hosts() {
+		if err := h.Close(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// simHost hands the pubsub router of a node streams whose rpcs are delivered by the simulated
+// network, and the other protocols streams which ignore deadlines.
+type simHost struct {
+	host.Host
+	transport *transport
+	index     int
+}
+
+// SetStreamHandler wraps the streams of the handler.
+func (h *simHost) SetStreamHandler(pid protocol.ID, handler network.StreamHandler) {
+	if gossipProtocols[pid] {
+		h.Host.SetStreamHandler(pid, func(stream network.Stream) {
+			handler(h.transport.openStream(stream, h.index))
+		})
+		return
+	}
+	h.Host.SetStreamHandler(pid, func(stream network.Stream) {
+		handler(&simStream{stream})
+	})
+}
+
+// NewStream opens a stream which ignores deadlines.
+func (h *simHost) NewStream(ctx context.Context, p peer.ID, pids ...protocol.ID) (network.Stream, error) {
+	stream, err := h.Host.NewStream(ctx, p, pids...)
+	if err != nil {
+		return nil, err
+	}
+	return &simStream{stream}, nil
+}
+
+// gossipStream is an inbound stream of a pubsub router, which only reads the rpcs the
+// simulated network delivered.
+type gossipStream struct {
+	simStream
+	lock sync.Mutex
+	cond *sync.Cond
+	buf  bytes.Buffer
+	err  error
+}
+
+func newGossipStream(stream network.Stream) *gossipStream {
+	gs := &gossipStream{simStream: simStream{stream}}
+	gs.cond = sync.NewCond(&gs.lock)
+	return gs
+}
+
+// Read the delivered rpcs.
+func (s *gossipStream) Read(p []byte) (int, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for s.buf.Len() == 0 && s.err == nil {
+		s.cond.Wait()
+	}
+	if s.buf.Len() > 0 {
+		return s.buf.Read(p)
+	}
+	return 0, s.err
+}
+
+// Close the stream, waking up its reader.
+func (s *gossipStream) Close() error {
+	err := s.Stream.Close()
+	s.closeWithError(errors.New("stream closed"))
+	return err
+}
+
+// Reset the stream, waking up its reader.
+func (s *gossipStream) Reset() error {
+	err := s.Stream.Reset()
+	s.closeWithError(errors.New("stream reset"))
+	return err
+}
+
+func (s *gossipStream) deliver(b []byte) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.buf.Write(b)
+	s.cond.Broadcast()
+}
+
+func (s *gossipStream) closeWithError(err error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.err == nil {
+		s.err = err
+	}
+	s.cond.Broadcast()
+}
+
+// simStream wraps a stream of the simulated network, which does not support deadlines. The RPC
+// handlers give up on streams whose deadlines can not be set, so the deadlines are ignored.
+type simStream struct {
+	network.Stream
+}
+
+// SetDeadline is a no-op.
+func (s *simStream) SetDeadline(time.Time) error {
+	return nil
+}
+
+// SetReadDeadline is a no-op.
+func (s *simStream) SetReadDeadline(time.Time) error {
+	return nil
+}
+
+// SetWriteDeadline is a no-op.
+func (s *simStream) SetWriteDeadline(time.Time) error {
+	return nil
+}
@@ -0,0 +1,343 @@
+package sim
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	regularsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	initialsync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/p2putils"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+// chainStartTimeout bounds the wait for the sync service of a node to pick up the start of
+// the chain.
+const chainStartTimeout = 10 * time.Second
+
+// Node is a beacon node of a simulation, running the p2p, blockchain, sync and initial sync
+// services on its own in-memory database.
+type Node struct {
+	Index       int
+	DB          db.Database
+	Chain       *blockchain.Service
+	Sync        *regularsync.Service
+	InitialSync *initialsync.Service
+	P2P         *p2p.Service
+
+	ctx       context.Context
+	cancel    context.CancelFunc
+	notifier  *notifier
+	events    *signal
+	processed map[[32]byte]bool
+	lock      sync.RWMutex
+}
+
+// newNode creates the services of a node on a database seeded with the genesis state. The p2p
+// service runs on a host of the simulated network, which traces its gossipsub router. The node
+// notifies the events signal of the blocks it processes.
+func newNode(t testing.TB, h *simHost, genesis *stateTrie.BeaconState, events *signal) (*Node, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	// Every node of a simulation runs in the same process, so the nodes use in-memory databases
+	// rather than bolt stores, which each register the same metrics.
+	beaconDB, stateSummaryCache := dbtest.SetupInMemoryDB(t)
+	if err := saveGenesis(ctx, beaconDB, genesis.Copy()); err != nil {
+		cancel()
+		return nil, err
+	}
+	dataDir, err := ioutil.TempDir(testutil.TempDir(), "sim")
+	if err != nil {
+		cancel()
+		return nil, errors.Wrap(err, "could not create data directory")
+	}
+	t.Cleanup(func() {
+		if err := os.RemoveAll(dataDir); err != nil {
+			t.Error(err)
+		}
+	})
+
+	n := &Node{
+		Index:     h.index,
+		DB:        beaconDB,
+		ctx:       ctx,
+		cancel:    cancel,
+		notifier:  newNotifier(),
+		events:    events,
+		processed: make(map[[32]byte]bool),
+	}
+	// The simulation decides which nodes are connected, so the nodes do not discover each other.
+	p, err := p2p.NewService(ctx, &p2p.Config{
+		NoDiscovery:   true,
+		MaxPeers:      uint(len(h.transport.hosts)),
+		DataDir:       dataDir,
+		StateNotifier: n.notifier,
+		Host:          h,
+		PubSubTracer:  &tracer{transport: h.transport, index: h.index},
+	})
+	if err != nil {
+		cancel()
+		return nil, errors.Wrap(err, "could not create p2p service")
+	}
+	n.P2P = p
+	stateGen := stategen.New(beaconDB, stateSummaryCache)
+	attPool := attestations.NewPool()
+	exitPool := voluntaryexits.NewPool()
+	slashingPool := slashings.NewPool()
+	depositCache, err := depositcache.New()
+	if err != nil {
+		cancel()
+		return nil, errors.Wrap(err, "could not create deposit cache")
+	}
+	opsService, err := attestations.NewService(ctx, &attestations.Config{Pool: attPool})
+	if err != nil {
+		cancel()
+		return nil, errors.Wrap(err, "could not create attestation pool service")
+	}
+	n.Chain, err = blockchain.NewService(ctx, &blockchain.Config{
+		BeaconDB:        beaconDB,
+		DepositCache:    depositCache,
+		AttPool:         attPool,
+		ExitPool:        exitPool,
+		SlashingPool:    slashingPool,
+		P2p:             p,
+		StateNotifier:   n.notifier,
+		ForkChoiceStore: protoarray.New(0, 0, [32]byte{}),
+		OpsService:      opsService,
+		StateGen:        stateGen,
+	})
+	if err != nil {
+		cancel()
+		return nil, errors.Wrap(err, "could not create chain service")
+	}
+	n.InitialSync = initialsync.NewService(ctx, &initialsync.Config{
+		P2P:           p,
+		DB:            beaconDB,
+		Chain:         n.Chain,
+		StateNotifier: n.notifier,
+		BlockNotifier: n.notifier,
+	})
+	n.Sync = regularsync.NewService(ctx, &regularsync.Config{
+		DB:                  beaconDB,
+		P2P:                 p,
+		Chain:               n.Chain,
+		InitialSync:         n.InitialSync,
+		StateNotifier:       n.notifier,
+		BlockNotifier:       n.notifier,
+		AttestationNotifier: n.notifier,
+		AttPool:             attPool,
+		ExitPool:            exitPool,
+		SlashingPool:        slashingPool,
+		StateSummaryCache:   stateSummaryCache,
+		StateGen:            stateGen,
+		RPCQuotas:           regularsync.DefaultQuotas(),
+	})
+	stateChannel := make(chan *feed.Event, 1)
+	go n.trackProcessedBlocks(stateChannel, n.notifier.StateFeed().Subscribe(stateChannel))
+	return n, nil
+}
+
+func saveGenesis(ctx context.Context, beaconDB db.Database, genesis *stateTrie.BeaconState) error {
+	stateRoot, err := genesis.HashTreeRoot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not hash genesis state")
+	}
+	genesisBlk := blocks.NewGenesisBlock(stateRoot[:])
+	genesisRoot, err := genesisBlk.Block.HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "could not hash genesis block")
+	}
+	if err := beaconDB.SaveBlock(ctx, genesisBlk); err != nil {
+		return errors.Wrap(err, "could not save genesis block")
+	}
+	if err := beaconDB.SaveState(ctx, genesis, genesisRoot); err != nil {
+		return errors.Wrap(err, "could not save genesis state")
+	}
+	if err := beaconDB.SaveStateSummary(ctx, &pb.StateSummary{
+		Slot: 0,
+		Root: genesisRoot[:],
+	}); err != nil {
+		return errors.Wrap(err, "could not save genesis state summary")
+	}
+	if err := beaconDB.SaveHeadBlockRoot(ctx, genesisRoot); err != nil {
+		return errors.Wrap(err, "could not save head block root")
+	}
+	if err := beaconDB.SaveGenesisBlockRoot(ctx, genesisRoot); err != nil {
+		return errors.Wrap(err, "could not save genesis block root")
+	}
+	checkpoint := &ethpb.Checkpoint{Root: genesisRoot[:]}
+	if err := beaconDB.SaveJustifiedCheckpoint(ctx, checkpoint); err != nil {
+		return errors.Wrap(err, "could not save justified checkpoint")
+	}
+	if err := beaconDB.SaveFinalizedCheckpoint(ctx, checkpoint); err != nil {
+		return errors.Wrap(err, "could not save finalized checkpoint")
+	}
+	return nil
+}
+
+// start runs the services of the node in the order of the beacon node, and waits until the p2p
+// service has started and the sync service has registered its rpc and gossip handlers.
+func (n *Node) start() error {
+	// The p2p service blocks until the chain service reports the start of the chain.
+	go n.P2P.Start()
+	n.Chain.Start()
+	n.InitialSync.Start()
+	n.Sync.Start()
+
+	genesisValidatorsRoot := n.Chain.GenesisValidatorRoot()
+	// The p2p and sync services subscribe to the state feed in the background, so they may miss
+	// the initialized event sent by the chain service. The event is resent until it is received.
+	initialized := &feed.Event{
+		Type: statefeed.Initialized,
+		Data: &statefeed.InitializedData{
+			StartTime:             n.Chain.GenesisTime(),
+			GenesisValidatorsRoot: genesisValidatorsRoot[:],
+		},
+	}
+	deadline := time.After(chainStartTimeout)
+	for !n.P2P.Started() || !n.subscribed() {
+		select {
+		case <-deadline:
+			return errors.Errorf("node %d: services did not pick up the start of the chain", n.Index)
+		case <-time.After(10 * time.Millisecond):
+			n.notifier.StateFeed().Send(initialized)
+		}
+	}
+	return nil
+}
+
+// subscribed returns whether the sync service has subscribed to the gossip topics, the last of
+// which is the attester slashing topic.
+func (n *Node) subscribed() bool {
+	genesisValidatorsRoot := n.Chain.GenesisValidatorRoot()
+	digest, err := p2putils.CreateForkDigest(n.Chain.GenesisTime(), genesisValidatorsRoot[:])
+	if err != nil {
+		return false
+	}
+	topic := fmt.Sprintf(p2p.AttesterSlashingSubnetTopicFormat, digest) + n.P2P.Encoding().ProtocolSuffix()
+	for _, t := range n.P2P.PubSub().GetTopics() {
+		if t == topic {
+			return true
+		}
+	}
+	return false
+}
+
+// stop the services of the node in reverse start order.
+func (n *Node) stop() error {
+	defer n.cancel()
+	if err := n.Sync.Stop(); err != nil {
+		return err
+	}
+	if err := n.InitialSync.Stop(); err != nil {
+		return err
+	}
+	if err := n.Chain.Stop(); err != nil {
+		return err
+	}
+	return n.P2P.Stop()
+}
+
+// trackProcessedBlocks records the blocks fully processed by the chain service, so that the
+// simulation can wait for a block to reach the head of a node.
+func (n *Node) trackProcessedBlocks(events <-chan *feed.Event, sub event.Subscription) {
+	defer sub.Unsubscribe()
+	for {
+		select {
+		case e := <-events:
+			if e.Type != statefeed.BlockProcessed {
+				continue
+			}
+			data, ok := e.Data.(*statefeed.BlockProcessedData)
+			if !ok {
+				continue
+			}
+			n.lock.Lock()
+			n.processed[data.BlockRoot] = true
+			n.lock.Unlock()
+			n.events.notify()
+		case <-sub.Err():
+			return
+		case <-n.ctx.Done():
+			return
+		}
+	}
+}
+
+// HasProcessed returns whether the node has processed the block with the given root.
+func (n *Node) HasProcessed(root [32]byte) bool {
+	n.lock.RLock()
+	defer n.lock.RUnlock()
+	return n.processed[root]
+}
+
+// HeadRoot returns the root of the head block of the node.
+func (n *Node) HeadRoot() ([32]byte, error) {
+	root, err := n.Chain.HeadRoot(n.ctx)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return bytesutil.ToBytes32(root), nil
+}
+
+// HeadSlot returns the slot of the head block of the node.
+func (n *Node) HeadSlot() uint64 {
+	return n.Chain.HeadSlot()
+}
+
+// FinalizedCheckpoint returns the finalized checkpoint of the node.
+func (n *Node) FinalizedCheckpoint() *ethpb.Checkpoint {
+	return n.Chain.FinalizedCheckpt()
+}
+
+// notifier holds the event feeds of a node.
+type notifier struct {
+	stateFeed     *event.Feed
+	blockFeed     *event.Feed
+	operationFeed *event.Feed
+}
+
+func newNotifier() *notifier {
+	return &notifier{
+		stateFeed:     new(event.Feed),
+		blockFeed:     new(event.Feed),
+		operationFeed: new(event.Feed),
+	}
+}
+
+// StateFeed returns the state feed of the node.
+func (n *notifier) StateFeed() *event.Feed {
+	return n.stateFeed
+}
+
+// BlockFeed returns the block feed of the node.
+func (n *notifier) BlockFeed() *event.Feed {
+	return n.blockFeed
+}
+
+// OperationFeed returns the operation feed of the node.
+func (n *notifier) OperationFeed() *event.Feed {
+	return n.operationFeed
+}
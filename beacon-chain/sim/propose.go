@@ -0,0 +1,162 @@
+package sim
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+// proposal is a block produced by a node.
+type proposal struct {
+	node  *Node
+	block *ethpb.SignedBeaconBlock
+	root  [32]byte
+}
+
+// owner returns the index of the node running a validator.
+func (s *Simulator) owner(validator uint64) int {
+	return int(validator % uint64(len(s.nodes)))
+}
+
+// produceBlock returns the block of the node for the slot, on top of its own head, or nil if the
+// proposer of the slot is not one of its validators.
+func (s *Simulator) produceBlock(ctx context.Context, n *Node, slot uint64) (*proposal, error) {
+	if n.HeadSlot() >= slot {
+		return nil, nil
+	}
+	headRoot, err := n.HeadRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get head root")
+	}
+	headState, err := n.Chain.HeadState(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get head state")
+	}
+	st, err := state.ProcessSlots(ctx, headState.Copy(), slot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process slots")
+	}
+	proposerIndex, err := helpers.BeaconProposerIndex(st)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get proposer index")
+	}
+	if s.owner(proposerIndex) != n.Index {
+		return nil, nil
+	}
+
+	var atts []*ethpb.Attestation
+	if slot > 0 {
+		atts, err = s.attestations(st, slot-1, n.Index)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not create attestations")
+		}
+	}
+	reveal, err := testutil.RandaoReveal(st, helpers.CurrentEpoch(st), s.keys)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create randao reveal")
+	}
+	blk := &ethpb.BeaconBlock{
+		Slot:          slot,
+		ProposerIndex: proposerIndex,
+		ParentRoot:    headRoot[:],
+		Body: &ethpb.BeaconBlockBody{
+			Eth1Data:     st.Eth1Data(),
+			RandaoReveal: reveal,
+			Attestations: atts,
+			Graffiti:     make([]byte, 32),
+		},
+	}
+	// Signing fills in the state root of the block.
+	sig, err := testutil.BlockSignature(headState.Copy(), blk, s.keys)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not sign block")
+	}
+	root, err := blk.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not hash block")
+	}
+	return &proposal{
+		node:  n,
+		block: &ethpb.SignedBeaconBlock{Block: blk, Signature: sig.Marshal()},
+		root:  root,
+	}, nil
+}
+
+// attestations returns an aggregated attestation for each committee of the slot, on the view of
+// the provided state. Only validators of nodes reachable from the proposing node take part, and
+// each of them only with the configured participation rate.
+func (s *Simulator) attestations(st *stateTrie.BeaconState, slot uint64, proposer int) ([]*ethpb.Attestation, error) {
+	epoch := helpers.SlotToEpoch(slot)
+	headRoot, err := helpers.BlockRootAtSlot(st, slot)
+	if err != nil {
+		return nil, err
+	}
+	targetRoot, err := helpers.BlockRoot(st, epoch)
+	if err != nil {
+		return nil, err
+	}
+	source := st.CurrentJustifiedCheckpoint()
+	if epoch < helpers.CurrentEpoch(st) {
+		source = st.PreviousJustifiedCheckpoint()
+	}
+	activeCount, err := helpers.ActiveValidatorCount(st, epoch)
+	if err != nil {
+		return nil, err
+	}
+	domain, err := helpers.Domain(st.Fork(), epoch, params.BeaconConfig().DomainBeaconAttester, st.GenesisValidatorRoot())
+	if err != nil {
+		return nil, err
+	}
+
+	var atts []*ethpb.Attestation
+	for c := uint64(0); c < helpers.SlotCommitteeCount(activeCount); c++ {
+		committee, err := helpers.BeaconCommitteeFromState(st, slot, c)
+		if err != nil {
+			return nil, err
+		}
+		data := &ethpb.AttestationData{
+			Slot:            slot,
+			CommitteeIndex:  c,
+			BeaconBlockRoot: headRoot,
+			Source:          source,
+			Target:          &ethpb.Checkpoint{Epoch: epoch, Root: targetRoot},
+		}
+		signingRoot, err := helpers.ComputeSigningRoot(data, domain)
+		if err != nil {
+			return nil, err
+		}
+		bits := bitfield.NewBitlist(uint64(len(committee)))
+		var sigs []bls.Signature
+		for i, validator := range committee {
+			if !s.transport.reachable(proposer, s.owner(validator)) || !s.participates() {
+				continue
+			}
+			bits.SetBitAt(uint64(i), true)
+			sigs = append(sigs, s.keys[validator].Sign(signingRoot[:]))
+		}
+		if len(sigs) == 0 {
+			continue
+		}
+		atts = append(atts, &ethpb.Attestation{
+			Data:            data,
+			AggregationBits: bits,
+			Signature:       bls.AggregateSignatures(sigs).Marshal(),
+		})
+	}
+	return atts, nil
+}
+
+// participates decides whether a validator takes part in an attestation.
+func (s *Simulator) participates() bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.participation >= 1 || s.rand.Float64() < s.participation
+}
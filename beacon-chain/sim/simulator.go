@@ -0,0 +1,542 @@
+// Package sim runs a network of beacon nodes in a single process, for testing sync and fork
+// choice under network conditions which are hard to reproduce with real nodes.
+//
+// Each node runs the p2p, blockchain, sync and initial sync services on its own database. The p2p
+// services talk to each other over a simulated libp2p transport, which controls the latency of
+// every link, partitions the network and drops gossip messages. Time is driven by a slot clock
+// which only moves when the simulation advances it. At every slot, the nodes whose validators
+// propose on their own head produce and gossip a block, carrying the attestations of the
+// validators of the nodes they can reach for the previous slot.
+//
+// The simulation is deterministic: the same configuration and seed produce the same chain on
+// every node. The rpcs of the gossipsub routers are held by the simulated network, and delivered
+// one at a time in the order of their delivery time on the slot clock, which is the time they
+// were sent plus the latency of their link. Before the next rpc is delivered, the simulation
+// waits until the routers have handled all delivered rpcs, and the nodes have processed or
+// dismissed the blocks they received. Which messages are dropped only depends on the seed, the
+// link and the message.
+//
+// Some parts of the nodes are not simulated:
+//   - Peers are not discovered, the simulation connects the nodes which can reach each other.
+//     The connection gater of the p2p service is not installed.
+//   - Requests and responses of the sync protocols are not delayed by the simulated network,
+//     and the sync services retry and time out on the wall clock, as does the status handshake
+//     of newly connected peers.
+//   - The gossipsub heartbeat and peer scores run on the wall clock. With no more nodes than the
+//     mesh degree, every node is in the mesh of every other, so the heartbeat does not change
+//     which nodes relay a message. Larger networks may relay messages along different paths
+//     from one run to the next.
+//   - Attestations are only included in blocks, and not gossiped on their own. All nodes start
+//     from the same genesis.
+//
+// The slot clock replaces the clock of the whole process, and the sync flags are set for the
+// simulation. Simulations in the same process are therefore serialized, and must not run along
+// with tests relying on the real clock or on other flags.
+package sim
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sync"
+	"testing"
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/p2putils"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "sim")
+
+// startupTimeout bounds the time the nodes of a new simulation take to start and connect to each
+// other.
+const startupTimeout = time.Minute
+
+// pollInterval is the interval at which the simulation checks on the progress of the nodes which
+// it is not notified of.
+const pollInterval = 10 * time.Millisecond
+
+// running is held by the simulation which owns the clock and flags of the process.
+var running sync.Mutex
+
+// defaultGenesisTime is the genesis time of simulations which do not configure one, so that
+// runs do not depend on the time they are started at.
+var defaultGenesisTime = time.Unix(1606824023, 0)
+
+// Config of a simulation.
+type Config struct {
+	// Nodes is the number of beacon nodes.
+	Nodes int
+	// Validators is the number of genesis validators, assigned to nodes round robin.
+	Validators uint64
+	// GenesisTime of the chain, which the slot clock starts at.
+	GenesisTime time.Time
+	// Latency of the links between nodes.
+	Latency time.Duration
+	// Seed of the random decisions of the simulation, such as message drops.
+	Seed int64
+}
+
+// Simulator runs a network of beacon nodes.
+type Simulator struct {
+	cfg           *Config
+	clock         *Clock
+	transport     *transport
+	nodes         []*Node
+	keys          []bls.SecretKey
+	lock          sync.Mutex
+	rand          *rand.Rand
+	participation float64
+	events        *signal
+	resetFlags    func()
+	blockTopic    string
+	blocks        map[[32]byte]*ethpb.BeaconBlock
+	received      []receivedBlock
+}
+
+// receivedBlock is a block gossiped to a node, which the node has yet to process.
+type receivedBlock struct {
+	node int
+	root [32]byte
+}
+
+// New starts a simulation, which is closed when the test completes. All nodes start connected
+// to each other, at the genesis slot. New blocks until any other simulation of the process is
+// closed.
+func New(t testing.TB, cfg *Config) *Simulator {
+	if cfg.Nodes < 1 {
+		t.Fatal("A simulation needs at least one node")
+	}
+	if cfg.GenesisTime.IsZero() {
+		cfg.GenesisTime = defaultGenesisTime
+	}
+	running.Lock()
+	prevFlags := flags.Get()
+	flags.Init(&flags.GlobalFlags{
+		MinimumSyncPeers:           1,
+		BlockBatchLimit:            64,
+		BlockBatchLimitBurstFactor: 10,
+	})
+	s := &Simulator{
+		cfg:           cfg,
+		clock:         newClock(cfg.GenesisTime),
+		rand:          rand.New(rand.NewSource(cfg.Seed)),
+		participation: 1,
+		events:        newSignal(),
+		blocks:        make(map[[32]byte]*ethpb.BeaconBlock),
+		resetFlags: func() {
+			flags.Init(prevFlags)
+			running.Unlock()
+		},
+	}
+	t.Cleanup(func() {
+		if err := s.Close(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	genesis, keys := testutil.DeterministicGenesisState(t, cfg.Validators)
+	if err := genesis.SetGenesisTime(uint64(cfg.GenesisTime.Unix())); err != nil {
+		t.Fatal(err)
+	}
+	s.keys = keys
+	digest, err := p2putils.CreateForkDigest(cfg.GenesisTime, genesis.GenesisValidatorRoot())
+	if err != nil {
+		t.Fatal(err)
+	}
+	tr, err := newTransport(context.Background(), cfg.Nodes, cfg.Latency, cfg.Seed, s.clock, s.events)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.transport = tr
+	for _, h := range tr.hosts {
+		n, err := newNode(t, h, genesis, s.events)
+		if err != nil {
+			t.Fatal(err)
+		}
+		s.nodes = append(s.nodes, n)
+		if err := n.start(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	s.blockTopic = fmt.Sprintf(p2p.BlockSubnetTopicFormat, digest) + s.nodes[0].P2P.Encoding().ProtocolSuffix()
+	if err := tr.connectAll(); err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), startupTimeout)
+	defer cancel()
+	if err := s.run(ctx, time.Time{}, s.connected); err != nil {
+		t.Fatal(errors.Wrap(err, "nodes did not connect to each other"))
+	}
+	return s
+}
+
+// Close stops all nodes and restores the clock and global flags of the process, letting the
+// next simulation start.
+func (s *Simulator) Close() error {
+	if s.resetFlags == nil {
+		return nil
+	}
+	var errs []error
+	for _, n := range s.nodes {
+		if err := n.stop(); err != nil {
+			errs = append(errs, errors.Wrapf(err, "could not stop node %d", n.Index))
+		}
+	}
+	if s.transport != nil {
+		if err := s.transport.close(); err != nil {
+			errs = append(errs, errors.Wrap(err, "could not close network"))
+		}
+	}
+	s.clock.stop()
+	s.resetFlags()
+	s.resetFlags = nil
+	if len(errs) > 0 {
+		return errs[0]
+	}
+	return nil
+}
+
+// Node returns the node with the given index.
+func (s *Simulator) Node(i int) *Node {
+	return s.nodes[i]
+}
+
+// Nodes returns all nodes of the simulation.
+func (s *Simulator) Nodes() []*Node {
+	return s.nodes
+}
+
+// Clock returns the slot clock of the simulation.
+func (s *Simulator) Clock() *Clock {
+	return s.clock
+}
+
+// SetLatency sets the latency of messages between two nodes, in both directions.
+func (s *Simulator) SetLatency(a, b int, latency time.Duration) {
+	s.transport.setLatency(a, b, latency)
+}
+
+// SetDropRate sets the share of gossip messages sent by one node which are dropped by another.
+// A dropped message may still reach the node through other peers, which relay it.
+func (s *Simulator) SetDropRate(from, to int, rate float64) {
+	s.transport.setDropRate(from, to, rate)
+}
+
+// SetParticipation sets the share of reachable validators which attest, from 0 to 1. Lowering it
+// below two thirds stops the chain from finalizing.
+func (s *Simulator) SetParticipation(participation float64) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.participation = participation
+}
+
+// Partition splits the network into groups of nodes, which can only reach nodes of their own
+// group. Nodes missing from the groups are isolated from all others. It waits until the nodes
+// dropped their connections to the nodes they can no longer reach, and exchanged their status
+// and subscriptions with the nodes they can newly reach. The clock runs on while they do.
+func (s *Simulator) Partition(ctx context.Context, groups ...[]int) error {
+	assigned := make([]int, len(s.nodes))
+	for i := range assigned {
+		assigned[i] = -1
+	}
+	for g, group := range groups {
+		for _, i := range group {
+			if i < 0 || i >= len(s.nodes) {
+				return errors.Errorf("node %d does not exist", i)
+			}
+			if assigned[i] != -1 {
+				return errors.Errorf("node %d is in more than one group", i)
+			}
+			assigned[i] = g
+		}
+	}
+	for i := range assigned {
+		if assigned[i] == -1 {
+			assigned[i] = len(groups) + i
+		}
+	}
+	if err := s.transport.partition(assigned); err != nil {
+		return err
+	}
+	return s.run(ctx, time.Time{}, s.connected)
+}
+
+// Heal reconnects all nodes of a partitioned network, and waits until they have exchanged their
+// status and subscriptions with each other. The clock runs on while they do.
+func (s *Simulator) Heal(ctx context.Context) error {
+	if err := s.transport.partition(make([]int, len(s.nodes))); err != nil {
+		return err
+	}
+	return s.run(ctx, time.Time{}, s.connected)
+}
+
+// AdvanceSlot moves the clock to the next slot, and has the nodes whose validators propose on
+// their own head produce and gossip a block. It then delivers the gossip of the slot.
+func (s *Simulator) AdvanceSlot(ctx context.Context) error {
+	slot := s.clock.Slot() + 1
+	s.clock.SetSlot(slot)
+
+	var proposals []*proposal
+	for _, n := range s.nodes {
+		p, err := s.produceBlock(ctx, n, slot)
+		if err != nil {
+			return errors.Wrapf(err, "node %d could not produce block for slot %d", n.Index, slot)
+		}
+		if p != nil {
+			proposals = append(proposals, p)
+		}
+	}
+	for _, p := range proposals {
+		// The block is processed before it is gossiped, so that the copy the node receives from
+		// its own subscription does not race with it.
+		if err := p.node.Chain.ReceiveBlock(ctx, p.block, p.root); err != nil {
+			return errors.Wrapf(err, "node %d could not process its own block", p.node.Index)
+		}
+		s.blocks[p.root] = p.block.Block
+		if err := p.node.P2P.Broadcast(ctx, p.block); err != nil {
+			return errors.Wrapf(err, "node %d could not broadcast block", p.node.Index)
+		}
+	}
+	return s.run(ctx, s.clock.nextSlotStart(), nil)
+}
+
+// RunSlots advances the simulation by the given number of slots.
+func (s *Simulator) RunSlots(ctx context.Context, slots uint64) error {
+	for i := uint64(0); i < slots; i++ {
+		if err := s.AdvanceSlot(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WaitForConvergence delivers the remaining gossip of the slot, and returns an error if the
+// nodes do not agree on the head of the chain.
+func (s *Simulator) WaitForConvergence(ctx context.Context) error {
+	if err := s.run(ctx, s.clock.nextSlotStart(), nil); err != nil {
+		return err
+	}
+	first, err := s.nodes[0].HeadRoot()
+	if err != nil {
+		return err
+	}
+	for _, n := range s.nodes[1:] {
+		root, err := n.HeadRoot()
+		if err != nil {
+			return err
+		}
+		if root != first {
+			return errors.Errorf("node %d has head %#x, node 0 has head %#x", n.Index, root, first)
+		}
+	}
+	return nil
+}
+
+// run delivers the gossip due before the provided time, moving the clock to each delivery. A
+// zero time delivers gossip regardless of when it is due. If a condition is provided, run
+// returns once it holds, and waits for the nodes to make progress on their own until it does.
+func (s *Simulator) run(ctx context.Context, until time.Time, done func() bool) error {
+	for {
+		if err := s.settle(ctx); err != nil {
+			return err
+		}
+		if done != nil && done() {
+			return nil
+		}
+		next := s.transport.next()
+		if next == nil || (!until.IsZero() && !next.at.Before(until)) {
+			if done == nil {
+				return nil
+			}
+			changed := s.events.wait()
+			if done() {
+				return nil
+			}
+			// The p2p services do not report changes to the connection states of their peers, so
+			// these are polled.
+			select {
+			case <-changed:
+				continue
+			case <-time.After(pollInterval):
+				continue
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		s.clock.advanceTo(next.at)
+		msgs, err := s.transport.release(next)
+		if err != nil {
+			return err
+		}
+		for _, msg := range msgs {
+			if msg.GetTopic() != s.blockTopic {
+				continue
+			}
+			n := s.nodes[next.link.to]
+			blk := &ethpb.SignedBeaconBlock{}
+			if err := n.P2P.Encoding().DecodeGossip(msg.Data, blk); err != nil {
+				return errors.Wrap(err, "could not decode gossiped block")
+			}
+			root, err := blk.Block.HashTreeRoot()
+			if err != nil {
+				return errors.Wrap(err, "could not hash gossiped block")
+			}
+			s.received = append(s.received, receivedBlock{node: n.Index, root: root})
+		}
+	}
+}
+
+// settle waits until the gossipsub routers have handled every rpc delivered to them and
+// validated every message, and the nodes have processed or dismissed the blocks gossiped to
+// them.
+func (s *Simulator) settle(ctx context.Context) error {
+	for {
+		changed := s.events.wait()
+		version := s.events.version()
+		if s.transport.idle() && s.blocksSettled() {
+			// A router traces the rpcs it sends once it queued them for sending, and the messages
+			// published on it once it took them in. Going through the event loop of every router
+			// ensures none is in the middle of doing either.
+			for _, n := range s.nodes {
+				n.P2P.PubSub().GetTopics()
+			}
+			if s.events.version() == version {
+				return nil
+			}
+			continue
+		}
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return errors.Wrap(ctx.Err(), "nodes did not handle the gossip delivered to them")
+		}
+	}
+}
+
+// blocksSettled returns whether every node processed the blocks gossiped to it, leaving out
+// the blocks it dismisses as they do not descend from its finalized checkpoint.
+func (s *Simulator) blocksSettled() bool {
+	pending := s.received[:0]
+	for _, b := range s.received {
+		n := s.nodes[b.node]
+		if !n.HasProcessed(b.root) && s.viable(n, b.root) {
+			pending = append(pending, b)
+		}
+	}
+	s.received = pending
+	return len(s.received) == 0
+}
+
+// viable returns whether a block descends from the finalized checkpoint of a node.
+func (s *Simulator) viable(n *Node, root [32]byte) bool {
+	finalized := n.FinalizedCheckpoint()
+	if finalized.Epoch == 0 {
+		return true
+	}
+	finalizedRoot := bytesutil.ToBytes32(finalized.Root)
+	finalizedSlot, err := helpers.StartSlot(finalized.Epoch)
+	if err != nil {
+		return false
+	}
+	for root != finalizedRoot {
+		blk, ok := s.blocks[root]
+		if !ok || blk.Slot <= finalizedSlot {
+			return false
+		}
+		root = bytesutil.ToBytes32(blk.ParentRoot)
+	}
+	return true
+}
+
+// connected redials the nodes which can reach each other but dropped their connection during
+// the status handshake, and returns whether every node is connected to, and in the block topic
+// mesh of, the nodes it can reach, and dropped the nodes it cannot reach.
+func (s *Simulator) connected() bool {
+	ready := true
+	for _, n := range s.nodes {
+		reachable, meshed := 0, 0
+		for _, other := range s.nodes {
+			if other == n {
+				continue
+			}
+			state, err := n.P2P.Peers().ConnectionState(other.P2P.PeerID())
+			if err == peers.ErrPeerUnknown {
+				state, err = peers.PeerDisconnected, nil
+			}
+			if err != nil {
+				log.WithError(err).Debug("Could not get connection state")
+				return false
+			}
+			if !s.transport.reachable(n.Index, other.Index) {
+				if state != peers.PeerDisconnected || s.transport.routerPeer(n.Index, other.Index) ||
+					s.transport.streamOpen(other.Index, n.Index) {
+					ready = false
+				}
+				continue
+			}
+			reachable++
+			if s.transport.meshed(n.Index, other.Index, s.blockTopic) {
+				meshed++
+			}
+			if state != peers.PeerConnected {
+				ready = false
+			}
+			if state == peers.PeerDisconnected && n.Index < other.Index {
+				if err := s.transport.redial(n.Index, other.Index); err != nil {
+					log.WithError(err).Debug("Could not redial node")
+				}
+			}
+		}
+		// The router keeps at least as many peers in a mesh as the lower bound of its degree.
+		if meshed < reachable && meshed < pubsub.GossipSubDlo {
+			ready = false
+		}
+	}
+	return ready
+}
+
+// signal wakes up the simulation whenever a node makes progress, such as handling an rpc or
+// processing a block, so that waits do not poll.
+type signal struct {
+	lock    sync.Mutex
+	ch      chan struct{}
+	counter uint64
+}
+
+func newSignal() *signal {
+	return &signal{ch: make(chan struct{})}
+}
+
+// wait returns a channel which is closed on the next notification.
+func (s *signal) wait() <-chan struct{} {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.ch
+}
+
+// version returns the number of notifications so far.
+func (s *signal) version() uint64 {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.counter
+}
+
+// notify wakes up all waiters.
+func (s *signal) notify() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	close(s.ch)
+	s.ch = make(chan struct{})
+	s.counter++
+}
@@ -0,0 +1,157 @@
+package sim
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+)
+
+func TestClock(t *testing.T) {
+	genesis := time.Unix(1606824023, 0)
+	c := newClock(genesis)
+	defer c.stop()
+
+	assert.Equal(t, genesis, timeutils.Now())
+	c.SetSlot(3)
+	assert.Equal(t, uint64(3), c.Slot())
+	assert.Equal(t, genesis.Add(3*slotDuration()), timeutils.Now())
+	c.Advance(slotDuration() / 2)
+	assert.Equal(t, uint64(3), c.Slot())
+	c.Advance(slotDuration() / 2)
+	assert.Equal(t, uint64(4), c.Slot())
+	// Reads in between moves of the clock keep increasing.
+	now := timeutils.Now()
+	assert.Equal(t, true, timeutils.Now().After(now), "Clock reads did not increase")
+	assert.Equal(t, uint64(4), c.Slot())
+
+	c.stop()
+	assert.Equal(t, true, timeutils.Now().After(genesis), "Clock not restored")
+}
+
+func TestSimulator_PartitionAndHeal(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	params.UseMinimalConfig()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+	s := New(t, &Config{Nodes: 4, Validators: 64, Latency: 10 * time.Millisecond})
+
+	require.NoError(t, s.RunSlots(ctx, 2))
+	require.NoError(t, s.WaitForConvergence(ctx))
+
+	// Both halves of the network keep building on their own head while partitioned.
+	require.NoError(t, s.Partition(ctx, []int{0, 1}, []int{2, 3}))
+	require.NoError(t, s.RunSlots(ctx, 4))
+	for _, half := range [][]int{{0, 1}, {2, 3}} {
+		a, err := s.Node(half[0]).HeadRoot()
+		require.NoError(t, err)
+		b, err := s.Node(half[1]).HeadRoot()
+		require.NoError(t, err)
+		assert.Equal(t, a, b, "Nodes %v do not agree on the head", half)
+	}
+
+	// Once healed, the nodes fetch the blocks of the other half and agree on a head again.
+	require.NoError(t, s.Heal(ctx))
+	require.NoError(t, s.RunSlots(ctx, 4))
+	require.NoError(t, s.WaitForConvergence(ctx))
+}
+
+func TestSimulator_Deterministic(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	params.UseMinimalConfig()
+	// run returns the heads of the nodes after every slot of a partition and heal scenario with
+	// uneven latencies and dropped messages.
+	run := func(t *testing.T) [][][32]byte {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+		defer cancel()
+		s := New(t, &Config{Nodes: 4, Validators: 64, Latency: 100 * time.Millisecond, Seed: 7})
+		s.SetLatency(0, 3, 2*time.Second)
+		s.SetDropRate(1, 2, 0.5)
+		s.SetDropRate(2, 1, 0.5)
+		s.SetParticipation(0.8)
+
+		var heads [][][32]byte
+		runSlots := func(slots uint64) {
+			for i := uint64(0); i < slots; i++ {
+				require.NoError(t, s.AdvanceSlot(ctx))
+				slotHeads := make([][32]byte, len(s.Nodes()))
+				for j, n := range s.Nodes() {
+					root, err := n.HeadRoot()
+					require.NoError(t, err)
+					slotHeads[j] = root
+				}
+				heads = append(heads, slotHeads)
+			}
+		}
+		runSlots(2)
+		require.NoError(t, s.Partition(ctx, []int{0, 1, 2}, []int{3}))
+		runSlots(3)
+		require.NoError(t, s.Heal(ctx))
+		runSlots(3)
+		return heads
+	}
+
+	var first, second [][][32]byte
+	t.Run("first", func(t *testing.T) {
+		first = run(t)
+	})
+	t.Run("second", func(t *testing.T) {
+		second = run(t)
+	})
+	require.Equal(t, len(first), len(second))
+	for i := range first {
+		assert.DeepEqual(t, first[i], second[i], "Heads differ after slot %d", i+1)
+	}
+}
+
+func TestSimulator_Partition_InvalidGroups(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	params.UseMinimalConfig()
+	ctx := context.Background()
+	s := New(t, &Config{Nodes: 2, Validators: 64})
+
+	assert.ErrorContains(t, "node 2 does not exist", s.Partition(ctx, []int{0}, []int{2}))
+	assert.ErrorContains(t, "node 0 is in more than one group", s.Partition(ctx, []int{0}, []int{0, 1}))
+}
+
+func TestSimulator_LongNonFinality(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	params.UseMinimalConfig()
+	// The chain outgrows the minimal historical root vectors, and the historical batch is hashed
+	// with the vector lengths of the mainnet ssz build.
+	cfg := params.BeaconConfig()
+	cfg.SlotsPerHistoricalRoot = params.MainnetConfig().SlotsPerHistoricalRoot
+	params.OverrideBeaconConfig(cfg)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+	s := New(t, &Config{Nodes: 2, Validators: 64})
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+
+	require.NoError(t, s.RunSlots(ctx, 5*slotsPerEpoch))
+	require.NoError(t, s.WaitForConvergence(ctx))
+	require.Equal(t, true, s.Node(0).FinalizedCheckpoint().Epoch > 0, "Chain did not finalize")
+
+	// With less than two thirds of the validators attesting, finality stalls once the
+	// attestations of the last justified epoch are processed, while the chain keeps growing.
+	s.SetParticipation(0.5)
+	require.NoError(t, s.RunSlots(ctx, 2*slotsPerEpoch))
+	stalled := s.Node(0).FinalizedCheckpoint().Epoch
+	require.NoError(t, s.RunSlots(ctx, 8*slotsPerEpoch))
+	require.NoError(t, s.WaitForConvergence(ctx))
+	for _, n := range s.Nodes() {
+		assert.Equal(t, stalled, n.FinalizedCheckpoint().Epoch, "Node %d finalized without a supermajority", n.Index)
+		assert.Equal(t, s.Clock().Slot(), n.HeadSlot(), "Node %d head did not follow the clock", n.Index)
+	}
+
+	// Finality resumes once participation is restored.
+	s.SetParticipation(1)
+	require.NoError(t, s.RunSlots(ctx, 5*slotsPerEpoch))
+	require.NoError(t, s.WaitForConvergence(ctx))
+	for _, n := range s.Nodes() {
+		assert.Equal(t, true, n.FinalizedCheckpoint().Epoch > stalled, "Node %d did not finalize again", n.Index)
+	}
+}
@@ -0,0 +1,95 @@
+package sim
+
+import (
+	"github.com/libp2p/go-libp2p-core/peer"
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
+)
+
+// routerState is the state of the pubsub router of a node, as traced by the router.
+type routerState struct {
+	// joined are the topics the node subscribed to.
+	joined map[string]bool
+	// mesh are the peers of the node in the mesh of each topic.
+	mesh map[string]map[int]bool
+	// peers are the peers the router exchanges rpcs with.
+	peers map[int]bool
+	// pending is the number of messages the router took in and has not yet delivered, rejected
+	// or found to be duplicates.
+	pending int
+}
+
+func newRouterState() *routerState {
+	return &routerState{
+		joined: make(map[string]bool),
+		mesh:   make(map[string]map[int]bool),
+		peers:  make(map[int]bool),
+	}
+}
+
+// tracer follows the trace events of the pubsub router of a node, so that the simulation knows
+// when the rpcs and messages of the router have all been handled.
+type tracer struct {
+	transport *transport
+	index     int
+}
+
+// Trace an event of the router.
+func (t *tracer) Trace(evt *pubsubpb.TraceEvent) {
+	n := t.transport
+	n.lock.Lock()
+	r := n.routers[t.index]
+	switch evt.GetType() {
+	case pubsubpb.TraceEvent_SEND_RPC:
+		if to, ok := n.index(peer.ID(evt.GetSendRPC().GetSendTo())); ok {
+			n.linkState(link{from: t.index, to: to}).sent++
+		}
+	case pubsubpb.TraceEvent_RECV_RPC:
+		recv := evt.GetRecvRPC()
+		if from, ok := n.index(peer.ID(recv.GetReceivedFrom())); ok {
+			n.linkState(link{from: from, to: t.index}).received++
+		}
+		// Messages of topics the node did not subscribe to are dropped without a trace.
+		for _, msg := range recv.GetMeta().GetMessages() {
+			if r.joined[msg.GetTopic()] {
+				r.pending++
+			}
+		}
+	case pubsubpb.TraceEvent_PUBLISH_MESSAGE:
+		r.pending++
+	case pubsubpb.TraceEvent_DELIVER_MESSAGE, pubsubpb.TraceEvent_REJECT_MESSAGE, pubsubpb.TraceEvent_DUPLICATE_MESSAGE:
+		r.pending--
+	case pubsubpb.TraceEvent_JOIN:
+		r.joined[evt.GetJoin().GetTopic()] = true
+	case pubsubpb.TraceEvent_LEAVE:
+		delete(r.joined, evt.GetLeave().GetTopic())
+	case pubsubpb.TraceEvent_GRAFT:
+		graft := evt.GetGraft()
+		if p, ok := n.index(peer.ID(graft.GetPeerID())); ok {
+			if r.mesh[graft.GetTopic()] == nil {
+				r.mesh[graft.GetTopic()] = make(map[int]bool)
+			}
+			r.mesh[graft.GetTopic()][p] = true
+		}
+	case pubsubpb.TraceEvent_PRUNE:
+		prune := evt.GetPrune()
+		if p, ok := n.index(peer.ID(prune.GetPeerID())); ok {
+			delete(r.mesh[prune.GetTopic()], p)
+		}
+	case pubsubpb.TraceEvent_ADD_PEER:
+		if p, ok := n.index(peer.ID(evt.GetAddPeer().GetPeerID())); ok {
+			r.peers[p] = true
+		}
+	case pubsubpb.TraceEvent_REMOVE_PEER:
+		if p, ok := n.index(peer.ID(evt.GetRemovePeer().GetPeerID())); ok {
+			delete(r.peers, p)
+			// The router neither sends to nor reads from a removed peer anymore.
+			for _, peers := range r.mesh {
+				delete(peers, p)
+			}
+			n.linkState(link{from: t.index, to: p}).sent = 0
+			n.linkState(link{from: p, to: t.index}).received = 0
+		}
+	}
+	n.lock.Unlock()
+	n.events.notify()
+}
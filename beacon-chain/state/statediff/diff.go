@@ -0,0 +1,348 @@
+// Package statediff computes and applies compact diffs between two beacon states. A diff holds the
+// entries of the large list and vector fields of the state (block roots, state roots, historical
+// roots, validators, balances, randao mixes and slashings) which differ from a base state, encoded
+// as SSZ values, and the protobuf encoding of the remaining, small fields of the target state.
+package statediff
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+const (
+	rootSize      = 32
+	uint64Size    = 8
+	validatorSize = 121
+)
+
+// Diff is the difference between a base state and a target state.
+type Diff struct {
+	// Slot is the slot of the target state.
+	Slot uint64
+	// BaseSlot is the slot of the base state the diff applies to.
+	BaseSlot uint64
+
+	remainder       []byte
+	blockRoots      *listDiff
+	stateRoots      *listDiff
+	historicalRoots *listDiff
+	validators      *listDiff
+	balances        *listDiff
+	randaoMixes     *listDiff
+	slashings       *listDiff
+}
+
+// listDiff holds the changed elements of a list of fixed size SSZ encoded elements. When most of
+// the elements changed, all of them are stored instead.
+type listDiff struct {
+	length  uint64
+	full    bool
+	indices []uint64
+	values  [][]byte
+}
+
+// New computes the diff turning base into target. Neither state is modified.
+func New(base, target *pb.BeaconState) (*Diff, error) {
+	if base == nil || target == nil {
+		return nil, errors.New("nil state")
+	}
+	baseValidators, err := encodeValidators(base.Validators)
+	if err != nil {
+		return nil, err
+	}
+	targetValidators, err := encodeValidators(target.Validators)
+	if err != nil {
+		return nil, err
+	}
+
+	// Shallow copy the target to encode the fields which are not diffed.
+	remainder := *target
+	remainder.BlockRoots = nil
+	remainder.StateRoots = nil
+	remainder.HistoricalRoots = nil
+	remainder.Validators = nil
+	remainder.Balances = nil
+	remainder.RandaoMixes = nil
+	remainder.Slashings = nil
+	enc, err := proto.Marshal(&remainder)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal state fields")
+	}
+
+	return &Diff{
+		Slot:            target.Slot,
+		BaseSlot:        base.Slot,
+		remainder:       enc,
+		blockRoots:      diffList(base.BlockRoots, target.BlockRoots),
+		stateRoots:      diffList(base.StateRoots, target.StateRoots),
+		historicalRoots: diffList(base.HistoricalRoots, target.HistoricalRoots),
+		validators:      diffList(baseValidators, targetValidators),
+		balances:        diffList(encodeUint64s(base.Balances), encodeUint64s(target.Balances)),
+		randaoMixes:     diffList(base.RandaoMixes, target.RandaoMixes),
+		slashings:       diffList(encodeUint64s(base.Slashings), encodeUint64s(target.Slashings)),
+	}, nil
+}
+
+// Apply returns the target state of the diff, rebuilt from base. The base state is not modified.
+func (d *Diff) Apply(base *pb.BeaconState) (*pb.BeaconState, error) {
+	if base == nil {
+		return nil, errors.New("nil base state")
+	}
+	if base.Slot != d.BaseSlot {
+		return nil, fmt.Errorf("diff applies to a state at slot %d, got slot %d", d.BaseSlot, base.Slot)
+	}
+	st := &pb.BeaconState{}
+	if err := proto.Unmarshal(d.remainder, st); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal state fields")
+	}
+
+	var err error
+	if st.BlockRoots, err = d.blockRoots.apply(base.BlockRoots); err != nil {
+		return nil, errors.Wrap(err, "could not apply block roots")
+	}
+	if st.StateRoots, err = d.stateRoots.apply(base.StateRoots); err != nil {
+		return nil, errors.Wrap(err, "could not apply state roots")
+	}
+	if st.HistoricalRoots, err = d.historicalRoots.apply(base.HistoricalRoots); err != nil {
+		return nil, errors.Wrap(err, "could not apply historical roots")
+	}
+	if st.RandaoMixes, err = d.randaoMixes.apply(base.RandaoMixes); err != nil {
+		return nil, errors.Wrap(err, "could not apply randao mixes")
+	}
+	baseValidators, err := encodeValidators(base.Validators)
+	if err != nil {
+		return nil, err
+	}
+	validators, err := d.validators.apply(baseValidators)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not apply validators")
+	}
+	if st.Validators, err = decodeValidators(validators); err != nil {
+		return nil, err
+	}
+	balances, err := d.balances.apply(encodeUint64s(base.Balances))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not apply balances")
+	}
+	st.Balances = decodeUint64s(balances)
+	slashings, err := d.slashings.apply(encodeUint64s(base.Slashings))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not apply slashings")
+	}
+	st.Slashings = decodeUint64s(slashings)
+	return st, nil
+}
+
+// Marshal encodes the diff.
+func (d *Diff) Marshal() []byte {
+	buf := make([]byte, 0, 2*uint64Size+4+len(d.remainder))
+	buf = appendUint64(buf, d.Slot)
+	buf = appendUint64(buf, d.BaseSlot)
+	buf = appendUint32(buf, uint32(len(d.remainder)))
+	buf = append(buf, d.remainder...)
+	for _, l := range d.lists() {
+		buf = l.marshal(buf)
+	}
+	return buf
+}
+
+// Unmarshal decodes a diff encoded by Marshal.
+func (d *Diff) Unmarshal(enc []byte) error {
+	r := &reader{buf: enc}
+	d.Slot = r.uint64()
+	d.BaseSlot = r.uint64()
+	d.remainder = r.bytes(int(r.uint32()))
+	sizes := []int{rootSize, rootSize, rootSize, validatorSize, uint64Size, rootSize, uint64Size}
+	lists := make([]*listDiff, len(sizes))
+	for i, size := range sizes {
+		lists[i] = unmarshalList(r, size)
+	}
+	if r.err != nil {
+		return errors.Wrap(r.err, "could not unmarshal state diff")
+	}
+	if len(r.buf) != 0 {
+		return fmt.Errorf("%d trailing bytes after state diff", len(r.buf))
+	}
+	d.blockRoots, d.stateRoots, d.historicalRoots = lists[0], lists[1], lists[2]
+	d.validators, d.balances, d.randaoMixes, d.slashings = lists[3], lists[4], lists[5], lists[6]
+	return nil
+}
+
+// lists returns the list diffs in their encoding order.
+func (d *Diff) lists() []*listDiff {
+	return []*listDiff{d.blockRoots, d.stateRoots, d.historicalRoots, d.validators, d.balances, d.randaoMixes, d.slashings}
+}
+
+func diffList(base, target [][]byte) *listDiff {
+	l := &listDiff{length: uint64(len(target))}
+	for i, v := range target {
+		if i < len(base) && bytes.Equal(base[i], v) {
+			continue
+		}
+		l.indices = append(l.indices, uint64(i))
+		l.values = append(l.values, v)
+	}
+	// Storing the indices of most elements would take more space than the full list.
+	if 2*len(l.indices) > len(target) {
+		l.full = true
+		l.indices = nil
+		l.values = target
+	}
+	return l
+}
+
+func (l *listDiff) apply(base [][]byte) ([][]byte, error) {
+	if l.full {
+		return copyList(l.values), nil
+	}
+	list := make([][]byte, l.length)
+	copy(list, base)
+	for i, idx := range l.indices {
+		if idx >= l.length {
+			return nil, fmt.Errorf("index %d out of range of list of length %d", idx, l.length)
+		}
+		list[idx] = l.values[i]
+	}
+	for i, v := range list {
+		if v == nil {
+			return nil, fmt.Errorf("missing element %d in base state", i)
+		}
+	}
+	return copyList(list), nil
+}
+
+func (l *listDiff) marshal(buf []byte) []byte {
+	buf = appendUint64(buf, l.length)
+	if l.full {
+		buf = append(buf, 1)
+		for _, v := range l.values {
+			buf = append(buf, v...)
+		}
+		return buf
+	}
+	buf = append(buf, 0)
+	buf = appendUint64(buf, uint64(len(l.indices)))
+	for i, idx := range l.indices {
+		buf = appendUint64(buf, idx)
+		buf = append(buf, l.values[i]...)
+	}
+	return buf
+}
+
+func unmarshalList(r *reader, size int) *listDiff {
+	l := &listDiff{length: r.uint64()}
+	l.full = r.bytes(1)[0] == 1
+	count := l.length
+	if !l.full {
+		count = r.uint64()
+	}
+	if r.err != nil {
+		return l
+	}
+	// Every element takes at least size bytes.
+	if count > uint64(len(r.buf)/size) {
+		r.err = errors.New("list diff exceeds encoded data")
+		return l
+	}
+	for i := uint64(0); i < count; i++ {
+		if !l.full {
+			l.indices = append(l.indices, r.uint64())
+		}
+		l.values = append(l.values, r.bytes(size))
+	}
+	return l
+}
+
+// copyList returns a copy of the list holding copies of its elements, so the decoded values never
+// alias the encoded diff.
+func copyList(list [][]byte) [][]byte {
+	cpy := make([][]byte, len(list))
+	for i, v := range list {
+		cpy[i] = append([]byte{}, v...)
+	}
+	return cpy
+}
+
+func encodeValidators(validators []*ethpb.Validator) ([][]byte, error) {
+	encoded := make([][]byte, len(validators))
+	for i, v := range validators {
+		enc, err := v.MarshalSSZ()
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not marshal validator %d", i)
+		}
+		encoded[i] = enc
+	}
+	return encoded, nil
+}
+
+func decodeValidators(encoded [][]byte) ([]*ethpb.Validator, error) {
+	validators := make([]*ethpb.Validator, len(encoded))
+	for i, enc := range encoded {
+		validators[i] = &ethpb.Validator{}
+		if err := validators[i].UnmarshalSSZ(enc); err != nil {
+			return nil, errors.Wrapf(err, "could not unmarshal validator %d", i)
+		}
+	}
+	return validators, nil
+}
+
+func encodeUint64s(values []uint64) [][]byte {
+	encoded := make([][]byte, len(values))
+	for i, v := range values {
+		encoded[i] = appendUint64(nil, v)
+	}
+	return encoded
+}
+
+func decodeUint64s(encoded [][]byte) []uint64 {
+	values := make([]uint64, len(encoded))
+	for i, enc := range encoded {
+		values[i] = binary.LittleEndian.Uint64(enc)
+	}
+	return values
+}
+
+func appendUint64(buf []byte, v uint64) []byte {
+	b := make([]byte, uint64Size)
+	binary.LittleEndian.PutUint64(b, v)
+	return append(buf, b...)
+}
+
+func appendUint32(buf []byte, v uint32) []byte {
+	b := make([]byte, 4)
+	binary.LittleEndian.PutUint32(b, v)
+	return append(buf, b...)
+}
+
+// reader consumes an encoded diff, recording the first error encountered.
+type reader struct {
+	buf []byte
+	err error
+}
+
+func (r *reader) bytes(n int) []byte {
+	if r.err != nil || n > len(r.buf) {
+		if r.err == nil {
+			r.err = errors.New("unexpected end of state diff")
+		}
+		// Callers only index into the fixed size values read after an error.
+		return make([]byte, uint64Size)
+	}
+	b := r.buf[:n]
+	r.buf = r.buf[n:]
+	return b
+}
+
+func (r *reader) uint64() uint64 {
+	return binary.LittleEndian.Uint64(r.bytes(uint64Size))
+}
+
+func (r *reader) uint32() uint32 {
+	return binary.LittleEndian.Uint32(r.bytes(4))
+}
@@ -0,0 +1,90 @@
+package statediff
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func testStates(t *testing.T) (*pb.BeaconState, *pb.BeaconState) {
+	st, _ := testutil.DeterministicGenesisState(t, 64)
+	base := st.CloneInnerState()
+	target := st.CloneInnerState()
+	target.Slot = 96
+	target.BlockRoots[3] = bytesutil.PadTo([]byte("block root"), 32)
+	target.StateRoots[3] = bytesutil.PadTo([]byte("state root"), 32)
+	target.RandaoMixes[2] = bytesutil.PadTo([]byte("randao mix"), 32)
+	target.HistoricalRoots = append(target.HistoricalRoots, bytesutil.PadTo([]byte("historical root"), 32))
+	target.Balances[5] = 1
+	target.Slashings[1] = 1
+	target.Validators[7].Slashed = true
+	target.Validators = append(target.Validators, &ethpb.Validator{
+		PublicKey:             bytesutil.PadTo([]byte("pubkey"), 48),
+		WithdrawalCredentials: make([]byte, 32),
+		EffectiveBalance:      32,
+	})
+	target.Balances = append(target.Balances, 32)
+	target.Eth1DepositIndex = 65
+	return base, target
+}
+
+func TestDiff_RoundTrip(t *testing.T) {
+	base, target := testStates(t)
+	d, err := New(base, target)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(96), d.Slot)
+	assert.Equal(t, uint64(0), d.BaseSlot)
+	assert.Equal(t, 1, len(d.blockRoots.indices))
+	assert.Equal(t, 2, len(d.validators.indices))
+
+	decoded := &Diff{}
+	require.NoError(t, decoded.Unmarshal(d.Marshal()))
+	got, err := decoded.Apply(base)
+	require.NoError(t, err)
+	assert.Equal(t, true, proto.Equal(target, got), "Did not rebuild target state")
+
+	// The base state is left untouched.
+	assert.Equal(t, false, base.Validators[7].Slashed)
+	assert.Equal(t, 64, len(base.Validators))
+}
+
+func TestDiff_StoresFullListWhenMostElementsChange(t *testing.T) {
+	base, target := testStates(t)
+	for i := range target.Balances {
+		target.Balances[i]++
+	}
+	d, err := New(base, target)
+	require.NoError(t, err)
+	assert.Equal(t, true, d.balances.full)
+	assert.Equal(t, false, d.validators.full)
+
+	decoded := &Diff{}
+	require.NoError(t, decoded.Unmarshal(d.Marshal()))
+	got, err := decoded.Apply(base)
+	require.NoError(t, err)
+	assert.DeepEqual(t, target.Balances, got.Balances)
+}
+
+func TestDiff_Apply_WrongBase(t *testing.T) {
+	base, target := testStates(t)
+	d, err := New(base, target)
+	require.NoError(t, err)
+	_, err = d.Apply(target)
+	assert.ErrorContains(t, "diff applies to a state at slot 0", err)
+}
+
+func TestDiff_Unmarshal_Truncated(t *testing.T) {
+	base, target := testStates(t)
+	d, err := New(base, target)
+	require.NoError(t, err)
+	enc := d.Marshal()
+
+	assert.ErrorContains(t, "unexpected end of state diff", (&Diff{}).Unmarshal(enc[:len(enc)-1]))
+	assert.ErrorContains(t, "trailing bytes", (&Diff{}).Unmarshal(append(enc, 0)))
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
@@ -23,7 +24,7 @@ func (s *State) HasState(ctx context.Context, blockRoot [32]byte) (bool, error)
 	if has {
 		return true, nil
 	}
-	return s.beaconDB.HasState(ctx, blockRoot), nil
+	return s.beaconDB.HasState(ctx, blockRoot) || s.beaconDB.HasStateDiff(ctx, blockRoot), nil
 }
 
 // StateByRoot retrieves the state using input block root.
@@ -41,6 +42,11 @@ func (s *State) StateByRoot(ctx context.Context, blockRoot [32]byte) (*state.Bea
 		return s.beaconDB.State(ctx, blockRoot)
 	}
 
+	// Short cut if the state can be rebuilt from a state diff in the DB.
+	if s.beaconDB.HasStateDiff(ctx, blockRoot) {
+		return s.stateByDiff(ctx, blockRoot)
+	}
+
 	return s.loadStateByRoot(ctx, blockRoot)
 }
 
@@ -102,6 +108,14 @@ func (s *State) StateBySlot(ctx context.Context, slot uint64) (*state.BeaconStat
 	ctx, span := trace.StartSpan(ctx, "stateGen.StateBySlot")
 	defer span.End()
 
+	lowest, err := s.beaconDB.LowestRetainedSlot(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve lowest retained slot")
+	}
+	if slot != 0 && slot < lowest {
+		return nil, errors.Wrapf(db.ErrHistoryPruned, "state at slot %d is below lowest retained slot %d", slot, lowest)
+	}
+
 	return s.loadStateBySlot(ctx, slot)
 }
 
@@ -205,8 +219,11 @@ func (s *State) loadStateBySlot(ctx context.Context, slot uint64) (*state.Beacon
 		return s.beaconDB.GenesisState(ctx)
 	}
 
-	// Gather last saved state, that is where node starts to replay the blocks.
-	startState, err := s.lastSavedState(ctx, slot)
+	// Gather last archived state, that is where node starts to replay the blocks.
+	startState, err := s.lastArchivedState(ctx, slot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get last archived state")
+	}
 
 	// Gather the last saved block root and the slot number.
 	lastValidRoot, lastValidSlot, err := s.lastSavedBlock(ctx, slot)
@@ -242,6 +259,7 @@ func (s *State) loadStateBySlot(ctx context.Context, slot uint64) (*state.Beacon
 // 1.) block parent state is the last finalized state
 // 2.) block parent state is the epoch boundary state and exists in epoch boundary cache.
 // 3.) block parent state is in DB.
+// 4.) block parent state can be rebuilt from a state diff in DB.
 func (s *State) lastAncestorState(ctx context.Context, root [32]byte) (*state.BeaconState, error) {
 	ctx, span := trace.StartSpan(ctx, "stateGen.lastAncestorState")
 	defer span.End()
@@ -291,6 +309,11 @@ func (s *State) lastAncestorState(ctx context.Context, root [32]byte) (*state.Be
 		if s.beaconDB.HasState(ctx, parentRoot) {
 			return s.beaconDB.State(ctx, parentRoot)
 		}
+
+		// Can the state be rebuilt from a state diff in DB.
+		if s.beaconDB.HasStateDiff(ctx, parentRoot) {
+			return s.stateByDiff(ctx, parentRoot)
+		}
 		b, err = s.beaconDB.Block(ctx, parentRoot)
 		if err != nil {
 			return nil, err
@@ -13,4 +13,10 @@ var (
 			Buckets: []float64{64, 256, 1024, 2048, 4096},
 		},
 	)
+	stateDiffAppliedCount = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "state_diff_applied_count",
+			Help: "The number of states rebuilt from a state diff",
+		},
+	)
 )
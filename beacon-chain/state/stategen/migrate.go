@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"fmt"
 
+	"github.com/pkg/errors"
 	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/sirupsen/logrus"
@@ -13,7 +14,8 @@ import (
 
 // MigrateToCold advances the finalized info in between the cold and hot state sections.
 // It moves the recent finalized states from the hot section to the cold section and
-// only preserve the ones that's on archived point.
+// only preserve the ones that's on archived point. Archived points in between the archived
+// snapshots are saved as diffs against the last snapshot.
 func (s *State) MigrateToCold(ctx context.Context, fRoot [32]byte) error {
 	ctx, span := trace.StartSpan(ctx, "stateGen.MigrateToCold")
 	defer span.End()
@@ -75,10 +77,28 @@ func (s *State) MigrateToCold(ctx context.Context, fRoot [32]byte) error {
 				aRoot = missingRoot
 				aState = missingState
 			}
-			if s.beaconDB.HasState(ctx, aRoot) {
+			if s.beaconDB.HasState(ctx, aRoot) || s.beaconDB.HasStateDiff(ctx, aRoot) {
 				continue
 			}
 
+			// In between the archived snapshots, only the difference to the last snapshot is saved.
+			// When the last snapshot is not in DB, such as below the origin of a checkpoint-synced
+			// node, the full state is saved instead.
+			if s.isStateDiffSlot(slot) {
+				err := s.saveStateDiff(ctx, aState, aRoot)
+				if err == nil {
+					log.WithFields(
+						logrus.Fields{
+							"slot": aState.Slot(),
+							"root": hex.EncodeToString(bytesutil.Trunc(aRoot[:])),
+						}).Info("Saved state diff in DB")
+					continue
+				}
+				if !errors.Is(err, errUnknownState) && !errors.Is(err, errUnknownBlock) {
+					return errors.Wrap(err, "could not save state diff")
+				}
+			}
+
 			if err := s.beaconDB.SaveState(ctx, aState, aRoot); err != nil {
 				return err
 			}
@@ -19,6 +19,7 @@ import (
 type State struct {
 	beaconDB                db.NoHeadAccessDatabase
 	slotsPerArchivedPoint   uint64
+	slotsPerArchiveSnapshot uint64
 	hotStateCache           *cache.HotStateCache
 	finalizedInfo           *finalizedInfo
 	stateSummaryCache       *cache.StateSummaryCache
@@ -41,6 +42,7 @@ func New(db db.NoHeadAccessDatabase, stateSummaryCache *cache.StateSummaryCache)
 		hotStateCache:           cache.NewHotStateCache(),
 		finalizedInfo:           &finalizedInfo{slot: 0, root: params.BeaconConfig().ZeroHash},
 		slotsPerArchivedPoint:   params.BeaconConfig().SlotsPerArchivedPoint,
+		slotsPerArchiveSnapshot: params.BeaconConfig().SlotsPerArchiveSnapshot,
 		stateSummaryCache:       stateSummaryCache,
 		epochBoundaryStateCache: newBoundaryStateCache(),
 	}
@@ -0,0 +1,125 @@
+package stategen
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/statediff"
+	"go.opencensus.io/trace"
+)
+
+// Returns true if the archived point at the input slot is saved as a state diff rather than a
+// full state.
+func (s *State) isStateDiffSlot(slot uint64) bool {
+	return s.slotsPerArchiveSnapshot != 0 && slot%s.slotsPerArchiveSnapshot != 0
+}
+
+// This saves the state of an archived point as a diff against the archived snapshot at or below
+// the archived point. The snapshot must be saved in DB as a full state, as the diff can only be
+// applied to it.
+func (s *State) saveStateDiff(ctx context.Context, st *state.BeaconState, root [32]byte) error {
+	ctx, span := trace.StartSpan(ctx, "stateGen.saveStateDiff")
+	defer span.End()
+
+	base, err := s.archiveSnapshot(ctx, st.Slot())
+	if err != nil {
+		return errors.Wrap(err, "could not get base state")
+	}
+	diff, err := statediff.New(base.InnerStateUnsafe(), st.InnerStateUnsafe())
+	if err != nil {
+		return err
+	}
+	return s.beaconDB.SaveStateDiff(ctx, root, diff)
+}
+
+// This rebuilds the state of the input block root from its state diff in DB.
+func (s *State) stateByDiff(ctx context.Context, root [32]byte) (*state.BeaconState, error) {
+	diff, err := s.beaconDB.StateDiff(ctx, root)
+	if err != nil {
+		return nil, err
+	}
+	if diff == nil {
+		return nil, errUnknownState
+	}
+	return s.applyStateDiff(ctx, diff)
+}
+
+// This applies a state diff to its base state saved in DB.
+func (s *State) applyStateDiff(ctx context.Context, diff *statediff.Diff) (*state.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "stateGen.applyStateDiff")
+	defer span.End()
+
+	base, err := s.archiveSnapshot(ctx, diff.Slot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get base state")
+	}
+	if base.Slot() != diff.BaseSlot {
+		return nil, errors.Wrapf(errUnknownState, "archived snapshot at slot %d is not the base of state diff at slot %d", base.Slot(), diff.Slot)
+	}
+	pbState, err := diff.Apply(base.InnerStateUnsafe())
+	if err != nil {
+		return nil, errors.Wrap(err, "could not apply state diff")
+	}
+	stateDiffAppliedCount.Inc()
+	return state.InitializeFromProtoUnsafe(pbState)
+}
+
+// This returns the full state of the archived snapshot at or below the input slot, which is the
+// base state of the state diffs of the archived points up to the next snapshot. The snapshot is
+// saved under the root of the highest block at or below its slot.
+func (s *State) archiveSnapshot(ctx context.Context, slot uint64) (*state.BeaconState, error) {
+	if s.slotsPerArchiveSnapshot == 0 {
+		return nil, errors.Wrap(errUnknownState, "archived snapshots are disabled")
+	}
+	snapshotSlot := slot - slot%s.slotsPerArchiveSnapshot
+	if snapshotSlot == 0 {
+		genesis, err := s.beaconDB.GenesisState(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if genesis == nil {
+			return nil, errors.Wrap(errUnknownState, "no archived snapshot at genesis")
+		}
+		return genesis, nil
+	}
+	blks, err := s.beaconDB.HighestSlotBlocksBelow(ctx, snapshotSlot+1)
+	if err != nil {
+		return nil, err
+	}
+	if len(blks) != 1 {
+		return nil, errors.Wrapf(errUnknownBlock, "no canonical block at or below archived snapshot at slot %d", snapshotSlot)
+	}
+	root, err := blks[0].Block.HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+	snapshot, err := s.beaconDB.State(ctx, root)
+	if err != nil {
+		return nil, err
+	}
+	if snapshot == nil {
+		return nil, errors.Wrapf(errUnknownState, "no archived snapshot at slot %d", snapshotSlot)
+	}
+	return snapshot, nil
+}
+
+// This returns the highest state at or below the input slot which can be loaded from DB without
+// replaying blocks: either a full state, or a state rebuilt from a state diff.
+func (s *State) lastArchivedState(ctx context.Context, slot uint64) (*state.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "stateGen.lastArchivedState")
+	defer span.End()
+
+	lastSaved, err := s.lastSavedState(ctx, slot)
+	if err != nil {
+		return nil, err
+	}
+	diff, err := s.beaconDB.HighestSlotStateDiffBelow(ctx, slot+1)
+	if err != nil {
+		return nil, err
+	}
+	if diff == nil || diff.Slot <= lastSaved.Slot() {
+		return lastSaved, nil
+	}
+	return s.applyStateDiff(ctx, diff)
+}
@@ -0,0 +1,148 @@
+package stategen
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/statediff"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestMigrateToCold_SavesStateDiff(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctx := context.Background()
+	db, _ := testDB.SetupDB(t)
+	service := New(db, cache.NewStateSummaryCache())
+	service.slotsPerArchivedPoint = 1
+	service.slotsPerArchiveSnapshot = 2
+
+	genesisState, _ := testutil.DeterministicGenesisState(t, 32)
+	genesis := testutil.NewBeaconBlock()
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, genesis))
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisRoot))
+	require.NoError(t, db.SaveState(ctx, genesisState, genesisRoot))
+
+	beaconState := genesisState.Copy()
+	require.NoError(t, beaconState.SetSlot(1))
+	require.NoError(t, beaconState.UpdateBalancesAtIndex(3, 1))
+	b := testutil.NewBeaconBlock()
+	b.Block.Slot = 2
+	fRoot, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, b))
+	require.NoError(t, service.epochBoundaryStateCache.put(fRoot, beaconState))
+	require.NoError(t, service.MigrateToCold(ctx, fRoot))
+
+	assert.Equal(t, false, db.HasState(ctx, fRoot), "Saved full state instead of a state diff")
+	assert.Equal(t, true, db.HasStateDiff(ctx, fRoot), "Did not save state diff")
+	require.LogsContain(t, hook, "Saved state diff in DB")
+
+	gotState, err := service.StateByRoot(ctx, fRoot)
+	require.NoError(t, err)
+	assert.Equal(t, true, proto.Equal(beaconState.InnerStateUnsafe(), gotState.InnerStateUnsafe()), "Did not rebuild state from diff")
+}
+
+func TestMigrateToCold_StateDiffBasedOnArchiveSnapshot(t *testing.T) {
+	ctx := context.Background()
+	db, _ := testDB.SetupDB(t)
+	service := New(db, cache.NewStateSummaryCache())
+	service.slotsPerArchivedPoint = 1
+	service.slotsPerArchiveSnapshot = 4
+
+	genesisState, _ := testutil.DeterministicGenesisState(t, 32)
+	genesis := testutil.NewBeaconBlock()
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, genesis))
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisRoot))
+	require.NoError(t, db.SaveState(ctx, genesisState, genesisRoot))
+
+	// A full state saved in between the snapshots, such as by a forced checkpoint, is not the
+	// base of the state diff.
+	checkpointState := genesisState.Copy()
+	require.NoError(t, checkpointState.SetSlot(1))
+	checkpointRoot := bytesutil.ToBytes32([]byte{'A'})
+	require.NoError(t, db.SaveState(ctx, checkpointState, checkpointRoot))
+
+	beaconState := genesisState.Copy()
+	require.NoError(t, beaconState.SetSlot(2))
+	require.NoError(t, beaconState.UpdateBalancesAtIndex(3, 1))
+	b := testutil.NewBeaconBlock()
+	b.Block.Slot = 3
+	fRoot, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, b))
+	require.NoError(t, service.epochBoundaryStateCache.put(fRoot, beaconState))
+	require.NoError(t, service.MigrateToCold(ctx, fRoot))
+
+	diff, err := db.StateDiff(ctx, fRoot)
+	require.NoError(t, err)
+	require.NotNil(t, diff)
+	assert.Equal(t, uint64(0), diff.BaseSlot, "State diff not based on the archived snapshot")
+
+	require.NoError(t, db.DeleteState(ctx, checkpointRoot))
+	gotState, err := service.StateByRoot(ctx, fRoot)
+	require.NoError(t, err)
+	assert.Equal(t, true, proto.Equal(beaconState.InnerStateUnsafe(), gotState.InnerStateUnsafe()), "Did not rebuild state from diff")
+}
+
+func TestMigrateToCold_SavesFullStateWithoutArchiveSnapshot(t *testing.T) {
+	ctx := context.Background()
+	db, _ := testDB.SetupDB(t)
+	service := New(db, cache.NewStateSummaryCache())
+	service.slotsPerArchivedPoint = 1
+	service.slotsPerArchiveSnapshot = 4
+
+	// Without a genesis state, as for a node started from a checkpoint, there is no snapshot to
+	// diff against.
+	genesisState, _ := testutil.DeterministicGenesisState(t, 32)
+	beaconState := genesisState.Copy()
+	require.NoError(t, beaconState.SetSlot(1))
+	b := testutil.NewBeaconBlock()
+	b.Block.Slot = 2
+	fRoot, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, b))
+	require.NoError(t, service.epochBoundaryStateCache.put(fRoot, beaconState))
+	require.NoError(t, service.MigrateToCold(ctx, fRoot))
+
+	assert.Equal(t, true, db.HasState(ctx, fRoot), "Did not save full state")
+	assert.Equal(t, false, db.HasStateDiff(ctx, fRoot), "Saved state diff without a base state")
+}
+
+func TestLastArchivedState_UsesStateDiff(t *testing.T) {
+	ctx := context.Background()
+	db, _ := testDB.SetupDB(t)
+	service := New(db, cache.NewStateSummaryCache())
+	service.slotsPerArchiveSnapshot = 16
+
+	genesisState, _ := testutil.DeterministicGenesisState(t, 32)
+	genesis := testutil.NewBeaconBlock()
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, genesis))
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisRoot))
+	require.NoError(t, db.SaveState(ctx, genesisState, genesisRoot))
+
+	target := genesisState.CloneInnerState()
+	target.Slot = 10
+	diff, err := statediff.New(genesisState.InnerStateUnsafe(), target)
+	require.NoError(t, err)
+	require.NoError(t, db.SaveStateDiff(ctx, bytesutil.ToBytes32([]byte{'A'}), diff))
+
+	st, err := service.lastArchivedState(ctx, 12)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(10), st.Slot())
+	st, err = service.lastArchivedState(ctx, 9)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), st.Slot())
+}
@@ -0,0 +1,179 @@
+package backfill
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	streamhelpers "github.com/libp2p/go-libp2p-core/helpers"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	prysmsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	p2ppb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/mathutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/rand"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	errNoPeersAvailable   = errors.New("no peers available to backfill from")
+	errInvalidFetchedData = errors.New("invalid data returned from peer")
+)
+
+// run requests batches of blocks backwards from the lowest saved block until the genesis
+// block, or the stop slot of a node keeping a bounded history, is reached. The cursor is
+// the exclusive upper bound of the next batch. It moves below the lowest saved block when
+// peers return empty batches for skipped slots, and is reset to the lowest saved block
+// whenever a batch does not link to it.
+func (s *Service) run() {
+	cursor := s.lowestBlockSlot()
+	for !s.isComplete() {
+		if s.ctx.Err() != nil {
+			return
+		}
+		if cursor == 0 {
+			// Every slot down to genesis was requested without finding the parent of the lowest
+			// block, so a peer must have withheld blocks. Start over from the lowest block.
+			cursor = s.lowestBlockSlot()
+		}
+		start := cursor - mathutil.Min(cursor, s.batchSize)
+		req := &p2ppb.BeaconBlocksByRangeRequest{
+			StartSlot: start,
+			Count:     cursor - start,
+			Step:      1,
+		}
+		blks, pid, err := s.requestBlocksFromPeer(s.ctx, req)
+		if err != nil {
+			backfillBatchesFailedTotal.Inc()
+			log.WithError(err).WithFields(logrus.Fields{
+				"start": req.StartSlot,
+				"count": req.Count,
+			}).Debug("Could not fetch backfill batch")
+			if !s.wait(retryInterval) {
+				return
+			}
+			continue
+		}
+		if err := verifyBatch(blks, s.lowestParentRoot()); err != nil {
+			backfillBatchesFailedTotal.Inc()
+			s.p2p.Peers().Scorers().BadResponsesScorer().Increment(pid)
+			log.WithError(err).WithField("peer", pid).Debug("Backfill batch does not link to the lowest saved block")
+			cursor = s.lowestBlockSlot()
+			if !s.wait(retryInterval) {
+				return
+			}
+			continue
+		}
+		if len(blks) > 0 {
+			if err := s.saveBatch(s.ctx, blks); err != nil {
+				log.WithError(err).Error("Could not save backfill batch")
+				return
+			}
+		}
+		cursor = start
+	}
+}
+
+// requestBlocksFromPeer requests a batch of blocks from a random peer that has finalized
+// the origin block.
+func (s *Service) requestBlocksFromPeer(ctx context.Context, req *p2ppb.BeaconBlocksByRangeRequest) ([]*ethpb.SignedBeaconBlock, peer.ID, error) {
+	_, peers := s.p2p.Peers().BestFinalized(params.BeaconConfig().MaxPeersToSync, s.originEpoch)
+	if len(peers) == 0 {
+		return nil, "", errNoPeersAvailable
+	}
+	pid := peers[rand.NewGenerator().Intn(len(peers))]
+	blks, err := s.requestBlocks(ctx, req, pid)
+	return blks, pid, err
+}
+
+// requestBlocks sends a BeaconBlocksByRangeRequest to a peer and reads the response chunks,
+// checking that the blocks are in the requested range and in ascending slot order. The request
+// waits until it fits in the outbound budget of block requests to the peer.
+func (s *Service) requestBlocks(ctx context.Context, req *p2ppb.BeaconBlocksByRangeRequest, pid peer.ID) ([]*ethpb.SignedBeaconBlock, error) {
+	if s.rateLimiter.Remaining(pid.String()) < int64(req.Count) {
+		log.WithField("peer", pid).Debug("Slowing down for rate limit")
+		if !s.wait(s.rateLimiter.TillEmpty(pid.String())) {
+			return nil, s.ctx.Err()
+		}
+	}
+	s.rateLimiter.Add(pid.String(), int64(req.Count))
+
+	ctx, cancel := context.WithTimeout(ctx, params.BeaconNetworkConfig().RespTimeout)
+	defer cancel()
+
+	stream, err := s.p2p.Send(ctx, req, p2p.RPCBlocksByRangeTopic, pid)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := streamhelpers.FullClose(stream); err != nil {
+			log.WithError(err).Debugf("Failed to close stream with protocol %s", stream.Protocol())
+		}
+	}()
+
+	blks := make([]*ethpb.SignedBeaconBlock, 0, req.Count)
+	var prevSlot uint64
+	for i := uint64(0); ; i++ {
+		isFirstChunk := i == 0
+		blk, err := prysmsync.ReadChunkedBlock(stream, s.p2p, isFirstChunk)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if i >= req.Count || i >= params.BeaconNetworkConfig().MaxRequestBlocks {
+			return nil, errInvalidFetchedData
+		}
+		if blk.Block == nil || blk.Block.Slot < req.StartSlot || blk.Block.Slot >= req.StartSlot+req.Count {
+			return nil, errInvalidFetchedData
+		}
+		if !isFirstChunk && prevSlot >= blk.Block.Slot {
+			return nil, errInvalidFetchedData
+		}
+		prevSlot = blk.Block.Slot
+		blks = append(blks, blk)
+	}
+	return blks, nil
+}
+
+// verifyBatch checks that a batch of blocks in ascending slot order forms a chain ending
+// in the block whose parent root is given. Instead of replaying state transitions, every
+// block is trusted because its root is committed to by its child, and ultimately by the
+// finalized origin block.
+func verifyBatch(blks []*ethpb.SignedBeaconBlock, parentRoot [32]byte) error {
+	expected := parentRoot
+	for i := len(blks) - 1; i >= 0; i-- {
+		root, err := blks[i].Block.HashTreeRoot()
+		if err != nil {
+			return errors.Wrap(err, "could not hash block")
+		}
+		if root != expected {
+			return fmt.Errorf("block at slot %d has root %#x, wanted parent root %#x", blks[i].Block.Slot, root, expected)
+		}
+		expected = bytesutil.ToBytes32(blks[i].Block.ParentRoot)
+	}
+	return nil
+}
+
+// saveBatch saves a verified batch, indexed as finalized, and records its lowest block as the
+// new backfill position. Once the genesis block, or a block at or below the stop slot, is
+// saved, backfill is complete.
+func (s *Service) saveBatch(ctx context.Context, blks []*ethpb.SignedBeaconBlock) error {
+	if err := s.db.SaveBackfillBlocks(ctx, blks); err != nil {
+		return errors.Wrap(err, "could not save blocks")
+	}
+	backfillBlocksTotal.Add(float64(len(blks)))
+
+	lowest := blks[0].Block
+	complete := lowest.Slot <= s.stopSlot
+	if complete {
+		log.Info("Historical block backfill complete")
+	}
+	s.setLowest(lowest.Slot, bytesutil.ToBytes32(lowest.ParentRoot), complete)
+	return nil
+}
@@ -0,0 +1,271 @@
+package backfill
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/gogo/protobuf/proto"
+	"github.com/libp2p/go-libp2p-core/network"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	p2pt "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	prysmsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	p2ppb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+const blocksByRangeTopic = "/eth2/beacon_chain/req/beacon_blocks_by_range/1/ssz_snappy"
+
+// makeChain builds a chain of blocks from genesis up to the given slot, skipping every
+// fifth slot. The last block has a state root matching a state at its slot, so it can
+// be used as an origin checkpoint.
+func makeChain(t *testing.T, headSlot uint64) []*ethpb.SignedBeaconBlock {
+	genesis := testutil.NewBeaconBlock()
+	parentRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	chain := []*ethpb.SignedBeaconBlock{genesis}
+	for slot := uint64(1); slot <= headSlot; slot++ {
+		if slot%5 == 0 && slot != headSlot {
+			continue
+		}
+		blk := testutil.NewBeaconBlock()
+		blk.Block.Slot = slot
+		blk.Block.ParentRoot = append([]byte{}, parentRoot[:]...)
+		if slot == headSlot {
+			st := testutil.NewBeaconState()
+			require.NoError(t, st.SetSlot(slot))
+			stateRoot, err := st.HashTreeRoot(context.Background())
+			require.NoError(t, err)
+			blk.Block.StateRoot = stateRoot[:]
+		}
+		parentRoot, err = blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+		chain = append(chain, blk)
+	}
+	return chain
+}
+
+// saveOrigin seeds the database with the last block of the chain as the origin checkpoint,
+// so the chain must end at the start of an epoch.
+func saveOrigin(t *testing.T, beaconDB db.Database, chain []*ethpb.SignedBeaconBlock) {
+	origin := chain[len(chain)-1]
+	st := testutil.NewBeaconState()
+	require.NoError(t, st.SetSlot(origin.Block.Slot))
+	require.NoError(t, beaconDB.SaveOriginCheckpoint(context.Background(), st, origin))
+}
+
+// connectServingPeer connects a peer which serves blocks by range from the given chain.
+func connectServingPeer(t *testing.T, host *p2pt.TestP2P, chain []*ethpb.SignedBeaconBlock, finalizedEpoch uint64) *p2pt.TestP2P {
+	p := p2pt.NewTestP2P(t)
+	p.SetStreamHandler(blocksByRangeTopic, func(stream network.Stream) {
+		defer func() {
+			assert.NoError(t, stream.Close())
+		}()
+		req := &p2ppb.BeaconBlocksByRangeRequest{}
+		assert.NoError(t, p.Encoding().DecodeWithMaxLength(stream, req))
+		for _, blk := range chain {
+			if blk.Block.Slot >= req.StartSlot && blk.Block.Slot < req.StartSlot+req.Count {
+				assert.NoError(t, prysmsync.WriteChunk(stream, p.Encoding(), blk))
+			}
+		}
+	})
+	p.Connect(host)
+
+	host.Peers().Add(new(enr.Record), p.PeerID(), nil, network.DirOutbound)
+	host.Peers().SetConnectionState(p.PeerID(), peers.PeerConnected)
+	host.Peers().SetChainState(p.PeerID(), &p2ppb.Status{
+		ForkDigest:     params.BeaconConfig().GenesisForkVersion,
+		FinalizedRoot:  make([]byte, 32),
+		FinalizedEpoch: finalizedEpoch,
+		HeadRoot:       make([]byte, 32),
+	})
+	return p
+}
+
+func TestVerifyBatch(t *testing.T) {
+	chain := makeChain(t, 20)
+	head := chain[len(chain)-1]
+	batch := chain[len(chain)-6 : len(chain)-1]
+
+	require.NoError(t, verifyBatch(batch, bytesutil.ToBytes32(head.Block.ParentRoot)))
+
+	err := verifyBatch(batch, bytesutil.ToBytes32([]byte("bad")))
+	assert.ErrorContains(t, "wanted parent root", err)
+
+	assert.NoError(t, verifyBatch(nil, bytesutil.ToBytes32(head.Block.ParentRoot)))
+
+	// A batch with a gap in the chain does not verify.
+	gapped := append([]*ethpb.SignedBeaconBlock{chain[0]}, batch[1:]...)
+	err = verifyBatch(gapped, bytesutil.ToBytes32(head.Block.ParentRoot))
+	assert.ErrorContains(t, "wanted parent root", err)
+}
+
+func TestService_Initialize_GenesisNode(t *testing.T) {
+	beaconDB, _ := dbtest.SetupDB(t)
+	s := NewService(context.Background(), &Config{DB: beaconDB})
+	require.NoError(t, s.initialize(context.Background()))
+	slot, complete := s.BackfillProgress()
+	assert.Equal(t, uint64(0), slot)
+	assert.Equal(t, true, complete)
+}
+
+func TestService_Initialize_ResumesFromBackfillRoot(t *testing.T) {
+	ctx := context.Background()
+	beaconDB, _ := dbtest.SetupDB(t)
+	headSlot := params.BeaconConfig().SlotsPerEpoch
+	chain := makeChain(t, headSlot)
+	saveOrigin(t, beaconDB, chain)
+
+	s := NewService(ctx, &Config{DB: beaconDB})
+	require.NoError(t, s.initialize(ctx))
+	slot, complete := s.BackfillProgress()
+	assert.Equal(t, headSlot, slot)
+	assert.Equal(t, false, complete)
+
+	lowest := chain[len(chain)-4]
+	lowestRoot, err := lowest.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveBlock(ctx, lowest))
+	require.NoError(t, beaconDB.SaveBackfillBlockRoot(ctx, lowestRoot))
+	require.NoError(t, s.initialize(ctx))
+	slot, complete = s.BackfillProgress()
+	assert.Equal(t, lowest.Block.Slot, slot)
+	assert.Equal(t, false, complete)
+	assert.Equal(t, bytesutil.ToBytes32(lowest.Block.ParentRoot), s.lowestParentRoot())
+}
+
+func TestService_BackfillToGenesis(t *testing.T) {
+	ctx := context.Background()
+	beaconDB, _ := dbtest.SetupDB(t)
+	headSlot := params.BeaconConfig().SlotsPerEpoch * 2
+	chain := makeChain(t, headSlot)
+	saveOrigin(t, beaconDB, chain)
+
+	p1 := p2pt.NewTestP2P(t)
+	connectServingPeer(t, p1, chain, 2)
+
+	s := NewService(ctx, &Config{
+		P2P:         p1,
+		DB:          beaconDB,
+		InitialSync: &mockSync.Sync{IsSyncing: false},
+	})
+	s.batchSize = 7
+	s.Start()
+
+	slot, complete := s.BackfillProgress()
+	assert.Equal(t, uint64(0), slot)
+	assert.Equal(t, true, complete)
+	for _, blk := range chain {
+		root, err := blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+		assert.Equal(t, true, beaconDB.HasBlock(ctx, root), "Missing block at slot %d", blk.Block.Slot)
+		assert.Equal(t, true, beaconDB.HasStateSummary(ctx, root), "Missing state summary at slot %d", blk.Block.Slot)
+		assert.Equal(t, true, beaconDB.IsFinalizedBlock(ctx, root), "Block at slot %d is not finalized", blk.Block.Slot)
+	}
+	genesis, err := beaconDB.GenesisBlock(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, true, proto.Equal(chain[0], genesis), "Wanted: %v, received: %v", chain[0], genesis)
+	genesisRoot, err := chain[0].Block.HashTreeRoot()
+	require.NoError(t, err)
+	backfillRoot, err := beaconDB.BackfillBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, genesisRoot, backfillRoot)
+}
+
+func TestService_BackfillToRetentionWindow(t *testing.T) {
+	ctx := context.Background()
+	beaconDB, _ := dbtest.SetupDB(t)
+	headSlot := params.BeaconConfig().SlotsPerEpoch * 3
+	chain := makeChain(t, headSlot)
+	saveOrigin(t, beaconDB, chain)
+
+	p1 := p2pt.NewTestP2P(t)
+	connectServingPeer(t, p1, chain, 3)
+
+	s := NewService(ctx, &Config{
+		P2P:                    p1,
+		DB:                     beaconDB,
+		InitialSync:            &mockSync.Sync{IsSyncing: false},
+		HistoryRetentionEpochs: 1,
+	})
+	s.batchSize = 7
+	s.Start()
+
+	stopSlot := params.BeaconConfig().SlotsPerEpoch * 2
+	slot, complete := s.BackfillProgress()
+	assert.Equal(t, true, complete)
+	assert.Equal(t, true, slot <= stopSlot, "Backfill stopped at slot %d above the retention window", slot)
+	for _, blk := range chain {
+		root, err := blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+		if blk.Block.Slot >= stopSlot {
+			assert.Equal(t, true, beaconDB.HasBlock(ctx, root), "Missing block at slot %d", blk.Block.Slot)
+		} else if blk.Block.Slot < slot {
+			assert.Equal(t, false, beaconDB.HasBlock(ctx, root), "Block at slot %d is below the retention window", blk.Block.Slot)
+		}
+	}
+}
+
+func TestService_Initialize_PrunedHistory(t *testing.T) {
+	ctx := context.Background()
+	beaconDB, _ := dbtest.SetupDB(t)
+	headSlot := params.BeaconConfig().SlotsPerEpoch * 2
+	chain := makeChain(t, headSlot)
+	saveOrigin(t, beaconDB, chain)
+	// The origin state anchors the retained history.
+	lowest, err := beaconDB.PruneHistory(ctx, headSlot)
+	require.NoError(t, err)
+	require.Equal(t, headSlot, lowest)
+
+	s := NewService(ctx, &Config{DB: beaconDB})
+	require.NoError(t, s.initialize(ctx))
+	slot, complete := s.BackfillProgress()
+	assert.Equal(t, headSlot, slot)
+	assert.Equal(t, true, complete, "Backfill would refill pruned history")
+}
+
+func TestService_RequestBlocks_OutOfRange(t *testing.T) {
+	ctx := context.Background()
+	chain := makeChain(t, 20)
+	p1 := p2pt.NewTestP2P(t)
+	p2 := p2pt.NewTestP2P(t)
+	p2.SetStreamHandler(blocksByRangeTopic, func(stream network.Stream) {
+		defer func() {
+			assert.NoError(t, stream.Close())
+		}()
+		req := &p2ppb.BeaconBlocksByRangeRequest{}
+		assert.NoError(t, p2.Encoding().DecodeWithMaxLength(stream, req))
+		assert.NoError(t, prysmsync.WriteChunk(stream, p2.Encoding(), chain[len(chain)-1]))
+	})
+	p1.Connect(p2)
+
+	s := NewService(ctx, &Config{P2P: p1})
+	req := &p2ppb.BeaconBlocksByRangeRequest{StartSlot: 0, Count: 10, Step: 1}
+	_, err := s.requestBlocks(ctx, req, p2.PeerID())
+	assert.ErrorContains(t, errInvalidFetchedData.Error(), err)
+}
+
+func TestService_RequestBlocks_WaitsForQuota(t *testing.T) {
+	p := p2pt.NewTestP2P(t)
+	s := NewService(context.Background(), &Config{
+		P2P:         p,
+		BlocksQuota: prysmsync.Quota{Rate: 0.000001, Burst: 10},
+	})
+	pid := p.PeerID()
+	s.rateLimiter.Add(pid.String(), 5)
+
+	// The request does not fit in the remaining budget, so it waits until the service stops.
+	require.NoError(t, s.Stop())
+	req := &p2ppb.BeaconBlocksByRangeRequest{StartSlot: 0, Count: 10, Step: 1}
+	_, err := s.requestBlocks(context.Background(), req, pid)
+	assert.Equal(t, context.Canceled, err)
+	assert.Equal(t, int64(5), s.rateLimiter.Remaining(pid.String()))
+}
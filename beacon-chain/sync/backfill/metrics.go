@@ -0,0 +1,21 @@
+package backfill
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	backfillLowestSlot = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "backfill_lowest_slot",
+		Help: "The slot of the lowest block saved by the historical block backfill.",
+	})
+	backfillBlocksTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "backfill_blocks_total",
+		Help: "Count of historical blocks saved by the backfill.",
+	})
+	backfillBatchesFailedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "backfill_batches_failed_total",
+		Help: "Count of backfill batches that could not be fetched or verified.",
+	})
+)
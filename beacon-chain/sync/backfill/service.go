@@ -0,0 +1,234 @@
+// Package backfill downloads the historical blocks below the finalized anchor of a
+// checkpoint-synced node, so the database eventually holds the full chain back to genesis.
+package backfill
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/kevinms/leakybucket-go"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	prysmsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+var _ = shared.Service(&Service{})
+
+// pollInterval is how often the service checks whether initial sync has finished.
+const pollInterval = time.Second
+
+// retryInterval is how long the service waits after a failed batch before retrying.
+const retryInterval = 2 * time.Second
+
+// ProgressFetcher reports the progress of the historical block backfill.
+type ProgressFetcher interface {
+	BackfillProgress() (lowestSlot uint64, complete bool)
+}
+
+// Config to set up the backfill service.
+type Config struct {
+	P2P         p2p.P2P
+	DB          db.NoHeadAccessDatabase
+	InitialSync prysmsync.Checker
+	// HistoryRetentionEpochs bounds the backfill to the epochs of history the node keeps below
+	// the origin block. Zero backfills the full history.
+	HistoryRetentionEpochs uint64
+	// BlocksQuota is the outbound budget of block requests to a peer. The default quota is used
+	// if unset.
+	BlocksQuota prysmsync.Quota
+}
+
+// Service requests blocks below the origin block from peers, verifies that they link to
+// the blocks already saved through their parent roots and saves them to the database.
+type Service struct {
+	ctx          context.Context
+	cancel       context.CancelFunc
+	p2p          p2p.P2P
+	db           db.NoHeadAccessDatabase
+	initialSync  prysmsync.Checker
+	batchSize    uint64
+	retention    uint64
+	rateLimiter  *leakybucket.Collector
+	originEpoch  uint64
+	stopSlot     uint64
+	lock         sync.RWMutex
+	lowestSlot   uint64
+	lowestParent [32]byte
+	complete     bool
+}
+
+// NewService configures the backfill service.
+func NewService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	batchSize := uint64(flags.Get().BlockBatchLimit)
+	if batchSize == 0 {
+		batchSize = params.BeaconConfig().SlotsPerEpoch
+	}
+	quota := cfg.BlocksQuota
+	if quota.Burst == 0 {
+		quota = prysmsync.DefaultQuotas()[p2p.RPCBlocksByRangeTopic].Outbound
+	}
+	return &Service{
+		ctx:         ctx,
+		cancel:      cancel,
+		p2p:         cfg.P2P,
+		db:          cfg.DB,
+		initialSync: cfg.InitialSync,
+		batchSize:   batchSize,
+		retention:   cfg.HistoryRetentionEpochs,
+		rateLimiter: leakybucket.NewCollector(quota.Rate, quota.Burst, false /* deleteEmptyBuckets */),
+	}
+}
+
+// Start the backfill service. Backfill only runs for nodes started from a checkpoint, and
+// waits for initial sync to complete so it does not compete with it for peers.
+func (s *Service) Start() {
+	if err := s.initialize(s.ctx); err != nil {
+		log.WithError(err).Error("Could not initialize historical block backfill")
+		return
+	}
+	if s.isComplete() {
+		return
+	}
+	if !s.waitForInitialSync() {
+		return
+	}
+	log.WithField("slot", s.lowestBlockSlot()).Info("Backfilling historical blocks below the origin block")
+	s.run()
+}
+
+// Stop the backfill service.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the backfill service.
+func (s *Service) Status() error {
+	return nil
+}
+
+// BackfillProgress returns the slot of the lowest block available in the database and
+// whether the database holds every block back to genesis.
+func (s *Service) BackfillProgress() (uint64, bool) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.lowestSlot, s.complete
+}
+
+// initialize resumes from the lowest block saved by a previous run, or from the origin block.
+// Backfill stops at the retention window of a node keeping a bounded history, and never runs
+// once that history was pruned.
+func (s *Service) initialize(ctx context.Context) error {
+	prunedSlot, err := s.db.LowestRetainedSlot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve lowest retained slot")
+	}
+	if prunedSlot > 0 {
+		s.setLowest(prunedSlot, [32]byte{}, true)
+		return nil
+	}
+	originRoot, err := s.db.OriginBlockRoot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve origin block root")
+	}
+	if originRoot == [32]byte{} {
+		// The node was started from genesis and already has the full chain.
+		s.setLowest(0, [32]byte{}, true)
+		return nil
+	}
+	origin, err := s.db.Block(ctx, originRoot)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve origin block")
+	}
+	if origin == nil || origin.Block == nil {
+		return errors.New("origin block not found in database")
+	}
+	s.originEpoch = helpers.SlotToEpoch(origin.Block.Slot)
+	s.stopSlot = 0
+	if s.retention > 0 && s.originEpoch > s.retention {
+		s.stopSlot, err = helpers.StartSlot(s.originEpoch - s.retention)
+		if err != nil {
+			return err
+		}
+	}
+
+	root, err := s.db.BackfillBlockRoot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve backfill block root")
+	}
+	lowest := origin
+	if root != [32]byte{} {
+		lowest, err = s.db.Block(ctx, root)
+		if err != nil {
+			return errors.Wrap(err, "could not retrieve lowest backfilled block")
+		}
+		if lowest == nil || lowest.Block == nil {
+			return errors.New("lowest backfilled block not found in database")
+		}
+	}
+	s.setLowest(lowest.Block.Slot, bytesutil.ToBytes32(lowest.Block.ParentRoot), lowest.Block.Slot <= s.stopSlot)
+	return nil
+}
+
+// waitForInitialSync blocks until initial sync is complete. It returns false if the
+// service is stopped before that happens.
+func (s *Service) waitForInitialSync() bool {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for s.initialSync.Syncing() {
+		select {
+		case <-s.ctx.Done():
+			return false
+		case <-ticker.C:
+		}
+	}
+	return true
+}
+
+// wait pauses the backfill for the given duration. It returns false if the service is
+// stopped in the meantime.
+func (s *Service) wait(d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-s.ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+func (s *Service) setLowest(slot uint64, parentRoot [32]byte, complete bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.lowestSlot = slot
+	s.lowestParent = parentRoot
+	s.complete = complete
+	backfillLowestSlot.Set(float64(slot))
+}
+
+func (s *Service) lowestBlockSlot() uint64 {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.lowestSlot
+}
+
+func (s *Service) lowestParentRoot() [32]byte {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.lowestParent
+}
+
+func (s *Service) isComplete() bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.complete
+}
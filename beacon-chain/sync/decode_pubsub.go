@@ -11,10 +11,10 @@ import (
 )
 
 func (s *Service) decodePubsubMessage(msg *pubsub.Message) (proto.Message, error) {
-	if msg == nil || msg.TopicIDs == nil || len(msg.TopicIDs) == 0 {
+	if msg == nil || msg.Topic == nil || *msg.Topic == "" {
 		return nil, errors.New("nil pubsub message")
 	}
-	topic := msg.TopicIDs[0]
+	topic := *msg.Topic
 	topic = strings.TrimSuffix(topic, s.p2p.Encoding().ProtocolSuffix())
 	topic = s.replaceForkDigest(topic)
 	base, ok := p2p.GossipTopicMappings[topic]
@@ -26,6 +26,10 @@ var errInvalidFinalizedRoot = errors.New("invalid finalized root")
 var errInvalidSequenceNum = errors.New(seqError)
 var errGeneric = errors.New(genericError)
 
+// errOutboundRateLimited is returned for requests we did not send to stay within our own
+// outbound budget. It says nothing of the peer, so the peer must not be penalized for it.
+var errOutboundRateLimited = errors.New("outbound request rate limited")
+
 var responseCodeSuccess = byte(0x00)
 var responseCodeInvalidRequest = byte(0x01)
 var responseCodeServerError = byte(0x02)
@@ -60,6 +60,7 @@ type blocksFetcherConfig struct {
 	p2p                      p2p.P2P
 	peerFilterCapacityWeight float64
 	mode                     syncMode
+	blocksQuota              prysmsync.Quota
 }
 
 // blocksFetcher is a service to fetch chain data from peers.
@@ -108,11 +109,13 @@ type fetchRequestResponse struct {
 // newBlocksFetcher creates ready to use fetcher.
 func newBlocksFetcher(ctx context.Context, cfg *blocksFetcherConfig) *blocksFetcher {
 	blocksPerSecond := flags.Get().BlockBatchLimit
-	allowedBlocksBurst := flags.Get().BlockBatchLimitBurstFactor * flags.Get().BlockBatchLimit
-	// Allow fetcher to go almost to the full burst capacity (less a single batch).
-	rateLimiter := leakybucket.NewCollector(
-		float64(blocksPerSecond), int64(allowedBlocksBurst-blocksPerSecond),
-		false /* deleteEmptyBuckets */)
+	// Requests to a peer take from the outbound budget of block requests, which by default
+	// allows the fetcher to go almost to the full burst capacity (less a single batch).
+	quota := cfg.blocksQuota
+	if quota.Burst == 0 {
+		quota = prysmsync.DefaultQuotas()[p2p.RPCBlocksByRangeTopic].Outbound
+	}
+	rateLimiter := leakybucket.NewCollector(quota.Rate, quota.Burst, false /* deleteEmptyBuckets */)
 
 	capacityWeight := cfg.peerFilterCapacityWeight
 	if capacityWeight >= 1 {
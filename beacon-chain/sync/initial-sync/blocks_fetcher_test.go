@@ -89,6 +89,19 @@ func TestBlocksFetcher_InitStartStop(t *testing.T) {
 	})
 }
 
+func TestBlocksFetcher_BlocksQuota(t *testing.T) {
+	p := p2pt.NewTestP2P(t)
+	fetcher := newBlocksFetcher(context.Background(), &blocksFetcherConfig{p2p: p})
+	defaultQuota := beaconsync.DefaultQuotas()[p2pm.RPCBlocksByRangeTopic].Outbound
+	assert.Equal(t, defaultQuota.Burst, fetcher.rateLimiter.Capacity())
+
+	fetcher = newBlocksFetcher(context.Background(), &blocksFetcherConfig{
+		p2p:         p,
+		blocksQuota: beaconsync.Quota{Rate: 16, Burst: 128},
+	})
+	assert.Equal(t, int64(128), fetcher.rateLimiter.Capacity())
+}
+
 func TestBlocksFetcher_RoundRobin(t *testing.T) {
 	blockBatchLimit := uint64(flags.Get().BlockBatchLimit)
 	requestsGenerator := func(start, end uint64, batchSize uint64) []*fetchRequestParams {
@@ -10,6 +10,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	prysmsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
 	"github.com/sirupsen/logrus"
 )
 
@@ -55,6 +56,7 @@ type blocksQueueConfig struct {
 	highestExpectedSlot uint64
 	p2p                 p2p.P2P
 	mode                syncMode
+	blocksQuota         prysmsync.Quota
 }
 
 // blocksQueue is a priority queue that serves as a intermediary between block fetchers (producers)
@@ -90,6 +92,7 @@ func newBlocksQueue(ctx context.Context, cfg *blocksQueueConfig) *blocksQueue {
 			headFetcher:         cfg.headFetcher,
 			finalizationFetcher: cfg.finalizationFetcher,
 			p2p:                 cfg.p2p,
+			blocksQuota:         cfg.blocksQuota,
 		})
 	}
 	highestExpectedSlot := cfg.highestExpectedSlot
@@ -59,6 +59,7 @@ func (s *Service) roundRobinSync(genesis time.Time) error {
 		finalizationFetcher: s.chain,
 		highestExpectedSlot: highestFinalizedSlot,
 		mode:                modeStopOnFinalizedEpoch,
+		blocksQuota:         s.blocksQuota,
 	})
 	if err := queue.start(); err != nil {
 		return err
@@ -93,6 +94,7 @@ func (s *Service) roundRobinSync(genesis time.Time) error {
 		finalizationFetcher: s.chain,
 		highestExpectedSlot: helpers.SlotsSince(genesis),
 		mode:                modeNonConstrained,
+		blocksQuota:         s.blocksQuota,
 	})
 	if err := queue.start(); err != nil {
 		return err
@@ -17,6 +17,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	prysmsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
 	"github.com/prysmaticlabs/prysm/shared"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/timeutils"
@@ -42,6 +43,9 @@ type Config struct {
 	BlockNotifier blockfeed.Notifier
 	WspBlockRoot  []byte
 	WspEpoch      uint64
+	// BlocksQuota is the outbound budget of block requests to a peer. The default quota is used
+	// if unset.
+	BlocksQuota prysmsync.Quota
 }
 
 // Service service.
@@ -56,6 +60,7 @@ type Service struct {
 	stateNotifier     statefeed.Notifier
 	counter           *ratecounter.RateCounter
 	lastProcessedSlot uint64
+	blocksQuota       prysmsync.Quota
 }
 
 // NewService configures the initial sync service responsible for bringing the node up to the
@@ -70,6 +75,7 @@ func NewService(ctx context.Context, cfg *Config) *Service {
 		db:            cfg.DB,
 		stateNotifier: cfg.StateNotifier,
 		counter:       ratecounter.NewRateCounter(counterSeconds * time.Second),
+		blocksQuota:   cfg.BlocksQuota,
 	}
 }
 
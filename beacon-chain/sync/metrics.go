@@ -41,6 +41,13 @@ var (
 		},
 		[]string{"topic"},
 	)
+	rateLimitViolationsCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "p2p_rpc_rate_limit_violations_total",
+			Help: "Count of rpc requests over the rate limit of a peer, by topic and direction.",
+		},
+		[]string{"topic", "direction"},
+	)
 	numberOfTimesResyncedCounter = promauto.NewCounter(
 		prometheus.CounterOpts{
 			Name: "number_of_times_resynced",
@@ -1,13 +1,12 @@
 package sync
 
 import (
-	"reflect"
 	"sync"
 
 	"github.com/kevinms/leakybucket-go"
 	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/pkg/errors"
-	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/sirupsen/logrus"
 )
@@ -15,43 +14,51 @@ import (
 const defaultBurstLimit = 5
 
 type limiter struct {
-	limiterMap map[string]*leakybucket.Collector
-	p2p        p2p.P2P
+	limiterMap  map[string]*leakybucket.Collector
+	outboundMap map[string]*leakybucket.Collector
+	quotas      Quotas
+	p2p         p2p.P2P
 	sync.RWMutex
 }
 
-// Instantiates a multi-rpc protocol rate limiter, providing
-// separate collectors for each topic.
+// Instantiates a multi-rpc protocol rate limiter with the default quotas.
 func newRateLimiter(p2pProvider p2p.P2P) *limiter {
+	return newQuotaRateLimiter(p2pProvider, DefaultQuotas())
+}
+
+// Instantiates a multi-rpc protocol rate limiter, providing separate
+// inbound and outbound collectors for each topic. Each collector tracks
+// a bucket per peer. Topics without a quota use the default one, and block
+// requests by root share the collectors of block requests by range.
+func newQuotaRateLimiter(p2pProvider p2p.P2P, quotas Quotas) *limiter {
+	defaults := DefaultQuotas()
+	inbound := make(map[string]*leakybucket.Collector, len(p2p.RPCTopicMappings))
+	outbound := make(map[string]*leakybucket.Collector, len(p2p.RPCTopicMappings))
+	topicQuotas := make(Quotas, len(p2p.RPCTopicMappings))
 	// add encoding suffix
 	addEncoding := func(topic string) string {
 		return topic + p2pProvider.Encoding().ProtocolSuffix()
 	}
-	// Initialize block limits.
-	allowedBlocksPerSecond := float64(flags.Get().BlockBatchLimit)
-	allowedBlocksBurst := int64(flags.Get().BlockBatchLimitBurstFactor * flags.Get().BlockBatchLimit)
-
-	// Set topic map for all rpc topics.
-	topicMap := make(map[string]*leakybucket.Collector, len(p2p.RPCTopicMappings))
-	// Goodbye Message
-	topicMap[addEncoding(p2p.RPCGoodByeTopic)] = leakybucket.NewCollector(1, 1, false /* deleteEmptyBuckets */)
-	// Metadata Message
-	topicMap[addEncoding(p2p.RPCMetaDataTopic)] = leakybucket.NewCollector(1, defaultBurstLimit, false /* deleteEmptyBuckets */)
-	// Ping Message
-	topicMap[addEncoding(p2p.RPCPingTopic)] = leakybucket.NewCollector(1, defaultBurstLimit, false /* deleteEmptyBuckets */)
-	// Status Message
-	topicMap[addEncoding(p2p.RPCStatusTopic)] = leakybucket.NewCollector(1, defaultBurstLimit, false /* deleteEmptyBuckets */)
-
-	// Use a single collector for block requests
-	blockCollector := leakybucket.NewCollector(allowedBlocksPerSecond, allowedBlocksBurst, false /* deleteEmptyBuckets */)
-
-	// BlocksByRoots requests
-	topicMap[addEncoding(p2p.RPCBlocksByRootTopic)] = blockCollector
-
-	// BlockByRange requests
-	topicMap[addEncoding(p2p.RPCBlocksByRangeTopic)] = blockCollector
-
-	return &limiter{limiterMap: topicMap, p2p: p2pProvider}
+	for topic := range p2p.RPCTopicMappings {
+		if sharedBudgetTopic(topic) != topic {
+			continue
+		}
+		quota, ok := quotas[topic]
+		if !ok {
+			quota = defaults[topic]
+		}
+		inbound[addEncoding(topic)] = leakybucket.NewCollector(quota.Inbound.Rate, quota.Inbound.Burst, false /* deleteEmptyBuckets */)
+		outbound[addEncoding(topic)] = leakybucket.NewCollector(quota.Outbound.Rate, quota.Outbound.Burst, false /* deleteEmptyBuckets */)
+		topicQuotas[addEncoding(topic)] = quota
+	}
+	for topic := range p2p.RPCTopicMappings {
+		if shared := sharedBudgetTopic(topic); shared != topic {
+			inbound[addEncoding(topic)] = inbound[addEncoding(shared)]
+			outbound[addEncoding(topic)] = outbound[addEncoding(shared)]
+			topicQuotas[addEncoding(topic)] = topicQuotas[addEncoding(shared)]
+		}
+	}
+	return &limiter{limiterMap: inbound, outboundMap: outbound, quotas: topicQuotas, p2p: p2pProvider}
 }
 
 // Returns the current topic collector for the provided topic.
@@ -80,6 +87,7 @@ func (l *limiter) validateRequest(stream network.Stream, amt uint64) error {
 		amt = 1
 	}
 	if amt > uint64(remaining) {
+		rateLimitViolationsCounter.WithLabelValues(topic, "inbound").Inc()
 		l.p2p.Peers().Scorers().BadResponsesScorer().Increment(stream.Conn().RemotePeer())
 		if l.p2p.Peers().IsBad(stream.Conn().RemotePeer()) {
 			log.Debug("Disconnecting bad peer")
@@ -112,25 +120,87 @@ func (l *limiter) add(stream network.Stream, amt int64) {
 	collector.Add(key, amt)
 }
 
-// frees all the collectors and removes them.
+// validateOutbound checks that a request to the peer with the accompanying cost
+// stays within our outbound budget for the topic, and takes the cost off the budget
+// if so. Requests over budget are not to be sent, so that we stay clear of the rate
+// limits of the peer, and errOutboundRateLimited is returned. A nil limiter imposes
+// no limits.
+func (l *limiter) validateOutbound(topic string, pid peer.ID, amt uint64) error {
+	if l == nil {
+		return nil
+	}
+	l.Lock()
+	defer l.Unlock()
+
+	topic += l.p2p.Encoding().ProtocolSuffix()
+	collector, ok := l.outboundMap[topic]
+	if !ok {
+		return errors.Errorf("outbound collector does not exist for topic %s", topic)
+	}
+	// Treat each request as a minimum of 1.
+	if amt == 0 {
+		amt = 1
+	}
+	key := pid.String()
+	if amt > uint64(collector.Remaining(key)) {
+		rateLimitViolationsCounter.WithLabelValues(topic, "outbound").Inc()
+		l.topicLogger(topic).WithField("peer", pid).Debug("Not sending request over outbound rate limit")
+		return errOutboundRateLimited
+	}
+	collector.Add(key, int64(amt))
+	return nil
+}
+
+// returns the fill levels of the inbound and outbound buckets of the provided peers.
+func (l *limiter) buckets(pids []peer.ID) map[peer.ID][]*RateLimitBucket {
+	l.RLock()
+	defer l.RUnlock()
+
+	result := make(map[peer.ID][]*RateLimitBucket, len(pids))
+	for _, pid := range pids {
+		key := pid.String()
+		buckets := make([]*RateLimitBucket, 0, len(l.limiterMap)+len(l.outboundMap))
+		for topic, collector := range l.limiterMap {
+			quota := l.quotas[topic].Inbound
+			buckets = append(buckets, &RateLimitBucket{
+				Topic:     topic,
+				Capacity:  quota.Burst,
+				Remaining: collector.Remaining(key),
+				Rate:      quota.Rate,
+			})
+		}
+		for topic, collector := range l.outboundMap {
+			quota := l.quotas[topic].Outbound
+			buckets = append(buckets, &RateLimitBucket{
+				Topic:     topic,
+				Outbound:  true,
+				Capacity:  quota.Burst,
+				Remaining: collector.Remaining(key),
+				Rate:      quota.Rate,
+			})
+		}
+		sortBuckets(buckets)
+		result[pid] = buckets
+	}
+	return result
+}
+
+// frees all the collectors and removes them. Collectors shared between topics
+// are freed once, as freeing a collector twice panics.
 func (l *limiter) free() {
 	l.Lock()
 	defer l.Unlock()
 
-	tempMap := map[uintptr]bool{}
-	for t, collector := range l.limiterMap {
-		// Check if collector has already been cleared off
-		// as all collectors are not distinct from each other.
-		ptr := reflect.ValueOf(collector).Pointer()
-		if tempMap[ptr] {
+	freed := make(map[*leakybucket.Collector]bool)
+	for _, collectors := range []map[string]*leakybucket.Collector{l.limiterMap, l.outboundMap} {
+		for t, collector := range collectors {
+			if !freed[collector] {
+				collector.Free()
+				freed[collector] = true
+			}
 			// Remove from map
-			delete(l.limiterMap, t)
-			continue
+			delete(collectors, t)
 		}
-		collector.Free()
-		// Remove from map
-		delete(l.limiterMap, t)
-		tempMap[ptr] = true
 	}
 }
 
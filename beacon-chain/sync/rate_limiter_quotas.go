@@ -0,0 +1,171 @@
+package sync
+
+import (
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+)
+
+// Quota is the budget of a peer on an rpc topic. Requests are counted in blocks for block
+// requests, and in requests for all other topics. A peer may use up to Burst units at once,
+// which are replenished at Rate units per second.
+type Quota struct {
+	Rate  float64
+	Burst int64
+}
+
+// TopicQuota holds the separate budgets for requests received from a peer, and for requests
+// sent to a peer.
+type TopicQuota struct {
+	Inbound  Quota
+	Outbound Quota
+}
+
+// Quotas maps rpc topics, without encoding suffix, to their quotas.
+type Quotas map[string]*TopicQuota
+
+// DefaultQuotas returns the quotas of all rpc topics. Block requests are limited by
+// --block-batch-limit and --block-batch-limit-burst-factor. As peers run the same defaults,
+// outbound budgets equal inbound ones to stay clear of the limits of remote peers, less a
+// single batch for block requests.
+//
+// Block requests by range and by root take from a single budget, so both block topics always
+// hold the same quota.
+func DefaultQuotas() Quotas {
+	both := func(q Quota) *TopicQuota {
+		return &TopicQuota{Inbound: q, Outbound: q}
+	}
+	request := Quota{Rate: 1, Burst: defaultBurstLimit}
+	blocks := func() *TopicQuota {
+		batch := int64(flags.Get().BlockBatchLimit)
+		burst := int64(flags.Get().BlockBatchLimitBurstFactor) * batch
+		return &TopicQuota{
+			Inbound:  Quota{Rate: float64(batch), Burst: burst},
+			Outbound: Quota{Rate: float64(batch), Burst: burst - batch},
+		}
+	}
+	return Quotas{
+		p2p.RPCGoodByeTopic:       both(Quota{Rate: 1, Burst: 1}),
+		p2p.RPCMetaDataTopic:      both(request),
+		p2p.RPCPingTopic:          both(request),
+		p2p.RPCStatusTopic:        both(request),
+		p2p.RPCBlocksByRootTopic:  blocks(),
+		p2p.RPCBlocksByRangeTopic: blocks(),
+	}
+}
+
+// sharedBudgetTopic returns the topic whose budget requests on the topic take from. Block
+// requests by root take from the budget of block requests by range.
+func sharedBudgetTopic(topic string) string {
+	if topic == p2p.RPCBlocksByRootTopic {
+		return p2p.RPCBlocksByRangeTopic
+	}
+	return topic
+}
+
+// ParseQuotas returns the default quotas overridden by the provided specs. A spec has the form
+// <method>[.inbound|.outbound]=<rate>/<burst>, where the method is the protocol name of the rpc
+// topic, such as ping or beacon_blocks_by_range. Specs without a direction set both budgets.
+// Specs for either block method set the shared budget of block requests.
+func ParseQuotas(specs []string) (Quotas, error) {
+	quotas := DefaultQuotas()
+	methods := make(map[string]string, len(p2p.RPCTopicMappings))
+	for topic := range p2p.RPCTopicMappings {
+		methods[rpcMethod(topic)] = topic
+	}
+	for _, spec := range specs {
+		parts := strings.Split(spec, "=")
+		if len(parts) != 2 {
+			return nil, errors.Errorf("invalid rpc quota %q, expected <method>=<rate>/<burst>", spec)
+		}
+		method, direction := parts[0], ""
+		if i := strings.LastIndex(method, "."); i >= 0 {
+			method, direction = method[:i], method[i+1:]
+		}
+		topic, ok := methods[method]
+		if !ok {
+			return nil, errors.Errorf("invalid rpc quota %q, unknown method %s", spec, method)
+		}
+		quota, err := parseQuota(parts[1])
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid rpc quota %q", spec)
+		}
+		switch direction {
+		case "":
+			quotas[topic].Inbound = quota
+			quotas[topic].Outbound = quota
+		case "inbound":
+			quotas[topic].Inbound = quota
+		case "outbound":
+			quotas[topic].Outbound = quota
+		default:
+			return nil, errors.Errorf("invalid rpc quota %q, unknown direction %s", spec, direction)
+		}
+		if topic == p2p.RPCBlocksByRootTopic || topic == p2p.RPCBlocksByRangeTopic {
+			*quotas[p2p.RPCBlocksByRootTopic] = *quotas[topic]
+			*quotas[p2p.RPCBlocksByRangeTopic] = *quotas[topic]
+		}
+	}
+	return quotas, nil
+}
+
+func parseQuota(s string) (Quota, error) {
+	parts := strings.Split(s, "/")
+	if len(parts) != 2 {
+		return Quota{}, errors.New("expected <rate>/<burst>")
+	}
+	rate, err := strconv.ParseFloat(parts[0], 64)
+	if err != nil || rate <= 0 {
+		return Quota{}, errors.Errorf("rate %s is not a positive number", parts[0])
+	}
+	burst, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil || burst <= 0 {
+		return Quota{}, errors.Errorf("burst %s is not a positive integer", parts[1])
+	}
+	return Quota{Rate: rate, Burst: burst}, nil
+}
+
+// rpcMethod returns the protocol name of an rpc topic, such as status for
+// /eth2/beacon_chain/req/status/1.
+func rpcMethod(topic string) string {
+	return path.Base(path.Dir(topic))
+}
+
+// RateLimitBucket is the fill level of the bucket of a peer for an rpc topic.
+type RateLimitBucket struct {
+	Topic     string
+	Outbound  bool
+	Capacity  int64
+	Remaining int64
+	Rate      float64
+}
+
+// RateLimitsFetcher returns the fill levels of the rate limiting buckets of peers.
+type RateLimitsFetcher interface {
+	RateLimits(pids ...peer.ID) map[peer.ID][]*RateLimitBucket
+}
+
+// RateLimits returns the fill levels of the inbound and outbound buckets of the provided peers on
+// every rpc topic, or those of all connected peers if no peers are provided.
+func (s *Service) RateLimits(pids ...peer.ID) map[peer.ID][]*RateLimitBucket {
+	if len(pids) == 0 {
+		pids = s.p2p.Peers().Connected()
+	}
+	return s.rateLimiter.buckets(pids)
+}
+
+// sortBuckets orders buckets by topic, with the inbound bucket of a topic first.
+func sortBuckets(buckets []*RateLimitBucket) {
+	sort.Slice(buckets, func(i, j int) bool {
+		if buckets[i].Topic != buckets[j].Topic {
+			return buckets[i].Topic < buckets[j].Topic
+		}
+		return !buckets[i].Outbound && buckets[j].Outbound
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/libp2p/go-libp2p-core/protocol"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	mockp2p "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
@@ -18,12 +19,45 @@ import (
 func TestNewRateLimiter(t *testing.T) {
 	rlimiter := newRateLimiter(mockp2p.NewTestP2P(t))
 	assert.Equal(t, len(rlimiter.limiterMap), 6, "correct number of topics not registered")
+	assert.Equal(t, len(rlimiter.outboundMap), 6, "correct number of outbound topics not registered")
+}
+
+func TestNewQuotaRateLimiter(t *testing.T) {
+	p := mockp2p.NewTestP2P(t)
+	rlimiter := newQuotaRateLimiter(p, Quotas{
+		p2p.RPCPingTopic: {Inbound: Quota{Rate: 2, Burst: 20}, Outbound: Quota{Rate: 1, Burst: 10}},
+	})
+	topic := p2p.RPCPingTopic + p.Encoding().ProtocolSuffix()
+	assert.Equal(t, int64(20), rlimiter.limiterMap[topic].Remaining(p.PeerID().String()))
+	assert.Equal(t, int64(10), rlimiter.outboundMap[topic].Remaining(p.PeerID().String()))
+	// Topics without a quota use the default one.
+	topic = p2p.RPCGoodByeTopic + p.Encoding().ProtocolSuffix()
+	assert.Equal(t, int64(1), rlimiter.limiterMap[topic].Remaining(p.PeerID().String()))
+	assert.Equal(t, int64(1), rlimiter.outboundMap[topic].Remaining(p.PeerID().String()))
+}
+
+func TestNewQuotaRateLimiter_SharedBlocksBudget(t *testing.T) {
+	p := mockp2p.NewTestP2P(t)
+	rlimiter := newQuotaRateLimiter(p, Quotas{
+		p2p.RPCBlocksByRangeTopic: {Inbound: Quota{Rate: 0.000001, Burst: 100}, Outbound: Quota{Rate: 0.000001, Burst: 50}},
+	})
+	byRange := p2p.RPCBlocksByRangeTopic + p.Encoding().ProtocolSuffix()
+	byRoot := p2p.RPCBlocksByRootTopic + p.Encoding().ProtocolSuffix()
+	assert.Equal(t, rlimiter.limiterMap[byRange], rlimiter.limiterMap[byRoot], "Inbound block budget not shared")
+	assert.Equal(t, rlimiter.outboundMap[byRange], rlimiter.outboundMap[byRoot], "Outbound block budget not shared")
+
+	key := p.PeerID().String()
+	require.NoError(t, rlimiter.validateOutbound(p2p.RPCBlocksByRootTopic, p.PeerID(), 30))
+	assert.Equal(t, int64(20), rlimiter.outboundMap[byRange].Remaining(key))
+	assert.Equal(t, errOutboundRateLimited, rlimiter.validateOutbound(p2p.RPCBlocksByRangeTopic, p.PeerID(), 30))
+	assert.Equal(t, int64(100), rlimiter.limiterMap[byRoot].Remaining(key))
 }
 
 func TestNewRateLimiter_FreeCorrectly(t *testing.T) {
 	rlimiter := newRateLimiter(mockp2p.NewTestP2P(t))
 	rlimiter.free()
 	assert.Equal(t, len(rlimiter.limiterMap), 0, "rate limiter not freed correctly")
+	assert.Equal(t, len(rlimiter.outboundMap), 0, "rate limiter not freed correctly")
 
 }
 
@@ -62,3 +96,88 @@ func TestRateLimiter_ExceedCapacity(t *testing.T) {
 		t.Fatal("Did not receive stream within 1 sec")
 	}
 }
+
+func TestRateLimiter_ValidateOutbound(t *testing.T) {
+	p1 := mockp2p.NewTestP2P(t)
+	p2 := mockp2p.NewTestP2P(t)
+	p3 := mockp2p.NewTestP2P(t)
+	rlimiter := newQuotaRateLimiter(p1, Quotas{
+		p2p.RPCStatusTopic: {Inbound: Quota{Rate: 0.000001, Burst: 5}, Outbound: Quota{Rate: 0.000001, Burst: 2}},
+	})
+
+	require.NoError(t, rlimiter.validateOutbound(p2p.RPCStatusTopic, p2.PeerID(), 1))
+	require.NoError(t, rlimiter.validateOutbound(p2p.RPCStatusTopic, p2.PeerID(), 0))
+	assert.Equal(t, errOutboundRateLimited, rlimiter.validateOutbound(p2p.RPCStatusTopic, p2.PeerID(), 1))
+	// Budgets are tracked per peer.
+	require.NoError(t, rlimiter.validateOutbound(p2p.RPCStatusTopic, p3.PeerID(), 2))
+	// Outbound requests do not take from the inbound budget.
+	topic := p2p.RPCStatusTopic + p1.Encoding().ProtocolSuffix()
+	assert.Equal(t, int64(5), rlimiter.limiterMap[topic].Remaining(p2.PeerID().String()))
+
+	var nilLimiter *limiter
+	assert.NoError(t, nilLimiter.validateOutbound(p2p.RPCStatusTopic, p2.PeerID(), 100))
+}
+
+func TestRateLimiter_Buckets(t *testing.T) {
+	p1 := mockp2p.NewTestP2P(t)
+	p2 := mockp2p.NewTestP2P(t)
+	rlimiter := newQuotaRateLimiter(p1, Quotas{
+		p2p.RPCPingTopic: {Inbound: Quota{Rate: 0.000001, Burst: 5}, Outbound: Quota{Rate: 0.000001, Burst: 3}},
+	})
+	require.NoError(t, rlimiter.validateOutbound(p2p.RPCPingTopic, p2.PeerID(), 1))
+
+	buckets := rlimiter.buckets([]peer.ID{p2.PeerID()})[p2.PeerID()]
+	require.Equal(t, 2*len(p2p.RPCTopicMappings), len(buckets))
+	topic := p2p.RPCPingTopic + p1.Encoding().ProtocolSuffix()
+	var inbound, outbound *RateLimitBucket
+	for i, bucket := range buckets {
+		if i > 0 {
+			assert.Equal(t, true, buckets[i-1].Topic <= bucket.Topic, "Buckets not sorted by topic")
+		}
+		if bucket.Topic != topic {
+			continue
+		}
+		if bucket.Outbound {
+			outbound = bucket
+		} else {
+			inbound = bucket
+		}
+	}
+	require.NotNil(t, inbound)
+	require.NotNil(t, outbound)
+	assert.DeepEqual(t, &RateLimitBucket{Topic: topic, Capacity: 5, Remaining: 5, Rate: 0.000001}, inbound)
+	assert.DeepEqual(t, &RateLimitBucket{Topic: topic, Outbound: true, Capacity: 3, Remaining: 2, Rate: 0.000001}, outbound)
+}
+
+func TestParseQuotas(t *testing.T) {
+	quotas, err := ParseQuotas([]string{
+		"ping=2/10",
+		"beacon_blocks_by_range.inbound=32/320",
+		"status.outbound=0.5/1",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, len(p2p.RPCTopicMappings), len(quotas))
+	assert.DeepEqual(t, &TopicQuota{Inbound: Quota{Rate: 2, Burst: 10}, Outbound: Quota{Rate: 2, Burst: 10}}, quotas[p2p.RPCPingTopic])
+	assert.DeepEqual(t, Quota{Rate: 32, Burst: 320}, quotas[p2p.RPCBlocksByRangeTopic].Inbound)
+	assert.DeepEqual(t, DefaultQuotas()[p2p.RPCBlocksByRangeTopic].Outbound, quotas[p2p.RPCBlocksByRangeTopic].Outbound)
+	assert.DeepEqual(t, DefaultQuotas()[p2p.RPCStatusTopic].Inbound, quotas[p2p.RPCStatusTopic].Inbound)
+	assert.DeepEqual(t, Quota{Rate: 0.5, Burst: 1}, quotas[p2p.RPCStatusTopic].Outbound)
+	// Block requests share a budget.
+	assert.DeepEqual(t, quotas[p2p.RPCBlocksByRangeTopic], quotas[p2p.RPCBlocksByRootTopic])
+
+	tests := []struct {
+		spec string
+		err  string
+	}{
+		{spec: "ping", err: "expected <method>=<rate>/<burst>"},
+		{spec: "pong=1/5", err: "unknown method pong"},
+		{spec: "ping.sideways=1/5", err: "unknown direction sideways"},
+		{spec: "ping=1", err: "expected <rate>/<burst>"},
+		{spec: "ping=0/5", err: "rate 0 is not a positive number"},
+		{spec: "ping=1/1.5", err: "burst 1.5 is not a positive integer"},
+	}
+	for _, tt := range tests {
+		_, err := ParseQuotas([]string{tt.spec})
+		assert.ErrorContains(t, tt.err, err, tt.spec)
+	}
+}
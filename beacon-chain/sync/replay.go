@@ -0,0 +1,81 @@
+package sync
+
+import (
+	"context"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/recorder"
+)
+
+// NewReplayService initializes a sync service which only processes the gossip messages passed to
+// ReplayGossip. Unlike NewService, no handlers are registered with the p2p service and no
+// background routines are started, so that nothing but the replayed messages is processed.
+func NewReplayService(ctx context.Context, cfg *Config) (*Service, error) {
+	s := newService(ctx, cfg)
+	if err := s.initCaches(); err != nil {
+		return nil, err
+	}
+	s.chainStarted = true
+	return s, nil
+}
+
+// ReplayGossip runs a recorded gossip message through the validator of its topic and, if the
+// message is accepted, through the subscriber of the topic, just like a message received from
+// the network. It returns the validation result along with the error of the subscriber, if any.
+// Messages are processed synchronously, callers replaying a recording should pin the clock to
+// the recorded time of the message for the replay to be deterministic.
+func (s *Service) ReplayGossip(ctx context.Context, entry *recorder.Entry) (pubsub.ValidationResult, error) {
+	if entry.Kind != recorder.KindGossip {
+		return pubsub.ValidationIgnore, errors.Errorf("can not replay %s entry as gossip", entry.Kind)
+	}
+	validate, handle, err := s.gossipHandlers(entry.Topic)
+	if err != nil {
+		return pubsub.ValidationIgnore, err
+	}
+	msg := &pubsub.Message{
+		Message: &pubsubpb.Message{
+			Data:  entry.Data,
+			Topic: &entry.Topic,
+		},
+		ReceivedFrom: entry.Peer,
+	}
+	result := validate(ctx, entry.Peer, msg)
+	if result != pubsub.ValidationAccept {
+		return result, nil
+	}
+	m, ok := msg.ValidatorData.(proto.Message)
+	if !ok {
+		return result, errors.New("validator did not decode message")
+	}
+	return result, handle(ctx, m)
+}
+
+// gossipHandlers returns the validator and subscriber the gossip topic is subscribed to with in
+// registerSubscribers.
+func (s *Service) gossipHandlers(topic string) (pubsub.ValidatorEx, subHandler, error) {
+	suffix := s.p2p.Encoding().ProtocolSuffix()
+	if !strings.HasSuffix(topic, suffix) || strings.Count(topic, "/") < 3 {
+		return nil, nil, errors.Errorf("unsupported gossip topic %s", topic)
+	}
+	format := s.replaceForkDigest(strings.TrimSuffix(topic, suffix))
+	switch {
+	case format == p2p.BlockSubnetTopicFormat:
+		return s.validateBeaconBlockPubSub, s.beaconBlockSubscriber, nil
+	case format == p2p.AggregateAndProofSubnetTopicFormat:
+		return s.validateAggregateAndProof, s.beaconAggregateProofSubscriber, nil
+	case format == p2p.ExitSubnetTopicFormat:
+		return s.validateVoluntaryExit, s.voluntaryExitSubscriber, nil
+	case format == p2p.ProposerSlashingSubnetTopicFormat:
+		return s.validateProposerSlashing, s.proposerSlashingSubscriber, nil
+	case format == p2p.AttesterSlashingSubnetTopicFormat:
+		return s.validateAttesterSlashing, s.attesterSlashingSubscriber, nil
+	case strings.HasPrefix(format, strings.TrimSuffix(p2p.AttestationSubnetTopicFormat, "%d")):
+		return s.validateCommitteeIndexBeaconAttestation, s.committeeIndexBeaconAttestationSubscriber, nil
+	}
+	return nil, nil, errors.Errorf("no handlers for gossip topic %s", topic)
+}
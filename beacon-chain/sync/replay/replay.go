@@ -0,0 +1,257 @@
+// Package replay feeds a recording of gossip traffic through the validators and subscribers of
+// the sync service, against a snapshot of the beacon node database.
+//
+// A replay is deterministic: the snapshot is copied so that every replay starts from the same
+// database, the gossip messages are processed one at a time in the order they were received in,
+// and the clock is pinned to the time each message was received at while it is processed. This
+// allows a recording of a misbehaving node to be turned into a regression test.
+//
+// The chain service is initialized from the snapshot without its routines driven by the wall
+// clock, so attestations are only applied to fork choice as the replayed blocks are processed.
+//
+// The clock is pinned with timeutils.SetNow, which overrides the time for the whole process. A
+// replay must therefore not run in a process hosting a live beacon node, and replays in the same
+// process are serialized.
+package replay
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/recorder"
+	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	chainSync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "replay")
+
+// clockLock serializes replays, as the clock they pin is global to the process.
+var clockLock sync.Mutex
+
+// Config for a replay.
+type Config struct {
+	// DBPath is the path to the beacon node database file to replay against. The file is copied
+	// before the replay and left untouched.
+	DBPath string
+	// Entries of the recording. Entries other than gossip messages are skipped.
+	Entries []*recorder.Entry
+	// LocalPeer is the peer ID of the recording node. Messages it published itself are accepted
+	// without validation by the node, so they are skipped as well.
+	LocalPeer peer.ID
+}
+
+// Result of replaying a gossip message.
+type Result struct {
+	Entry *recorder.Entry
+	// Result is the validation result of the replayed message.
+	Result string
+	// Err is the error of the subscriber, or of replaying the message at all.
+	Err error
+}
+
+// Diverged returns whether the replayed message validated differently from the recorded one.
+func (r *Result) Diverged() bool {
+	return r.Entry.Result != "" && r.Entry.Result != r.Result
+}
+
+// Replay runs the gossip messages of the recording through the sync service and returns the
+// result of every message replayed, in replay order. The clock of the process is pinned for the
+// duration of the replay, see the package documentation.
+func Replay(ctx context.Context, cfg *Config) ([]*Result, error) {
+	entries := gossipEntries(cfg.Entries, cfg.LocalPeer)
+	if len(entries) == 0 {
+		return nil, errors.New("no gossip messages to replay")
+	}
+	clockLock.Lock()
+	defer clockLock.Unlock()
+	// Start the chain at the time of the first message, rather than the time of the replay.
+	resetClock := timeutils.SetNow(func() time.Time {
+		return entries[0].Time
+	})
+	defer resetClock()
+
+	dir, err := ioutil.TempDir("", "replay")
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create temporary directory")
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			log.WithError(err).Error("Could not remove temporary directory")
+		}
+	}()
+	if err := copyFile(cfg.DBPath, path.Join(dir, kv.DatabaseFileName)); err != nil {
+		return nil, errors.Wrap(err, "could not copy database snapshot")
+	}
+	stateSummaryCache := cache.NewStateSummaryCache()
+	beaconDB, err := db.NewDB(dir, stateSummaryCache)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not open database snapshot")
+	}
+	defer func() {
+		if err := beaconDB.Close(); err != nil {
+			log.WithError(err).Error("Could not close database")
+		}
+	}()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	s, err := newSyncService(ctx, beaconDB, stateSummaryCache)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*Result, 0, len(entries))
+	for _, entry := range entries {
+		if err := ctx.Err(); err != nil {
+			return results, err
+		}
+		resetClock := timeutils.SetNow(func() time.Time {
+			return entry.Time
+		})
+		result, err := s.ReplayGossip(ctx, entry)
+		resetClock()
+		results = append(results, &Result{
+			Entry:  entry,
+			Result: recorder.ResultName(result),
+			Err:    err,
+		})
+	}
+	return results, nil
+}
+
+// gossipEntries returns the gossip messages received from remote peers, sorted by the time
+// they were received at. Messages are recorded once validated, so the recording order may differ
+// slightly from the order they were received in.
+func gossipEntries(entries []*recorder.Entry, localPeer peer.ID) []*recorder.Entry {
+	var gossip []*recorder.Entry
+	for _, entry := range entries {
+		if entry.Kind != recorder.KindGossip || (localPeer != "" && entry.Peer == localPeer) {
+			continue
+		}
+		gossip = append(gossip, entry)
+	}
+	sort.SliceStable(gossip, func(i, j int) bool {
+		return gossip[i].Time.Before(gossip[j].Time)
+	})
+	return gossip
+}
+
+// newSyncService initializes a chain service from the database and returns a sync service
+// processing gossip messages against it. The chain service routines are not started, as they run
+// on the wall clock rather than on the replayed one. Nothing is sent to the network.
+func newSyncService(ctx context.Context, beaconDB db.Database, stateSummaryCache *cache.StateSummaryCache) (*chainSync.Service, error) {
+	p2p := p2ptest.NewFuzzTestP2P()
+	stateGen := stategen.New(beaconDB, stateSummaryCache)
+	stateNotifier := &mock.MockStateNotifier{}
+	attPool := attestations.NewPool()
+	exitPool := voluntaryexits.NewPool()
+	slashingPool := slashings.NewPool()
+	opsService, err := attestations.NewService(ctx, &attestations.Config{Pool: attPool})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create attestation pool service")
+	}
+	chain, err := blockchain.NewService(ctx, &blockchain.Config{
+		BeaconDB:        beaconDB,
+		AttPool:         attPool,
+		ExitPool:        exitPool,
+		SlashingPool:    slashingPool,
+		P2p:             p2p,
+		StateNotifier:   stateNotifier,
+		ForkChoiceStore: protoarray.New(0, 0, [32]byte{}),
+		OpsService:      opsService,
+		StateGen:        stateGen,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create chain service")
+	}
+	if err := chain.Resume(); err != nil {
+		return nil, errors.Wrap(err, "could not initialize chain service")
+	}
+
+	return chainSync.NewReplayService(ctx, &chainSync.Config{
+		DB:                  beaconDB,
+		P2P:                 p2p,
+		Chain:               chain,
+		InitialSync:         &notSyncing{},
+		StateNotifier:       stateNotifier,
+		BlockNotifier:       &mock.MockBlockNotifier{},
+		AttestationNotifier: &mock.MockOperationNotifier{},
+		AttPool:             attPool,
+		ExitPool:            exitPool,
+		SlashingPool:        slashingPool,
+		StateSummaryCache:   stateSummaryCache,
+		StateGen:            stateGen,
+	})
+}
+
+// notSyncing reports the replayed node as synced, as it was when the messages were recorded.
+type notSyncing struct{}
+
+func (*notSyncing) Syncing() bool {
+	return false
+}
+
+func (*notSyncing) Status() error {
+	return nil
+}
+
+func (*notSyncing) Resync() error {
+	return nil
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := in.Close(); err != nil {
+			log.WithError(err).Debug("Could not close file")
+		}
+	}()
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_EXCL, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		_ = out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+// Summary counts the replayed messages by validation result, along with the number of messages
+// which diverged from the recording or failed in the subscriber.
+func Summary(results []*Result) (byResult map[string]int, diverged int, failed int) {
+	byResult = make(map[string]int)
+	for _, r := range results {
+		byResult[r.Result]++
+		if r.Diverged() {
+			diverged++
+		}
+		if r.Err != nil {
+			failed++
+		}
+	}
+	return byResult, diverged, failed
+}
@@ -0,0 +1,136 @@
+package replay
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/recorder"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestReplay_Deterministic(t *testing.T) {
+	ctx := context.Background()
+	dir := path.Join(testutil.TempDir(), "replaytest")
+	defer func() {
+		require.NoError(t, os.RemoveAll(dir))
+	}()
+
+	// Snapshot a database holding the genesis of the chain.
+	genesis, privKeys := testutil.DeterministicGenesisState(t, 64)
+	stateRoot, err := genesis.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	genesisBlk := blocks.NewGenesisBlock(stateRoot[:])
+	genesisRoot, err := genesisBlk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	store, err := kv.NewKVStore(dir, cache.NewStateSummaryCache())
+	require.NoError(t, err)
+	require.NoError(t, store.SaveBlock(ctx, genesisBlk))
+	require.NoError(t, store.SaveState(ctx, genesis, genesisRoot))
+	require.NoError(t, store.SaveStateSummary(ctx, &pb.StateSummary{Slot: 0, Root: genesisRoot[:]}))
+	require.NoError(t, store.SaveHeadBlockRoot(ctx, genesisRoot))
+	require.NoError(t, store.SaveGenesisBlockRoot(ctx, genesisRoot))
+	require.NoError(t, store.SaveJustifiedCheckpoint(ctx, &ethpb.Checkpoint{Root: genesisRoot[:]}))
+	require.NoError(t, store.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Root: genesisRoot[:]}))
+	require.NoError(t, store.Close())
+	snapshot := path.Join(dir, kv.DatabaseFileName)
+	snapshotContents, err := ioutil.ReadFile(snapshot)
+	require.NoError(t, err)
+
+	blk, err := testutil.GenerateFullBlock(genesis, privKeys, nil, 1)
+	require.NoError(t, err)
+	enc := &encoder.SszNetworkEncoder{}
+	buf := new(bytes.Buffer)
+	_, err = enc.EncodeGossip(buf, blk)
+	require.NoError(t, err)
+	topic := fmt.Sprintf(p2p.BlockSubnetTopicFormat, []byte{1, 2, 3, 4}) + enc.ProtocolSuffix()
+	slotStart := time.Unix(int64(genesis.GenesisTime()+params.BeaconConfig().SecondsPerSlot), 0)
+
+	entries := []*recorder.Entry{
+		{
+			Kind:   recorder.KindGossip,
+			Time:   slotStart.Add(2 * time.Second),
+			Topic:  topic,
+			Peer:   "peer1",
+			Data:   buf.Bytes(),
+			Result: recorder.ResultAccept,
+		},
+		// Recorded after, but received before the block.
+		{
+			Kind:   recorder.KindGossip,
+			Time:   slotStart.Add(time.Second),
+			Topic:  topic,
+			Peer:   "peer2",
+			Data:   []byte("not a block"),
+			Result: recorder.ResultAccept,
+		},
+		// Skipped, as it is no gossip message.
+		{
+			Kind:  recorder.KindRPC,
+			Time:  slotStart,
+			Topic: "/eth2/beacon_chain/req/status/1/ssz_snappy",
+			Peer:  "peer1",
+		},
+		// Skipped, as the recording node published it itself.
+		{
+			Kind:   recorder.KindGossip,
+			Time:   slotStart.Add(3 * time.Second),
+			Topic:  topic,
+			Peer:   "self",
+			Data:   []byte("not a block"),
+			Result: recorder.ResultAccept,
+		},
+	}
+	cfg := &Config{
+		DBPath:    snapshot,
+		Entries:   entries,
+		LocalPeer: "self",
+	}
+
+	for i := 0; i < 2; i++ {
+		results, err := Replay(ctx, cfg)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(results))
+
+		assert.Equal(t, entries[1], results[0].Entry)
+		assert.Equal(t, recorder.ResultReject, results[0].Result)
+		assert.Equal(t, true, results[0].Diverged(), "Rejected message did not diverge from the recording")
+
+		assert.Equal(t, entries[0], results[1].Entry)
+		assert.Equal(t, recorder.ResultAccept, results[1].Result)
+		assert.NoError(t, results[1].Err)
+		assert.Equal(t, false, results[1].Diverged())
+
+		byResult, diverged, failed := Summary(results)
+		assert.DeepEqual(t, map[string]int{recorder.ResultAccept: 1, recorder.ResultReject: 1}, byResult)
+		assert.Equal(t, 1, diverged)
+		assert.Equal(t, 0, failed)
+	}
+
+	// The snapshot is left untouched by the replays.
+	contents, err := ioutil.ReadFile(snapshot)
+	require.NoError(t, err)
+	assert.Equal(t, true, bytes.Equal(snapshotContents, contents), "Snapshot modified by replay")
+}
+
+func TestReplay_NoGossip(t *testing.T) {
+	_, err := Replay(context.Background(), &Config{
+		Entries: []*recorder.Entry{{Kind: recorder.KindRPC}},
+	})
+	assert.ErrorContains(t, "no gossip messages to replay", err)
+}
@@ -0,0 +1,83 @@
+package sync
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/recorder"
+	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestReplayGossip_VoluntaryExit(t *testing.T) {
+	p := p2ptest.NewTestP2P(t)
+	exit, s := setupValidExit(t)
+	pool := voluntaryexits.NewPool()
+	r, err := NewReplayService(context.Background(), &Config{
+		P2P:                 p,
+		Chain:               &mock.ChainService{State: s},
+		InitialSync:         &mockSync.Sync{IsSyncing: false},
+		ExitPool:            pool,
+		AttestationNotifier: &mock.MockOperationNotifier{},
+	})
+	require.NoError(t, err)
+
+	buf := new(bytes.Buffer)
+	_, err = p.Encoding().EncodeGossip(buf, exit)
+	require.NoError(t, err)
+	entry := &recorder.Entry{
+		Kind:  recorder.KindGossip,
+		Topic: fmt.Sprintf(p2p.ExitSubnetTopicFormat, []byte{1, 2, 3, 4}) + p.Encoding().ProtocolSuffix(),
+		Peer:  "peer1",
+		Data:  buf.Bytes(),
+	}
+	result, err := r.ReplayGossip(context.Background(), entry)
+	require.NoError(t, err)
+	assert.Equal(t, pubsub.ValidationAccept, result)
+	assert.Equal(t, 1, len(pool.PendingExits(s, s.Slot())), "Exit not passed to the subscriber")
+
+	// The exit has been seen by the subscriber, so replaying it again ignores it.
+	result, err = r.ReplayGossip(context.Background(), entry)
+	require.NoError(t, err)
+	assert.Equal(t, pubsub.ValidationIgnore, result)
+}
+
+func TestReplayGossip_Unsupported(t *testing.T) {
+	p := p2ptest.NewTestP2P(t)
+	r, err := NewReplayService(context.Background(), &Config{P2P: p})
+	require.NoError(t, err)
+
+	_, err = r.ReplayGossip(context.Background(), &recorder.Entry{Kind: recorder.KindRPC})
+	assert.ErrorContains(t, "can not replay rpc entry", err)
+	_, err = r.ReplayGossip(context.Background(), &recorder.Entry{
+		Kind:  recorder.KindGossip,
+		Topic: "/eth2/01020304/unknown_topic" + p.Encoding().ProtocolSuffix(),
+	})
+	assert.ErrorContains(t, "no handlers for gossip topic", err)
+	_, err = r.ReplayGossip(context.Background(), &recorder.Entry{
+		Kind:  recorder.KindGossip,
+		Topic: "/eth2/01020304/beacon_block/ssz",
+	})
+	assert.ErrorContains(t, "unsupported gossip topic", err)
+}
+
+func TestGossipHandlers_AllTopics(t *testing.T) {
+	p := p2ptest.NewTestP2P(t)
+	r := &Service{p2p: p}
+	for format := range p2p.GossipTopicMappings {
+		topic := fmt.Sprintf(format, []byte{1, 2, 3, 4})
+		if format == p2p.AttestationSubnetTopicFormat {
+			topic = fmt.Sprintf(format, []byte{1, 2, 3, 4}, 5)
+		}
+		_, _, err := r.gossipHandlers(topic + p.Encoding().ProtocolSuffix())
+		assert.NoError(t, err, "No handlers for %s", format)
+	}
+}
@@ -18,6 +18,9 @@ func (s *Service) sendRecentBeaconBlocksRequest(ctx context.Context, blockRoots
 	ctx, cancel := context.WithTimeout(ctx, respTimeout)
 	defer cancel()
 
+	if err := s.rateLimiter.validateOutbound(p2p.RPCBlocksByRootTopic, id, uint64(len(blockRoots))); err != nil {
+		return err
+	}
 	stream, err := s.p2p.Send(ctx, blockRoots, p2p.RPCBlocksByRootTopic, id)
 	if err != nil {
 		return err
@@ -71,6 +71,9 @@ func (s *Service) sendGoodByeMessage(ctx context.Context, code uint64, id peer.I
 	ctx, cancel := context.WithTimeout(ctx, respTimeout)
 	defer cancel()
 
+	if err := s.rateLimiter.validateOutbound(p2p.RPCGoodByeTopic, id, 1); err != nil {
+		return err
+	}
 	stream, err := s.p2p.Send(ctx, &code, p2p.RPCGoodByeTopic, id)
 	if err != nil {
 		return err
@@ -38,6 +38,9 @@ func (s *Service) sendMetaDataRequest(ctx context.Context, id peer.ID) (*pb.Meta
 	ctx, cancel := context.WithTimeout(ctx, respTimeout)
 	defer cancel()
 
+	if err := s.rateLimiter.validateOutbound(p2p.RPCMetaDataTopic, id, 1); err != nil {
+		return nil, err
+	}
 	stream, err := s.p2p.Send(ctx, new(interface{}), p2p.RPCMetaDataTopic, id)
 	if err != nil {
 		return nil, err
@@ -91,6 +91,9 @@ func (s *Service) sendPingRequest(ctx context.Context, id peer.ID) error {
 	defer cancel()
 
 	metadataSeq := s.p2p.MetadataSeq()
+	if err := s.rateLimiter.validateOutbound(p2p.RPCPingTopic, id, 1); err != nil {
+		return err
+	}
 	stream, err := s.p2p.Send(ctx, &metadataSeq, p2p.RPCPingTopic, id)
 	if err != nil {
 		return err
@@ -56,7 +56,11 @@ func (s *Service) maintainPeerStatuses() {
 				if timeutils.Now().After(lastUpdated.Add(interval)) {
 					if err := s.reValidatePeer(s.ctx, id); err != nil {
 						log.WithField("peer", id).WithError(err).Debug("Failed to revalidate peer")
-						s.p2p.Peers().Scorers().BadResponsesScorer().Increment(id)
+						// The status request may have been held back by our own outbound
+						// rate limit, in which case the peer is not at fault.
+						if !errors.Is(err, errOutboundRateLimited) {
+							s.p2p.Peers().Scorers().BadResponsesScorer().Increment(id)
+						}
 					}
 				}
 			}(pid)
@@ -126,6 +130,9 @@ func (s *Service) sendRPCStatusRequest(ctx context.Context, id peer.ID) error {
 		HeadRoot:       headRoot,
 		HeadSlot:       s.chain.HeadSlot(),
 	}
+	if err := s.rateLimiter.validateOutbound(p2p.RPCStatusTopic, id, 1); err != nil {
+		return err
+	}
 	stream, err := s.p2p.Send(ctx, resp, p2p.RPCStatusTopic, id)
 	if err != nil {
 		return err
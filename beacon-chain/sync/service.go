@@ -26,6 +26,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/recorder"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	"github.com/prysmaticlabs/prysm/shared"
 	"github.com/prysmaticlabs/prysm/shared/runutil"
@@ -58,6 +59,8 @@ type Config struct {
 	AttestationNotifier operation.Notifier
 	StateSummaryCache   *cache.StateSummaryCache
 	StateGen            *stategen.State
+	Recorder            *recorder.Recorder
+	RPCQuotas           Quotas
 }
 
 // This defines the interface for interacting with block chain service
@@ -109,13 +112,22 @@ type Service struct {
 	badBlockLock              sync.RWMutex
 	stateSummaryCache         *cache.StateSummaryCache
 	stateGen                  *stategen.State
+	recorder                  *recorder.Recorder
 }
 
 // NewService initializes new regular sync service.
 func NewService(ctx context.Context, cfg *Config) *Service {
-	rLimiter := newRateLimiter(cfg.P2P)
+	r := newService(ctx, cfg)
+
+	go r.registerHandlers()
+
+	return r
+}
+
+func newService(ctx context.Context, cfg *Config) *Service {
+	rLimiter := newQuotaRateLimiter(cfg.P2P, cfg.RPCQuotas)
 	ctx, cancel := context.WithCancel(ctx)
-	r := &Service{
+	return &Service{
 		ctx:                  ctx,
 		cancel:               cancel,
 		db:                   cfg.DB,
@@ -134,11 +146,8 @@ func NewService(ctx context.Context, cfg *Config) *Service {
 		stateSummaryCache:    cfg.StateSummaryCache,
 		stateGen:             cfg.StateGen,
 		rateLimiter:          rLimiter,
+		recorder:             cfg.Recorder,
 	}
-
-	go r.registerHandlers()
-
-	return r
 }
 
 // Start the regular sync service.
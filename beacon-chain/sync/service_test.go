@@ -8,6 +8,7 @@ import (
 	mockChain "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
 	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
 	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
@@ -74,3 +75,15 @@ func TestSyncHandlers_WaitToSync(t *testing.T) {
 	time.Sleep(400 * time.Millisecond)
 	require.Equal(t, true, r.chainStarted, "Did not receive chain start event.")
 }
+
+func TestService_Stop_FreesSharedRateLimits(t *testing.T) {
+	p := p2ptest.NewTestP2P(t)
+	r := newService(context.Background(), &Config{P2P: p})
+	byRange := p2p.RPCBlocksByRangeTopic + p.Encoding().ProtocolSuffix()
+	byRoot := p2p.RPCBlocksByRootTopic + p.Encoding().ProtocolSuffix()
+	require.Equal(t, r.rateLimiter.limiterMap[byRange], r.rateLimiter.limiterMap[byRoot], "Block budget not shared")
+
+	require.NoError(t, r.Stop())
+	assert.Equal(t, 0, len(r.rateLimiter.limiterMap))
+	assert.Equal(t, 0, len(r.rateLimiter.outboundMap))
+}
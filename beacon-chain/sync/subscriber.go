@@ -95,6 +95,9 @@ func (s *Service) subscribeWithBase(base proto.Message, topic string, validator
 	topic += s.p2p.Encoding().ProtocolSuffix()
 	log := log.WithField("topic", topic)
 
+	if s.recorder != nil {
+		validator = s.recorder.WrapValidator(topic, validator)
+	}
 	if err := s.p2p.PubSub().RegisterTopicValidator(wrapAndReportValidation(topic, validator)); err != nil {
 		log.WithError(err).Error("Failed to register validator")
 	}
@@ -164,7 +167,9 @@ func (s *Service) subscribeWithBase(base proto.Message, topic string, validator
 }
 
 // Wrap the pubsub validator with a metric monitoring function. This function increments the
-// appropriate counter if the particular message fails to validate.
+// appropriate counter if the particular message fails to validate. The validation result also
+// feeds gossipsub peer scoring: rejected messages are penalized as invalid deliveries of the
+// peer that sent them, while ignored messages are dropped without penalty.
 func wrapAndReportValidation(topic string, v pubsub.ValidatorEx) (string, pubsub.ValidatorEx) {
 	return topic, func(ctx context.Context, pid peer.ID, msg *pubsub.Message) pubsub.ValidationResult {
 		defer messagehandler.HandlePanic(ctx, msg)
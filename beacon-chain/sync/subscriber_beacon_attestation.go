@@ -46,8 +46,10 @@ func (s *Service) committeeIndexBeaconAttestationSubscriber(ctx context.Context,
 	return s.attPool.SaveUnaggregatedAttestation(a)
 }
 
+// persistentSubnetIndices returns the subnets of the validators attached to the node, along with the
+// long-lived subnets the node serves on its own behalf.
 func (s *Service) persistentSubnetIndices() []uint64 {
-	return cache.SubnetIDs.GetAllSubnets()
+	return sliceutil.SetUint64(append(cache.SubnetIDs.GetAllSubnets(), cache.SubnetIDs.GetLongLivedSubnets()...))
 }
 
 func (s *Service) aggregatorSubnetIndices(currentSlot uint64) []uint64 {
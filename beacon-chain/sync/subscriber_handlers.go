@@ -7,6 +7,8 @@ import (
 	"github.com/gogo/protobuf/proto"
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
 )
 
 func (s *Service) voluntaryExitSubscriber(ctx context.Context, msg proto.Message) error {
@@ -25,6 +27,13 @@ func (s *Service) voluntaryExitSubscriber(ctx context.Context, msg proto.Message
 		return err
 	}
 	s.exitPool.InsertVoluntaryExit(ctx, headState, ve)
+
+	s.attestationNotifier.OperationFeed().Send(&feed.Event{
+		Type: operation.ExitReceived,
+		Data: &operation.ExitReceivedData{
+			Exit: ve,
+		},
+	})
 	return nil
 }
 
@@ -45,6 +54,13 @@ func (s *Service) attesterSlashingSubscriber(ctx context.Context, msg proto.Mess
 			return errors.Wrap(err, "could not insert attester slashing into pool")
 		}
 		s.setAttesterSlashingIndicesSeen(aSlashing.Attestation_1.AttestingIndices, aSlashing.Attestation_2.AttestingIndices)
+
+		s.attestationNotifier.OperationFeed().Send(&feed.Event{
+			Type: operation.AttesterSlashingReceived,
+			Data: &operation.AttesterSlashingReceivedData{
+				AttesterSlashing: aSlashing,
+			},
+		})
 	}
 	return nil
 }
@@ -66,6 +82,13 @@ func (s *Service) proposerSlashingSubscriber(ctx context.Context, msg proto.Mess
 			return errors.Wrap(err, "could not insert proposer slashing into pool")
 		}
 		s.setProposerSlashingIndexSeen(pSlashing.Header_1.Header.ProposerIndex)
+
+		s.attestationNotifier.OperationFeed().Send(&feed.Event{
+			Type: operation.ProposerSlashingReceived,
+			Data: &operation.ProposerSlashingReceivedData{
+				ProposerSlashing: pSlashing,
+			},
+		})
 	}
 	return nil
 }
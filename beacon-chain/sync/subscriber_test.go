@@ -80,6 +80,7 @@ func TestSubscribe_ReceivesAttesterSlashing(t *testing.T) {
 		chain:                     chainService,
 		db:                        d,
 		seenAttesterSlashingCache: c,
+		attestationNotifier:       chainService.OperationNotifier(),
 	}
 	topic := "/eth2/%x/attester_slashing"
 	var wg sync.WaitGroup
@@ -131,6 +132,7 @@ func TestSubscribe_ReceivesProposerSlashing(t *testing.T) {
 		chain:                     chainService,
 		db:                        d,
 		seenProposerSlashingCache: c,
+		attestationNotifier:       chainService.OperationNotifier(),
 	}
 	topic := "/eth2/%x/proposer_slashing"
 	var wg sync.WaitGroup
@@ -36,10 +36,14 @@ func (s *Service) validateCommitteeIndexBeaconAttestation(ctx context.Context, p
 	ctx, span := trace.StartSpan(ctx, "sync.validateCommitteeIndexBeaconAttestation")
 	defer span.End()
 
+	if msg.Topic == nil {
+		return pubsub.ValidationReject
+	}
+
 	// Override topic for decoding.
-	originalTopic := msg.TopicIDs[0]
+	originalTopic := *msg.Topic
 	format := p2p.GossipTypeMapping[reflect.TypeOf(&eth.Attestation{})]
-	msg.TopicIDs[0] = format
+	msg.Topic = &format
 
 	m, err := s.decodePubsubMessage(msg)
 	if err != nil {
@@ -48,7 +52,7 @@ func (s *Service) validateCommitteeIndexBeaconAttestation(ctx context.Context, p
 		return pubsub.ValidationReject
 	}
 	// Restore topic.
-	msg.TopicIDs[0] = originalTopic
+	msg.Topic = &originalTopic
 
 	att, ok := m.(*eth.Attestation)
 	if !ok {
@@ -64,6 +64,9 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.MaxGoroutines,
 			cmd.ForceClearDB,
 			cmd.ClearDB,
+			cmd.BackupIntervalFlag,
+			cmd.BackupRetentionFlag,
+			cmd.BackupIncrementalsFlag,
 			cmd.ConfigFileFlag,
 			cmd.ChainConfigFileFlag,
 			cmd.GrpcMaxCallRecvMsgSizeFlag,
@@ -100,14 +103,27 @@ var appHelpFlagGroups = []flagGroup{
 			flags.UnsafeSync,
 			flags.DisableSync,
 			flags.SlotsPerArchivedPoint,
+			flags.SlotsPerArchiveSnapshot,
+			flags.HistoryRetentionEpochs,
 			flags.DisableDiscv5,
 			flags.BlockBatchLimit,
 			flags.BlockBatchLimitBurstFactor,
+			flags.RPCQuotas,
 			flags.EnableDebugRPCEndpoints,
 			flags.SlotsPerArchivedPoint,
 			flags.HistoricalSlasherNode,
 			flags.ChainID,
 			flags.NetworkID,
+			flags.CheckpointStateFlag,
+			flags.CheckpointBlockFlag,
+			flags.DepositSnapshotFlag,
+			flags.Eth1SimulatorFlag,
+			flags.Eth1SimulatorBlockTimeFlag,
+			flags.Eth1SimulatorHTTPPortFlag,
+			flags.ExportFileFlag,
+			flags.ExportFileMaxSizeFlag,
+			flags.ExportFileMaxBackupsFlag,
+			flags.ExportSocketFlag,
 		},
 	},
 	{
@@ -117,6 +133,14 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.P2PHost,
 			cmd.P2PHostDNS,
 			cmd.P2PMaxPeers,
+			flags.P2PMaxPeersPerIP,
+			flags.P2PMaxPeersPerSubnet,
+			flags.P2PMinOutboundPeersRatio,
+			flags.P2PLongLivedSubnets,
+			flags.P2PMinPeersPerSubnet,
+			flags.P2PRecordDir,
+			flags.P2PRecordMaxFileSize,
+			flags.P2PRecordMaxFiles,
 			cmd.P2PPrivKey,
 			cmd.P2PMetadata,
 			cmd.P2PAllowList,
@@ -30,6 +30,7 @@ type ETH1ChainData struct {
 	BeaconState          *v1.BeaconState     `protobuf:"bytes,3,opt,name=beacon_state,json=beaconState,proto3" json:"beacon_state,omitempty"`
 	Trie                 *SparseMerkleTrie   `protobuf:"bytes,4,opt,name=trie,proto3" json:"trie,omitempty"`
 	DepositContainers    []*DepositContainer `protobuf:"bytes,5,rep,name=deposit_containers,json=depositContainers,proto3" json:"deposit_containers,omitempty"`
+	DepositSnapshot      *DepositSnapshot    `protobuf:"bytes,6,opt,name=deposit_snapshot,json=depositSnapshot,proto3" json:"deposit_snapshot,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
 	XXX_unrecognized     []byte              `json:"-"`
 	XXX_sizecache        int32               `json:"-"`
@@ -103,6 +104,13 @@ func (m *ETH1ChainData) GetDepositContainers() []*DepositContainer {
 	return nil
 }
 
+func (m *ETH1ChainData) GetDepositSnapshot() *DepositSnapshot {
+	if m != nil {
+		return m.DepositSnapshot
+	}
+	return nil
+}
+
 type LatestETH1Data struct {
 	BlockHeight          uint64   `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
 	BlockTime            uint64   `protobuf:"varint,3,opt,name=block_time,json=blockTime,proto3" json:"block_time,omitempty"`
@@ -434,6 +442,85 @@ func (m *DepositContainer) GetDepositRoot() []byte {
 	return nil
 }
 
+type DepositSnapshot struct {
+	Finalized            [][]byte `protobuf:"bytes,1,rep,name=finalized,proto3" json:"finalized,omitempty"`
+	DepositRoot          []byte   `protobuf:"bytes,2,opt,name=deposit_root,json=depositRoot,proto3" json:"deposit_root,omitempty"`
+	DepositCount         uint64   `protobuf:"varint,3,opt,name=deposit_count,json=depositCount,proto3" json:"deposit_count,omitempty"`
+	Eth1BlockHash        []byte   `protobuf:"bytes,4,opt,name=eth1_block_hash,json=eth1BlockHash,proto3" json:"eth1_block_hash,omitempty"`
+	Eth1BlockHeight      uint64   `protobuf:"varint,5,opt,name=eth1_block_height,json=eth1BlockHeight,proto3" json:"eth1_block_height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DepositSnapshot) Reset()         { *m = DepositSnapshot{} }
+func (m *DepositSnapshot) String() string { return proto.CompactTextString(m) }
+func (*DepositSnapshot) ProtoMessage()    {}
+func (*DepositSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_338787f8da2f3d61, []int{6}
+}
+func (m *DepositSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DepositSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DepositSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DepositSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositSnapshot.Merge(m, src)
+}
+func (m *DepositSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *DepositSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DepositSnapshot proto.InternalMessageInfo
+
+func (m *DepositSnapshot) GetFinalized() [][]byte {
+	if m != nil {
+		return m.Finalized
+	}
+	return nil
+}
+
+func (m *DepositSnapshot) GetDepositRoot() []byte {
+	if m != nil {
+		return m.DepositRoot
+	}
+	return nil
+}
+
+func (m *DepositSnapshot) GetDepositCount() uint64 {
+	if m != nil {
+		return m.DepositCount
+	}
+	return 0
+}
+
+func (m *DepositSnapshot) GetEth1BlockHash() []byte {
+	if m != nil {
+		return m.Eth1BlockHash
+	}
+	return nil
+}
+
+func (m *DepositSnapshot) GetEth1BlockHeight() uint64 {
+	if m != nil {
+		return m.Eth1BlockHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ETH1ChainData)(nil), "prysm.beacon.db.ETH1ChainData")
 	proto.RegisterType((*LatestETH1Data)(nil), "prysm.beacon.db.LatestETH1Data")
@@ -441,54 +528,60 @@ func init() {
 	proto.RegisterType((*SparseMerkleTrie)(nil), "prysm.beacon.db.SparseMerkleTrie")
 	proto.RegisterType((*TrieLayer)(nil), "prysm.beacon.db.TrieLayer")
 	proto.RegisterType((*DepositContainer)(nil), "prysm.beacon.db.DepositContainer")
+	proto.RegisterType((*DepositSnapshot)(nil), "prysm.beacon.db.DepositSnapshot")
 }
 
 func init() { proto.RegisterFile("proto/beacon/db/powchain.proto", fileDescriptor_338787f8da2f3d61) }
 
 var fileDescriptor_338787f8da2f3d61 = []byte{
-	// 666 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0xcf, 0x4e, 0xdb, 0x4e,
-	0x10, 0x96, 0x93, 0xc0, 0x0f, 0x36, 0x21, 0x81, 0xfd, 0x71, 0x88, 0x90, 0x1a, 0x20, 0xa8, 0x12,
-	0xea, 0xc1, 0x6e, 0x52, 0x55, 0xea, 0x81, 0x53, 0x80, 0x2a, 0x55, 0xa9, 0x5a, 0x2d, 0x9c, 0x7a,
-	0x89, 0xd6, 0xf1, 0x28, 0x5e, 0xe1, 0xd8, 0xae, 0x77, 0x42, 0xcb, 0xb9, 0xc7, 0x3e, 0x46, 0x9f,
-	0xa0, 0x6f, 0xd1, 0x63, 0x1f, 0xa1, 0xe2, 0x25, 0x7a, 0xad, 0x76, 0x76, 0x4d, 0x48, 0x00, 0xf5,
-	0x38, 0x33, 0xdf, 0xf7, 0xed, 0xfc, 0xf9, 0x6c, 0xd6, 0xc9, 0x8b, 0x0c, 0xb3, 0x20, 0x04, 0x39,
-	0xce, 0xd2, 0x20, 0x0a, 0x83, 0x3c, 0xfb, 0x3c, 0x8e, 0xa5, 0x4a, 0x7d, 0x2a, 0xf0, 0x56, 0x5e,
-	0x5c, 0xeb, 0xa9, 0x6f, 0xeb, 0x7e, 0x14, 0xee, 0xec, 0x02, 0xc6, 0xc1, 0x55, 0x4f, 0x26, 0x79,
-	0x2c, 0x7b, 0x8e, 0x37, 0x0a, 0x93, 0x6c, 0x7c, 0x69, 0x19, 0x3b, 0xbb, 0x0b, 0x8a, 0x79, 0x3f,
-	0x0f, 0xae, 0x7a, 0x01, 0x5e, 0xe7, 0xa0, 0x2d, 0xa0, 0xfb, 0xa7, 0xc2, 0x36, 0x4e, 0x2f, 0x86,
-	0xbd, 0x63, 0xf3, 0xcc, 0x89, 0x44, 0xc9, 0xdf, 0xb2, 0xad, 0xf1, 0xac, 0x28, 0x20, 0xc5, 0x11,
-	0x60, 0xdc, 0x1b, 0x45, 0x12, 0x65, 0xdb, 0xdb, 0xf3, 0x0e, 0xeb, 0xfd, 0x5d, 0x7f, 0xa9, 0x01,
-	0xff, 0x4c, 0x22, 0x68, 0x34, 0x02, 0x86, 0x2b, 0x5a, 0x8e, 0x79, 0x8a, 0x31, 0x25, 0xf8, 0x90,
-	0xb5, 0x68, 0x00, 0x8d, 0xb2, 0x40, 0x2b, 0x55, 0x79, 0x44, 0x8a, 0x3a, 0x38, 0x37, 0x38, 0x92,
-	0x6a, 0xce, 0x79, 0xa4, 0xf4, 0x9a, 0x35, 0xdc, 0x7c, 0x1a, 0x25, 0x42, 0xbb, 0x4a, 0x32, 0x07,
-	0x3e, 0x60, 0x0c, 0x05, 0xcc, 0x6e, 0x95, 0xf2, 0x7e, 0xee, 0x5f, 0xf5, 0xfc, 0x01, 0x45, 0xe7,
-	0x06, 0x2a, 0xea, 0xe1, 0x3c, 0xe0, 0x2f, 0x59, 0x0d, 0x0b, 0x05, 0xed, 0x1a, 0xf1, 0xf7, 0xef,
-	0xb5, 0x71, 0x9e, 0xcb, 0x42, 0xc3, 0x3b, 0x28, 0x2e, 0x13, 0xb8, 0x28, 0x14, 0x08, 0x82, 0xf3,
-	0x0f, 0x8c, 0x47, 0x90, 0x67, 0x5a, 0xe1, 0x68, 0x9c, 0xa5, 0x28, 0x55, 0x0a, 0x85, 0x6e, 0xaf,
-	0xec, 0x55, 0x1f, 0x14, 0x39, 0xb1, 0xd0, 0xe3, 0x12, 0x29, 0xb6, 0xa2, 0xa5, 0x8c, 0xee, 0x7e,
-	0xf7, 0x58, 0x73, 0x71, 0x7d, 0x7c, 0x9f, 0x35, 0xe8, 0x78, 0xa3, 0x18, 0xd4, 0x24, 0x46, 0x5a,
-	0x55, 0x4d, 0xd4, 0x29, 0x37, 0xa4, 0x14, 0x7f, 0xc2, 0x98, 0x85, 0xa0, 0x9a, 0xda, 0x25, 0xd4,
-	0xc4, 0x3a, 0x65, 0x2e, 0xd4, 0x14, 0xe6, 0xe5, 0x58, 0xea, 0x98, 0x66, 0x6c, 0xb8, 0xf2, 0x50,
-	0xea, 0x98, 0x3f, 0x67, 0xdb, 0x89, 0xd4, 0x38, 0x2a, 0xe0, 0xd3, 0x0c, 0x34, 0x42, 0x64, 0xcd,
-	0xd2, 0x5e, 0x21, 0x1d, 0x6e, 0x6a, 0xa2, 0x2c, 0x0d, 0x4c, 0xa5, 0xfb, 0xad, 0xc2, 0x9a, 0x8b,
-	0x97, 0xe1, 0x5d, 0xd6, 0x98, 0xdf, 0x06, 0x22, 0xf2, 0xc6, 0x9a, 0x58, 0xc8, 0x99, 0x49, 0x26,
-	0x90, 0x82, 0x56, 0xda, 0x36, 0xea, 0x26, 0x71, 0x39, 0x6a, 0xf5, 0x80, 0x6d, 0x94, 0x10, 0xdb,
-	0x84, 0x1d, 0xa6, 0xe4, 0xd1, 0xf3, 0xfc, 0x88, 0xad, 0xcf, 0x4d, 0x58, 0x73, 0xce, 0xb9, 0x3d,
-	0x39, 0x60, 0xec, 0x97, 0xee, 0xf7, 0x4b, 0xcf, 0x89, 0x35, 0x28, 0xdd, 0xf7, 0x9e, 0xfd, 0x7f,
-	0xd7, 0x7d, 0xf6, 0x04, 0xe5, 0xd5, 0x3a, 0x8f, 0xe8, 0xb8, 0xdb, 0x09, 0x7e, 0xc7, 0x80, 0x8e,
-	0xd9, 0xfd, 0xea, 0xb1, 0xcd, 0x65, 0x83, 0xf0, 0x6d, 0xb6, 0x12, 0x41, 0x8e, 0x31, 0x2d, 0xa2,
-	0x26, 0x6c, 0xc0, 0xfb, 0x6c, 0x35, 0x91, 0xd7, 0xc6, 0x24, 0x15, 0x7a, 0x6e, 0xe7, 0x9e, 0x49,
-	0x0c, 0xf9, 0xcc, 0x40, 0x84, 0x43, 0xf2, 0xa7, 0xac, 0x99, 0x15, 0x6a, 0xa2, 0x52, 0x99, 0x8c,
-	0x14, 0xc2, 0x54, 0xb7, 0xab, 0x7b, 0xd5, 0xc3, 0x86, 0xd8, 0x28, 0xb3, 0x6f, 0x4c, 0xb2, 0xbb,
-	0xcf, 0xd6, 0x6f, 0xb9, 0xe6, 0x75, 0x62, 0xb7, 0x3d, 0x82, 0xda, 0xa0, 0xfb, 0xc3, 0x63, 0x9b,
-	0xcb, 0x26, 0x34, 0x50, 0x95, 0x46, 0xf0, 0x85, 0x1a, 0xad, 0x0a, 0x1b, 0xf0, 0x67, 0x6c, 0x8b,
-	0x56, 0xfc, 0x80, 0xf3, 0x5a, 0xa6, 0x30, 0xb8, 0xe3, 0xbe, 0x57, 0xec, 0x3f, 0xb7, 0x45, 0xf7,
-	0xfd, 0xfd, 0x6b, 0x89, 0x25, 0xdc, 0x18, 0xa2, 0xfc, 0x7e, 0x8a, 0x2c, 0x43, 0x67, 0xcd, 0xba,
-	0xcb, 0x89, 0x2c, 0xc3, 0xc1, 0xd1, 0xcf, 0x9b, 0x8e, 0xf7, 0xeb, 0xa6, 0xe3, 0xfd, 0xbe, 0xe9,
-	0x78, 0x1f, 0xfd, 0x89, 0xc2, 0x78, 0x16, 0xfa, 0xe3, 0x6c, 0x1a, 0xd0, 0xe6, 0x24, 0xaa, 0x71,
-	0x22, 0x43, 0x6d, 0xa3, 0x60, 0xe9, 0x57, 0x19, 0xae, 0x52, 0xe2, 0xc5, 0xdf, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x45, 0x35, 0x8c, 0x86, 0x44, 0x05, 0x00, 0x00,
+	// 751 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0xcb, 0x6e, 0xd3, 0x4c,
+	0x14, 0x96, 0x73, 0xe9, 0xdf, 0x4c, 0x6e, 0xed, 0xfc, 0x5d, 0x58, 0x15, 0xa4, 0xa9, 0x2b, 0x50,
+	0xc5, 0xc2, 0x26, 0x41, 0x48, 0x2c, 0xba, 0x4a, 0x5b, 0x14, 0xd4, 0x22, 0xd0, 0xa4, 0x2b, 0x36,
+	0xd1, 0x38, 0x1e, 0xe2, 0x51, 0x13, 0xdb, 0x78, 0x4e, 0x0a, 0x65, 0xcb, 0x92, 0xc7, 0xe0, 0x09,
+	0x78, 0x07, 0x16, 0x48, 0x6c, 0x78, 0x04, 0xd4, 0x27, 0x41, 0x73, 0x71, 0x9c, 0x5b, 0xc5, 0xf2,
+	0x7c, 0xe7, 0x3b, 0x9f, 0xcf, 0x39, 0xf3, 0x9d, 0x04, 0xb5, 0x92, 0x34, 0x86, 0xd8, 0xf3, 0x19,
+	0x1d, 0xc5, 0x91, 0x17, 0xf8, 0x5e, 0x12, 0x7f, 0x1c, 0x85, 0x94, 0x47, 0xae, 0x4a, 0xe0, 0x66,
+	0x92, 0xde, 0x8a, 0xa9, 0xab, 0xf3, 0x6e, 0xe0, 0xef, 0x1f, 0x30, 0x08, 0xbd, 0x9b, 0x0e, 0x9d,
+	0x24, 0x21, 0xed, 0x98, 0xba, 0xa1, 0x3f, 0x89, 0x47, 0xd7, 0xba, 0x62, 0xff, 0x60, 0x49, 0x31,
+	0xe9, 0x26, 0xde, 0x4d, 0xc7, 0x83, 0xdb, 0x84, 0x09, 0x4d, 0x70, 0x7e, 0x14, 0x51, 0xfd, 0xfc,
+	0xaa, 0xdf, 0x39, 0x95, 0x9f, 0x39, 0xa3, 0x40, 0xf1, 0x05, 0xda, 0x1d, 0xcd, 0xd2, 0x94, 0x45,
+	0x30, 0x64, 0x10, 0x76, 0x86, 0x01, 0x05, 0x6a, 0x5b, 0x6d, 0xeb, 0xb8, 0xda, 0x3d, 0x70, 0x57,
+	0x1a, 0x70, 0x2f, 0x29, 0x30, 0x01, 0x52, 0x40, 0xd6, 0x92, 0xa6, 0xa9, 0x3c, 0x87, 0x50, 0x01,
+	0xb8, 0x8f, 0x9a, 0x6a, 0x00, 0x01, 0x34, 0x05, 0x2d, 0x55, 0xb8, 0x47, 0x4a, 0x75, 0x30, 0x90,
+	0x3c, 0x25, 0xd5, 0xc8, 0xeb, 0x94, 0xd2, 0x4b, 0x54, 0x33, 0xf3, 0x09, 0xa0, 0xc0, 0xec, 0xa2,
+	0x92, 0x39, 0x72, 0x19, 0x84, 0x2c, 0x65, 0xb3, 0xb9, 0x52, 0xd2, 0x4d, 0xdc, 0x9b, 0x8e, 0xdb,
+	0x53, 0xd1, 0x40, 0x52, 0x49, 0xd5, 0xcf, 0x03, 0xfc, 0x1c, 0x95, 0x20, 0xe5, 0xcc, 0x2e, 0xa9,
+	0xfa, 0xc3, 0xb5, 0x36, 0x06, 0x09, 0x4d, 0x05, 0x7b, 0xcd, 0xd2, 0xeb, 0x09, 0xbb, 0x4a, 0x39,
+	0x23, 0x8a, 0x8e, 0xdf, 0x22, 0x1c, 0xb0, 0x24, 0x16, 0x1c, 0x86, 0xa3, 0x38, 0x02, 0xca, 0x23,
+	0x96, 0x0a, 0xbb, 0xdc, 0x2e, 0x6e, 0x14, 0x39, 0xd3, 0xd4, 0xd3, 0x8c, 0x49, 0x76, 0x83, 0x15,
+	0x44, 0xe0, 0x0b, 0xb4, 0x93, 0x29, 0x8a, 0x88, 0x26, 0x22, 0x8c, 0xc1, 0xde, 0x52, 0x4d, 0xb5,
+	0xef, 0xd3, 0x1b, 0x18, 0x1e, 0x69, 0x06, 0xcb, 0x80, 0xf3, 0xcd, 0x42, 0x8d, 0xe5, 0xb7, 0xc0,
+	0x87, 0xa8, 0xa6, 0x9c, 0x30, 0x0c, 0x19, 0x1f, 0x87, 0xa0, 0xf6, 0x5e, 0x22, 0x55, 0x85, 0xf5,
+	0x15, 0x84, 0x1f, 0x22, 0xa4, 0x29, 0xc0, 0xa7, 0x7a, 0xa3, 0x25, 0x52, 0x51, 0xc8, 0x15, 0x9f,
+	0xb2, 0x3c, 0x1d, 0x52, 0x11, 0xaa, 0x85, 0xd5, 0x4c, 0xba, 0x4f, 0x45, 0x88, 0x9f, 0xa2, 0xbd,
+	0x09, 0x15, 0x30, 0x4c, 0xd9, 0x87, 0x19, 0x13, 0xc0, 0x02, 0xed, 0x3c, 0xbb, 0xac, 0x74, 0xb0,
+	0xcc, 0x91, 0x2c, 0xd5, 0x93, 0x19, 0xe7, 0x6b, 0x01, 0x35, 0x96, 0x9f, 0x19, 0x3b, 0xa8, 0x96,
+	0x3f, 0x34, 0x0b, 0x94, 0xd1, 0xb6, 0xc9, 0x12, 0x26, 0x27, 0x19, 0xb3, 0x88, 0x09, 0x2e, 0x74,
+	0xa3, 0x66, 0x12, 0x83, 0xa9, 0x56, 0x8f, 0x50, 0x3d, 0xa3, 0xe8, 0x26, 0xf4, 0x30, 0x59, 0x9d,
+	0xfa, 0x3c, 0x3e, 0x41, 0x95, 0xdc, 0xd1, 0x25, 0x63, 0xc3, 0xb9, 0x7f, 0x18, 0x84, 0x6e, 0x76,
+	0x4a, 0x6e, 0x66, 0x60, 0xb2, 0xcd, 0x32, 0x2b, 0xbf, 0x41, 0xff, 0x2f, 0x5a, 0x59, 0x3f, 0x40,
+	0x66, 0x81, 0xd6, 0x3d, 0x3a, 0xe6, 0xe1, 0x08, 0x5e, 0x70, 0xb3, 0xa9, 0x74, 0xbe, 0x58, 0x68,
+	0x67, 0xd5, 0x6d, 0x78, 0x0f, 0x95, 0x03, 0x96, 0x40, 0xa8, 0x16, 0x51, 0x22, 0x3a, 0xc0, 0x5d,
+	0xb4, 0x35, 0xa1, 0xb7, 0xd2, 0x71, 0x05, 0xf5, 0xb9, 0xfd, 0x35, 0x87, 0xc8, 0xe2, 0x4b, 0x49,
+	0x21, 0x86, 0x89, 0x1f, 0xa1, 0x46, 0x9c, 0xf2, 0x31, 0x8f, 0xe8, 0x64, 0xc8, 0x81, 0x4d, 0x85,
+	0x5d, 0x6c, 0x17, 0x8f, 0x6b, 0xa4, 0x9e, 0xa1, 0xaf, 0x24, 0xe8, 0x1c, 0xa2, 0xca, 0xbc, 0x56,
+	0x7e, 0x5d, 0x55, 0xdb, 0x96, 0xa2, 0xea, 0xc0, 0xf9, 0x6e, 0xa1, 0x9d, 0x55, 0x47, 0x4b, 0x2a,
+	0x8f, 0x02, 0xf6, 0x49, 0x35, 0x5a, 0x24, 0x3a, 0xc0, 0x4f, 0xd0, 0xae, 0x5a, 0xf1, 0x06, 0xe7,
+	0x35, 0x65, 0xa2, 0xb7, 0xe0, 0xbe, 0x17, 0xe8, 0x3f, 0xb3, 0x45, 0x73, 0xcc, 0xff, 0x5a, 0x62,
+	0x46, 0x97, 0x86, 0xc8, 0x4e, 0x27, 0x8d, 0x63, 0x30, 0xd6, 0xac, 0x1a, 0x8c, 0xc4, 0x31, 0x38,
+	0xbf, 0x2c, 0xd4, 0x5c, 0xb9, 0x1a, 0xfc, 0x00, 0x55, 0xde, 0xcb, 0xc1, 0xf9, 0x67, 0x65, 0x34,
+	0x39, 0x61, 0x0e, 0xac, 0x89, 0x16, 0xd6, 0x44, 0xa5, 0xcb, 0xf2, 0x1f, 0x81, 0x59, 0x04, 0x99,
+	0xcb, 0xe6, 0xc7, 0x3d, 0x8b, 0x00, 0x3f, 0x46, 0xcd, 0xc5, 0x15, 0xe4, 0xa7, 0x53, 0xcf, 0x17,
+	0x20, 0xcf, 0x67, 0xe3, 0xaa, 0xca, 0x1b, 0x57, 0xd5, 0x3b, 0xf9, 0x79, 0xd7, 0xb2, 0x7e, 0xdf,
+	0xb5, 0xac, 0x3f, 0x77, 0x2d, 0xeb, 0x9d, 0x3b, 0xe6, 0x10, 0xce, 0x7c, 0x77, 0x14, 0x4f, 0x3d,
+	0xe5, 0x03, 0x0a, 0x7c, 0x34, 0xa1, 0xbe, 0xd0, 0x91, 0xb7, 0xf2, 0x2f, 0xe2, 0x6f, 0x29, 0xe0,
+	0xd9, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf3, 0xd1, 0x37, 0x2f, 0x5f, 0x06, 0x00, 0x00,
 }
 
 func (m *ETH1ChainData) Marshal() (dAtA []byte, err error) {
@@ -515,6 +608,18 @@ func (m *ETH1ChainData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.DepositSnapshot != nil {
+		{
+			size, err := m.DepositSnapshot.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPowchain(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.DepositContainers) > 0 {
 		for iNdEx := len(m.DepositContainers) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -849,6 +954,66 @@ func (m *DepositContainer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DepositSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DepositSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepositSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Eth1BlockHeight != 0 {
+		i = encodeVarintPowchain(dAtA, i, uint64(m.Eth1BlockHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Eth1BlockHash) > 0 {
+		i -= len(m.Eth1BlockHash)
+		copy(dAtA[i:], m.Eth1BlockHash)
+		i = encodeVarintPowchain(dAtA, i, uint64(len(m.Eth1BlockHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.DepositCount != 0 {
+		i = encodeVarintPowchain(dAtA, i, uint64(m.DepositCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.DepositRoot) > 0 {
+		i -= len(m.DepositRoot)
+		copy(dAtA[i:], m.DepositRoot)
+		i = encodeVarintPowchain(dAtA, i, uint64(len(m.DepositRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Finalized) > 0 {
+		for iNdEx := len(m.Finalized) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Finalized[iNdEx])
+			copy(dAtA[i:], m.Finalized[iNdEx])
+			i = encodeVarintPowchain(dAtA, i, uint64(len(m.Finalized[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintPowchain(dAtA []byte, offset int, v uint64) int {
 	offset -= sovPowchain(v)
 	base := offset
@@ -888,6 +1053,10 @@ func (m *ETH1ChainData) Size() (n int) {
 			n += 1 + l + sovPowchain(uint64(l))
 		}
 	}
+	if m.DepositSnapshot != nil {
+		l = m.DepositSnapshot.Size()
+		n += 1 + l + sovPowchain(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1021,6 +1190,38 @@ func (m *DepositContainer) Size() (n int) {
 	return n
 }
 
+func (m *DepositSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Finalized) > 0 {
+		for _, b := range m.Finalized {
+			l = len(b)
+			n += 1 + l + sovPowchain(uint64(l))
+		}
+	}
+	l = len(m.DepositRoot)
+	if l > 0 {
+		n += 1 + l + sovPowchain(uint64(l))
+	}
+	if m.DepositCount != 0 {
+		n += 1 + sovPowchain(uint64(m.DepositCount))
+	}
+	l = len(m.Eth1BlockHash)
+	if l > 0 {
+		n += 1 + l + sovPowchain(uint64(l))
+	}
+	if m.Eth1BlockHeight != 0 {
+		n += 1 + sovPowchain(uint64(m.Eth1BlockHeight))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovPowchain(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1234,6 +1435,42 @@ func (m *ETH1ChainData) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositSnapshot", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPowchain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPowchain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPowchain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DepositSnapshot == nil {
+				m.DepositSnapshot = &DepositSnapshot{}
+			}
+			if err := m.DepositSnapshot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPowchain(dAtA[iNdEx:])
@@ -1973,6 +2210,198 @@ func (m *DepositContainer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DepositSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPowchain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DepositSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DepositSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finalized", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPowchain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPowchain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPowchain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Finalized = append(m.Finalized, make([]byte, postIndex-iNdEx))
+			copy(m.Finalized[len(m.Finalized)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPowchain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPowchain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPowchain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositRoot = append(m.DepositRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.DepositRoot == nil {
+				m.DepositRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositCount", wireType)
+			}
+			m.DepositCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPowchain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepositCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eth1BlockHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPowchain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPowchain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPowchain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Eth1BlockHash = append(m.Eth1BlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Eth1BlockHash == nil {
+				m.Eth1BlockHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eth1BlockHeight", wireType)
+			}
+			m.Eth1BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPowchain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Eth1BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPowchain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPowchain
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPowchain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipPowchain(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
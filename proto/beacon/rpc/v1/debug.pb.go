@@ -5,6 +5,7 @@ package ethereum_beacon_rpc_v1
 
 import (
 	context "context"
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	proto "github.com/gogo/protobuf/proto"
 	types "github.com/gogo/protobuf/types"
@@ -58,6 +59,43 @@ func (LoggingLevelRequest_Level) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_851e5cb2de3d61dd, []int{5, 0}
 }
 
+type ChainEvent_Type int32
+
+const (
+	ChainEvent_UNKNOWN              ChainEvent_Type = 0
+	ChainEvent_HEAD                 ChainEvent_Type = 1
+	ChainEvent_REORG                ChainEvent_Type = 2
+	ChainEvent_FINALIZED_CHECKPOINT ChainEvent_Type = 3
+	ChainEvent_JUSTIFIED_CHECKPOINT ChainEvent_Type = 4
+	ChainEvent_SYNC_STATUS          ChainEvent_Type = 5
+)
+
+var ChainEvent_Type_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "HEAD",
+	2: "REORG",
+	3: "FINALIZED_CHECKPOINT",
+	4: "JUSTIFIED_CHECKPOINT",
+	5: "SYNC_STATUS",
+}
+
+var ChainEvent_Type_value = map[string]int32{
+	"UNKNOWN":              0,
+	"HEAD":                 1,
+	"REORG":                2,
+	"FINALIZED_CHECKPOINT": 3,
+	"JUSTIFIED_CHECKPOINT": 4,
+	"SYNC_STATUS":          5,
+}
+
+func (x ChainEvent_Type) String() string {
+	return proto.EnumName(ChainEvent_Type_name, int32(x))
+}
+
+func (ChainEvent_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{20, 0}
+}
+
 type InclusionSlotRequest struct {
 	Id                   uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Slot                 uint64   `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
@@ -728,6 +766,8 @@ type DebugPeerResponse_PeerInfo struct {
 	ProtocolVersion      string       `protobuf:"bytes,4,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
 	AgentVersion         string       `protobuf:"bytes,5,opt,name=agent_version,json=agentVersion,proto3" json:"agent_version,omitempty"`
 	PeerLatency          uint64       `protobuf:"varint,6,opt,name=peer_latency,json=peerLatency,proto3" json:"peer_latency,omitempty"`
+	Score                float64      `protobuf:"fixed64,7,opt,name=score,proto3" json:"score,omitempty"`
+	GossipScore          float64      `protobuf:"fixed64,8,opt,name=gossip_score,json=gossipScore,proto3" json:"gossip_score,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
 	XXX_unrecognized     []byte       `json:"-"`
 	XXX_sizecache        int32        `json:"-"`
@@ -808,1281 +848,8035 @@ func (m *DebugPeerResponse_PeerInfo) GetPeerLatency() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterEnum("ethereum.beacon.rpc.v1.LoggingLevelRequest_Level", LoggingLevelRequest_Level_name, LoggingLevelRequest_Level_value)
-	proto.RegisterType((*InclusionSlotRequest)(nil), "ethereum.beacon.rpc.v1.InclusionSlotRequest")
-	proto.RegisterType((*InclusionSlotResponse)(nil), "ethereum.beacon.rpc.v1.InclusionSlotResponse")
-	proto.RegisterType((*BeaconStateRequest)(nil), "ethereum.beacon.rpc.v1.BeaconStateRequest")
-	proto.RegisterType((*BlockRequest)(nil), "ethereum.beacon.rpc.v1.BlockRequest")
-	proto.RegisterType((*SSZResponse)(nil), "ethereum.beacon.rpc.v1.SSZResponse")
-	proto.RegisterType((*LoggingLevelRequest)(nil), "ethereum.beacon.rpc.v1.LoggingLevelRequest")
-	proto.RegisterType((*ProtoArrayForkChoiceResponse)(nil), "ethereum.beacon.rpc.v1.ProtoArrayForkChoiceResponse")
-	proto.RegisterMapType((map[string]uint64)(nil), "ethereum.beacon.rpc.v1.ProtoArrayForkChoiceResponse.IndicesEntry")
-	proto.RegisterType((*ProtoArrayNode)(nil), "ethereum.beacon.rpc.v1.ProtoArrayNode")
-	proto.RegisterType((*DebugPeerResponses)(nil), "ethereum.beacon.rpc.v1.DebugPeerResponses")
-	proto.RegisterType((*DebugPeerResponse)(nil), "ethereum.beacon.rpc.v1.DebugPeerResponse")
-	proto.RegisterType((*DebugPeerResponse_PeerInfo)(nil), "ethereum.beacon.rpc.v1.DebugPeerResponse.PeerInfo")
+func (m *DebugPeerResponse_PeerInfo) GetScore() float64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("proto/beacon/rpc/v1/debug.proto", fileDescriptor_851e5cb2de3d61dd) }
+func (m *DebugPeerResponse_PeerInfo) GetGossipScore() float64 {
+	if m != nil {
+		return m.GossipScore
+	}
+	return 0
+}
 
-var fileDescriptor_851e5cb2de3d61dd = []byte{
-	// 1218 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdd, 0x6e, 0x1b, 0xc5,
-	0x17, 0xef, 0x3a, 0x71, 0x12, 0x1f, 0xfb, 0xef, 0xb8, 0xd3, 0xfe, 0x53, 0xe3, 0xb6, 0x49, 0xba,
-	0x2d, 0xfd, 0xa4, 0x6b, 0xc5, 0x70, 0x81, 0x2a, 0x24, 0x94, 0xaf, 0xa6, 0x91, 0x42, 0x5b, 0x36,
-	0x2d, 0x17, 0x54, 0x68, 0x35, 0xd9, 0x3d, 0xb6, 0x97, 0x6c, 0x66, 0xb6, 0x3b, 0xb3, 0x01, 0x97,
-	0xbb, 0x0a, 0xc1, 0x25, 0x17, 0x48, 0x5c, 0xf3, 0x18, 0x3c, 0x02, 0x97, 0x48, 0xbc, 0x00, 0x8a,
-	0x78, 0x0a, 0xae, 0xd0, 0xcc, 0xec, 0xae, 0x6d, 0x62, 0x97, 0x80, 0xb8, 0x9b, 0xf3, 0x9b, 0xdf,
-	0xf9, 0x98, 0x73, 0xce, 0xcc, 0x19, 0x58, 0x89, 0x13, 0x2e, 0x79, 0xfb, 0x00, 0xa9, 0xcf, 0x59,
-	0x3b, 0x89, 0xfd, 0xf6, 0xf1, 0x5a, 0x3b, 0xc0, 0x83, 0xb4, 0xe7, 0xe8, 0x1d, 0xb2, 0x84, 0xb2,
-	0x8f, 0x09, 0xa6, 0x47, 0x8e, 0xe1, 0x38, 0x49, 0xec, 0x3b, 0xc7, 0x6b, 0xad, 0x4b, 0x28, 0xfb,
-	0xed, 0xe3, 0x35, 0x1a, 0xc5, 0x7d, 0xba, 0xd6, 0x66, 0x3c, 0x40, 0xa3, 0xd0, 0xb2, 0xc7, 0x2c,
-	0xc6, 0x9d, 0x58, 0x59, 0x3c, 0x42, 0x21, 0x68, 0x0f, 0x45, 0xc6, 0xb9, 0xd2, 0xe3, 0xbc, 0x17,
-	0x61, 0x9b, 0xc6, 0x61, 0x9b, 0x32, 0xc6, 0x25, 0x95, 0x21, 0x67, 0xf9, 0xee, 0xe5, 0x6c, 0x57,
-	0x4b, 0x07, 0x69, 0xb7, 0x8d, 0x47, 0xb1, 0x1c, 0x98, 0x4d, 0xfb, 0x01, 0x5c, 0xdc, 0x65, 0x7e,
-	0x94, 0x8a, 0x90, 0xb3, 0xfd, 0x88, 0x4b, 0x17, 0x5f, 0xa6, 0x28, 0x24, 0xa9, 0x43, 0x29, 0x0c,
-	0x9a, 0xd6, 0xaa, 0x75, 0x7b, 0xd6, 0x2d, 0x85, 0x01, 0x21, 0x30, 0x2b, 0x22, 0x2e, 0x9b, 0x25,
-	0x8d, 0xe8, 0xb5, 0x7d, 0x0f, 0xfe, 0xff, 0x17, 0x5d, 0x11, 0x73, 0x26, 0x70, 0x22, 0xf9, 0x05,
-	0x90, 0x0d, 0x7d, 0x86, 0x7d, 0x49, 0x25, 0xe6, 0x6e, 0x2e, 0x66, 0x4c, 0xed, 0xe8, 0xd1, 0x39,
-	0xc3, 0x25, 0x2b, 0x00, 0x07, 0x11, 0xf7, 0x0f, 0xbd, 0x84, 0x67, 0x56, 0x6a, 0x8f, 0xce, 0xb9,
-	0x15, 0x8d, 0xb9, 0x9c, 0xcb, 0x8d, 0x3a, 0xd4, 0x5e, 0xa6, 0x98, 0x0c, 0xbc, 0x6e, 0x18, 0x49,
-	0x4c, 0xec, 0xfb, 0x50, 0xdb, 0xd0, 0x9b, 0x99, 0xd9, 0xab, 0x63, 0x06, 0x94, 0xf1, 0xda, 0x88,
-	0xba, 0x7d, 0x0b, 0xaa, 0xfb, 0xfb, 0x9f, 0x16, 0xe1, 0x36, 0x61, 0x1e, 0x99, 0xcf, 0x03, 0x0c,
-	0x32, 0x6a, 0x2e, 0xda, 0xdf, 0x5a, 0x70, 0x61, 0x8f, 0xf7, 0x7a, 0x21, 0xeb, 0xed, 0xe1, 0x31,
-	0x46, 0xb9, 0xfd, 0x1d, 0x28, 0x47, 0x4a, 0xd6, 0xfc, 0x7a, 0x67, 0xcd, 0x99, 0x5c, 0x55, 0x67,
-	0x82, 0xae, 0x63, 0x04, 0xa3, 0x6f, 0xdf, 0x82, 0xb2, 0x96, 0xc9, 0x02, 0xcc, 0xee, 0x3e, 0x7e,
-	0xf8, 0xa4, 0x71, 0x8e, 0x54, 0xa0, 0xbc, 0xb5, 0xbd, 0xf1, 0x7c, 0xa7, 0x61, 0xa9, 0xe5, 0x33,
-	0x77, 0x7d, 0x73, 0xbb, 0x51, 0xb2, 0xbf, 0x99, 0x81, 0x2b, 0x4f, 0x55, 0xc5, 0xd6, 0x93, 0x84,
-	0x0e, 0x1e, 0xf2, 0xe4, 0x70, 0xb3, 0xcf, 0x43, 0x1f, 0x8b, 0x43, 0xdc, 0x82, 0xc5, 0x38, 0x49,
-	0x19, 0x7a, 0xb2, 0x9f, 0xa0, 0xe8, 0xf3, 0x28, 0xaf, 0x5e, 0x5d, 0xc3, 0xcf, 0x72, 0x54, 0x11,
-	0x3f, 0x4f, 0x85, 0x0c, 0xbb, 0x21, 0x06, 0x1e, 0xc6, 0xdc, 0xef, 0x67, 0x75, 0xaa, 0x17, 0xf0,
-	0xb6, 0x42, 0x15, 0xb1, 0x1b, 0x32, 0x1a, 0x85, 0xaf, 0x0a, 0xe2, 0x8c, 0x21, 0x16, 0xb0, 0x21,
-	0xba, 0x70, 0x5e, 0x37, 0x93, 0x47, 0x55, 0x6c, 0x9e, 0x6a, 0x5e, 0xd1, 0x9c, 0x5d, 0x9d, 0xb9,
-	0x5d, 0xed, 0xdc, 0x9c, 0x96, 0x99, 0xe1, 0x59, 0x1e, 0xf3, 0x00, 0xdd, 0xc5, 0x78, 0x4c, 0x16,
-	0xe4, 0x05, 0xcc, 0x87, 0x2c, 0x08, 0x7d, 0x14, 0xcd, 0xb2, 0xb6, 0xb4, 0xfe, 0xf7, 0x96, 0x4e,
-	0x67, 0xc5, 0xd9, 0x35, 0x36, 0xb6, 0x99, 0x4c, 0x06, 0x6e, 0x6e, 0xb1, 0xf5, 0x00, 0x6a, 0xa3,
-	0x1b, 0xa4, 0x01, 0x33, 0x87, 0x38, 0xd0, 0xf9, 0xaa, 0xb8, 0x6a, 0x49, 0x2e, 0x42, 0xf9, 0x98,
-	0x46, 0x29, 0x66, 0xa9, 0x31, 0xc2, 0x83, 0xd2, 0xfb, 0x96, 0xfd, 0xba, 0x04, 0xf5, 0xf1, 0xe0,
-	0x8b, 0x76, 0xb7, 0x86, 0xed, 0xae, 0xb0, 0x61, 0xf3, 0xba, 0x7a, 0x4d, 0x96, 0x60, 0x2e, 0xa6,
-	0x09, 0x32, 0x99, 0xe5, 0x31, 0x93, 0x26, 0x55, 0x64, 0xf6, 0xac, 0x15, 0x29, 0x4f, 0xac, 0xc8,
-	0x12, 0xcc, 0x7d, 0x81, 0x61, 0xaf, 0x2f, 0x9b, 0x73, 0xc6, 0x93, 0x91, 0xf4, 0xbd, 0x40, 0x21,
-	0x3d, 0xbf, 0x1f, 0x46, 0x41, 0x73, 0x5e, 0xef, 0x55, 0x14, 0xb2, 0xa9, 0x00, 0x65, 0x5f, 0x6f,
-	0x07, 0x28, 0x7c, 0x64, 0x01, 0x65, 0xb2, 0xb9, 0x60, 0xec, 0x2b, 0x78, 0xab, 0x40, 0xed, 0xcf,
-	0x80, 0x6c, 0xa9, 0x47, 0xed, 0x29, 0x62, 0x92, 0xe7, 0x5a, 0x90, 0x1d, 0xa8, 0x24, 0xb9, 0xd0,
-	0xb4, 0x74, 0xd5, 0xee, 0x4c, 0xab, 0xda, 0x29, 0x75, 0x77, 0xa8, 0x6b, 0xff, 0x54, 0x86, 0xf3,
-	0xa7, 0x08, 0xa4, 0x0d, 0x17, 0xa2, 0x50, 0x48, 0x64, 0x21, 0xeb, 0x79, 0x34, 0x08, 0x12, 0x14,
-	0xb9, 0xa3, 0x8a, 0x4b, 0x8a, 0xad, 0xf5, 0x7c, 0x87, 0x6c, 0x40, 0x25, 0x08, 0x13, 0xf4, 0xd5,
-	0x63, 0xa8, 0x0b, 0x51, 0xef, 0xdc, 0x18, 0xc6, 0x83, 0xb2, 0xef, 0xe4, 0x0f, 0xae, 0xa3, 0x1c,
-	0x6d, 0xe5, 0x5c, 0x77, 0xa8, 0x46, 0x3e, 0x86, 0x86, 0xcf, 0x19, 0x33, 0x92, 0x27, 0xd4, 0xdb,
-	0xa5, 0xab, 0x57, 0x1f, 0x6d, 0xed, 0x31, 0x53, 0x9b, 0x05, 0xdd, 0xbc, 0x74, 0x8b, 0xfe, 0x38,
-	0x40, 0x2e, 0xc1, 0x7c, 0x8c, 0x98, 0x78, 0x61, 0xa0, 0xcb, 0x5c, 0x71, 0xe7, 0x94, 0xb8, 0x1b,
-	0xa8, 0x36, 0x44, 0x96, 0xe8, 0x92, 0x56, 0x5c, 0xb5, 0x24, 0x4f, 0xa0, 0x62, 0xa8, 0xac, 0xcb,
-	0x75, 0x29, 0xab, 0x9d, 0xce, 0x99, 0x33, 0xaa, 0x0f, 0xb5, 0xcb, 0xba, 0xdc, 0x5d, 0x88, 0xb3,
-	0x15, 0xf9, 0x10, 0xaa, 0xda, 0xa0, 0x3a, 0x48, 0x2a, 0x74, 0x07, 0x54, 0x3b, 0xcb, 0xa7, 0x4c,
-	0xc6, 0x9d, 0x58, 0x99, 0xdc, 0xd7, 0x2c, 0x17, 0x94, 0x8a, 0x59, 0x93, 0x6b, 0x50, 0x8b, 0xa8,
-	0x90, 0x5e, 0x1a, 0x07, 0x54, 0x62, 0x90, 0xf5, 0x47, 0x55, 0x61, 0xcf, 0x0d, 0xd4, 0xfa, 0xc3,
-	0x82, 0x85, 0xdc, 0x35, 0xf9, 0x00, 0x16, 0x8e, 0x50, 0xd2, 0x80, 0x4a, 0xaa, 0xef, 0x47, 0xb5,
-	0xb3, 0x3a, 0xcd, 0xdb, 0x47, 0x28, 0xe9, 0x16, 0x95, 0xd4, 0x2d, 0x34, 0xc8, 0x15, 0xa8, 0xe8,
-	0x87, 0xc1, 0xe7, 0x91, 0x68, 0x96, 0x74, 0xa1, 0x87, 0x00, 0x59, 0x81, 0x6a, 0x97, 0xa6, 0x91,
-	0xf4, 0x7c, 0x9e, 0x16, 0x97, 0x0a, 0x34, 0xb4, 0xa9, 0x10, 0x72, 0x07, 0x1a, 0x39, 0xdb, 0x3b,
-	0xc6, 0x44, 0xcd, 0xa9, 0x2c, 0xe5, 0x8b, 0x39, 0xfe, 0x89, 0x81, 0xc9, 0x75, 0xf8, 0x1f, 0xed,
-	0x21, 0x93, 0x05, 0xcf, 0x54, 0xa1, 0xa6, 0xc1, 0x9c, 0x74, 0x0d, 0x6a, 0x3a, 0x7b, 0x11, 0x95,
-	0xc8, 0xfc, 0x41, 0x76, 0xb9, 0x74, 0x46, 0xf7, 0x0c, 0xd4, 0xf9, 0x71, 0x1e, 0xca, 0xba, 0x12,
-	0xe4, 0x6b, 0x0b, 0xea, 0x3b, 0x28, 0x47, 0x86, 0x1e, 0xb9, 0x3b, 0xad, 0x76, 0xa7, 0x27, 0x63,
-	0xeb, 0xfa, 0x34, 0xee, 0xc8, 0xe4, 0xb2, 0xaf, 0xbd, 0xfe, 0xf5, 0xf7, 0xef, 0x4b, 0x97, 0xc9,
-	0x5b, 0xed, 0xb1, 0xef, 0x83, 0xfe, 0x70, 0xb4, 0x75, 0xb3, 0x92, 0x2f, 0x61, 0x41, 0x45, 0xa1,
-	0x66, 0x1f, 0xb9, 0x31, 0xd5, 0xff, 0xc8, 0xf0, 0xfc, 0x0f, 0x3c, 0xeb, 0x49, 0x4b, 0xbe, 0x82,
-	0xc5, 0x7d, 0x94, 0xa3, 0x23, 0x90, 0xdc, 0xfb, 0x07, 0x83, 0xb2, 0xb5, 0xe4, 0x98, 0x8f, 0x8b,
-	0x93, 0x7f, 0x5c, 0x9c, 0x6d, 0xf5, 0x71, 0xb1, 0xaf, 0x6b, 0xd7, 0x57, 0xed, 0xcb, 0x93, 0x5c,
-	0x47, 0xc6, 0x10, 0xf9, 0xce, 0x82, 0x4b, 0x3b, 0x28, 0x27, 0x0d, 0x07, 0x32, 0xc5, 0x70, 0xeb,
-	0xbd, 0x7f, 0x33, 0x62, 0xec, 0x9b, 0x3a, 0x9c, 0x55, 0xb2, 0x3c, 0x29, 0x9c, 0x2e, 0x4f, 0x0e,
-	0x7d, 0xe3, 0x35, 0x81, 0xca, 0x5e, 0x28, 0xa4, 0xba, 0x19, 0x62, 0x6a, 0x08, 0x77, 0xcf, 0x7c,
-	0xbb, 0xc5, 0x9b, 0x4b, 0x10, 0x6b, 0x37, 0xaf, 0x60, 0x5e, 0x25, 0x01, 0x31, 0x21, 0xf6, 0x1b,
-	0x5e, 0xbe, 0x3c, 0xe3, 0x67, 0x7f, 0xad, 0xed, 0x55, 0xed, 0xbc, 0x45, 0x9a, 0xd3, 0x9c, 0x93,
-	0x1f, 0x2c, 0x68, 0xec, 0xa0, 0x1c, 0xfb, 0x21, 0x92, 0x77, 0xa6, 0x79, 0x98, 0xf4, 0x09, 0x6d,
-	0xdd, 0x3f, 0x23, 0x3b, 0x8b, 0xe9, 0x6d, 0x1d, 0xd3, 0x0a, 0xb9, 0x3a, 0x29, 0xa6, 0x30, 0x57,
-	0xd9, 0xa8, 0xfd, 0x7c, 0xb2, 0x6c, 0xfd, 0x72, 0xb2, 0x6c, 0xfd, 0x76, 0xb2, 0x6c, 0x1d, 0xcc,
-	0xe9, 0x0a, 0xbc, 0xfb, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff, 0x03, 0xf6, 0x1f, 0xf4, 0xba, 0x0b,
-	0x00, 0x00,
+type TrustedPeerRequest struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *TrustedPeerRequest) Reset()         { *m = TrustedPeerRequest{} }
+func (m *TrustedPeerRequest) String() string { return proto.CompactTextString(m) }
+func (*TrustedPeerRequest) ProtoMessage()    {}
+func (*TrustedPeerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{10}
+}
+func (m *TrustedPeerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TrustedPeerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TrustedPeerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TrustedPeerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrustedPeerRequest.Merge(m, src)
+}
+func (m *TrustedPeerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TrustedPeerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrustedPeerRequest.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_TrustedPeerRequest proto.InternalMessageInfo
 
-// DebugClient is the client API for Debug service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type DebugClient interface {
-	GetBeaconState(ctx context.Context, in *BeaconStateRequest, opts ...grpc.CallOption) (*SSZResponse, error)
-	GetBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*SSZResponse, error)
-	SetLoggingLevel(ctx context.Context, in *LoggingLevelRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	GetProtoArrayForkChoice(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ProtoArrayForkChoiceResponse, error)
-	ListPeers(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*DebugPeerResponses, error)
-	GetPeer(ctx context.Context, in *v1alpha1.PeerRequest, opts ...grpc.CallOption) (*DebugPeerResponse, error)
-	GetInclusionSlot(ctx context.Context, in *InclusionSlotRequest, opts ...grpc.CallOption) (*InclusionSlotResponse, error)
+func (m *TrustedPeerRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-type debugClient struct {
-	cc *grpc.ClientConn
+type TrustedPeersResponse struct {
+	Addresses            []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func NewDebugClient(cc *grpc.ClientConn) DebugClient {
-	return &debugClient{cc}
+func (m *TrustedPeersResponse) Reset()         { *m = TrustedPeersResponse{} }
+func (m *TrustedPeersResponse) String() string { return proto.CompactTextString(m) }
+func (*TrustedPeersResponse) ProtoMessage()    {}
+func (*TrustedPeersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{11}
 }
-
-func (c *debugClient) GetBeaconState(ctx context.Context, in *BeaconStateRequest, opts ...grpc.CallOption) (*SSZResponse, error) {
-	out := new(SSZResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetBeaconState", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *TrustedPeersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *debugClient) GetBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*SSZResponse, error) {
-	out := new(SSZResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetBlock", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TrustedPeersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TrustedPeersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *TrustedPeersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrustedPeersResponse.Merge(m, src)
+}
+func (m *TrustedPeersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TrustedPeersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrustedPeersResponse.DiscardUnknown(m)
 }
 
-func (c *debugClient) SetLoggingLevel(ctx context.Context, in *LoggingLevelRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/SetLoggingLevel", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_TrustedPeersResponse proto.InternalMessageInfo
+
+func (m *TrustedPeersResponse) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
 	}
-	return out, nil
+	return nil
 }
 
-func (c *debugClient) GetProtoArrayForkChoice(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ProtoArrayForkChoiceResponse, error) {
-	out := new(ProtoArrayForkChoiceResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetProtoArrayForkChoice", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type BanPeerRequest struct {
+	PeerId               string   `protobuf:"bytes,1,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	Ip                   string   `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip,omitempty"`
+	DurationSeconds      uint64   `protobuf:"varint,3,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *debugClient) ListPeers(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*DebugPeerResponses, error) {
-	out := new(DebugPeerResponses)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/ListPeers", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BanPeerRequest) Reset()         { *m = BanPeerRequest{} }
+func (m *BanPeerRequest) String() string { return proto.CompactTextString(m) }
+func (*BanPeerRequest) ProtoMessage()    {}
+func (*BanPeerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{12}
+}
+func (m *BanPeerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BanPeerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BanPeerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *BanPeerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BanPeerRequest.Merge(m, src)
+}
+func (m *BanPeerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BanPeerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BanPeerRequest.DiscardUnknown(m)
 }
 
-func (c *debugClient) GetPeer(ctx context.Context, in *v1alpha1.PeerRequest, opts ...grpc.CallOption) (*DebugPeerResponse, error) {
-	out := new(DebugPeerResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetPeer", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BanPeerRequest proto.InternalMessageInfo
+
+func (m *BanPeerRequest) GetPeerId() string {
+	if m != nil {
+		return m.PeerId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *debugClient) GetInclusionSlot(ctx context.Context, in *InclusionSlotRequest, opts ...grpc.CallOption) (*InclusionSlotResponse, error) {
-	out := new(InclusionSlotResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetInclusionSlot", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BanPeerRequest) GetIp() string {
+	if m != nil {
+		return m.Ip
 	}
-	return out, nil
+	return ""
 }
 
-// DebugServer is the server API for Debug service.
-type DebugServer interface {
-	GetBeaconState(context.Context, *BeaconStateRequest) (*SSZResponse, error)
-	GetBlock(context.Context, *BlockRequest) (*SSZResponse, error)
-	SetLoggingLevel(context.Context, *LoggingLevelRequest) (*types.Empty, error)
-	GetProtoArrayForkChoice(context.Context, *types.Empty) (*ProtoArrayForkChoiceResponse, error)
-	ListPeers(context.Context, *types.Empty) (*DebugPeerResponses, error)
-	GetPeer(context.Context, *v1alpha1.PeerRequest) (*DebugPeerResponse, error)
-	GetInclusionSlot(context.Context, *InclusionSlotRequest) (*InclusionSlotResponse, error)
+func (m *BanPeerRequest) GetDurationSeconds() uint64 {
+	if m != nil {
+		return m.DurationSeconds
+	}
+	return 0
 }
 
-// UnimplementedDebugServer can be embedded to have forward compatible implementations.
-type UnimplementedDebugServer struct {
+type PeerBan struct {
+	PeerId               string   `protobuf:"bytes,1,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	Ip                   string   `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip,omitempty"`
+	ExpiresAt            uint64   `protobuf:"varint,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (*UnimplementedDebugServer) GetBeaconState(ctx context.Context, req *BeaconStateRequest) (*SSZResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetBeaconState not implemented")
-}
-func (*UnimplementedDebugServer) GetBlock(ctx context.Context, req *BlockRequest) (*SSZResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetBlock not implemented")
+func (m *PeerBan) Reset()         { *m = PeerBan{} }
+func (m *PeerBan) String() string { return proto.CompactTextString(m) }
+func (*PeerBan) ProtoMessage()    {}
+func (*PeerBan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{13}
 }
-func (*UnimplementedDebugServer) SetLoggingLevel(ctx context.Context, req *LoggingLevelRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetLoggingLevel not implemented")
+func (m *PeerBan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedDebugServer) GetProtoArrayForkChoice(ctx context.Context, req *types.Empty) (*ProtoArrayForkChoiceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProtoArrayForkChoice not implemented")
+func (m *PeerBan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PeerBan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedDebugServer) ListPeers(ctx context.Context, req *types.Empty) (*DebugPeerResponses, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListPeers not implemented")
+func (m *PeerBan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerBan.Merge(m, src)
 }
-func (*UnimplementedDebugServer) GetPeer(ctx context.Context, req *v1alpha1.PeerRequest) (*DebugPeerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPeer not implemented")
+func (m *PeerBan) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedDebugServer) GetInclusionSlot(ctx context.Context, req *InclusionSlotRequest) (*InclusionSlotResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetInclusionSlot not implemented")
+func (m *PeerBan) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerBan.DiscardUnknown(m)
 }
 
-func RegisterDebugServer(s *grpc.Server, srv DebugServer) {
-	s.RegisterService(&_Debug_serviceDesc, srv)
-}
+var xxx_messageInfo_PeerBan proto.InternalMessageInfo
 
-func _Debug_GetBeaconState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BeaconStateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(DebugServer).GetBeaconState(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetBeaconState",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DebugServer).GetBeaconState(ctx, req.(*BeaconStateRequest))
+func (m *PeerBan) GetPeerId() string {
+	if m != nil {
+		return m.PeerId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Debug_GetBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BlockRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(DebugServer).GetBlock(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetBlock",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DebugServer).GetBlock(ctx, req.(*BlockRequest))
+func (m *PeerBan) GetIp() string {
+	if m != nil {
+		return m.Ip
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Debug_SetLoggingLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoggingLevelRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *PeerBan) GetExpiresAt() uint64 {
+	if m != nil {
+		return m.ExpiresAt
 	}
-	if interceptor == nil {
-		return srv.(DebugServer).SetLoggingLevel(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.Debug/SetLoggingLevel",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DebugServer).SetLoggingLevel(ctx, req.(*LoggingLevelRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Debug_GetProtoArrayForkChoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(DebugServer).GetProtoArrayForkChoice(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetProtoArrayForkChoice",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DebugServer).GetProtoArrayForkChoice(ctx, req.(*types.Empty))
-	}
-	return interceptor(ctx, in, info, handler)
+type PeerBansResponse struct {
+	Bans                 []*PeerBan `protobuf:"bytes,1,rep,name=bans,proto3" json:"bans,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
-func _Debug_ListPeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(DebugServer).ListPeers(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.Debug/ListPeers",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DebugServer).ListPeers(ctx, req.(*types.Empty))
+func (m *PeerBansResponse) Reset()         { *m = PeerBansResponse{} }
+func (m *PeerBansResponse) String() string { return proto.CompactTextString(m) }
+func (*PeerBansResponse) ProtoMessage()    {}
+func (*PeerBansResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{14}
+}
+func (m *PeerBansResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PeerBansResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PeerBansResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *PeerBansResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerBansResponse.Merge(m, src)
+}
+func (m *PeerBansResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PeerBansResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerBansResponse.DiscardUnknown(m)
 }
 
-func _Debug_GetPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha1.PeerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(DebugServer).GetPeer(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetPeer",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DebugServer).GetPeer(ctx, req.(*v1alpha1.PeerRequest))
+var xxx_messageInfo_PeerBansResponse proto.InternalMessageInfo
+
+func (m *PeerBansResponse) GetBans() []*PeerBan {
+	if m != nil {
+		return m.Bans
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Debug_GetInclusionSlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InclusionSlotRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(DebugServer).GetInclusionSlot(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetInclusionSlot",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DebugServer).GetInclusionSlot(ctx, req.(*InclusionSlotRequest))
+type DisconnectPeerRequest struct {
+	PeerId               string   `protobuf:"bytes,1,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	Reason               uint64   `protobuf:"varint,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DisconnectPeerRequest) Reset()         { *m = DisconnectPeerRequest{} }
+func (m *DisconnectPeerRequest) String() string { return proto.CompactTextString(m) }
+func (*DisconnectPeerRequest) ProtoMessage()    {}
+func (*DisconnectPeerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{15}
+}
+func (m *DisconnectPeerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DisconnectPeerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DisconnectPeerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *DisconnectPeerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisconnectPeerRequest.Merge(m, src)
+}
+func (m *DisconnectPeerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DisconnectPeerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisconnectPeerRequest.DiscardUnknown(m)
 }
 
-var _Debug_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ethereum.beacon.rpc.v1.Debug",
-	HandlerType: (*DebugServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetBeaconState",
-			Handler:    _Debug_GetBeaconState_Handler,
-		},
-		{
-			MethodName: "GetBlock",
-			Handler:    _Debug_GetBlock_Handler,
-		},
-		{
-			MethodName: "SetLoggingLevel",
-			Handler:    _Debug_SetLoggingLevel_Handler,
-		},
-		{
-			MethodName: "GetProtoArrayForkChoice",
-			Handler:    _Debug_GetProtoArrayForkChoice_Handler,
-		},
-		{
-			MethodName: "ListPeers",
-			Handler:    _Debug_ListPeers_Handler,
-		},
-		{
-			MethodName: "GetPeer",
-			Handler:    _Debug_GetPeer_Handler,
-		},
-		{
-			MethodName: "GetInclusionSlot",
-			Handler:    _Debug_GetInclusionSlot_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "proto/beacon/rpc/v1/debug.proto",
+var xxx_messageInfo_DisconnectPeerRequest proto.InternalMessageInfo
+
+func (m *DisconnectPeerRequest) GetPeerId() string {
+	if m != nil {
+		return m.PeerId
+	}
+	return ""
 }
 
-func (m *InclusionSlotRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DisconnectPeerRequest) GetReason() uint64 {
+	if m != nil {
+		return m.Reason
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *InclusionSlotRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type RateLimitsRequest struct {
+	PeerId               string   `protobuf:"bytes,1,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *InclusionSlotRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Slot != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.Slot))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Id != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (m *RateLimitsRequest) Reset()         { *m = RateLimitsRequest{} }
+func (m *RateLimitsRequest) String() string { return proto.CompactTextString(m) }
+func (*RateLimitsRequest) ProtoMessage()    {}
+func (*RateLimitsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{16}
+}
+func (m *RateLimitsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *RateLimitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitsRequest.Merge(m, src)
+}
+func (m *RateLimitsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitsRequest.DiscardUnknown(m)
 }
 
-func (m *InclusionSlotResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RateLimitsRequest proto.InternalMessageInfo
+
+func (m *RateLimitsRequest) GetPeerId() string {
+	if m != nil {
+		return m.PeerId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *InclusionSlotResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type RateLimitsResponse struct {
+	Peers                []*PeerRateLimits `protobuf:"bytes,1,rep,name=peers,proto3" json:"peers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *InclusionSlotResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Slot != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.Slot))
-		i--
-		dAtA[i] = 0x10
+func (m *RateLimitsResponse) Reset()         { *m = RateLimitsResponse{} }
+func (m *RateLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*RateLimitsResponse) ProtoMessage()    {}
+func (*RateLimitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{17}
+}
+func (m *RateLimitsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *RateLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitsResponse.Merge(m, src)
+}
+func (m *RateLimitsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitsResponse.DiscardUnknown(m)
 }
 
-func (m *BeaconStateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RateLimitsResponse proto.InternalMessageInfo
+
+func (m *RateLimitsResponse) GetPeers() []*PeerRateLimits {
+	if m != nil {
+		return m.Peers
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *BeaconStateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type PeerRateLimits struct {
+	PeerId               string             `protobuf:"bytes,1,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	Buckets              []*RateLimitBucket `protobuf:"bytes,2,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
-func (m *BeaconStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.QueryFilter != nil {
-		{
-			size := m.QueryFilter.Size()
-			i -= size
-			if _, err := m.QueryFilter.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
+func (m *PeerRateLimits) Reset()         { *m = PeerRateLimits{} }
+func (m *PeerRateLimits) String() string { return proto.CompactTextString(m) }
+func (*PeerRateLimits) ProtoMessage()    {}
+func (*PeerRateLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{18}
+}
+func (m *PeerRateLimits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PeerRateLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PeerRateLimits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *PeerRateLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerRateLimits.Merge(m, src)
+}
+func (m *PeerRateLimits) XXX_Size() int {
+	return m.Size()
+}
+func (m *PeerRateLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerRateLimits.DiscardUnknown(m)
 }
 
-func (m *BeaconStateRequest_Slot) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_PeerRateLimits proto.InternalMessageInfo
+
+func (m *PeerRateLimits) GetPeerId() string {
+	if m != nil {
+		return m.PeerId
+	}
+	return ""
 }
 
-func (m *BeaconStateRequest_Slot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i = encodeVarintDebug(dAtA, i, uint64(m.Slot))
-	i--
-	dAtA[i] = 0x8
-	return len(dAtA) - i, nil
+func (m *PeerRateLimits) GetBuckets() []*RateLimitBucket {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
 }
-func (m *BeaconStateRequest_BlockRoot) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+type RateLimitBucket struct {
+	Topic                string   `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	Outbound             bool     `protobuf:"varint,2,opt,name=outbound,proto3" json:"outbound,omitempty"`
+	Capacity             int64    `protobuf:"varint,3,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Remaining            int64    `protobuf:"varint,4,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	Rate                 float64  `protobuf:"fixed64,5,opt,name=rate,proto3" json:"rate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *BeaconStateRequest_BlockRoot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.BlockRoot != nil {
-		i -= len(m.BlockRoot)
-		copy(dAtA[i:], m.BlockRoot)
-		i = encodeVarintDebug(dAtA, i, uint64(len(m.BlockRoot)))
-		i--
-		dAtA[i] = 0x12
+func (m *RateLimitBucket) Reset()         { *m = RateLimitBucket{} }
+func (m *RateLimitBucket) String() string { return proto.CompactTextString(m) }
+func (*RateLimitBucket) ProtoMessage()    {}
+func (*RateLimitBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{19}
+}
+func (m *RateLimitBucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitBucket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-func (m *BlockRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RateLimitBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitBucket.Merge(m, src)
+}
+func (m *RateLimitBucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitBucket proto.InternalMessageInfo
+
+func (m *RateLimitBucket) GetTopic() string {
+	if m != nil {
+		return m.Topic
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *BlockRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *RateLimitBucket) GetOutbound() bool {
+	if m != nil {
+		return m.Outbound
+	}
+	return false
 }
 
-func (m *BlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *RateLimitBucket) GetCapacity() int64 {
+	if m != nil {
+		return m.Capacity
 	}
-	if len(m.BlockRoot) > 0 {
-		i -= len(m.BlockRoot)
-		copy(dAtA[i:], m.BlockRoot)
-		i = encodeVarintDebug(dAtA, i, uint64(len(m.BlockRoot)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *RateLimitBucket) GetRemaining() int64 {
+	if m != nil {
+		return m.Remaining
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *SSZResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RateLimitBucket) GetRate() float64 {
+	if m != nil {
+		return m.Rate
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *SSZResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ChainEvent struct {
+	Type                 ChainEvent_Type  `protobuf:"varint,1,opt,name=type,proto3,enum=ethereum.beacon.rpc.v1.ChainEvent_Type" json:"type,omitempty"`
+	Head                 *HeadEvent       `protobuf:"bytes,2,opt,name=head,proto3" json:"head,omitempty"`
+	Reorg                *ReorgEvent      `protobuf:"bytes,3,opt,name=reorg,proto3" json:"reorg,omitempty"`
+	Checkpoint           *CheckpointEvent `protobuf:"bytes,4,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
+	SyncStatus           *SyncStatusEvent `protobuf:"bytes,5,opt,name=sync_status,json=syncStatus,proto3" json:"sync_status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *SSZResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Encoded) > 0 {
-		i -= len(m.Encoded)
-		copy(dAtA[i:], m.Encoded)
-		i = encodeVarintDebug(dAtA, i, uint64(len(m.Encoded)))
-		i--
-		dAtA[i] = 0xa
+func (m *ChainEvent) Reset()         { *m = ChainEvent{} }
+func (m *ChainEvent) String() string { return proto.CompactTextString(m) }
+func (*ChainEvent) ProtoMessage()    {}
+func (*ChainEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{20}
+}
+func (m *ChainEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ChainEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainEvent.Merge(m, src)
+}
+func (m *ChainEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainEvent.DiscardUnknown(m)
 }
 
-func (m *LoggingLevelRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ChainEvent proto.InternalMessageInfo
+
+func (m *ChainEvent) GetType() ChainEvent_Type {
+	if m != nil {
+		return m.Type
 	}
-	return dAtA[:n], nil
+	return ChainEvent_UNKNOWN
 }
 
-func (m *LoggingLevelRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ChainEvent) GetHead() *HeadEvent {
+	if m != nil {
+		return m.Head
+	}
+	return nil
 }
 
-func (m *LoggingLevelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *ChainEvent) GetReorg() *ReorgEvent {
+	if m != nil {
+		return m.Reorg
 	}
-	if m.Level != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.Level))
-		i--
-		dAtA[i] = 0x8
+	return nil
+}
+
+func (m *ChainEvent) GetCheckpoint() *CheckpointEvent {
+	if m != nil {
+		return m.Checkpoint
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *ProtoArrayForkChoiceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ChainEvent) GetSyncStatus() *SyncStatusEvent {
+	if m != nil {
+		return m.SyncStatus
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ProtoArrayForkChoiceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type HeadEvent struct {
+	Slot                 uint64   `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	BlockRoot            []byte   `protobuf:"bytes,2,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
+	PreviousBlockRoot    []byte   `protobuf:"bytes,3,opt,name=previous_block_root,json=previousBlockRoot,proto3" json:"previous_block_root,omitempty"`
+	EpochTransition      bool     `protobuf:"varint,4,opt,name=epoch_transition,json=epochTransition,proto3" json:"epoch_transition,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ProtoArrayForkChoiceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Indices) > 0 {
-		for k := range m.Indices {
-			v := m.Indices[k]
-			baseI := i
-			i = encodeVarintDebug(dAtA, i, uint64(v))
-			i--
-			dAtA[i] = 0x10
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintDebug(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintDebug(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x2a
+func (m *HeadEvent) Reset()         { *m = HeadEvent{} }
+func (m *HeadEvent) String() string { return proto.CompactTextString(m) }
+func (*HeadEvent) ProtoMessage()    {}
+func (*HeadEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{21}
+}
+func (m *HeadEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HeadEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HeadEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.ProtoArrayNodes) > 0 {
-		for iNdEx := len(m.ProtoArrayNodes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ProtoArrayNodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDebug(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if m.FinalizedEpoch != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.FinalizedEpoch))
-		i--
-		dAtA[i] = 0x18
+}
+func (m *HeadEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeadEvent.Merge(m, src)
+}
+func (m *HeadEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *HeadEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeadEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HeadEvent proto.InternalMessageInfo
+
+func (m *HeadEvent) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
 	}
-	if m.JustifiedEpoch != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.JustifiedEpoch))
-		i--
-		dAtA[i] = 0x10
+	return 0
+}
+
+func (m *HeadEvent) GetBlockRoot() []byte {
+	if m != nil {
+		return m.BlockRoot
 	}
-	if m.PruneThreshold != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.PruneThreshold))
-		i--
-		dAtA[i] = 0x8
+	return nil
+}
+
+func (m *HeadEvent) GetPreviousBlockRoot() []byte {
+	if m != nil {
+		return m.PreviousBlockRoot
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *ProtoArrayNode) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *HeadEvent) GetEpochTransition() bool {
+	if m != nil {
+		return m.EpochTransition
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *ProtoArrayNode) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ReorgEvent struct {
+	OldHeadRoot          []byte   `protobuf:"bytes,1,opt,name=old_head_root,json=oldHeadRoot,proto3" json:"old_head_root,omitempty"`
+	OldHeadSlot          uint64   `protobuf:"varint,2,opt,name=old_head_slot,json=oldHeadSlot,proto3" json:"old_head_slot,omitempty"`
+	NewHeadRoot          []byte   `protobuf:"bytes,3,opt,name=new_head_root,json=newHeadRoot,proto3" json:"new_head_root,omitempty"`
+	NewHeadSlot          uint64   `protobuf:"varint,4,opt,name=new_head_slot,json=newHeadSlot,proto3" json:"new_head_slot,omitempty"`
+	CommonAncestorRoot   []byte   `protobuf:"bytes,5,opt,name=common_ancestor_root,json=commonAncestorRoot,proto3" json:"common_ancestor_root,omitempty"`
+	CommonAncestorSlot   uint64   `protobuf:"varint,6,opt,name=common_ancestor_slot,json=commonAncestorSlot,proto3" json:"common_ancestor_slot,omitempty"`
+	Depth                uint64   `protobuf:"varint,7,opt,name=depth,proto3" json:"depth,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ProtoArrayNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.BestDescendant != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.BestDescendant))
-		i--
-		dAtA[i] = 0x40
+func (m *ReorgEvent) Reset()         { *m = ReorgEvent{} }
+func (m *ReorgEvent) String() string { return proto.CompactTextString(m) }
+func (*ReorgEvent) ProtoMessage()    {}
+func (*ReorgEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{22}
+}
+func (m *ReorgEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReorgEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReorgEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.BestChild != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.BestChild))
-		i--
-		dAtA[i] = 0x38
+}
+func (m *ReorgEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReorgEvent.Merge(m, src)
+}
+func (m *ReorgEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReorgEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReorgEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReorgEvent proto.InternalMessageInfo
+
+func (m *ReorgEvent) GetOldHeadRoot() []byte {
+	if m != nil {
+		return m.OldHeadRoot
 	}
-	if m.Weight != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.Weight))
-		i--
-		dAtA[i] = 0x30
+	return nil
+}
+
+func (m *ReorgEvent) GetOldHeadSlot() uint64 {
+	if m != nil {
+		return m.OldHeadSlot
 	}
-	if m.FinalizedEpoch != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.FinalizedEpoch))
-		i--
-		dAtA[i] = 0x28
+	return 0
+}
+
+func (m *ReorgEvent) GetNewHeadRoot() []byte {
+	if m != nil {
+		return m.NewHeadRoot
 	}
-	if m.JustifiedEpoch != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.JustifiedEpoch))
-		i--
-		dAtA[i] = 0x20
+	return nil
+}
+
+func (m *ReorgEvent) GetNewHeadSlot() uint64 {
+	if m != nil {
+		return m.NewHeadSlot
 	}
-	if m.Parent != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.Parent))
-		i--
-		dAtA[i] = 0x18
+	return 0
+}
+
+func (m *ReorgEvent) GetCommonAncestorRoot() []byte {
+	if m != nil {
+		return m.CommonAncestorRoot
 	}
-	if len(m.Root) > 0 {
-		i -= len(m.Root)
-		copy(dAtA[i:], m.Root)
-		i = encodeVarintDebug(dAtA, i, uint64(len(m.Root)))
-		i--
-		dAtA[i] = 0x12
+	return nil
+}
+
+func (m *ReorgEvent) GetCommonAncestorSlot() uint64 {
+	if m != nil {
+		return m.CommonAncestorSlot
 	}
-	if m.Slot != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.Slot))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *ReorgEvent) GetDepth() uint64 {
+	if m != nil {
+		return m.Depth
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *DebugPeerResponses) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+type CheckpointEvent struct {
+	Epoch                uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Root                 []byte   `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CheckpointEvent) Reset()         { *m = CheckpointEvent{} }
+func (m *CheckpointEvent) String() string { return proto.CompactTextString(m) }
+func (*CheckpointEvent) ProtoMessage()    {}
+func (*CheckpointEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{23}
+}
+func (m *CheckpointEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckpointEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckpointEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *CheckpointEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckpointEvent.Merge(m, src)
+}
+func (m *CheckpointEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *CheckpointEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckpointEvent.DiscardUnknown(m)
 }
 
-func (m *DebugPeerResponses) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_CheckpointEvent proto.InternalMessageInfo
+
+func (m *CheckpointEvent) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
 }
 
-func (m *DebugPeerResponses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *CheckpointEvent) GetRoot() []byte {
+	if m != nil {
+		return m.Root
 	}
-	if len(m.Responses) > 0 {
-		for iNdEx := len(m.Responses) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Responses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDebug(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	return nil
+}
+
+type SyncStatusEvent struct {
+	Syncing              bool     `protobuf:"varint,1,opt,name=syncing,proto3" json:"syncing,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SyncStatusEvent) Reset()         { *m = SyncStatusEvent{} }
+func (m *SyncStatusEvent) String() string { return proto.CompactTextString(m) }
+func (*SyncStatusEvent) ProtoMessage()    {}
+func (*SyncStatusEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{24}
+}
+func (m *SyncStatusEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncStatusEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SyncStatusEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *SyncStatusEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncStatusEvent.Merge(m, src)
+}
+func (m *SyncStatusEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncStatusEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncStatusEvent.DiscardUnknown(m)
 }
 
-func (m *DebugPeerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SyncStatusEvent proto.InternalMessageInfo
+
+func (m *SyncStatusEvent) GetSyncing() bool {
+	if m != nil {
+		return m.Syncing
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *DebugPeerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Eth1ConnectionStatus struct {
+	ActiveEndpoint       string                `protobuf:"bytes,1,opt,name=active_endpoint,json=activeEndpoint,proto3" json:"active_endpoint,omitempty"`
+	Endpoints            []*Eth1EndpointStatus `protobuf:"bytes,2,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
-func (m *DebugPeerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.LastUpdated != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.LastUpdated))
-		i--
-		dAtA[i] = 0x40
+func (m *Eth1ConnectionStatus) Reset()         { *m = Eth1ConnectionStatus{} }
+func (m *Eth1ConnectionStatus) String() string { return proto.CompactTextString(m) }
+func (*Eth1ConnectionStatus) ProtoMessage()    {}
+func (*Eth1ConnectionStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{25}
+}
+func (m *Eth1ConnectionStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Eth1ConnectionStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Eth1ConnectionStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.PeerStatus != nil {
-		{
-			size, err := m.PeerStatus.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDebug(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
+}
+func (m *Eth1ConnectionStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Eth1ConnectionStatus.Merge(m, src)
+}
+func (m *Eth1ConnectionStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *Eth1ConnectionStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_Eth1ConnectionStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Eth1ConnectionStatus proto.InternalMessageInfo
+
+func (m *Eth1ConnectionStatus) GetActiveEndpoint() string {
+	if m != nil {
+		return m.ActiveEndpoint
 	}
-	if m.PeerInfo != nil {
-		{
-			size, err := m.PeerInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDebug(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *Eth1ConnectionStatus) GetEndpoints() []*Eth1EndpointStatus {
+	if m != nil {
+		return m.Endpoints
+	}
+	return nil
+}
+
+type Eth1EndpointStatus struct {
+	Endpoint             string   `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	Active               bool     `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
+	Healthy              bool     `protobuf:"varint,3,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	HeadBlock            uint64   `protobuf:"varint,4,opt,name=head_block,json=headBlock,proto3" json:"head_block,omitempty"`
+	RejectionReason      string   `protobuf:"bytes,5,opt,name=rejection_reason,json=rejectionReason,proto3" json:"rejection_reason,omitempty"`
+	LastChecked          uint64   `protobuf:"varint,6,opt,name=last_checked,json=lastChecked,proto3" json:"last_checked,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Eth1EndpointStatus) Reset()         { *m = Eth1EndpointStatus{} }
+func (m *Eth1EndpointStatus) String() string { return proto.CompactTextString(m) }
+func (*Eth1EndpointStatus) ProtoMessage()    {}
+func (*Eth1EndpointStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{26}
+}
+func (m *Eth1EndpointStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Eth1EndpointStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Eth1EndpointStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x32
+		return b[:n], nil
 	}
-	if len(m.Enr) > 0 {
-		i -= len(m.Enr)
-		copy(dAtA[i:], m.Enr)
-		i = encodeVarintDebug(dAtA, i, uint64(len(m.Enr)))
-		i--
-		dAtA[i] = 0x2a
+}
+func (m *Eth1EndpointStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Eth1EndpointStatus.Merge(m, src)
+}
+func (m *Eth1EndpointStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *Eth1EndpointStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_Eth1EndpointStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Eth1EndpointStatus proto.InternalMessageInfo
+
+func (m *Eth1EndpointStatus) GetEndpoint() string {
+	if m != nil {
+		return m.Endpoint
 	}
-	if len(m.PeerId) > 0 {
-		i -= len(m.PeerId)
-		copy(dAtA[i:], m.PeerId)
-		i = encodeVarintDebug(dAtA, i, uint64(len(m.PeerId)))
-		i--
-		dAtA[i] = 0x22
+	return ""
+}
+
+func (m *Eth1EndpointStatus) GetActive() bool {
+	if m != nil {
+		return m.Active
 	}
-	if m.ConnectionState != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.ConnectionState))
-		i--
-		dAtA[i] = 0x18
+	return false
+}
+
+func (m *Eth1EndpointStatus) GetHealthy() bool {
+	if m != nil {
+		return m.Healthy
 	}
-	if m.Direction != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.Direction))
-		i--
-		dAtA[i] = 0x10
+	return false
+}
+
+func (m *Eth1EndpointStatus) GetHeadBlock() uint64 {
+	if m != nil {
+		return m.HeadBlock
 	}
-	if len(m.ListeningAddresses) > 0 {
-		for iNdEx := len(m.ListeningAddresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ListeningAddresses[iNdEx])
-			copy(dAtA[i:], m.ListeningAddresses[iNdEx])
-			i = encodeVarintDebug(dAtA, i, uint64(len(m.ListeningAddresses[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+	return 0
+}
+
+func (m *Eth1EndpointStatus) GetRejectionReason() string {
+	if m != nil {
+		return m.RejectionReason
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *DebugPeerResponse_PeerInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Eth1EndpointStatus) GetLastChecked() uint64 {
+	if m != nil {
+		return m.LastChecked
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *DebugPeerResponse_PeerInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type DepositSnapshotResponse struct {
+	Snapshot             []byte   `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	DepositCount         uint64   `protobuf:"varint,2,opt,name=deposit_count,json=depositCount,proto3" json:"deposit_count,omitempty"`
+	DepositRoot          []byte   `protobuf:"bytes,3,opt,name=deposit_root,json=depositRoot,proto3" json:"deposit_root,omitempty"`
+	Eth1BlockHash        []byte   `protobuf:"bytes,4,opt,name=eth1_block_hash,json=eth1BlockHash,proto3" json:"eth1_block_hash,omitempty"`
+	Eth1BlockHeight      uint64   `protobuf:"varint,5,opt,name=eth1_block_height,json=eth1BlockHeight,proto3" json:"eth1_block_height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DebugPeerResponse_PeerInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *DepositSnapshotResponse) Reset()         { *m = DepositSnapshotResponse{} }
+func (m *DepositSnapshotResponse) String() string { return proto.CompactTextString(m) }
+func (*DepositSnapshotResponse) ProtoMessage()    {}
+func (*DepositSnapshotResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{27}
+}
+func (m *DepositSnapshotResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DepositSnapshotResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DepositSnapshotResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.PeerLatency != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.PeerLatency))
-		i--
-		dAtA[i] = 0x30
+}
+func (m *DepositSnapshotResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositSnapshotResponse.Merge(m, src)
+}
+func (m *DepositSnapshotResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DepositSnapshotResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositSnapshotResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DepositSnapshotResponse proto.InternalMessageInfo
+
+func (m *DepositSnapshotResponse) GetSnapshot() []byte {
+	if m != nil {
+		return m.Snapshot
 	}
-	if len(m.AgentVersion) > 0 {
-		i -= len(m.AgentVersion)
-		copy(dAtA[i:], m.AgentVersion)
-		i = encodeVarintDebug(dAtA, i, uint64(len(m.AgentVersion)))
-		i--
-		dAtA[i] = 0x2a
+	return nil
+}
+
+func (m *DepositSnapshotResponse) GetDepositCount() uint64 {
+	if m != nil {
+		return m.DepositCount
 	}
-	if len(m.ProtocolVersion) > 0 {
-		i -= len(m.ProtocolVersion)
-		copy(dAtA[i:], m.ProtocolVersion)
-		i = encodeVarintDebug(dAtA, i, uint64(len(m.ProtocolVersion)))
-		i--
-		dAtA[i] = 0x22
+	return 0
+}
+
+func (m *DepositSnapshotResponse) GetDepositRoot() []byte {
+	if m != nil {
+		return m.DepositRoot
 	}
-	if m.FaultCount != 0 {
-		i = encodeVarintDebug(dAtA, i, uint64(m.FaultCount))
-		i--
-		dAtA[i] = 0x18
+	return nil
+}
+
+func (m *DepositSnapshotResponse) GetEth1BlockHash() []byte {
+	if m != nil {
+		return m.Eth1BlockHash
 	}
-	if len(m.Protocols) > 0 {
-		for iNdEx := len(m.Protocols) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Protocols[iNdEx])
-			copy(dAtA[i:], m.Protocols[iNdEx])
-			i = encodeVarintDebug(dAtA, i, uint64(len(m.Protocols[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+	return nil
+}
+
+func (m *DepositSnapshotResponse) GetEth1BlockHeight() uint64 {
+	if m != nil {
+		return m.Eth1BlockHeight
 	}
-	if m.Metadata != nil {
-		{
-			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintDebug(dAtA, i, uint64(size))
+	return 0
+}
+
+type Eth1DataVotesResponse struct {
+	VotingPeriodStartSlot uint64                     `protobuf:"varint,1,opt,name=voting_period_start_slot,json=votingPeriodStartSlot,proto3" json:"voting_period_start_slot,omitempty"`
+	VotesRequired         uint64                     `protobuf:"varint,2,opt,name=votes_required,json=votesRequired,proto3" json:"votes_required,omitempty"`
+	EarliestValidTime     uint64                     `protobuf:"varint,3,opt,name=earliest_valid_time,json=earliestValidTime,proto3" json:"earliest_valid_time,omitempty"`
+	LatestValidTime       uint64                     `protobuf:"varint,4,opt,name=latest_valid_time,json=latestValidTime,proto3" json:"latest_valid_time,omitempty"`
+	FirstValidBlock       uint64                     `protobuf:"varint,5,opt,name=first_valid_block,json=firstValidBlock,proto3" json:"first_valid_block,omitempty"`
+	LastValidBlock        uint64                     `protobuf:"varint,6,opt,name=last_valid_block,json=lastValidBlock,proto3" json:"last_valid_block,omitempty"`
+	FollowDistanceBlock   *Eth1DataCandidate         `protobuf:"bytes,7,opt,name=follow_distance_block,json=followDistanceBlock,proto3" json:"follow_distance_block,omitempty"`
+	Candidates            []*Eth1DataCandidate       `protobuf:"bytes,8,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	CurrentEth1Data       *v1alpha1.Eth1Data         `protobuf:"bytes,9,opt,name=current_eth1_data,json=currentEth1Data,proto3" json:"current_eth1_data,omitempty"`
+	NextProposalSlot      uint64                     `protobuf:"varint,10,opt,name=next_proposal_slot,json=nextProposalSlot,proto3" json:"next_proposal_slot,omitempty"`
+	NextProposerIndex     uint64                     `protobuf:"varint,11,opt,name=next_proposer_index,json=nextProposerIndex,proto3" json:"next_proposer_index,omitempty"`
+	NextVote              *v1alpha1.Eth1Data         `protobuf:"bytes,12,opt,name=next_vote,json=nextVote,proto3" json:"next_vote,omitempty"`
+	PendingDeposits       []*PendingDepositInclusion `protobuf:"bytes,13,rep,name=pending_deposits,json=pendingDeposits,proto3" json:"pending_deposits,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}                   `json:"-"`
+	XXX_unrecognized      []byte                     `json:"-"`
+	XXX_sizecache         int32                      `json:"-"`
+}
+
+func (m *Eth1DataVotesResponse) Reset()         { *m = Eth1DataVotesResponse{} }
+func (m *Eth1DataVotesResponse) String() string { return proto.CompactTextString(m) }
+func (*Eth1DataVotesResponse) ProtoMessage()    {}
+func (*Eth1DataVotesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{28}
+}
+func (m *Eth1DataVotesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Eth1DataVotesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Eth1DataVotesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *Eth1DataVotesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Eth1DataVotesResponse.Merge(m, src)
+}
+func (m *Eth1DataVotesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *Eth1DataVotesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_Eth1DataVotesResponse.DiscardUnknown(m)
 }
 
-func encodeVarintDebug(dAtA []byte, offset int, v uint64) int {
-	offset -= sovDebug(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_Eth1DataVotesResponse proto.InternalMessageInfo
+
+func (m *Eth1DataVotesResponse) GetVotingPeriodStartSlot() uint64 {
+	if m != nil {
+		return m.VotingPeriodStartSlot
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *InclusionSlotRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *Eth1DataVotesResponse) GetVotesRequired() uint64 {
+	if m != nil {
+		return m.VotesRequired
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovDebug(uint64(m.Id))
+	return 0
+}
+
+func (m *Eth1DataVotesResponse) GetEarliestValidTime() uint64 {
+	if m != nil {
+		return m.EarliestValidTime
 	}
-	if m.Slot != 0 {
-		n += 1 + sovDebug(uint64(m.Slot))
+	return 0
+}
+
+func (m *Eth1DataVotesResponse) GetLatestValidTime() uint64 {
+	if m != nil {
+		return m.LatestValidTime
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return 0
+}
+
+func (m *Eth1DataVotesResponse) GetFirstValidBlock() uint64 {
+	if m != nil {
+		return m.FirstValidBlock
 	}
-	return n
+	return 0
 }
 
-func (m *InclusionSlotResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Eth1DataVotesResponse) GetLastValidBlock() uint64 {
+	if m != nil {
+		return m.LastValidBlock
 	}
-	var l int
-	_ = l
-	if m.Slot != 0 {
-		n += 1 + sovDebug(uint64(m.Slot))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return 0
 }
 
-func (m *BeaconStateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.QueryFilter != nil {
-		n += m.QueryFilter.Size()
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *Eth1DataVotesResponse) GetFollowDistanceBlock() *Eth1DataCandidate {
+	if m != nil {
+		return m.FollowDistanceBlock
 	}
-	return n
+	return nil
 }
 
-func (m *BeaconStateRequest_Slot) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Eth1DataVotesResponse) GetCandidates() []*Eth1DataCandidate {
+	if m != nil {
+		return m.Candidates
 	}
-	var l int
-	_ = l
-	n += 1 + sovDebug(uint64(m.Slot))
-	return n
+	return nil
 }
-func (m *BeaconStateRequest_BlockRoot) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BlockRoot != nil {
-		l = len(m.BlockRoot)
-		n += 1 + l + sovDebug(uint64(l))
+
+func (m *Eth1DataVotesResponse) GetCurrentEth1Data() *v1alpha1.Eth1Data {
+	if m != nil {
+		return m.CurrentEth1Data
 	}
-	return n
+	return nil
 }
-func (m *BlockRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.BlockRoot)
-	if l > 0 {
-		n += 1 + l + sovDebug(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+
+func (m *Eth1DataVotesResponse) GetNextProposalSlot() uint64 {
+	if m != nil {
+		return m.NextProposalSlot
 	}
-	return n
+	return 0
 }
 
-func (m *SSZResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Encoded)
-	if l > 0 {
-		n += 1 + l + sovDebug(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *Eth1DataVotesResponse) GetNextProposerIndex() uint64 {
+	if m != nil {
+		return m.NextProposerIndex
 	}
-	return n
+	return 0
 }
 
-func (m *LoggingLevelRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Level != 0 {
-		n += 1 + sovDebug(uint64(m.Level))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *Eth1DataVotesResponse) GetNextVote() *v1alpha1.Eth1Data {
+	if m != nil {
+		return m.NextVote
 	}
-	return n
+	return nil
 }
 
-func (m *ProtoArrayForkChoiceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PruneThreshold != 0 {
-		n += 1 + sovDebug(uint64(m.PruneThreshold))
-	}
-	if m.JustifiedEpoch != 0 {
-		n += 1 + sovDebug(uint64(m.JustifiedEpoch))
-	}
-	if m.FinalizedEpoch != 0 {
-		n += 1 + sovDebug(uint64(m.FinalizedEpoch))
-	}
-	if len(m.ProtoArrayNodes) > 0 {
-		for _, e := range m.ProtoArrayNodes {
-			l = e.Size()
-			n += 1 + l + sovDebug(uint64(l))
-		}
+func (m *Eth1DataVotesResponse) GetPendingDeposits() []*PendingDepositInclusion {
+	if m != nil {
+		return m.PendingDeposits
 	}
-	if len(m.Indices) > 0 {
-		for k, v := range m.Indices {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovDebug(uint64(len(k))) + 1 + sovDebug(uint64(v))
-			n += mapEntrySize + 1 + sovDebug(uint64(mapEntrySize))
+	return nil
+}
+
+type Eth1DataCandidate struct {
+	Eth1Data             *v1alpha1.Eth1Data `protobuf:"bytes,1,opt,name=eth1_data,json=eth1Data,proto3" json:"eth1_data,omitempty"`
+	Votes                uint64             `protobuf:"varint,2,opt,name=votes,proto3" json:"votes,omitempty"`
+	BlockHeight          uint64             `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Counted              bool               `protobuf:"varint,4,opt,name=counted,proto3" json:"counted,omitempty"`
+	RejectionReason      string             `protobuf:"bytes,5,opt,name=rejection_reason,json=rejectionReason,proto3" json:"rejection_reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *Eth1DataCandidate) Reset()         { *m = Eth1DataCandidate{} }
+func (m *Eth1DataCandidate) String() string { return proto.CompactTextString(m) }
+func (*Eth1DataCandidate) ProtoMessage()    {}
+func (*Eth1DataCandidate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{29}
+}
+func (m *Eth1DataCandidate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Eth1DataCandidate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Eth1DataCandidate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+}
+func (m *Eth1DataCandidate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Eth1DataCandidate.Merge(m, src)
+}
+func (m *Eth1DataCandidate) XXX_Size() int {
+	return m.Size()
+}
+func (m *Eth1DataCandidate) XXX_DiscardUnknown() {
+	xxx_messageInfo_Eth1DataCandidate.DiscardUnknown(m)
 }
 
-func (m *ProtoArrayNode) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Slot != 0 {
-		n += 1 + sovDebug(uint64(m.Slot))
+var xxx_messageInfo_Eth1DataCandidate proto.InternalMessageInfo
+
+func (m *Eth1DataCandidate) GetEth1Data() *v1alpha1.Eth1Data {
+	if m != nil {
+		return m.Eth1Data
 	}
-	l = len(m.Root)
-	if l > 0 {
-		n += 1 + l + sovDebug(uint64(l))
+	return nil
+}
+
+func (m *Eth1DataCandidate) GetVotes() uint64 {
+	if m != nil {
+		return m.Votes
 	}
-	if m.Parent != 0 {
-		n += 1 + sovDebug(uint64(m.Parent))
+	return 0
+}
+
+func (m *Eth1DataCandidate) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	if m.JustifiedEpoch != 0 {
-		n += 1 + sovDebug(uint64(m.JustifiedEpoch))
+	return 0
+}
+
+func (m *Eth1DataCandidate) GetCounted() bool {
+	if m != nil {
+		return m.Counted
 	}
-	if m.FinalizedEpoch != 0 {
-		n += 1 + sovDebug(uint64(m.FinalizedEpoch))
+	return false
+}
+
+func (m *Eth1DataCandidate) GetRejectionReason() string {
+	if m != nil {
+		return m.RejectionReason
 	}
-	if m.Weight != 0 {
-		n += 1 + sovDebug(uint64(m.Weight))
+	return ""
+}
+
+type PendingDepositInclusion struct {
+	Index                uint64   `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	PublicKey            []byte   `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Eth1BlockHeight      uint64   `protobuf:"varint,3,opt,name=eth1_block_height,json=eth1BlockHeight,proto3" json:"eth1_block_height,omitempty"`
+	EstimatedSlot        uint64   `protobuf:"varint,4,opt,name=estimated_slot,json=estimatedSlot,proto3" json:"estimated_slot,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PendingDepositInclusion) Reset()         { *m = PendingDepositInclusion{} }
+func (m *PendingDepositInclusion) String() string { return proto.CompactTextString(m) }
+func (*PendingDepositInclusion) ProtoMessage()    {}
+func (*PendingDepositInclusion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{30}
+}
+func (m *PendingDepositInclusion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingDepositInclusion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingDepositInclusion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.BestChild != 0 {
-		n += 1 + sovDebug(uint64(m.BestChild))
+}
+func (m *PendingDepositInclusion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingDepositInclusion.Merge(m, src)
+}
+func (m *PendingDepositInclusion) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingDepositInclusion) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingDepositInclusion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingDepositInclusion proto.InternalMessageInfo
+
+func (m *PendingDepositInclusion) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
 	}
-	if m.BestDescendant != 0 {
-		n += 1 + sovDebug(uint64(m.BestDescendant))
+	return 0
+}
+
+func (m *PendingDepositInclusion) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return nil
+}
+
+func (m *PendingDepositInclusion) GetEth1BlockHeight() uint64 {
+	if m != nil {
+		return m.Eth1BlockHeight
 	}
-	return n
+	return 0
 }
 
-func (m *DebugPeerResponses) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PendingDepositInclusion) GetEstimatedSlot() uint64 {
+	if m != nil {
+		return m.EstimatedSlot
 	}
-	var l int
-	_ = l
-	if len(m.Responses) > 0 {
-		for _, e := range m.Responses {
-			l = e.Size()
-			n += 1 + l + sovDebug(uint64(l))
+	return 0
+}
+
+type BackfillStatusResponse struct {
+	LowestSlot           uint64   `protobuf:"varint,1,opt,name=lowest_slot,json=lowestSlot,proto3" json:"lowest_slot,omitempty"`
+	Complete             bool     `protobuf:"varint,2,opt,name=complete,proto3" json:"complete,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BackfillStatusResponse) Reset()         { *m = BackfillStatusResponse{} }
+func (m *BackfillStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*BackfillStatusResponse) ProtoMessage()    {}
+func (*BackfillStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{31}
+}
+func (m *BackfillStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BackfillStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BackfillStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+}
+func (m *BackfillStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackfillStatusResponse.Merge(m, src)
+}
+func (m *BackfillStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BackfillStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackfillStatusResponse.DiscardUnknown(m)
 }
 
-func (m *DebugPeerResponse) Size() (n int) {
+var xxx_messageInfo_BackfillStatusResponse proto.InternalMessageInfo
+
+func (m *BackfillStatusResponse) GetLowestSlot() uint64 {
+	if m != nil {
+		return m.LowestSlot
+	}
+	return 0
+}
+
+func (m *BackfillStatusResponse) GetComplete() bool {
+	if m != nil {
+		return m.Complete
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterEnum("ethereum.beacon.rpc.v1.LoggingLevelRequest_Level", LoggingLevelRequest_Level_name, LoggingLevelRequest_Level_value)
+	proto.RegisterEnum("ethereum.beacon.rpc.v1.ChainEvent_Type", ChainEvent_Type_name, ChainEvent_Type_value)
+	proto.RegisterType((*InclusionSlotRequest)(nil), "ethereum.beacon.rpc.v1.InclusionSlotRequest")
+	proto.RegisterType((*InclusionSlotResponse)(nil), "ethereum.beacon.rpc.v1.InclusionSlotResponse")
+	proto.RegisterType((*BeaconStateRequest)(nil), "ethereum.beacon.rpc.v1.BeaconStateRequest")
+	proto.RegisterType((*BlockRequest)(nil), "ethereum.beacon.rpc.v1.BlockRequest")
+	proto.RegisterType((*SSZResponse)(nil), "ethereum.beacon.rpc.v1.SSZResponse")
+	proto.RegisterType((*LoggingLevelRequest)(nil), "ethereum.beacon.rpc.v1.LoggingLevelRequest")
+	proto.RegisterType((*ProtoArrayForkChoiceResponse)(nil), "ethereum.beacon.rpc.v1.ProtoArrayForkChoiceResponse")
+	proto.RegisterMapType((map[string]uint64)(nil), "ethereum.beacon.rpc.v1.ProtoArrayForkChoiceResponse.IndicesEntry")
+	proto.RegisterType((*ProtoArrayNode)(nil), "ethereum.beacon.rpc.v1.ProtoArrayNode")
+	proto.RegisterType((*DebugPeerResponses)(nil), "ethereum.beacon.rpc.v1.DebugPeerResponses")
+	proto.RegisterType((*DebugPeerResponse)(nil), "ethereum.beacon.rpc.v1.DebugPeerResponse")
+	proto.RegisterType((*DebugPeerResponse_PeerInfo)(nil), "ethereum.beacon.rpc.v1.DebugPeerResponse.PeerInfo")
+	proto.RegisterType((*TrustedPeerRequest)(nil), "ethereum.beacon.rpc.v1.TrustedPeerRequest")
+	proto.RegisterType((*TrustedPeersResponse)(nil), "ethereum.beacon.rpc.v1.TrustedPeersResponse")
+	proto.RegisterType((*BanPeerRequest)(nil), "ethereum.beacon.rpc.v1.BanPeerRequest")
+	proto.RegisterType((*PeerBan)(nil), "ethereum.beacon.rpc.v1.PeerBan")
+	proto.RegisterType((*PeerBansResponse)(nil), "ethereum.beacon.rpc.v1.PeerBansResponse")
+	proto.RegisterType((*DisconnectPeerRequest)(nil), "ethereum.beacon.rpc.v1.DisconnectPeerRequest")
+	proto.RegisterType((*RateLimitsRequest)(nil), "ethereum.beacon.rpc.v1.RateLimitsRequest")
+	proto.RegisterType((*RateLimitsResponse)(nil), "ethereum.beacon.rpc.v1.RateLimitsResponse")
+	proto.RegisterType((*PeerRateLimits)(nil), "ethereum.beacon.rpc.v1.PeerRateLimits")
+	proto.RegisterType((*RateLimitBucket)(nil), "ethereum.beacon.rpc.v1.RateLimitBucket")
+	proto.RegisterType((*ChainEvent)(nil), "ethereum.beacon.rpc.v1.ChainEvent")
+	proto.RegisterType((*HeadEvent)(nil), "ethereum.beacon.rpc.v1.HeadEvent")
+	proto.RegisterType((*ReorgEvent)(nil), "ethereum.beacon.rpc.v1.ReorgEvent")
+	proto.RegisterType((*CheckpointEvent)(nil), "ethereum.beacon.rpc.v1.CheckpointEvent")
+	proto.RegisterType((*SyncStatusEvent)(nil), "ethereum.beacon.rpc.v1.SyncStatusEvent")
+	proto.RegisterType((*Eth1ConnectionStatus)(nil), "ethereum.beacon.rpc.v1.Eth1ConnectionStatus")
+	proto.RegisterType((*Eth1EndpointStatus)(nil), "ethereum.beacon.rpc.v1.Eth1EndpointStatus")
+	proto.RegisterType((*DepositSnapshotResponse)(nil), "ethereum.beacon.rpc.v1.DepositSnapshotResponse")
+	proto.RegisterType((*Eth1DataVotesResponse)(nil), "ethereum.beacon.rpc.v1.Eth1DataVotesResponse")
+	proto.RegisterType((*Eth1DataCandidate)(nil), "ethereum.beacon.rpc.v1.Eth1DataCandidate")
+	proto.RegisterType((*PendingDepositInclusion)(nil), "ethereum.beacon.rpc.v1.PendingDepositInclusion")
+	proto.RegisterType((*BackfillStatusResponse)(nil), "ethereum.beacon.rpc.v1.BackfillStatusResponse")
+}
+
+func init() { proto.RegisterFile("proto/beacon/rpc/v1/debug.proto", fileDescriptor_851e5cb2de3d61dd) }
+
+var fileDescriptor_851e5cb2de3d61dd = []byte{
+	// 2902 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x39, 0xeb, 0x6e, 0x1b, 0xc7,
+	0xd5, 0x5e, 0x49, 0x94, 0xc8, 0x43, 0x99, 0xa4, 0xc6, 0xb2, 0xcd, 0x4f, 0xb1, 0x2d, 0x6b, 0x7d,
+	0xbf, 0x91, 0x91, 0x92, 0x0f, 0x0d, 0x92, 0x00, 0x85, 0x6e, 0x96, 0x54, 0xbb, 0xb2, 0xb3, 0x94,
+	0x52, 0x34, 0x41, 0xb0, 0x18, 0xed, 0x8e, 0xc4, 0x8d, 0x96, 0x3b, 0x9b, 0xdd, 0x21, 0x6d, 0xa6,
+	0x3f, 0x8a, 0xa4, 0x37, 0x14, 0x28, 0x50, 0x14, 0x45, 0x83, 0xf6, 0x5f, 0xfb, 0x0c, 0xfd, 0xdb,
+	0x07, 0xe8, 0xbf, 0xb6, 0xe8, 0x0b, 0x14, 0x41, 0x5f, 0xa0, 0x6f, 0x50, 0xcc, 0x99, 0xd9, 0xe5,
+	0x52, 0xe2, 0x4a, 0x4c, 0x91, 0x7f, 0x9c, 0x73, 0x9f, 0x73, 0xdb, 0x33, 0x87, 0xb0, 0x18, 0x46,
+	0x5c, 0xf0, 0xe6, 0x01, 0xa3, 0x0e, 0x0f, 0x9a, 0x51, 0xe8, 0x34, 0x7b, 0xcb, 0x4d, 0x97, 0x1d,
+	0x74, 0x8f, 0x1a, 0x88, 0x21, 0x57, 0x98, 0x68, 0xb3, 0x88, 0x75, 0x3b, 0x0d, 0x45, 0xd3, 0x88,
+	0x42, 0xa7, 0xd1, 0x5b, 0x5e, 0x58, 0x64, 0xa2, 0xdd, 0xec, 0x2d, 0x53, 0x3f, 0x6c, 0xd3, 0x65,
+	0xcd, 0x6f, 0x1f, 0xf8, 0xdc, 0x39, 0x56, 0x8c, 0x0b, 0x57, 0x87, 0x08, 0x02, 0xee, 0x32, 0x8d,
+	0x30, 0x87, 0x54, 0x86, 0x2b, 0xa1, 0x54, 0xd9, 0x61, 0x71, 0x4c, 0x8f, 0x58, 0xac, 0x69, 0xae,
+	0x1d, 0x71, 0x7e, 0xe4, 0xb3, 0x26, 0x0d, 0xbd, 0x26, 0x0d, 0x02, 0x2e, 0xa8, 0xf0, 0x78, 0x90,
+	0x60, 0xdf, 0xd0, 0x58, 0x3c, 0x1d, 0x74, 0x0f, 0x9b, 0xac, 0x13, 0x8a, 0xbe, 0x42, 0x9a, 0xef,
+	0xc2, 0xfc, 0x4e, 0xe0, 0xf8, 0xdd, 0xd8, 0xe3, 0x41, 0xcb, 0xe7, 0xc2, 0x62, 0x9f, 0x75, 0x59,
+	0x2c, 0x48, 0x05, 0x26, 0x3c, 0xb7, 0x6e, 0xdc, 0x34, 0xee, 0x4f, 0x59, 0x13, 0x9e, 0x4b, 0x08,
+	0x4c, 0xc5, 0x3e, 0x17, 0xf5, 0x09, 0x84, 0xe0, 0x6f, 0xf3, 0x11, 0x5c, 0x3e, 0xc1, 0x1b, 0x87,
+	0x3c, 0x88, 0xd9, 0x48, 0xe2, 0x8f, 0x81, 0xac, 0xe1, 0x1d, 0x5a, 0x82, 0x0a, 0x96, 0xa8, 0x99,
+	0xd7, 0x94, 0xa8, 0x68, 0xfb, 0x82, 0xa2, 0x25, 0x8b, 0x00, 0xe8, 0x1b, 0x3b, 0xe2, 0x5a, 0xca,
+	0xec, 0xf6, 0x05, 0xab, 0x84, 0x30, 0x8b, 0x73, 0xb1, 0x56, 0x81, 0xd9, 0xcf, 0xba, 0x2c, 0xea,
+	0xdb, 0x87, 0x9e, 0x2f, 0x58, 0x64, 0x3e, 0x81, 0xd9, 0x35, 0x44, 0x6a, 0xb1, 0xd7, 0x87, 0x04,
+	0x48, 0xe1, 0xb3, 0x19, 0x76, 0xf3, 0x1e, 0x94, 0x5b, 0xad, 0x8f, 0x52, 0x73, 0xeb, 0x30, 0xc3,
+	0x02, 0x87, 0xbb, 0xcc, 0xd5, 0xa4, 0xc9, 0xd1, 0xfc, 0x85, 0x01, 0x97, 0x9e, 0xf3, 0xa3, 0x23,
+	0x2f, 0x38, 0x7a, 0xce, 0x7a, 0xcc, 0x4f, 0xe4, 0x6f, 0x41, 0xc1, 0x97, 0x67, 0xa4, 0xaf, 0xac,
+	0x2c, 0x37, 0x46, 0x87, 0xbd, 0x31, 0x82, 0xb7, 0xa1, 0x0e, 0x8a, 0xdf, 0xbc, 0x07, 0x05, 0x3c,
+	0x93, 0x22, 0x4c, 0xed, 0xec, 0x3e, 0x7d, 0x51, 0xbb, 0x40, 0x4a, 0x50, 0xd8, 0xd8, 0x5c, 0xdb,
+	0xdf, 0xaa, 0x19, 0xf2, 0xe7, 0x9e, 0xb5, 0xba, 0xbe, 0x59, 0x9b, 0x30, 0x7f, 0x3e, 0x09, 0xd7,
+	0x5e, 0xca, 0x88, 0xad, 0x46, 0x11, 0xed, 0x3f, 0xe5, 0xd1, 0xf1, 0x7a, 0x9b, 0x7b, 0x0e, 0x4b,
+	0x2f, 0x71, 0x0f, 0xaa, 0x61, 0xd4, 0x0d, 0x98, 0x2d, 0xda, 0x11, 0x8b, 0xdb, 0xdc, 0x4f, 0xa2,
+	0x57, 0x41, 0xf0, 0x5e, 0x02, 0x95, 0x84, 0x9f, 0x76, 0x63, 0xe1, 0x1d, 0x7a, 0xcc, 0xb5, 0x59,
+	0xc8, 0x9d, 0xb6, 0x8e, 0x53, 0x25, 0x05, 0x6f, 0x4a, 0xa8, 0x24, 0x3c, 0xf4, 0x02, 0xea, 0x7b,
+	0x9f, 0xa7, 0x84, 0x93, 0x8a, 0x30, 0x05, 0x2b, 0x42, 0x0b, 0xe6, 0x30, 0x99, 0x6c, 0x2a, 0x6d,
+	0xb3, 0x65, 0xf2, 0xc6, 0xf5, 0xa9, 0x9b, 0x93, 0xf7, 0xcb, 0x2b, 0x77, 0xf3, 0x3c, 0x33, 0xb8,
+	0xcb, 0x2e, 0x77, 0x99, 0x55, 0x0d, 0x87, 0xce, 0x31, 0xf9, 0x18, 0x66, 0xbc, 0xc0, 0xf5, 0x1c,
+	0x16, 0xd7, 0x0b, 0x28, 0x69, 0xf5, 0x7c, 0x49, 0xa7, 0xbd, 0xd2, 0xd8, 0x51, 0x32, 0x36, 0x03,
+	0x11, 0xf5, 0xad, 0x44, 0xe2, 0xc2, 0xbb, 0x30, 0x9b, 0x45, 0x90, 0x1a, 0x4c, 0x1e, 0xb3, 0x3e,
+	0xfa, 0xab, 0x64, 0xc9, 0x9f, 0x64, 0x1e, 0x0a, 0x3d, 0xea, 0x77, 0x99, 0x76, 0x8d, 0x3a, 0xbc,
+	0x3b, 0xf1, 0x8e, 0x61, 0x7e, 0x39, 0x01, 0x95, 0x61, 0xe3, 0xd3, 0x74, 0x37, 0x06, 0xe9, 0x2e,
+	0x61, 0x83, 0xe4, 0xb5, 0xf0, 0x37, 0xb9, 0x02, 0xd3, 0x21, 0x8d, 0x58, 0x20, 0xb4, 0x1f, 0xf5,
+	0x69, 0x54, 0x44, 0xa6, 0xc6, 0x8d, 0x48, 0x61, 0x64, 0x44, 0xae, 0xc0, 0xf4, 0x2b, 0xe6, 0x1d,
+	0xb5, 0x45, 0x7d, 0x5a, 0x69, 0x52, 0x27, 0xac, 0x0b, 0x16, 0x0b, 0xdb, 0x69, 0x7b, 0xbe, 0x5b,
+	0x9f, 0x41, 0x5c, 0x49, 0x42, 0xd6, 0x25, 0x40, 0xca, 0x47, 0xb4, 0xcb, 0x62, 0x87, 0x05, 0x2e,
+	0x0d, 0x44, 0xbd, 0xa8, 0xe4, 0x4b, 0xf0, 0x46, 0x0a, 0x35, 0x3f, 0x01, 0xb2, 0x21, 0xbb, 0xde,
+	0x4b, 0xc6, 0xa2, 0xc4, 0xd7, 0x31, 0xd9, 0x82, 0x52, 0x94, 0x1c, 0xea, 0x06, 0x46, 0xed, 0x41,
+	0x5e, 0xd4, 0x4e, 0xb1, 0x5b, 0x03, 0x5e, 0xf3, 0x3f, 0x05, 0x98, 0x3b, 0x45, 0x40, 0x9a, 0x70,
+	0xc9, 0xf7, 0x62, 0xc1, 0x02, 0x2f, 0x38, 0xb2, 0xa9, 0xeb, 0x46, 0x2c, 0x4e, 0x14, 0x95, 0x2c,
+	0x92, 0xa2, 0x56, 0x13, 0x0c, 0x59, 0x83, 0x92, 0xeb, 0x45, 0xcc, 0x91, 0xcd, 0x10, 0x03, 0x51,
+	0x59, 0xb9, 0x3d, 0xb0, 0x87, 0x89, 0x76, 0x23, 0x69, 0xb8, 0x0d, 0xa9, 0x68, 0x23, 0xa1, 0xb5,
+	0x06, 0x6c, 0xe4, 0x03, 0xa8, 0x39, 0x3c, 0x08, 0xd4, 0xc9, 0x8e, 0x65, 0xef, 0xc2, 0xe8, 0x55,
+	0xb2, 0xa9, 0x3d, 0x24, 0x6a, 0x3d, 0x25, 0x57, 0x9d, 0xae, 0xea, 0x0c, 0x03, 0xc8, 0x55, 0x98,
+	0x09, 0x19, 0x8b, 0x6c, 0xcf, 0xc5, 0x30, 0x97, 0xac, 0x69, 0x79, 0xdc, 0x71, 0x65, 0x1a, 0xb2,
+	0x20, 0xc2, 0x90, 0x96, 0x2c, 0xf9, 0x93, 0xbc, 0x80, 0x92, 0x22, 0x0d, 0x0e, 0x39, 0x86, 0xb2,
+	0xbc, 0xb2, 0x32, 0xb6, 0x47, 0xf1, 0x52, 0x3b, 0xc1, 0x21, 0xb7, 0x8a, 0xa1, 0xfe, 0x45, 0xbe,
+	0x0b, 0x65, 0x14, 0x28, 0x2f, 0xd2, 0x8d, 0x31, 0x03, 0xca, 0x2b, 0x37, 0x4e, 0x89, 0x0c, 0x57,
+	0x42, 0x29, 0xb2, 0x85, 0x54, 0x16, 0x48, 0x16, 0xf5, 0x9b, 0x2c, 0xc1, 0xac, 0x4f, 0x63, 0x61,
+	0x77, 0x43, 0x97, 0x0a, 0xe6, 0xea, 0xfc, 0x28, 0x4b, 0xd8, 0xbe, 0x02, 0x2d, 0xfc, 0x79, 0x02,
+	0x8a, 0x89, 0x6a, 0xf2, 0x3e, 0x14, 0x3b, 0x4c, 0x50, 0x97, 0x0a, 0x8a, 0xf5, 0x51, 0x5e, 0xb9,
+	0x99, 0xa7, 0xed, 0xfb, 0x4c, 0xd0, 0x0d, 0x2a, 0xa8, 0x95, 0x72, 0x90, 0x6b, 0x50, 0xc2, 0xc6,
+	0xe0, 0x70, 0x3f, 0xae, 0x4f, 0x60, 0xa0, 0x07, 0x00, 0xb2, 0x08, 0xe5, 0x43, 0xda, 0xf5, 0x85,
+	0xed, 0xf0, 0x6e, 0x5a, 0x54, 0x80, 0xa0, 0x75, 0x09, 0x21, 0x0f, 0xa0, 0x96, 0x50, 0xdb, 0x3d,
+	0x16, 0xc9, 0xef, 0x94, 0x76, 0x79, 0x35, 0x81, 0x7f, 0xa8, 0xc0, 0xe4, 0x16, 0x5c, 0xa4, 0x47,
+	0x2c, 0x10, 0x29, 0x9d, 0x8a, 0xc2, 0x2c, 0x02, 0x13, 0xa2, 0x25, 0x98, 0x45, 0xef, 0xf9, 0x54,
+	0xb0, 0xc0, 0xe9, 0xeb, 0xe2, 0x42, 0x8f, 0x3e, 0x57, 0x20, 0xd9, 0x38, 0x62, 0x87, 0x47, 0x0c,
+	0x5d, 0x6b, 0x58, 0xea, 0x20, 0x19, 0x8f, 0x78, 0x1c, 0x7b, 0xa1, 0xad, 0x90, 0x45, 0x44, 0x96,
+	0x15, 0xac, 0x25, 0x41, 0x66, 0x03, 0xc8, 0x5e, 0xd4, 0x8d, 0x05, 0x73, 0x55, 0x0c, 0xd5, 0x87,
+	0xa6, 0x0e, 0x33, 0x3a, 0xd3, 0x75, 0x77, 0x4a, 0x8e, 0xe6, 0xdb, 0x30, 0x9f, 0xa1, 0x8f, 0xd3,
+	0x2a, 0xb9, 0x06, 0xa5, 0x93, 0xb5, 0x31, 0x00, 0x98, 0x2e, 0x54, 0xd6, 0x68, 0x90, 0xd5, 0x90,
+	0xc9, 0x46, 0x63, 0x28, 0x1b, 0xe5, 0x04, 0x10, 0x62, 0xd9, 0x94, 0xac, 0x09, 0x2f, 0x94, 0xce,
+	0x74, 0xbb, 0x11, 0x55, 0x75, 0xc0, 0x1c, 0x1e, 0xb8, 0xb1, 0x76, 0x79, 0x35, 0x81, 0xb7, 0x14,
+	0xd8, 0xfc, 0x00, 0x66, 0xa4, 0x8a, 0x35, 0x1a, 0x8c, 0x2f, 0xfe, 0x3a, 0x00, 0x7b, 0x1d, 0x7a,
+	0x11, 0x8b, 0x6d, 0x9a, 0xc4, 0xb2, 0xa4, 0x21, 0xab, 0xc2, 0xdc, 0x82, 0x9a, 0x16, 0x39, 0xb8,
+	0xea, 0x5b, 0x30, 0x75, 0x40, 0x83, 0xa4, 0xd5, 0x2c, 0xe6, 0x7e, 0x20, 0x14, 0x9f, 0x85, 0xc4,
+	0xe6, 0x36, 0x5c, 0xde, 0xf0, 0x62, 0x5d, 0x93, 0x63, 0x39, 0xe2, 0x0a, 0x4c, 0x47, 0x8c, 0xc6,
+	0xba, 0x87, 0x4c, 0x59, 0xfa, 0x64, 0x3e, 0x86, 0x39, 0x8b, 0x0a, 0xf6, 0xdc, 0xeb, 0x78, 0x22,
+	0x3e, 0x4f, 0x8a, 0x69, 0x01, 0xc9, 0x52, 0xeb, 0x2b, 0xbc, 0x0f, 0x05, 0x89, 0x4f, 0xee, 0x70,
+	0xf7, 0xac, 0x3b, 0x64, 0xd8, 0x15, 0x93, 0xe9, 0x43, 0x65, 0x18, 0x91, 0x7f, 0x89, 0x55, 0x98,
+	0x39, 0xe8, 0x3a, 0xc7, 0x4c, 0xa8, 0x3a, 0x2a, 0xaf, 0xdc, 0xcb, 0x53, 0x95, 0x4a, 0x5b, 0x43,
+	0x7a, 0x2b, 0xe1, 0x33, 0x7f, 0x63, 0x40, 0xf5, 0x04, 0x52, 0xa6, 0xbb, 0xe0, 0xa1, 0xe7, 0x68,
+	0x6d, 0xea, 0x40, 0x16, 0xa0, 0xc8, 0xbb, 0xe2, 0x80, 0x77, 0x03, 0x17, 0x7d, 0x56, 0xb4, 0xd2,
+	0xb3, 0xc4, 0x39, 0x34, 0xa4, 0x8e, 0x27, 0xfa, 0x18, 0xe5, 0x49, 0x2b, 0x3d, 0xcb, 0xdc, 0x8d,
+	0x58, 0x87, 0x7a, 0xb2, 0x8d, 0x63, 0xa1, 0x4e, 0x5a, 0x03, 0x00, 0x7e, 0x52, 0x65, 0xfb, 0x2d,
+	0x60, 0xf1, 0xe0, 0x6f, 0xf3, 0x2f, 0x93, 0x00, 0xeb, 0x6d, 0xea, 0x05, 0x9b, 0x3d, 0xf9, 0x25,
+	0x7d, 0x0f, 0xa6, 0x44, 0x3f, 0x64, 0x7a, 0x2c, 0xcb, 0xbd, 0xe2, 0x80, 0xa3, 0xb1, 0xd7, 0x0f,
+	0x99, 0x85, 0x4c, 0xe4, 0xff, 0x61, 0xaa, 0xcd, 0xa8, 0xb2, 0xb8, 0xbc, 0xb2, 0x94, 0xc7, 0xbc,
+	0xcd, 0xa8, 0x8b, 0xbc, 0x16, 0x92, 0x93, 0x77, 0xa0, 0x10, 0x31, 0x1e, 0x1d, 0xe1, 0x6d, 0xca,
+	0x2b, 0x66, 0xae, 0x5f, 0x25, 0x91, 0x62, 0x54, 0x0c, 0x64, 0x0b, 0xc0, 0x69, 0x33, 0xe7, 0x38,
+	0xe4, 0x5e, 0x20, 0xf0, 0xbe, 0xe5, 0xb3, 0x6c, 0x4e, 0x28, 0x95, 0x8c, 0x0c, 0x2b, 0xd9, 0x86,
+	0x72, 0xdc, 0x0f, 0x9c, 0xa4, 0xab, 0x17, 0xce, 0x96, 0xd4, 0xea, 0x07, 0x8e, 0xea, 0xe6, 0x5a,
+	0x52, 0x9c, 0x02, 0xcc, 0x0e, 0x4c, 0x49, 0x8f, 0x90, 0x32, 0xcc, 0xec, 0xef, 0x3e, 0xdb, 0x7d,
+	0xf1, 0x83, 0xdd, 0xda, 0x05, 0x39, 0x9b, 0x6e, 0x6f, 0xae, 0x6e, 0xa8, 0x81, 0xd4, 0xda, 0x7c,
+	0x61, 0x6d, 0xd5, 0x26, 0x48, 0x1d, 0xe6, 0x9f, 0xee, 0xec, 0xae, 0x3e, 0xdf, 0xf9, 0x68, 0x73,
+	0xc3, 0x5e, 0xdf, 0xde, 0x5c, 0x7f, 0xf6, 0xf2, 0xc5, 0xce, 0xee, 0x5e, 0x6d, 0x52, 0x62, 0xbe,
+	0xb7, 0xdf, 0xda, 0xdb, 0x79, 0xba, 0x33, 0x8c, 0x99, 0x22, 0x55, 0x28, 0xb7, 0x7e, 0xb8, 0xbb,
+	0x6e, 0xb7, 0xf6, 0x56, 0xf7, 0xf6, 0x5b, 0xb5, 0x82, 0xf9, 0x07, 0x03, 0x4a, 0xa9, 0x3f, 0x47,
+	0xce, 0x51, 0xd7, 0x4f, 0x3f, 0x05, 0x32, 0x93, 0x3c, 0x69, 0xc0, 0xa5, 0x30, 0x62, 0x3d, 0x8f,
+	0x77, 0x63, 0x3b, 0x43, 0x37, 0x89, 0x74, 0x73, 0x09, 0x6a, 0x2d, 0xa5, 0x7f, 0x00, 0x35, 0x9c,
+	0x9b, 0x6c, 0x11, 0xd1, 0x20, 0xf6, 0x44, 0xf2, 0x45, 0x28, 0x5a, 0x55, 0x84, 0xef, 0xa5, 0x60,
+	0xf3, 0xab, 0x09, 0x80, 0x41, 0xcc, 0x88, 0x09, 0x17, 0xb9, 0xef, 0xda, 0x32, 0xe4, 0xd9, 0x57,
+	0x45, 0x99, 0xfb, 0xae, 0xbc, 0x01, 0x4a, 0xcf, 0xd2, 0x64, 0x1e, 0x40, 0x09, 0x8d, 0x7c, 0x23,
+	0x49, 0x9a, 0x80, 0xbd, 0xca, 0xc8, 0x51, 0xb6, 0x96, 0x03, 0xf6, 0x2a, 0x2b, 0x27, 0xa5, 0x41,
+	0x39, 0x6a, 0x1c, 0x4c, 0x68, 0x50, 0xce, 0x9b, 0x30, 0xef, 0xf0, 0x4e, 0x87, 0x07, 0x36, 0x0d,
+	0x1c, 0x16, 0x0b, 0x1e, 0x29, 0x71, 0x05, 0x14, 0x47, 0x14, 0x6e, 0x55, 0xa3, 0x50, 0xea, 0x08,
+	0x0e, 0x14, 0xae, 0xbe, 0x62, 0x27, 0x38, 0x50, 0xc7, 0x3c, 0x14, 0x5c, 0x16, 0x8a, 0xb6, 0x9e,
+	0x14, 0xd5, 0xc1, 0x7c, 0x0f, 0xaa, 0x27, 0x92, 0x51, 0x12, 0xaa, 0x71, 0x54, 0x85, 0x4e, 0x1d,
+	0x46, 0xcd, 0xc0, 0xe6, 0x23, 0xa8, 0x9e, 0xc8, 0x3f, 0xf9, 0x8d, 0x93, 0x19, 0x28, 0x6b, 0xde,
+	0xc0, 0x50, 0x24, 0x47, 0xf3, 0x97, 0x06, 0xcc, 0x6f, 0x8a, 0xf6, 0xf2, 0xf0, 0x48, 0xd5, 0x8d,
+	0xe5, 0xa0, 0x4a, 0x1d, 0xe1, 0xf5, 0x98, 0xcd, 0x02, 0x57, 0x95, 0x8f, 0x6a, 0x40, 0x15, 0x05,
+	0xde, 0xd4, 0x50, 0xb2, 0x0d, 0xa5, 0x84, 0x22, 0x69, 0x7c, 0x0f, 0xf3, 0xea, 0x42, 0x6a, 0x4a,
+	0x18, 0xf5, 0xe4, 0x33, 0x60, 0x36, 0xff, 0x66, 0x00, 0x39, 0x4d, 0x21, 0xdb, 0xd9, 0x09, 0x13,
+	0xd2, 0xb3, 0xfc, 0x70, 0x28, 0x73, 0x74, 0x13, 0xd4, 0x27, 0x79, 0xe1, 0x36, 0xa3, 0xbe, 0x68,
+	0xab, 0x0e, 0x58, 0xb4, 0x92, 0xa3, 0xcc, 0x76, 0x0c, 0x3a, 0xa6, 0xb2, 0x8e, 0x7a, 0x49, 0x42,
+	0x30, 0x83, 0x65, 0xf6, 0x46, 0xec, 0x53, 0x3d, 0x8b, 0xea, 0x6f, 0x92, 0x9a, 0x53, 0xaa, 0x29,
+	0xdc, 0x42, 0x70, 0x3a, 0xa7, 0x61, 0x97, 0x60, 0x6e, 0x32, 0xaa, 0x48, 0xd8, 0xba, 0x02, 0x99,
+	0xff, 0x30, 0xe0, 0xea, 0x06, 0x0b, 0x79, 0xec, 0x89, 0x56, 0x40, 0xc3, 0xb8, 0x9d, 0x79, 0xc1,
+	0x2f, 0x40, 0x31, 0xd6, 0x30, 0x9d, 0xe8, 0xe9, 0x59, 0x8e, 0x4a, 0xae, 0x62, 0xd3, 0x83, 0x97,
+	0xca, 0xf2, 0x59, 0x0d, 0x54, 0xa3, 0xd7, 0x12, 0x24, 0xe7, 0xa1, 0x2c, 0xd7, 0x30, 0xcc, 0xc7,
+	0xbb, 0x50, 0x65, 0xa2, 0xbd, 0xac, 0xeb, 0xb6, 0x4d, 0x63, 0xf5, 0xec, 0x99, 0xb5, 0x2e, 0x4a,
+	0x30, 0xde, 0x78, 0x9b, 0xc6, 0x6d, 0xf2, 0x10, 0xe6, 0xb2, 0x74, 0xea, 0x5d, 0xa3, 0xde, 0x3d,
+	0xd5, 0x01, 0x25, 0x82, 0xcd, 0xdf, 0x4f, 0xc3, 0x65, 0x19, 0x25, 0x39, 0x47, 0x7e, 0xc8, 0x05,
+	0x1b, 0x7c, 0x69, 0xbf, 0x03, 0xf5, 0x1e, 0x17, 0xf2, 0xe9, 0x10, 0xb2, 0xc8, 0xe3, 0xae, 0x6c,
+	0x96, 0x91, 0xb0, 0x33, 0x0d, 0xe7, 0xb2, 0xc2, 0xbf, 0x44, 0x74, 0x4b, 0x62, 0xb1, 0x08, 0xee,
+	0x40, 0xa5, 0x27, 0x25, 0xd9, 0x11, 0xfb, 0xac, 0xeb, 0x45, 0xcc, 0xd5, 0xf7, 0xbd, 0xd8, 0x53,
+	0xf2, 0x15, 0x50, 0x76, 0x22, 0x46, 0x23, 0xdf, 0x93, 0xef, 0xa7, 0x1e, 0xf5, 0x3d, 0xd7, 0x16,
+	0x5e, 0x87, 0xe9, 0x41, 0x66, 0x2e, 0x41, 0x7d, 0x28, 0x31, 0x7b, 0x5e, 0x87, 0xc9, 0x5b, 0xc9,
+	0x31, 0x72, 0x98, 0x5a, 0x45, 0xbc, 0xaa, 0x10, 0x43, 0xb4, 0x87, 0x5e, 0x94, 0x92, 0xaa, 0xec,
+	0xd0, 0x1e, 0x40, 0x04, 0x92, 0xaa, 0x1c, 0xb9, 0x0f, 0x35, 0x0c, 0x7c, 0x96, 0x54, 0x05, 0xbf,
+	0x22, 0xe1, 0x19, 0xca, 0x4f, 0xe0, 0xf2, 0x21, 0xf7, 0x7d, 0xfe, 0xca, 0x76, 0xbd, 0x58, 0xc8,
+	0x9e, 0xa0, 0xc9, 0xd5, 0xab, 0xe0, 0xc1, 0x59, 0x75, 0x22, 0xfd, 0xbb, 0x4e, 0x03, 0xd7, 0x93,
+	0x23, 0xbf, 0x75, 0x49, 0xc9, 0xd9, 0xd0, 0x62, 0x94, 0xf8, 0x1d, 0x00, 0x27, 0xa1, 0x88, 0xeb,
+	0xc5, 0xb3, 0x9f, 0x83, 0xa7, 0x65, 0x66, 0x98, 0xc9, 0x33, 0x98, 0x73, 0xba, 0x91, 0x7c, 0x2b,
+	0xdb, 0x98, 0x09, 0xf8, 0x9a, 0x28, 0xa1, 0x95, 0x8b, 0x39, 0xaf, 0xb0, 0x44, 0xa0, 0x55, 0xd5,
+	0x9c, 0x09, 0x80, 0x3c, 0x06, 0x12, 0xb0, 0xd7, 0xc2, 0x0e, 0x23, 0x1e, 0xf2, 0x98, 0xfa, 0x2a,
+	0x05, 0x00, 0x5d, 0x54, 0x93, 0x98, 0x97, 0x1a, 0x81, 0xd1, 0x6f, 0xc0, 0xa5, 0x0c, 0x35, 0x3e,
+	0xc5, 0x5c, 0xf6, 0xba, 0x5e, 0x56, 0x61, 0x1d, 0x90, 0xcb, 0xe7, 0x8e, 0xcb, 0x5e, 0x93, 0xf7,
+	0xa1, 0x84, 0xf4, 0x32, 0x39, 0xea, 0xb3, 0xe3, 0x99, 0x58, 0x94, 0x1c, 0x32, 0x5b, 0xc9, 0x47,
+	0x50, 0x0b, 0x59, 0xe0, 0xca, 0x2c, 0xd5, 0x95, 0x12, 0xd7, 0x2f, 0xa2, 0xe7, 0x9a, 0xf9, 0x93,
+	0x21, 0xd2, 0xeb, 0x42, 0x4e, 0xf7, 0x71, 0x56, 0x35, 0x1c, 0x42, 0x60, 0x03, 0x9b, 0x3b, 0xe5,
+	0x66, 0x69, 0xef, 0xc0, 0xa5, 0xc6, 0x98, 0xf6, 0xb2, 0xc4, 0x97, 0xf3, 0x50, 0xc0, 0x2a, 0x48,
+	0xd7, 0x24, 0xf2, 0x20, 0x6b, 0x7f, 0xa8, 0x56, 0x55, 0x0d, 0x94, 0x0f, 0x06, 0x75, 0x2a, 0x5b,
+	0x20, 0xf6, 0x0e, 0xe6, 0xea, 0xcf, 0x6f, 0x72, 0xfc, 0x06, 0x3d, 0xce, 0xfc, 0x93, 0x01, 0x57,
+	0x73, 0xae, 0x2f, 0x2d, 0x53, 0x91, 0xd2, 0x5f, 0x24, 0x3c, 0xc8, 0xfe, 0x1a, 0x76, 0x0f, 0x7c,
+	0xcf, 0xb1, 0x8f, 0x59, 0x3f, 0x99, 0x26, 0x14, 0xe4, 0x19, 0xeb, 0x8f, 0xee, 0x34, 0x93, 0x23,
+	0x3b, 0x8d, 0x6c, 0x0b, 0x2c, 0x16, 0x5e, 0x47, 0x3e, 0x79, 0xb3, 0x1f, 0xe9, 0x8b, 0x29, 0x54,
+	0xe6, 0x8f, 0xb9, 0x0f, 0x57, 0xd6, 0xa8, 0x73, 0x7c, 0xe8, 0xf9, 0xbe, 0xfe, 0xa6, 0x24, 0x0d,
+	0x69, 0x11, 0xca, 0x3e, 0x7f, 0x25, 0x1b, 0x40, 0xa6, 0x07, 0x81, 0x02, 0x61, 0xea, 0xc9, 0x49,
+	0x99, 0x77, 0x42, 0x9f, 0x89, 0xe4, 0x03, 0x92, 0x9e, 0x57, 0xfe, 0x38, 0x0f, 0x05, 0x7c, 0xf0,
+	0x93, 0x9f, 0x1a, 0x50, 0xd9, 0x62, 0x22, 0xb3, 0x5b, 0x25, 0xb9, 0x5f, 0xb8, 0xd3, 0x0b, 0xd8,
+	0x85, 0x5b, 0xb9, 0x53, 0xe2, 0x60, 0x41, 0x6a, 0x2e, 0x7d, 0xf9, 0xcf, 0x7f, 0xff, 0x76, 0xe2,
+	0x0d, 0xf2, 0x7f, 0xcd, 0xa1, 0x2d, 0x35, 0x2e, 0xbe, 0x9b, 0xb8, 0x13, 0x21, 0xaf, 0xa1, 0x28,
+	0xad, 0xc0, 0xca, 0xbf, 0x9d, 0xab, 0x3f, 0xb3, 0xa3, 0xfd, 0x16, 0x34, 0x63, 0x98, 0xc8, 0x8f,
+	0xa0, 0xda, 0x62, 0x22, 0xbb, 0x69, 0x25, 0x8f, 0xbe, 0xc1, 0x3e, 0x76, 0xe1, 0x4a, 0x43, 0xed,
+	0xc7, 0x1b, 0xc9, 0x7e, 0xbc, 0xb1, 0xd9, 0x09, 0x45, 0xdf, 0xbc, 0x85, 0xaa, 0xaf, 0x9b, 0x6f,
+	0x8c, 0x52, 0xed, 0x2b, 0x41, 0xe4, 0xd7, 0x06, 0x5c, 0xdd, 0x62, 0x62, 0xd4, 0x0e, 0x92, 0xe4,
+	0x08, 0x5e, 0x78, 0xfb, 0x7f, 0xd9, 0x64, 0x9a, 0x77, 0xd1, 0x9c, 0x9b, 0xe4, 0xc6, 0x28, 0x73,
+	0x0e, 0x79, 0x74, 0xec, 0x28, 0xad, 0x11, 0x94, 0x9e, 0x7b, 0x31, 0xbe, 0x6c, 0xe3, 0x5c, 0x13,
+	0x1e, 0x8e, 0xbd, 0x44, 0x8a, 0xcf, 0x0e, 0x01, 0xbe, 0x43, 0xc9, 0xe7, 0x30, 0x23, 0x9d, 0xc0,
+	0x58, 0x44, 0xcc, 0x33, 0x16, 0x6c, 0x89, 0xc7, 0xc7, 0x5f, 0x0a, 0x9a, 0x37, 0x51, 0xf9, 0x02,
+	0xa9, 0xe7, 0x29, 0x27, 0x5f, 0x19, 0x50, 0xdb, 0x62, 0x62, 0xe8, 0x8f, 0x08, 0xf2, 0x38, 0x4f,
+	0xc3, 0xa8, 0xff, 0x3a, 0x16, 0x9e, 0x8c, 0x49, 0xad, 0x6d, 0xba, 0x83, 0x36, 0x2d, 0x92, 0xeb,
+	0xa3, 0x6c, 0xf2, 0xd2, 0x0e, 0xf4, 0x63, 0xa8, 0xac, 0xba, 0x6e, 0x66, 0x47, 0x93, 0x5f, 0x97,
+	0xa7, 0x17, 0x3f, 0xb9, 0x59, 0xf9, 0x00, 0x95, 0xdf, 0x32, 0x97, 0x72, 0xa3, 0xd1, 0x14, 0x4a,
+	0x1a, 0xf9, 0x1c, 0xe6, 0x2c, 0xd6, 0xe1, 0x3d, 0x96, 0xb5, 0x61, 0x9c, 0xf8, 0x9c, 0xa3, 0xfb,
+	0xe1, 0x18, 0xba, 0x7f, 0x62, 0x40, 0x4d, 0xa6, 0x61, 0x76, 0x45, 0x95, 0x9b, 0x8d, 0x8f, 0xc7,
+	0xf0, 0x4b, 0xda, 0x37, 0x13, 0x2b, 0xc8, 0x18, 0x56, 0x84, 0x30, 0xa3, 0xb7, 0x5d, 0x24, 0x77,
+	0xb3, 0x32, 0xbc, 0x0e, 0xcb, 0xbd, 0xbb, 0x0e, 0xba, 0x79, 0x3d, 0x5f, 0xeb, 0x01, 0x0d, 0x88,
+	0x80, 0xd2, 0x7e, 0x70, 0xf0, 0x2d, 0xe9, 0xbc, 0x87, 0x3a, 0x97, 0xcc, 0xc5, 0x7c, 0x9d, 0x5d,
+	0xa9, 0x8c, 0xbc, 0x86, 0xd9, 0xa4, 0xe6, 0xd7, 0x68, 0x90, 0xef, 0xe8, 0xfb, 0xe7, 0xac, 0xc8,
+	0xe2, 0xf1, 0xba, 0x4d, 0x7a, 0xdd, 0x98, 0xfc, 0xcc, 0x80, 0xca, 0xf0, 0x3a, 0x8d, 0xe4, 0x56,
+	0xd3, 0xc8, 0xb5, 0x5b, 0xee, 0xe5, 0x1f, 0xa3, 0x05, 0x77, 0xcd, 0xdb, 0xf9, 0x16, 0xb8, 0xa9,
+	0x40, 0xf2, 0x3b, 0x03, 0x2a, 0xd2, 0x05, 0x99, 0x55, 0xd8, 0x83, 0x73, 0x17, 0x5c, 0xc9, 0xd2,
+	0x2e, 0xbf, 0x1d, 0x9e, 0xde, 0xd8, 0x25, 0x76, 0x91, 0x33, 0xec, 0x8a, 0xa8, 0x60, 0xbe, 0x32,
+	0xa2, 0x05, 0x73, 0x2d, 0x11, 0x31, 0xda, 0x19, 0xac, 0x9c, 0xf2, 0xc3, 0x63, 0x9e, 0xbf, 0xaf,
+	0x32, 0x2f, 0xbc, 0x69, 0x90, 0x5f, 0xa9, 0x8f, 0xce, 0xc8, 0x97, 0xf1, 0x37, 0xae, 0xb1, 0x51,
+	0x52, 0xcc, 0x47, 0x78, 0xc9, 0x3b, 0xe4, 0xd6, 0xa8, 0x4b, 0xca, 0x49, 0xa8, 0x39, 0xf8, 0x53,
+	0x43, 0x9a, 0x43, 0xb6, 0x98, 0x38, 0xf1, 0x94, 0xcc, 0xb5, 0xa4, 0x99, 0xdf, 0xfd, 0x47, 0xbe,
+	0x45, 0xcd, 0x27, 0x68, 0xcc, 0x3d, 0x72, 0x67, 0x94, 0x31, 0xc9, 0x98, 0xdc, 0x4c, 0x9f, 0xa7,
+	0x5f, 0xa8, 0x0f, 0xc2, 0xd0, 0x2b, 0x30, 0xd7, 0x98, 0x27, 0xe7, 0x3d, 0x48, 0x86, 0x1e, 0x91,
+	0x67, 0x97, 0x05, 0xfa, 0x45, 0x4d, 0xc0, 0x5f, 0x18, 0x30, 0x27, 0xc7, 0xa1, 0xa1, 0xc9, 0x2f,
+	0xd7, 0x88, 0x46, 0x7e, 0x9f, 0x18, 0x35, 0x39, 0x9a, 0xb7, 0xd1, 0x8a, 0x1b, 0xe4, 0xda, 0xc8,
+	0xa1, 0x48, 0xf3, 0xac, 0xcd, 0xfe, 0xf5, 0xeb, 0x1b, 0xc6, 0xdf, 0xbf, 0xbe, 0x61, 0xfc, 0xeb,
+	0xeb, 0x1b, 0xc6, 0xc1, 0x34, 0xea, 0x7c, 0xeb, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xe3, 0x1b,
+	0x5d, 0xec, 0xc2, 0x20, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// DebugClient is the client API for Debug service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DebugClient interface {
+	GetBeaconState(ctx context.Context, in *BeaconStateRequest, opts ...grpc.CallOption) (*SSZResponse, error)
+	GetBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*SSZResponse, error)
+	SetLoggingLevel(ctx context.Context, in *LoggingLevelRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	GetProtoArrayForkChoice(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ProtoArrayForkChoiceResponse, error)
+	ListPeers(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*DebugPeerResponses, error)
+	GetPeer(ctx context.Context, in *v1alpha1.PeerRequest, opts ...grpc.CallOption) (*DebugPeerResponse, error)
+	GetInclusionSlot(ctx context.Context, in *InclusionSlotRequest, opts ...grpc.CallOption) (*InclusionSlotResponse, error)
+	AddTrustedPeer(ctx context.Context, in *TrustedPeerRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	RemoveTrustedPeer(ctx context.Context, in *v1alpha1.PeerRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	ListTrustedPeers(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*TrustedPeersResponse, error)
+	BanPeer(ctx context.Context, in *BanPeerRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	UnbanPeer(ctx context.Context, in *BanPeerRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	ListPeerBans(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*PeerBansResponse, error)
+	DisconnectPeer(ctx context.Context, in *DisconnectPeerRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	ListRateLimits(ctx context.Context, in *RateLimitsRequest, opts ...grpc.CallOption) (*RateLimitsResponse, error)
+	StreamChainEvents(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (Debug_StreamChainEventsClient, error)
+	GetEth1ConnectionStatus(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*Eth1ConnectionStatus, error)
+	GetDepositSnapshot(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*DepositSnapshotResponse, error)
+	GetEth1DataVotes(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*Eth1DataVotesResponse, error)
+	GetBackfillStatus(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*BackfillStatusResponse, error)
+}
+
+type debugClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewDebugClient(cc *grpc.ClientConn) DebugClient {
+	return &debugClient{cc}
+}
+
+func (c *debugClient) GetBeaconState(ctx context.Context, in *BeaconStateRequest, opts ...grpc.CallOption) (*SSZResponse, error) {
+	out := new(SSZResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetBeaconState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) GetBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*SSZResponse, error) {
+	out := new(SSZResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) SetLoggingLevel(ctx context.Context, in *LoggingLevelRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/SetLoggingLevel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) GetProtoArrayForkChoice(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ProtoArrayForkChoiceResponse, error) {
+	out := new(ProtoArrayForkChoiceResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetProtoArrayForkChoice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) ListPeers(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*DebugPeerResponses, error) {
+	out := new(DebugPeerResponses)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/ListPeers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) GetPeer(ctx context.Context, in *v1alpha1.PeerRequest, opts ...grpc.CallOption) (*DebugPeerResponse, error) {
+	out := new(DebugPeerResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetPeer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) GetInclusionSlot(ctx context.Context, in *InclusionSlotRequest, opts ...grpc.CallOption) (*InclusionSlotResponse, error) {
+	out := new(InclusionSlotResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetInclusionSlot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) AddTrustedPeer(ctx context.Context, in *TrustedPeerRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/AddTrustedPeer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) RemoveTrustedPeer(ctx context.Context, in *v1alpha1.PeerRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/RemoveTrustedPeer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) ListTrustedPeers(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*TrustedPeersResponse, error) {
+	out := new(TrustedPeersResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/ListTrustedPeers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) BanPeer(ctx context.Context, in *BanPeerRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/BanPeer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) UnbanPeer(ctx context.Context, in *BanPeerRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/UnbanPeer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) ListPeerBans(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*PeerBansResponse, error) {
+	out := new(PeerBansResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/ListPeerBans", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) DisconnectPeer(ctx context.Context, in *DisconnectPeerRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/DisconnectPeer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) ListRateLimits(ctx context.Context, in *RateLimitsRequest, opts ...grpc.CallOption) (*RateLimitsResponse, error) {
+	out := new(RateLimitsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/ListRateLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) StreamChainEvents(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (Debug_StreamChainEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Debug_serviceDesc.Streams[0], "/ethereum.beacon.rpc.v1.Debug/StreamChainEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &debugStreamChainEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Debug_StreamChainEventsClient interface {
+	Recv() (*ChainEvent, error)
+	grpc.ClientStream
+}
+
+type debugStreamChainEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *debugStreamChainEventsClient) Recv() (*ChainEvent, error) {
+	m := new(ChainEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *debugClient) GetEth1ConnectionStatus(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*Eth1ConnectionStatus, error) {
+	out := new(Eth1ConnectionStatus)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetEth1ConnectionStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) GetDepositSnapshot(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*DepositSnapshotResponse, error) {
+	out := new(DepositSnapshotResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetDepositSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) GetEth1DataVotes(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*Eth1DataVotesResponse, error) {
+	out := new(Eth1DataVotesResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetEth1DataVotes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) GetBackfillStatus(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*BackfillStatusResponse, error) {
+	out := new(BackfillStatusResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetBackfillStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DebugServer is the server API for Debug service.
+type DebugServer interface {
+	GetBeaconState(context.Context, *BeaconStateRequest) (*SSZResponse, error)
+	GetBlock(context.Context, *BlockRequest) (*SSZResponse, error)
+	SetLoggingLevel(context.Context, *LoggingLevelRequest) (*types.Empty, error)
+	GetProtoArrayForkChoice(context.Context, *types.Empty) (*ProtoArrayForkChoiceResponse, error)
+	ListPeers(context.Context, *types.Empty) (*DebugPeerResponses, error)
+	GetPeer(context.Context, *v1alpha1.PeerRequest) (*DebugPeerResponse, error)
+	GetInclusionSlot(context.Context, *InclusionSlotRequest) (*InclusionSlotResponse, error)
+	AddTrustedPeer(context.Context, *TrustedPeerRequest) (*types.Empty, error)
+	RemoveTrustedPeer(context.Context, *v1alpha1.PeerRequest) (*types.Empty, error)
+	ListTrustedPeers(context.Context, *types.Empty) (*TrustedPeersResponse, error)
+	BanPeer(context.Context, *BanPeerRequest) (*types.Empty, error)
+	UnbanPeer(context.Context, *BanPeerRequest) (*types.Empty, error)
+	ListPeerBans(context.Context, *types.Empty) (*PeerBansResponse, error)
+	DisconnectPeer(context.Context, *DisconnectPeerRequest) (*types.Empty, error)
+	ListRateLimits(context.Context, *RateLimitsRequest) (*RateLimitsResponse, error)
+	StreamChainEvents(*types.Empty, Debug_StreamChainEventsServer) error
+	GetEth1ConnectionStatus(context.Context, *types.Empty) (*Eth1ConnectionStatus, error)
+	GetDepositSnapshot(context.Context, *types.Empty) (*DepositSnapshotResponse, error)
+	GetEth1DataVotes(context.Context, *types.Empty) (*Eth1DataVotesResponse, error)
+	GetBackfillStatus(context.Context, *types.Empty) (*BackfillStatusResponse, error)
+}
+
+// UnimplementedDebugServer can be embedded to have forward compatible implementations.
+type UnimplementedDebugServer struct {
+}
+
+func (*UnimplementedDebugServer) GetBeaconState(ctx context.Context, req *BeaconStateRequest) (*SSZResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBeaconState not implemented")
+}
+func (*UnimplementedDebugServer) GetBlock(ctx context.Context, req *BlockRequest) (*SSZResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlock not implemented")
+}
+func (*UnimplementedDebugServer) SetLoggingLevel(ctx context.Context, req *LoggingLevelRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLoggingLevel not implemented")
+}
+func (*UnimplementedDebugServer) GetProtoArrayForkChoice(ctx context.Context, req *types.Empty) (*ProtoArrayForkChoiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoArrayForkChoice not implemented")
+}
+func (*UnimplementedDebugServer) ListPeers(ctx context.Context, req *types.Empty) (*DebugPeerResponses, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPeers not implemented")
+}
+func (*UnimplementedDebugServer) GetPeer(ctx context.Context, req *v1alpha1.PeerRequest) (*DebugPeerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPeer not implemented")
+}
+func (*UnimplementedDebugServer) GetInclusionSlot(ctx context.Context, req *InclusionSlotRequest) (*InclusionSlotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInclusionSlot not implemented")
+}
+func (*UnimplementedDebugServer) AddTrustedPeer(ctx context.Context, req *TrustedPeerRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTrustedPeer not implemented")
+}
+func (*UnimplementedDebugServer) RemoveTrustedPeer(ctx context.Context, req *v1alpha1.PeerRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTrustedPeer not implemented")
+}
+func (*UnimplementedDebugServer) ListTrustedPeers(ctx context.Context, req *types.Empty) (*TrustedPeersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrustedPeers not implemented")
+}
+func (*UnimplementedDebugServer) BanPeer(ctx context.Context, req *BanPeerRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BanPeer not implemented")
+}
+func (*UnimplementedDebugServer) UnbanPeer(ctx context.Context, req *BanPeerRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbanPeer not implemented")
+}
+func (*UnimplementedDebugServer) ListPeerBans(ctx context.Context, req *types.Empty) (*PeerBansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPeerBans not implemented")
+}
+func (*UnimplementedDebugServer) DisconnectPeer(ctx context.Context, req *DisconnectPeerRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisconnectPeer not implemented")
+}
+func (*UnimplementedDebugServer) ListRateLimits(ctx context.Context, req *RateLimitsRequest) (*RateLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRateLimits not implemented")
+}
+func (*UnimplementedDebugServer) StreamChainEvents(req *types.Empty, srv Debug_StreamChainEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamChainEvents not implemented")
+}
+func (*UnimplementedDebugServer) GetEth1ConnectionStatus(ctx context.Context, req *types.Empty) (*Eth1ConnectionStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEth1ConnectionStatus not implemented")
+}
+func (*UnimplementedDebugServer) GetDepositSnapshot(ctx context.Context, req *types.Empty) (*DepositSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDepositSnapshot not implemented")
+}
+func (*UnimplementedDebugServer) GetEth1DataVotes(ctx context.Context, req *types.Empty) (*Eth1DataVotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEth1DataVotes not implemented")
+}
+func (*UnimplementedDebugServer) GetBackfillStatus(ctx context.Context, req *types.Empty) (*BackfillStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBackfillStatus not implemented")
+}
+
+func RegisterDebugServer(s *grpc.Server, srv DebugServer) {
+	s.RegisterService(&_Debug_serviceDesc, srv)
+}
+
+func _Debug_GetBeaconState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BeaconStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).GetBeaconState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetBeaconState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).GetBeaconState(ctx, req.(*BeaconStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_GetBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).GetBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).GetBlock(ctx, req.(*BlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_SetLoggingLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoggingLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).SetLoggingLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/SetLoggingLevel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).SetLoggingLevel(ctx, req.(*LoggingLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_GetProtoArrayForkChoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).GetProtoArrayForkChoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetProtoArrayForkChoice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).GetProtoArrayForkChoice(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_ListPeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).ListPeers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/ListPeers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).ListPeers(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_GetPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.PeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).GetPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).GetPeer(ctx, req.(*v1alpha1.PeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_GetInclusionSlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InclusionSlotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).GetInclusionSlot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetInclusionSlot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).GetInclusionSlot(ctx, req.(*InclusionSlotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_AddTrustedPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrustedPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).AddTrustedPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/AddTrustedPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).AddTrustedPeer(ctx, req.(*TrustedPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_RemoveTrustedPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.PeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).RemoveTrustedPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/RemoveTrustedPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).RemoveTrustedPeer(ctx, req.(*v1alpha1.PeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_ListTrustedPeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).ListTrustedPeers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/ListTrustedPeers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).ListTrustedPeers(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_BanPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BanPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).BanPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/BanPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).BanPeer(ctx, req.(*BanPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_UnbanPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BanPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).UnbanPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/UnbanPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).UnbanPeer(ctx, req.(*BanPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_ListPeerBans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).ListPeerBans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/ListPeerBans",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).ListPeerBans(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_DisconnectPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisconnectPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).DisconnectPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/DisconnectPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).DisconnectPeer(ctx, req.(*DisconnectPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_ListRateLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RateLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).ListRateLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/ListRateLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).ListRateLimits(ctx, req.(*RateLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_StreamChainEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(types.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DebugServer).StreamChainEvents(m, &debugStreamChainEventsServer{stream})
+}
+
+type Debug_StreamChainEventsServer interface {
+	Send(*ChainEvent) error
+	grpc.ServerStream
+}
+
+type debugStreamChainEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *debugStreamChainEventsServer) Send(m *ChainEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Debug_GetEth1ConnectionStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).GetEth1ConnectionStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetEth1ConnectionStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).GetEth1ConnectionStatus(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_GetDepositSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).GetDepositSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetDepositSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).GetDepositSnapshot(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_GetEth1DataVotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).GetEth1DataVotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetEth1DataVotes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).GetEth1DataVotes(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_GetBackfillStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).GetBackfillStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetBackfillStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).GetBackfillStatus(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Debug_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.Debug",
+	HandlerType: (*DebugServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetBeaconState",
+			Handler:    _Debug_GetBeaconState_Handler,
+		},
+		{
+			MethodName: "GetBlock",
+			Handler:    _Debug_GetBlock_Handler,
+		},
+		{
+			MethodName: "SetLoggingLevel",
+			Handler:    _Debug_SetLoggingLevel_Handler,
+		},
+		{
+			MethodName: "GetProtoArrayForkChoice",
+			Handler:    _Debug_GetProtoArrayForkChoice_Handler,
+		},
+		{
+			MethodName: "ListPeers",
+			Handler:    _Debug_ListPeers_Handler,
+		},
+		{
+			MethodName: "GetPeer",
+			Handler:    _Debug_GetPeer_Handler,
+		},
+		{
+			MethodName: "GetInclusionSlot",
+			Handler:    _Debug_GetInclusionSlot_Handler,
+		},
+		{
+			MethodName: "AddTrustedPeer",
+			Handler:    _Debug_AddTrustedPeer_Handler,
+		},
+		{
+			MethodName: "RemoveTrustedPeer",
+			Handler:    _Debug_RemoveTrustedPeer_Handler,
+		},
+		{
+			MethodName: "ListTrustedPeers",
+			Handler:    _Debug_ListTrustedPeers_Handler,
+		},
+		{
+			MethodName: "BanPeer",
+			Handler:    _Debug_BanPeer_Handler,
+		},
+		{
+			MethodName: "UnbanPeer",
+			Handler:    _Debug_UnbanPeer_Handler,
+		},
+		{
+			MethodName: "ListPeerBans",
+			Handler:    _Debug_ListPeerBans_Handler,
+		},
+		{
+			MethodName: "DisconnectPeer",
+			Handler:    _Debug_DisconnectPeer_Handler,
+		},
+		{
+			MethodName: "ListRateLimits",
+			Handler:    _Debug_ListRateLimits_Handler,
+		},
+		{
+			MethodName: "GetEth1ConnectionStatus",
+			Handler:    _Debug_GetEth1ConnectionStatus_Handler,
+		},
+		{
+			MethodName: "GetDepositSnapshot",
+			Handler:    _Debug_GetDepositSnapshot_Handler,
+		},
+		{
+			MethodName: "GetEth1DataVotes",
+			Handler:    _Debug_GetEth1DataVotes_Handler,
+		},
+		{
+			MethodName: "GetBackfillStatus",
+			Handler:    _Debug_GetBackfillStatus_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamChainEvents",
+			Handler:       _Debug_StreamChainEvents_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/beacon/rpc/v1/debug.proto",
+}
+
+func (m *InclusionSlotRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InclusionSlotRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InclusionSlotRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Slot != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InclusionSlotResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InclusionSlotResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InclusionSlotResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Slot != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x10
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BeaconStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BeaconStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BeaconStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.QueryFilter != nil {
+		{
+			size := m.QueryFilter.Size()
+			i -= size
+			if _, err := m.QueryFilter.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BeaconStateRequest_Slot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BeaconStateRequest_Slot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintDebug(dAtA, i, uint64(m.Slot))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+func (m *BeaconStateRequest_BlockRoot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BeaconStateRequest_BlockRoot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.BlockRoot != nil {
+		i -= len(m.BlockRoot)
+		copy(dAtA[i:], m.BlockRoot)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.BlockRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *BlockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.BlockRoot) > 0 {
+		i -= len(m.BlockRoot)
+		copy(dAtA[i:], m.BlockRoot)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.BlockRoot)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SSZResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SSZResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SSZResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Encoded) > 0 {
+		i -= len(m.Encoded)
+		copy(dAtA[i:], m.Encoded)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.Encoded)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LoggingLevelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LoggingLevelRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LoggingLevelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Level != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Level))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProtoArrayForkChoiceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProtoArrayForkChoiceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProtoArrayForkChoiceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Indices) > 0 {
+		for k := range m.Indices {
+			v := m.Indices[k]
+			baseI := i
+			i = encodeVarintDebug(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintDebug(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintDebug(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ProtoArrayNodes) > 0 {
+		for iNdEx := len(m.ProtoArrayNodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProtoArrayNodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDebug(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.FinalizedEpoch != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.FinalizedEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.JustifiedEpoch != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.JustifiedEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PruneThreshold != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.PruneThreshold))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProtoArrayNode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProtoArrayNode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProtoArrayNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.BestDescendant != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.BestDescendant))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.BestChild != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.BestChild))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Weight != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.FinalizedEpoch != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.FinalizedEpoch))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.JustifiedEpoch != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.JustifiedEpoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Parent != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Parent))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Slot != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DebugPeerResponses) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DebugPeerResponses) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DebugPeerResponses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Responses) > 0 {
+		for iNdEx := len(m.Responses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Responses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDebug(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DebugPeerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DebugPeerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DebugPeerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.LastUpdated != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.LastUpdated))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.PeerStatus != nil {
+		{
+			size, err := m.PeerStatus.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDebug(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.PeerInfo != nil {
+		{
+			size, err := m.PeerInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDebug(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Enr) > 0 {
+		i -= len(m.Enr)
+		copy(dAtA[i:], m.Enr)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.Enr)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PeerId) > 0 {
+		i -= len(m.PeerId)
+		copy(dAtA[i:], m.PeerId)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.PeerId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ConnectionState != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.ConnectionState))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Direction != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Direction))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ListeningAddresses) > 0 {
+		for iNdEx := len(m.ListeningAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ListeningAddresses[iNdEx])
+			copy(dAtA[i:], m.ListeningAddresses[iNdEx])
+			i = encodeVarintDebug(dAtA, i, uint64(len(m.ListeningAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DebugPeerResponse_PeerInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DebugPeerResponse_PeerInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DebugPeerResponse_PeerInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.GossipScore != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.GossipScore))))
+		i--
+		dAtA[i] = 0x41
+	}
+	if m.Score != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Score))))
+		i--
+		dAtA[i] = 0x39
+	}
+	if m.PeerLatency != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.PeerLatency))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.AgentVersion) > 0 {
+		i -= len(m.AgentVersion)
+		copy(dAtA[i:], m.AgentVersion)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.AgentVersion)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ProtocolVersion) > 0 {
+		i -= len(m.ProtocolVersion)
+		copy(dAtA[i:], m.ProtocolVersion)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.ProtocolVersion)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.FaultCount != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.FaultCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Protocols) > 0 {
+		for iNdEx := len(m.Protocols) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Protocols[iNdEx])
+			copy(dAtA[i:], m.Protocols[iNdEx])
+			i = encodeVarintDebug(dAtA, i, uint64(len(m.Protocols[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDebug(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TrustedPeerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TrustedPeerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TrustedPeerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TrustedPeersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TrustedPeersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TrustedPeersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintDebug(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BanPeerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BanPeerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BanPeerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.DurationSeconds != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.DurationSeconds))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Ip) > 0 {
+		i -= len(m.Ip)
+		copy(dAtA[i:], m.Ip)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.Ip)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PeerId) > 0 {
+		i -= len(m.PeerId)
+		copy(dAtA[i:], m.PeerId)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.PeerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PeerBan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PeerBan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PeerBan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ExpiresAt != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Ip) > 0 {
+		i -= len(m.Ip)
+		copy(dAtA[i:], m.Ip)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.Ip)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PeerId) > 0 {
+		i -= len(m.PeerId)
+		copy(dAtA[i:], m.PeerId)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.PeerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PeerBansResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PeerBansResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PeerBansResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Bans) > 0 {
+		for iNdEx := len(m.Bans) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bans[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDebug(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DisconnectPeerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DisconnectPeerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DisconnectPeerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Reason != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PeerId) > 0 {
+		i -= len(m.PeerId)
+		copy(dAtA[i:], m.PeerId)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.PeerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimitsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PeerId) > 0 {
+		i -= len(m.PeerId)
+		copy(dAtA[i:], m.PeerId)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.PeerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Peers) > 0 {
+		for iNdEx := len(m.Peers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Peers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDebug(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PeerRateLimits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PeerRateLimits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PeerRateLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Buckets) > 0 {
+		for iNdEx := len(m.Buckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Buckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDebug(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.PeerId) > 0 {
+		i -= len(m.PeerId)
+		copy(dAtA[i:], m.PeerId)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.PeerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimitBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Rate != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Rate))))
+		i--
+		dAtA[i] = 0x29
+	}
+	if m.Remaining != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Remaining))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Capacity != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Capacity))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Outbound {
+		i--
+		if m.Outbound {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Topic) > 0 {
+		i -= len(m.Topic)
+		copy(dAtA[i:], m.Topic)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.Topic)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChainEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.SyncStatus != nil {
+		{
+			size, err := m.SyncStatus.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDebug(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Checkpoint != nil {
+		{
+			size, err := m.Checkpoint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDebug(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Reorg != nil {
+		{
+			size, err := m.Reorg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDebug(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Head != nil {
+		{
+			size, err := m.Head.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDebug(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HeadEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HeadEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HeadEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.EpochTransition {
+		i--
+		if m.EpochTransition {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.PreviousBlockRoot) > 0 {
+		i -= len(m.PreviousBlockRoot)
+		copy(dAtA[i:], m.PreviousBlockRoot)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.PreviousBlockRoot)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BlockRoot) > 0 {
+		i -= len(m.BlockRoot)
+		copy(dAtA[i:], m.BlockRoot)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.BlockRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Slot != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReorgEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReorgEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReorgEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Depth != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Depth))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.CommonAncestorSlot != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.CommonAncestorSlot))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.CommonAncestorRoot) > 0 {
+		i -= len(m.CommonAncestorRoot)
+		copy(dAtA[i:], m.CommonAncestorRoot)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.CommonAncestorRoot)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.NewHeadSlot != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.NewHeadSlot))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.NewHeadRoot) > 0 {
+		i -= len(m.NewHeadRoot)
+		copy(dAtA[i:], m.NewHeadRoot)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.NewHeadRoot)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.OldHeadSlot != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.OldHeadSlot))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.OldHeadRoot) > 0 {
+		i -= len(m.OldHeadRoot)
+		copy(dAtA[i:], m.OldHeadRoot)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.OldHeadRoot)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckpointEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckpointEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckpointEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SyncStatusEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncStatusEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncStatusEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Syncing {
+		i--
+		if m.Syncing {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Eth1ConnectionStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Eth1ConnectionStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Eth1ConnectionStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Endpoints) > 0 {
+		for iNdEx := len(m.Endpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Endpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDebug(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ActiveEndpoint) > 0 {
+		i -= len(m.ActiveEndpoint)
+		copy(dAtA[i:], m.ActiveEndpoint)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.ActiveEndpoint)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Eth1EndpointStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Eth1EndpointStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Eth1EndpointStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.LastChecked != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.LastChecked))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.RejectionReason) > 0 {
+		i -= len(m.RejectionReason)
+		copy(dAtA[i:], m.RejectionReason)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.RejectionReason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.HeadBlock != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.HeadBlock))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Healthy {
+		i--
+		if m.Healthy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Endpoint) > 0 {
+		i -= len(m.Endpoint)
+		copy(dAtA[i:], m.Endpoint)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.Endpoint)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DepositSnapshotResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DepositSnapshotResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepositSnapshotResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Eth1BlockHeight != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Eth1BlockHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Eth1BlockHash) > 0 {
+		i -= len(m.Eth1BlockHash)
+		copy(dAtA[i:], m.Eth1BlockHash)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.Eth1BlockHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DepositRoot) > 0 {
+		i -= len(m.DepositRoot)
+		copy(dAtA[i:], m.DepositRoot)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.DepositRoot)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.DepositCount != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.DepositCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Snapshot) > 0 {
+		i -= len(m.Snapshot)
+		copy(dAtA[i:], m.Snapshot)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.Snapshot)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Eth1DataVotesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Eth1DataVotesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Eth1DataVotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PendingDeposits) > 0 {
+		for iNdEx := len(m.PendingDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDebug(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.NextVote != nil {
+		{
+			size, err := m.NextVote.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDebug(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.NextProposerIndex != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.NextProposerIndex))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.NextProposalSlot != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.NextProposalSlot))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.CurrentEth1Data != nil {
+		{
+			size, err := m.CurrentEth1Data.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDebug(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Candidates) > 0 {
+		for iNdEx := len(m.Candidates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Candidates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDebug(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.FollowDistanceBlock != nil {
+		{
+			size, err := m.FollowDistanceBlock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDebug(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.LastValidBlock != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.LastValidBlock))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.FirstValidBlock != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.FirstValidBlock))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LatestValidTime != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.LatestValidTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EarliestValidTime != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.EarliestValidTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.VotesRequired != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.VotesRequired))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.VotingPeriodStartSlot != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.VotingPeriodStartSlot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Eth1DataCandidate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Eth1DataCandidate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Eth1DataCandidate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.RejectionReason) > 0 {
+		i -= len(m.RejectionReason)
+		copy(dAtA[i:], m.RejectionReason)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.RejectionReason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Counted {
+		i--
+		if m.Counted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Votes != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Votes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Eth1Data != nil {
+		{
+			size, err := m.Eth1Data.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDebug(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingDepositInclusion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingDepositInclusion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingDepositInclusion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.EstimatedSlot != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.EstimatedSlot))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Eth1BlockHeight != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Eth1BlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BackfillStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BackfillStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BackfillStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Complete {
+		i--
+		if m.Complete {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.LowestSlot != 0 {
+		i = encodeVarintDebug(dAtA, i, uint64(m.LowestSlot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDebug(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDebug(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *InclusionSlotRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovDebug(uint64(m.Id))
+	}
+	if m.Slot != 0 {
+		n += 1 + sovDebug(uint64(m.Slot))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InclusionSlotResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovDebug(uint64(m.Slot))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BeaconStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.QueryFilter != nil {
+		n += m.QueryFilter.Size()
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BeaconStateRequest_Slot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovDebug(uint64(m.Slot))
+	return n
+}
+func (m *BeaconStateRequest_BlockRoot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockRoot != nil {
+		l = len(m.BlockRoot)
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	return n
+}
+func (m *BlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BlockRoot)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SSZResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Encoded)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LoggingLevelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Level != 0 {
+		n += 1 + sovDebug(uint64(m.Level))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProtoArrayForkChoiceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PruneThreshold != 0 {
+		n += 1 + sovDebug(uint64(m.PruneThreshold))
+	}
+	if m.JustifiedEpoch != 0 {
+		n += 1 + sovDebug(uint64(m.JustifiedEpoch))
+	}
+	if m.FinalizedEpoch != 0 {
+		n += 1 + sovDebug(uint64(m.FinalizedEpoch))
+	}
+	if len(m.ProtoArrayNodes) > 0 {
+		for _, e := range m.ProtoArrayNodes {
+			l = e.Size()
+			n += 1 + l + sovDebug(uint64(l))
+		}
+	}
+	if len(m.Indices) > 0 {
+		for k, v := range m.Indices {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovDebug(uint64(len(k))) + 1 + sovDebug(uint64(v))
+			n += mapEntrySize + 1 + sovDebug(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProtoArrayNode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovDebug(uint64(m.Slot))
+	}
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.Parent != 0 {
+		n += 1 + sovDebug(uint64(m.Parent))
+	}
+	if m.JustifiedEpoch != 0 {
+		n += 1 + sovDebug(uint64(m.JustifiedEpoch))
+	}
+	if m.FinalizedEpoch != 0 {
+		n += 1 + sovDebug(uint64(m.FinalizedEpoch))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovDebug(uint64(m.Weight))
+	}
+	if m.BestChild != 0 {
+		n += 1 + sovDebug(uint64(m.BestChild))
+	}
+	if m.BestDescendant != 0 {
+		n += 1 + sovDebug(uint64(m.BestDescendant))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DebugPeerResponses) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Responses) > 0 {
+		for _, e := range m.Responses {
+			l = e.Size()
+			n += 1 + l + sovDebug(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DebugPeerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ListeningAddresses) > 0 {
+		for _, s := range m.ListeningAddresses {
+			l = len(s)
+			n += 1 + l + sovDebug(uint64(l))
+		}
+	}
+	if m.Direction != 0 {
+		n += 1 + sovDebug(uint64(m.Direction))
+	}
+	if m.ConnectionState != 0 {
+		n += 1 + sovDebug(uint64(m.ConnectionState))
+	}
+	l = len(m.PeerId)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	l = len(m.Enr)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.PeerInfo != nil {
+		l = m.PeerInfo.Size()
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.PeerStatus != nil {
+		l = m.PeerStatus.Size()
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.LastUpdated != 0 {
+		n += 1 + sovDebug(uint64(m.LastUpdated))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DebugPeerResponse_PeerInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if len(m.Protocols) > 0 {
+		for _, s := range m.Protocols {
+			l = len(s)
+			n += 1 + l + sovDebug(uint64(l))
+		}
+	}
+	if m.FaultCount != 0 {
+		n += 1 + sovDebug(uint64(m.FaultCount))
+	}
+	l = len(m.ProtocolVersion)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	l = len(m.AgentVersion)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.PeerLatency != 0 {
+		n += 1 + sovDebug(uint64(m.PeerLatency))
+	}
+	if m.Score != 0 {
+		n += 9
+	}
+	if m.GossipScore != 0 {
+		n += 9
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TrustedPeerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TrustedPeersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovDebug(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BanPeerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PeerId)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	l = len(m.Ip)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.DurationSeconds != 0 {
+		n += 1 + sovDebug(uint64(m.DurationSeconds))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PeerBan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PeerId)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	l = len(m.Ip)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovDebug(uint64(m.ExpiresAt))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PeerBansResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Bans) > 0 {
+		for _, e := range m.Bans {
+			l = e.Size()
+			n += 1 + l + sovDebug(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DisconnectPeerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PeerId)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.Reason != 0 {
+		n += 1 + sovDebug(uint64(m.Reason))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RateLimitsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PeerId)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RateLimitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Peers) > 0 {
+		for _, e := range m.Peers {
+			l = e.Size()
+			n += 1 + l + sovDebug(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PeerRateLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PeerId)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if len(m.Buckets) > 0 {
+		for _, e := range m.Buckets {
+			l = e.Size()
+			n += 1 + l + sovDebug(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RateLimitBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Topic)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.Outbound {
+		n += 2
+	}
+	if m.Capacity != 0 {
+		n += 1 + sovDebug(uint64(m.Capacity))
+	}
+	if m.Remaining != 0 {
+		n += 1 + sovDebug(uint64(m.Remaining))
+	}
+	if m.Rate != 0 {
+		n += 9
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChainEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovDebug(uint64(m.Type))
+	}
+	if m.Head != nil {
+		l = m.Head.Size()
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.Reorg != nil {
+		l = m.Reorg.Size()
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.Checkpoint != nil {
+		l = m.Checkpoint.Size()
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.SyncStatus != nil {
+		l = m.SyncStatus.Size()
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *HeadEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovDebug(uint64(m.Slot))
+	}
+	l = len(m.BlockRoot)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	l = len(m.PreviousBlockRoot)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.EpochTransition {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReorgEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OldHeadRoot)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.OldHeadSlot != 0 {
+		n += 1 + sovDebug(uint64(m.OldHeadSlot))
+	}
+	l = len(m.NewHeadRoot)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.NewHeadSlot != 0 {
+		n += 1 + sovDebug(uint64(m.NewHeadSlot))
+	}
+	l = len(m.CommonAncestorRoot)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.CommonAncestorSlot != 0 {
+		n += 1 + sovDebug(uint64(m.CommonAncestorSlot))
+	}
+	if m.Depth != 0 {
+		n += 1 + sovDebug(uint64(m.Depth))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CheckpointEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovDebug(uint64(m.Epoch))
+	}
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SyncStatusEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Syncing {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Eth1ConnectionStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ActiveEndpoint)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if len(m.Endpoints) > 0 {
+		for _, e := range m.Endpoints {
+			l = e.Size()
+			n += 1 + l + sovDebug(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Eth1EndpointStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Endpoint)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.Active {
+		n += 2
+	}
+	if m.Healthy {
+		n += 2
+	}
+	if m.HeadBlock != 0 {
+		n += 1 + sovDebug(uint64(m.HeadBlock))
+	}
+	l = len(m.RejectionReason)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.LastChecked != 0 {
+		n += 1 + sovDebug(uint64(m.LastChecked))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DepositSnapshotResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Snapshot)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.DepositCount != 0 {
+		n += 1 + sovDebug(uint64(m.DepositCount))
+	}
+	l = len(m.DepositRoot)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	l = len(m.Eth1BlockHash)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.Eth1BlockHeight != 0 {
+		n += 1 + sovDebug(uint64(m.Eth1BlockHeight))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Eth1DataVotesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VotingPeriodStartSlot != 0 {
+		n += 1 + sovDebug(uint64(m.VotingPeriodStartSlot))
+	}
+	if m.VotesRequired != 0 {
+		n += 1 + sovDebug(uint64(m.VotesRequired))
+	}
+	if m.EarliestValidTime != 0 {
+		n += 1 + sovDebug(uint64(m.EarliestValidTime))
+	}
+	if m.LatestValidTime != 0 {
+		n += 1 + sovDebug(uint64(m.LatestValidTime))
+	}
+	if m.FirstValidBlock != 0 {
+		n += 1 + sovDebug(uint64(m.FirstValidBlock))
+	}
+	if m.LastValidBlock != 0 {
+		n += 1 + sovDebug(uint64(m.LastValidBlock))
+	}
+	if m.FollowDistanceBlock != nil {
+		l = m.FollowDistanceBlock.Size()
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if len(m.Candidates) > 0 {
+		for _, e := range m.Candidates {
+			l = e.Size()
+			n += 1 + l + sovDebug(uint64(l))
+		}
+	}
+	if m.CurrentEth1Data != nil {
+		l = m.CurrentEth1Data.Size()
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.NextProposalSlot != 0 {
+		n += 1 + sovDebug(uint64(m.NextProposalSlot))
+	}
+	if m.NextProposerIndex != 0 {
+		n += 1 + sovDebug(uint64(m.NextProposerIndex))
+	}
+	if m.NextVote != nil {
+		l = m.NextVote.Size()
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if len(m.PendingDeposits) > 0 {
+		for _, e := range m.PendingDeposits {
+			l = e.Size()
+			n += 1 + l + sovDebug(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Eth1DataCandidate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Eth1Data != nil {
+		l = m.Eth1Data.Size()
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.Votes != 0 {
+		n += 1 + sovDebug(uint64(m.Votes))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovDebug(uint64(m.BlockHeight))
+	}
+	if m.Counted {
+		n += 2
+	}
+	l = len(m.RejectionReason)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PendingDepositInclusion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovDebug(uint64(m.Index))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.Eth1BlockHeight != 0 {
+		n += 1 + sovDebug(uint64(m.Eth1BlockHeight))
+	}
+	if m.EstimatedSlot != 0 {
+		n += 1 + sovDebug(uint64(m.EstimatedSlot))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BackfillStatusResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	if len(m.ListeningAddresses) > 0 {
-		for _, s := range m.ListeningAddresses {
-			l = len(s)
-			n += 1 + l + sovDebug(uint64(l))
+	var l int
+	_ = l
+	if m.LowestSlot != 0 {
+		n += 1 + sovDebug(uint64(m.LowestSlot))
+	}
+	if m.Complete {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovDebug(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDebug(x uint64) (n int) {
+	return sovDebug(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *InclusionSlotRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InclusionSlotRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InclusionSlotRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InclusionSlotResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InclusionSlotResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InclusionSlotResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BeaconStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BeaconStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BeaconStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.QueryFilter = &BeaconStateRequest_Slot{v}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.QueryFilter = &BeaconStateRequest_BlockRoot{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockRoot = append(m.BlockRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockRoot == nil {
+				m.BlockRoot = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SSZResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SSZResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SSZResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Encoded", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Encoded = append(m.Encoded[:0], dAtA[iNdEx:postIndex]...)
+			if m.Encoded == nil {
+				m.Encoded = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LoggingLevelRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LoggingLevelRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LoggingLevelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			}
+			m.Level = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Level |= LoggingLevelRequest_Level(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProtoArrayForkChoiceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProtoArrayForkChoiceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProtoArrayForkChoiceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PruneThreshold", wireType)
+			}
+			m.PruneThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PruneThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JustifiedEpoch", wireType)
+			}
+			m.JustifiedEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JustifiedEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedEpoch", wireType)
+			}
+			m.FinalizedEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalizedEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtoArrayNodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProtoArrayNodes = append(m.ProtoArrayNodes, &ProtoArrayNode{})
+			if err := m.ProtoArrayNodes[len(m.ProtoArrayNodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Indices == nil {
+				m.Indices = make(map[string]uint64)
+			}
+			var mapkey string
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDebug
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDebug
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthDebug
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthDebug
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDebug
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipDebug(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthDebug
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Indices[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProtoArrayNode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProtoArrayNode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProtoArrayNode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
+			if m.Root == nil {
+				m.Root = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+			}
+			m.Parent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Parent |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JustifiedEpoch", wireType)
+			}
+			m.JustifiedEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JustifiedEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedEpoch", wireType)
+			}
+			m.FinalizedEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalizedEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BestChild", wireType)
+			}
+			m.BestChild = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BestChild |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BestDescendant", wireType)
+			}
+			m.BestDescendant = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BestDescendant |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DebugPeerResponses) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DebugPeerResponses: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DebugPeerResponses: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Responses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Responses = append(m.Responses, &DebugPeerResponse{})
+			if err := m.Responses[len(m.Responses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DebugPeerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DebugPeerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DebugPeerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListeningAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ListeningAddresses = append(m.ListeningAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			m.Direction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Direction |= v1alpha1.PeerDirection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionState", wireType)
+			}
+			m.ConnectionState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConnectionState |= v1alpha1.ConnectionState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Enr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PeerInfo == nil {
+				m.PeerInfo = &DebugPeerResponse_PeerInfo{}
+			}
+			if err := m.PeerInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PeerStatus == nil {
+				m.PeerStatus = &v1.Status{}
+			}
+			if err := m.PeerStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdated", wireType)
+			}
+			m.LastUpdated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUpdated |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DebugPeerResponse_PeerInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PeerInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PeerInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &v1.MetaData{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Protocols", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Protocols = append(m.Protocols, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FaultCount", wireType)
+			}
+			m.FaultCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FaultCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProtocolVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AgentVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerLatency", wireType)
+			}
+			m.PeerLatency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeerLatency |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Score = float64(math.Float64frombits(v))
+		case 8:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GossipScore", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.GossipScore = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TrustedPeerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TrustedPeerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TrustedPeerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TrustedPeersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TrustedPeersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TrustedPeersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BanPeerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BanPeerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BanPeerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ip", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ip = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationSeconds", wireType)
+			}
+			m.DurationSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DurationSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PeerBan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PeerBan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PeerBan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ip", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ip = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PeerBansResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PeerBansResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PeerBansResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bans", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bans = append(m.Bans, &PeerBan{})
+			if err := m.Bans[len(m.Bans)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DisconnectPeerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DisconnectPeerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DisconnectPeerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimitsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimitsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Peers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Peers = append(m.Peers, &PeerRateLimits{})
+			if err := m.Peers[len(m.Peers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PeerRateLimits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PeerRateLimits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PeerRateLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buckets = append(m.Buckets, &RateLimitBucket{})
+			if err := m.Buckets[len(m.Buckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimitBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topic = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outbound", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Outbound = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+			}
+			m.Capacity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Capacity |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			m.Remaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Remaining |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Rate = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChainEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= ChainEvent_Type(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Head == nil {
+				m.Head = &HeadEvent{}
+			}
+			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reorg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Reorg == nil {
+				m.Reorg = &ReorgEvent{}
+			}
+			if err := m.Reorg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Checkpoint == nil {
+				m.Checkpoint = &CheckpointEvent{}
+			}
+			if err := m.Checkpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SyncStatus == nil {
+				m.SyncStatus = &SyncStatusEvent{}
+			}
+			if err := m.SyncStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
 		}
 	}
-	if m.Direction != 0 {
-		n += 1 + sovDebug(uint64(m.Direction))
-	}
-	if m.ConnectionState != 0 {
-		n += 1 + sovDebug(uint64(m.ConnectionState))
-	}
-	l = len(m.PeerId)
-	if l > 0 {
-		n += 1 + l + sovDebug(uint64(l))
-	}
-	l = len(m.Enr)
-	if l > 0 {
-		n += 1 + l + sovDebug(uint64(l))
-	}
-	if m.PeerInfo != nil {
-		l = m.PeerInfo.Size()
-		n += 1 + l + sovDebug(uint64(l))
-	}
-	if m.PeerStatus != nil {
-		l = m.PeerStatus.Size()
-		n += 1 + l + sovDebug(uint64(l))
-	}
-	if m.LastUpdated != 0 {
-		n += 1 + sovDebug(uint64(m.LastUpdated))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
 
-func (m *DebugPeerResponse_PeerInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovDebug(uint64(l))
-	}
-	if len(m.Protocols) > 0 {
-		for _, s := range m.Protocols {
-			l = len(s)
-			n += 1 + l + sovDebug(uint64(l))
-		}
-	}
-	if m.FaultCount != 0 {
-		n += 1 + sovDebug(uint64(m.FaultCount))
-	}
-	l = len(m.ProtocolVersion)
-	if l > 0 {
-		n += 1 + l + sovDebug(uint64(l))
-	}
-	l = len(m.AgentVersion)
-	if l > 0 {
-		n += 1 + l + sovDebug(uint64(l))
-	}
-	if m.PeerLatency != 0 {
-		n += 1 + sovDebug(uint64(m.PeerLatency))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovDebug(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozDebug(x uint64) (n int) {
-	return sovDebug(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *InclusionSlotRequest) Unmarshal(dAtA []byte) error {
+func (m *HeadEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2105,17 +8899,17 @@ func (m *InclusionSlotRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InclusionSlotRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: HeadEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InclusionSlotRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HeadEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
 			}
-			m.Id = 0
+			m.Slot = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -2125,16 +8919,84 @@ func (m *InclusionSlotRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockRoot = append(m.BlockRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockRoot == nil {
+				m.BlockRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousBlockRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousBlockRoot = append(m.PreviousBlockRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.PreviousBlockRoot == nil {
+				m.PreviousBlockRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochTransition", wireType)
 			}
-			m.Slot = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -2144,11 +9006,12 @@ func (m *InclusionSlotRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Slot |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.EpochTransition = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDebug(dAtA[iNdEx:])
@@ -2174,7 +9037,7 @@ func (m *InclusionSlotRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InclusionSlotResponse) Unmarshal(dAtA []byte) error {
+func (m *ReorgEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2197,17 +9060,17 @@ func (m *InclusionSlotResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InclusionSlotResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReorgEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InclusionSlotResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReorgEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldHeadRoot", wireType)
 			}
-			m.Slot = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -2217,70 +9080,84 @@ func (m *InclusionSlotResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Slot |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDebug(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthDebug
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
 				return ErrInvalidLengthDebug
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BeaconStateRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDebug
+			m.OldHeadRoot = append(m.OldHeadRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.OldHeadRoot == nil {
+				m.OldHeadRoot = []byte{}
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldHeadSlot", wireType)
+			}
+			m.OldHeadSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OldHeadSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewHeadRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.NewHeadRoot = append(m.NewHeadRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.NewHeadRoot == nil {
+				m.NewHeadRoot = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BeaconStateRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BeaconStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewHeadSlot", wireType)
 			}
-			var v uint64
+			m.NewHeadSlot = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -2290,15 +9167,14 @@ func (m *BeaconStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= uint64(b&0x7F) << shift
+				m.NewHeadSlot |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.QueryFilter = &BeaconStateRequest_Slot{v}
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommonAncestorRoot", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2325,10 +9201,49 @@ func (m *BeaconStateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := make([]byte, postIndex-iNdEx)
-			copy(v, dAtA[iNdEx:postIndex])
-			m.QueryFilter = &BeaconStateRequest_BlockRoot{v}
+			m.CommonAncestorRoot = append(m.CommonAncestorRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.CommonAncestorRoot == nil {
+				m.CommonAncestorRoot = []byte{}
+			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommonAncestorSlot", wireType)
+			}
+			m.CommonAncestorSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommonAncestorSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
+			}
+			m.Depth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Depth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDebug(dAtA[iNdEx:])
@@ -2354,7 +9269,7 @@ func (m *BeaconStateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockRequest) Unmarshal(dAtA []byte) error {
+func (m *CheckpointEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2377,15 +9292,34 @@ func (m *BlockRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BlockRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CheckpointEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CheckpointEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2412,9 +9346,9 @@ func (m *BlockRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockRoot = append(m.BlockRoot[:0], dAtA[iNdEx:postIndex]...)
-			if m.BlockRoot == nil {
-				m.BlockRoot = []byte{}
+			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
+			if m.Root == nil {
+				m.Root = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -2442,7 +9376,7 @@ func (m *BlockRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SSZResponse) Unmarshal(dAtA []byte) error {
+func (m *SyncStatusEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2465,17 +9399,17 @@ func (m *SSZResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SSZResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncStatusEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SSZResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncStatusEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Encoded", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Syncing", wireType)
 			}
-			var byteLen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -2485,26 +9419,12 @@ func (m *SSZResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthDebug
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDebug
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Encoded = append(m.Encoded[:0], dAtA[iNdEx:postIndex]...)
-			if m.Encoded == nil {
-				m.Encoded = []byte{}
-			}
-			iNdEx = postIndex
+			m.Syncing = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDebug(dAtA[iNdEx:])
@@ -2530,7 +9450,7 @@ func (m *SSZResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LoggingLevelRequest) Unmarshal(dAtA []byte) error {
+func (m *Eth1ConnectionStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2553,17 +9473,17 @@ func (m *LoggingLevelRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LoggingLevelRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Eth1ConnectionStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LoggingLevelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Eth1ConnectionStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveEndpoint", wireType)
 			}
-			m.Level = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -2573,11 +9493,58 @@ func (m *LoggingLevelRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Level |= LoggingLevelRequest_Level(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActiveEndpoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endpoints = append(m.Endpoints, &Eth1EndpointStatus{})
+			if err := m.Endpoints[len(m.Endpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDebug(dAtA[iNdEx:])
@@ -2603,7 +9570,7 @@ func (m *LoggingLevelRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProtoArrayForkChoiceResponse) Unmarshal(dAtA []byte) error {
+func (m *Eth1EndpointStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2626,17 +9593,17 @@ func (m *ProtoArrayForkChoiceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProtoArrayForkChoiceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: Eth1EndpointStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProtoArrayForkChoiceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Eth1EndpointStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PruneThreshold", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
 			}
-			m.PruneThreshold = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -2646,16 +9613,29 @@ func (m *ProtoArrayForkChoiceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PruneThreshold |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JustifiedEpoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
 			}
-			m.JustifiedEpoch = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -2665,16 +9645,17 @@ func (m *ProtoArrayForkChoiceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.JustifiedEpoch |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Active = bool(v != 0)
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedEpoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Healthy", wireType)
 			}
-			m.FinalizedEpoch = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -2684,16 +9665,36 @@ func (m *ProtoArrayForkChoiceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FinalizedEpoch |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Healthy = bool(v != 0)
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadBlock", wireType)
+			}
+			m.HeadBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeadBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProtoArrayNodes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectionReason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -2703,31 +9704,102 @@ func (m *ProtoArrayForkChoiceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDebug
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProtoArrayNodes = append(m.ProtoArrayNodes, &ProtoArrayNode{})
-			if err := m.ProtoArrayNodes[len(m.ProtoArrayNodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RejectionReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastChecked", wireType)
+			}
+			m.LastChecked = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastChecked |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 5:
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DepositSnapshotResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DepositSnapshotResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DepositSnapshotResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Indices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -2737,105 +9809,132 @@ func (m *ProtoArrayForkChoiceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthDebug
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Indices == nil {
-				m.Indices = make(map[string]uint64)
+			m.Snapshot = append(m.Snapshot[:0], dAtA[iNdEx:postIndex]...)
+			if m.Snapshot == nil {
+				m.Snapshot = []byte{}
 			}
-			var mapkey string
-			var mapvalue uint64
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowDebug
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositCount", wireType)
+			}
+			m.DepositCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowDebug
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthDebug
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthDebug
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowDebug
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipDebug(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthDebug
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepositCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositRoot = append(m.DepositRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.DepositRoot == nil {
+				m.DepositRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eth1BlockHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Indices[mapkey] = mapvalue
+			if byteLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Eth1BlockHash = append(m.Eth1BlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Eth1BlockHash == nil {
+				m.Eth1BlockHash = []byte{}
+			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eth1BlockHeight", wireType)
+			}
+			m.Eth1BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Eth1BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDebug(dAtA[iNdEx:])
@@ -2861,7 +9960,7 @@ func (m *ProtoArrayForkChoiceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProtoArrayNode) Unmarshal(dAtA []byte) error {
+func (m *Eth1DataVotesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2884,17 +9983,17 @@ func (m *ProtoArrayNode) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProtoArrayNode: wiretype end group for non-group")
+			return fmt.Errorf("proto: Eth1DataVotesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProtoArrayNode: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Eth1DataVotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriodStartSlot", wireType)
 			}
-			m.Slot = 0
+			m.VotingPeriodStartSlot = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -2904,16 +10003,16 @@ func (m *ProtoArrayNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Slot |= uint64(b&0x7F) << shift
+				m.VotingPeriodStartSlot |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotesRequired", wireType)
 			}
-			var byteLen int
+			m.VotesRequired = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -2923,31 +10022,16 @@ func (m *ProtoArrayNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.VotesRequired |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthDebug
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDebug
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
-			if m.Root == nil {
-				m.Root = []byte{}
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EarliestValidTime", wireType)
 			}
-			m.Parent = 0
+			m.EarliestValidTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -2957,16 +10041,16 @@ func (m *ProtoArrayNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Parent |= uint64(b&0x7F) << shift
+				m.EarliestValidTime |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JustifiedEpoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestValidTime", wireType)
 			}
-			m.JustifiedEpoch = 0
+			m.LatestValidTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -2976,16 +10060,16 @@ func (m *ProtoArrayNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.JustifiedEpoch |= uint64(b&0x7F) << shift
+				m.LatestValidTime |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedEpoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstValidBlock", wireType)
 			}
-			m.FinalizedEpoch = 0
+			m.FirstValidBlock = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -2995,16 +10079,16 @@ func (m *ProtoArrayNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FinalizedEpoch |= uint64(b&0x7F) << shift
+				m.FirstValidBlock |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastValidBlock", wireType)
 			}
-			m.Weight = 0
+			m.LastValidBlock = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -3014,16 +10098,16 @@ func (m *ProtoArrayNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Weight |= uint64(b&0x7F) << shift
+				m.LastValidBlock |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BestChild", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FollowDistanceBlock", wireType)
 			}
-			m.BestChild = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -3033,16 +10117,33 @@ func (m *ProtoArrayNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BestChild |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FollowDistanceBlock == nil {
+				m.FollowDistanceBlock = &Eth1DataCandidate{}
+			}
+			if err := m.FollowDistanceBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BestDescendant", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Candidates", wireType)
 			}
-			m.BestDescendant = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -3052,68 +10153,139 @@ func (m *ProtoArrayNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BestDescendant |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDebug(dAtA[iNdEx:])
-			if err != nil {
+			if msglen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Candidates = append(m.Candidates, &Eth1DataCandidate{})
+			if err := m.Candidates[len(m.Candidates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEth1Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthDebug
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthDebug
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DebugPeerResponses) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDebug
+			if m.CurrentEth1Data == nil {
+				m.CurrentEth1Data = &v1alpha1.Eth1Data{}
 			}
-			if iNdEx >= l {
+			if err := m.CurrentEth1Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextProposalSlot", wireType)
+			}
+			m.NextProposalSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextProposalSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextProposerIndex", wireType)
+			}
+			m.NextProposerIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextProposerIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextVote", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.NextVote == nil {
+				m.NextVote = &v1alpha1.Eth1Data{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DebugPeerResponses: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DebugPeerResponses: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.NextVote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Responses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingDeposits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3140,8 +10312,8 @@ func (m *DebugPeerResponses) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Responses = append(m.Responses, &DebugPeerResponse{})
-			if err := m.Responses[len(m.Responses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PendingDeposits = append(m.PendingDeposits, &PendingDepositInclusion{})
+			if err := m.PendingDeposits[len(m.PendingDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3170,7 +10342,7 @@ func (m *DebugPeerResponses) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DebugPeerResponse) Unmarshal(dAtA []byte) error {
+func (m *Eth1DataCandidate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3193,17 +10365,17 @@ func (m *DebugPeerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DebugPeerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: Eth1DataCandidate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DebugPeerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Eth1DataCandidate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListeningAddresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Eth1Data", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -3213,29 +10385,33 @@ func (m *DebugPeerResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDebug
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ListeningAddresses = append(m.ListeningAddresses, string(dAtA[iNdEx:postIndex]))
+			if m.Eth1Data == nil {
+				m.Eth1Data = &v1alpha1.Eth1Data{}
+			}
+			if err := m.Eth1Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
 			}
-			m.Direction = 0
+			m.Votes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -3245,16 +10421,16 @@ func (m *DebugPeerResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Direction |= v1alpha1.PeerDirection(b&0x7F) << shift
+				m.Votes |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			m.ConnectionState = 0
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -3264,16 +10440,16 @@ func (m *DebugPeerResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ConnectionState |= v1alpha1.ConnectionState(b&0x7F) << shift
+				m.BlockHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeerId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counted", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -3283,27 +10459,15 @@ func (m *DebugPeerResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDebug
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDebug
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PeerId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Counted = bool(v != 0)
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectionReason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3331,99 +10495,8 @@ func (m *DebugPeerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Enr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeerInfo", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthDebug
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthDebug
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.PeerInfo == nil {
-				m.PeerInfo = &DebugPeerResponse_PeerInfo{}
-			}
-			if err := m.PeerInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeerStatus", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthDebug
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthDebug
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.PeerStatus == nil {
-				m.PeerStatus = &v1.Status{}
-			}
-			if err := m.PeerStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RejectionReason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdated", wireType)
-			}
-			m.LastUpdated = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDebug
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LastUpdated |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDebug(dAtA[iNdEx:])
@@ -3449,7 +10522,7 @@ func (m *DebugPeerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DebugPeerResponse_PeerInfo) Unmarshal(dAtA []byte) error {
+func (m *PendingDepositInclusion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3472,17 +10545,17 @@ func (m *DebugPeerResponse_PeerInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PeerInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingDepositInclusion: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PeerInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingDepositInclusion: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			var msglen int
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -3492,33 +10565,16 @@ func (m *DebugPeerResponse_PeerInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Index |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthDebug
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthDebug
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Metadata == nil {
-				m.Metadata = &v1.MetaData{}
-			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Protocols", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -3528,29 +10584,31 @@ func (m *DebugPeerResponse_PeerInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthDebug
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDebug
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Protocols = append(m.Protocols, string(dAtA[iNdEx:postIndex]))
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FaultCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Eth1BlockHeight", wireType)
 			}
-			m.FaultCount = 0
+			m.Eth1BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -3560,16 +10618,16 @@ func (m *DebugPeerResponse_PeerInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FaultCount |= uint64(b&0x7F) << shift
+				m.Eth1BlockHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolVersion", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedSlot", wireType)
 			}
-			var stringLen uint64
+			m.EstimatedSlot = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -3579,29 +10637,70 @@ func (m *DebugPeerResponse_PeerInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.EstimatedSlot |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthDebug
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthDebug
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProtocolVersion = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AgentVersion", wireType)
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BackfillStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
 			}
-			var stringLen uint64
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BackfillStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BackfillStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowestSlot", wireType)
+			}
+			m.LowestSlot = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -3611,29 +10710,16 @@ func (m *DebugPeerResponse_PeerInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.LowestSlot |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDebug
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDebug
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AgentVersion = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeerLatency", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Complete", wireType)
 			}
-			m.PeerLatency = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDebug
@@ -3643,11 +10729,12 @@ func (m *DebugPeerResponse_PeerInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PeerLatency |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Complete = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDebug(dAtA[iNdEx:])
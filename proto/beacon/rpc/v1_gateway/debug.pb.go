@@ -56,6 +56,43 @@ func (LoggingLevelRequest_Level) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_851e5cb2de3d61dd, []int{5, 0}
 }
 
+type ChainEvent_Type int32
+
+const (
+	ChainEvent_UNKNOWN              ChainEvent_Type = 0
+	ChainEvent_HEAD                 ChainEvent_Type = 1
+	ChainEvent_REORG                ChainEvent_Type = 2
+	ChainEvent_FINALIZED_CHECKPOINT ChainEvent_Type = 3
+	ChainEvent_JUSTIFIED_CHECKPOINT ChainEvent_Type = 4
+	ChainEvent_SYNC_STATUS          ChainEvent_Type = 5
+)
+
+var ChainEvent_Type_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "HEAD",
+	2: "REORG",
+	3: "FINALIZED_CHECKPOINT",
+	4: "JUSTIFIED_CHECKPOINT",
+	5: "SYNC_STATUS",
+}
+
+var ChainEvent_Type_value = map[string]int32{
+	"UNKNOWN":              0,
+	"HEAD":                 1,
+	"REORG":                2,
+	"FINALIZED_CHECKPOINT": 3,
+	"JUSTIFIED_CHECKPOINT": 4,
+	"SYNC_STATUS":          5,
+}
+
+func (x ChainEvent_Type) String() string {
+	return proto.EnumName(ChainEvent_Type_name, int32(x))
+}
+
+func (ChainEvent_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{20, 0}
+}
+
 type InclusionSlotRequest struct {
 	Id                   uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Slot                 uint64   `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
@@ -646,6 +683,8 @@ type DebugPeerResponse_PeerInfo struct {
 	ProtocolVersion      string       `protobuf:"bytes,4,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
 	AgentVersion         string       `protobuf:"bytes,5,opt,name=agent_version,json=agentVersion,proto3" json:"agent_version,omitempty"`
 	PeerLatency          uint64       `protobuf:"varint,6,opt,name=peer_latency,json=peerLatency,proto3" json:"peer_latency,omitempty"`
+	Score                float64      `protobuf:"fixed64,7,opt,name=score,proto3" json:"score,omitempty"`
+	GossipScore          float64      `protobuf:"fixed64,8,opt,name=gossip_score,json=gossipScore,proto3" json:"gossip_score,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
 	XXX_unrecognized     []byte       `json:"-"`
 	XXX_sizecache        int32        `json:"-"`
@@ -718,312 +757,1950 @@ func (m *DebugPeerResponse_PeerInfo) GetPeerLatency() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterEnum("ethereum.beacon.rpc.v1.LoggingLevelRequest_Level", LoggingLevelRequest_Level_name, LoggingLevelRequest_Level_value)
-	proto.RegisterType((*InclusionSlotRequest)(nil), "ethereum.beacon.rpc.v1.InclusionSlotRequest")
-	proto.RegisterType((*InclusionSlotResponse)(nil), "ethereum.beacon.rpc.v1.InclusionSlotResponse")
-	proto.RegisterType((*BeaconStateRequest)(nil), "ethereum.beacon.rpc.v1.BeaconStateRequest")
-	proto.RegisterType((*BlockRequest)(nil), "ethereum.beacon.rpc.v1.BlockRequest")
-	proto.RegisterType((*SSZResponse)(nil), "ethereum.beacon.rpc.v1.SSZResponse")
-	proto.RegisterType((*LoggingLevelRequest)(nil), "ethereum.beacon.rpc.v1.LoggingLevelRequest")
-	proto.RegisterType((*ProtoArrayForkChoiceResponse)(nil), "ethereum.beacon.rpc.v1.ProtoArrayForkChoiceResponse")
-	proto.RegisterMapType((map[string]uint64)(nil), "ethereum.beacon.rpc.v1.ProtoArrayForkChoiceResponse.IndicesEntry")
-	proto.RegisterType((*ProtoArrayNode)(nil), "ethereum.beacon.rpc.v1.ProtoArrayNode")
-	proto.RegisterType((*DebugPeerResponses)(nil), "ethereum.beacon.rpc.v1.DebugPeerResponses")
-	proto.RegisterType((*DebugPeerResponse)(nil), "ethereum.beacon.rpc.v1.DebugPeerResponse")
-	proto.RegisterType((*DebugPeerResponse_PeerInfo)(nil), "ethereum.beacon.rpc.v1.DebugPeerResponse.PeerInfo")
+func (m *DebugPeerResponse_PeerInfo) GetScore() float64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("proto/beacon/rpc/v1/debug.proto", fileDescriptor_851e5cb2de3d61dd) }
+func (m *DebugPeerResponse_PeerInfo) GetGossipScore() float64 {
+	if m != nil {
+		return m.GossipScore
+	}
+	return 0
+}
 
-var fileDescriptor_851e5cb2de3d61dd = []byte{
-	// 1197 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdd, 0x6e, 0x1b, 0x45,
-	0x14, 0xae, 0x9d, 0x38, 0x89, 0x8f, 0x8d, 0xe3, 0x4e, 0x4b, 0x6a, 0xdc, 0x9f, 0xa4, 0xdb, 0xd2,
-	0x5f, 0xba, 0x56, 0x0c, 0x17, 0xa8, 0x42, 0x42, 0xf9, 0x6b, 0x1a, 0x29, 0xb4, 0x65, 0xd3, 0x72,
-	0x41, 0x85, 0x56, 0x93, 0xdd, 0x63, 0x7b, 0xc9, 0x66, 0x66, 0x3b, 0x33, 0x1b, 0x70, 0xb9, 0xab,
-	0x10, 0x5c, 0x72, 0x81, 0xc4, 0x35, 0x8f, 0xc1, 0x7b, 0xf0, 0x0a, 0x3c, 0x05, 0x57, 0x68, 0x66,
-	0x76, 0xd7, 0x36, 0xb1, 0x4b, 0x40, 0xdc, 0xcd, 0xf9, 0xe6, 0x3b, 0x3f, 0x73, 0xce, 0x99, 0x39,
-	0x03, 0xab, 0x89, 0xe0, 0x8a, 0x77, 0x0e, 0x91, 0x06, 0x9c, 0x75, 0x44, 0x12, 0x74, 0x4e, 0xd6,
-	0x3b, 0x21, 0x1e, 0xa6, 0x7d, 0xd7, 0xec, 0x90, 0x15, 0x54, 0x03, 0x14, 0x98, 0x1e, 0xbb, 0x96,
-	0xe3, 0x8a, 0x24, 0x70, 0x4f, 0xd6, 0xdb, 0x97, 0x50, 0x0d, 0x3a, 0x27, 0xeb, 0x34, 0x4e, 0x06,
-	0x74, 0xbd, 0xc3, 0x78, 0x88, 0x56, 0xa1, 0xed, 0x4c, 0x58, 0x4c, 0xba, 0x89, 0xb6, 0x78, 0x8c,
-	0x52, 0xd2, 0x3e, 0xca, 0x8c, 0x73, 0xa5, 0xcf, 0x79, 0x3f, 0xc6, 0x0e, 0x4d, 0xa2, 0x0e, 0x65,
-	0x8c, 0x2b, 0xaa, 0x22, 0xce, 0xf2, 0xdd, 0xcb, 0xd9, 0xae, 0x91, 0x0e, 0xd3, 0x5e, 0x07, 0x8f,
-	0x13, 0x35, 0xb4, 0x9b, 0xce, 0x43, 0xb8, 0xb8, 0xc7, 0x82, 0x38, 0x95, 0x11, 0x67, 0x07, 0x31,
-	0x57, 0x1e, 0xbe, 0x4a, 0x51, 0x2a, 0xd2, 0x80, 0x72, 0x14, 0xb6, 0x4a, 0x6b, 0xa5, 0x3b, 0xf3,
-	0x5e, 0x39, 0x0a, 0x09, 0x81, 0x79, 0x19, 0x73, 0xd5, 0x2a, 0x1b, 0xc4, 0xac, 0x9d, 0xfb, 0xf0,
-	0xee, 0xdf, 0x74, 0x65, 0xc2, 0x99, 0xc4, 0xa9, 0xe4, 0x97, 0x40, 0x36, 0xcd, 0x19, 0x0e, 0x14,
-	0x55, 0x98, 0xbb, 0xb9, 0x98, 0x31, 0x8d, 0xa3, 0xc7, 0xe7, 0x2c, 0x97, 0xac, 0x02, 0x1c, 0xc6,
-	0x3c, 0x38, 0xf2, 0x05, 0xcf, 0xac, 0xd4, 0x1f, 0x9f, 0xf3, 0xaa, 0x06, 0xf3, 0x38, 0x57, 0x9b,
-	0x0d, 0xa8, 0xbf, 0x4a, 0x51, 0x0c, 0xfd, 0x5e, 0x14, 0x2b, 0x14, 0xce, 0x03, 0xa8, 0x6f, 0x9a,
-	0xcd, 0xcc, 0xec, 0xd5, 0x09, 0x03, 0xda, 0x78, 0x7d, 0x4c, 0xdd, 0xb9, 0x0d, 0xb5, 0x83, 0x83,
-	0x2f, 0x8b, 0x70, 0x5b, 0xb0, 0x88, 0x2c, 0xe0, 0x21, 0x86, 0x19, 0x35, 0x17, 0x9d, 0x1f, 0x4b,
-	0x70, 0x61, 0x9f, 0xf7, 0xfb, 0x11, 0xeb, 0xef, 0xe3, 0x09, 0xc6, 0xb9, 0xfd, 0x5d, 0xa8, 0xc4,
-	0x5a, 0x36, 0xfc, 0x46, 0x77, 0xdd, 0x9d, 0x5e, 0x55, 0x77, 0x8a, 0xae, 0x6b, 0x05, 0xab, 0xef,
-	0xdc, 0x86, 0x8a, 0x91, 0xc9, 0x12, 0xcc, 0xef, 0x3d, 0x79, 0xf4, 0xb4, 0x79, 0x8e, 0x54, 0xa1,
-	0xb2, 0xbd, 0xb3, 0xf9, 0x62, 0xb7, 0x59, 0xd2, 0xcb, 0xe7, 0xde, 0xc6, 0xd6, 0x4e, 0xb3, 0xec,
-	0xfc, 0x30, 0x07, 0x57, 0x9e, 0xe9, 0x8a, 0x6d, 0x08, 0x41, 0x87, 0x8f, 0xb8, 0x38, 0xda, 0x1a,
-	0xf0, 0x28, 0xc0, 0xe2, 0x10, 0xb7, 0x61, 0x39, 0x11, 0x29, 0x43, 0x5f, 0x0d, 0x04, 0xca, 0x01,
-	0x8f, 0xf3, 0xea, 0x35, 0x0c, 0xfc, 0x3c, 0x47, 0x35, 0xf1, 0xeb, 0x54, 0xaa, 0xa8, 0x17, 0x61,
-	0xe8, 0x63, 0xc2, 0x83, 0x41, 0x56, 0xa7, 0x46, 0x01, 0xef, 0x68, 0x54, 0x13, 0x7b, 0x11, 0xa3,
-	0x71, 0xf4, 0xba, 0x20, 0xce, 0x59, 0x62, 0x01, 0x5b, 0xa2, 0x07, 0xe7, 0x4d, 0x33, 0xf9, 0x54,
-	0xc7, 0xe6, 0xeb, 0xe6, 0x95, 0xad, 0xf9, 0xb5, 0xb9, 0x3b, 0xb5, 0xee, 0xad, 0x59, 0x99, 0x19,
-	0x9d, 0xe5, 0x09, 0x0f, 0xd1, 0x5b, 0x4e, 0x26, 0x64, 0x49, 0x5e, 0xc2, 0x62, 0xc4, 0xc2, 0x28,
-	0x40, 0xd9, 0xaa, 0x18, 0x4b, 0x1b, 0xff, 0x6c, 0xe9, 0x74, 0x56, 0xdc, 0x3d, 0x6b, 0x63, 0x87,
-	0x29, 0x31, 0xf4, 0x72, 0x8b, 0xed, 0x87, 0x50, 0x1f, 0xdf, 0x20, 0x4d, 0x98, 0x3b, 0xc2, 0xa1,
-	0xc9, 0x57, 0xd5, 0xd3, 0x4b, 0x72, 0x11, 0x2a, 0x27, 0x34, 0x4e, 0x31, 0x4b, 0x8d, 0x15, 0x1e,
-	0x96, 0x3f, 0x2e, 0x39, 0x6f, 0xca, 0xd0, 0x98, 0x0c, 0xbe, 0x68, 0xf7, 0xd2, 0xa8, 0xdd, 0x35,
-	0x36, 0x6a, 0x5e, 0xcf, 0xac, 0xc9, 0x0a, 0x2c, 0x24, 0x54, 0x20, 0x53, 0x59, 0x1e, 0x33, 0x69,
-	0x5a, 0x45, 0xe6, 0xcf, 0x5a, 0x91, 0xca, 0xd4, 0x8a, 0xac, 0xc0, 0xc2, 0x37, 0x18, 0xf5, 0x07,
-	0xaa, 0xb5, 0x60, 0x3d, 0x59, 0xc9, 0xdc, 0x0b, 0x94, 0xca, 0x0f, 0x06, 0x51, 0x1c, 0xb6, 0x16,
-	0xcd, 0x5e, 0x55, 0x23, 0x5b, 0x1a, 0xd0, 0xf6, 0xcd, 0x76, 0x88, 0x32, 0x40, 0x16, 0x52, 0xa6,
-	0x5a, 0x4b, 0xd6, 0xbe, 0x86, 0xb7, 0x0b, 0xd4, 0xf9, 0x0a, 0xc8, 0xb6, 0x7e, 0xd4, 0x9e, 0x21,
-	0x8a, 0x3c, 0xd7, 0x92, 0xec, 0x42, 0x55, 0xe4, 0x42, 0xab, 0x64, 0xaa, 0x76, 0x77, 0x56, 0xd5,
-	0x4e, 0xa9, 0x7b, 0x23, 0x5d, 0xe7, 0xb7, 0x0a, 0x9c, 0x3f, 0x45, 0x20, 0x1d, 0xb8, 0x10, 0x47,
-	0x52, 0x21, 0x8b, 0x58, 0xdf, 0xa7, 0x61, 0x28, 0x50, 0xe6, 0x8e, 0xaa, 0x1e, 0x29, 0xb6, 0x36,
-	0xf2, 0x1d, 0xb2, 0x09, 0xd5, 0x30, 0x12, 0x18, 0xe8, 0xc7, 0xd0, 0x14, 0xa2, 0xd1, 0xbd, 0x39,
-	0x8a, 0x07, 0xd5, 0xc0, 0xcd, 0x1f, 0x5c, 0x57, 0x3b, 0xda, 0xce, 0xb9, 0xde, 0x48, 0x8d, 0x7c,
-	0x0e, 0xcd, 0x80, 0x33, 0x66, 0x25, 0x5f, 0xea, 0xb7, 0xcb, 0x54, 0xaf, 0x31, 0xde, 0xda, 0x13,
-	0xa6, 0xb6, 0x0a, 0xba, 0x7d, 0xe9, 0x96, 0x83, 0x49, 0x80, 0x5c, 0x82, 0xc5, 0x04, 0x51, 0xf8,
-	0x51, 0x68, 0xca, 0x5c, 0xf5, 0x16, 0xb4, 0xb8, 0x17, 0xea, 0x36, 0x44, 0x26, 0x4c, 0x49, 0xab,
-	0x9e, 0x5e, 0x92, 0xa7, 0x50, 0xb5, 0x54, 0xd6, 0xe3, 0xa6, 0x94, 0xb5, 0x6e, 0xf7, 0xcc, 0x19,
-	0x35, 0x87, 0xda, 0x63, 0x3d, 0xee, 0x2d, 0x25, 0xd9, 0x8a, 0x7c, 0x0a, 0x35, 0x63, 0x50, 0x1f,
-	0x24, 0x95, 0xa6, 0x03, 0x6a, 0xdd, 0x6b, 0xa7, 0x4c, 0x26, 0xdd, 0x44, 0x9b, 0x3c, 0x30, 0x2c,
-	0x0f, 0xb4, 0x8a, 0x5d, 0x93, 0xeb, 0x50, 0x8f, 0xa9, 0x54, 0x7e, 0x9a, 0x84, 0x54, 0x61, 0x98,
-	0xf5, 0x47, 0x4d, 0x63, 0x2f, 0x2c, 0xd4, 0xfe, 0xb3, 0x04, 0x4b, 0xb9, 0x6b, 0xf2, 0x09, 0x2c,
-	0x1d, 0xa3, 0xa2, 0x21, 0x55, 0xd4, 0xdc, 0x8f, 0x5a, 0x77, 0x6d, 0x96, 0xb7, 0xcf, 0x50, 0xd1,
-	0x6d, 0xaa, 0xa8, 0x57, 0x68, 0x90, 0x2b, 0x50, 0x35, 0x0f, 0x43, 0xc0, 0x63, 0xd9, 0x2a, 0x9b,
-	0x42, 0x8f, 0x00, 0xb2, 0x0a, 0xb5, 0x1e, 0x4d, 0x63, 0xe5, 0x07, 0x3c, 0x2d, 0x2e, 0x15, 0x18,
-	0x68, 0x4b, 0x23, 0xe4, 0x2e, 0x34, 0x73, 0xb6, 0x7f, 0x82, 0x42, 0xcf, 0xa9, 0x2c, 0xe5, 0xcb,
-	0x39, 0xfe, 0x85, 0x85, 0xc9, 0x0d, 0x78, 0x87, 0xf6, 0x91, 0xa9, 0x82, 0x67, 0xab, 0x50, 0x37,
-	0x60, 0x4e, 0xba, 0x0e, 0x75, 0x93, 0xbd, 0x98, 0x2a, 0x64, 0xc1, 0x30, 0xbb, 0x5c, 0x26, 0xa3,
-	0xfb, 0x16, 0xea, 0xfe, 0xba, 0x08, 0x15, 0x53, 0x09, 0xf2, 0x7d, 0x09, 0x1a, 0xbb, 0xa8, 0xc6,
-	0x86, 0x1e, 0xb9, 0x37, 0xab, 0x76, 0xa7, 0x27, 0x63, 0xfb, 0xc6, 0x2c, 0xee, 0xd8, 0xe4, 0x72,
-	0xae, 0xbf, 0xf9, 0xfd, 0x8f, 0x9f, 0xcb, 0x97, 0xc9, 0x7b, 0x9d, 0x89, 0xef, 0x83, 0xf9, 0x70,
-	0x74, 0x4c, 0xb3, 0x92, 0x6f, 0x61, 0x49, 0x47, 0xa1, 0x67, 0x1f, 0xb9, 0x39, 0xd3, 0xff, 0xd8,
-	0xf0, 0xfc, 0x1f, 0x3c, 0x9b, 0x49, 0x4b, 0xbe, 0x83, 0xe5, 0x03, 0x54, 0xe3, 0x23, 0x90, 0xdc,
-	0xff, 0x17, 0x83, 0xb2, 0xbd, 0xe2, 0xda, 0x8f, 0x8b, 0x9b, 0x7f, 0x5c, 0xdc, 0x1d, 0xfd, 0x71,
-	0x71, 0x6e, 0x18, 0xd7, 0x57, 0x9d, 0xcb, 0xd3, 0x5c, 0xc7, 0xd6, 0x10, 0xf9, 0xa9, 0x04, 0x97,
-	0x76, 0x51, 0x4d, 0x1b, 0x0e, 0x64, 0x86, 0xe1, 0xf6, 0x47, 0xff, 0x65, 0xc4, 0x38, 0xb7, 0x4c,
-	0x38, 0x6b, 0xe4, 0xda, 0xb4, 0x70, 0x7a, 0x5c, 0x1c, 0x05, 0xd6, 0xab, 0x80, 0xea, 0x7e, 0x24,
-	0x95, 0xbe, 0x19, 0x72, 0x66, 0x08, 0xf7, 0xce, 0x7c, 0xbb, 0xe5, 0xdb, 0x4b, 0x90, 0x18, 0x37,
-	0xaf, 0x61, 0x51, 0x27, 0x01, 0x51, 0x10, 0xe7, 0x2d, 0x2f, 0x5f, 0x9e, 0xf1, 0xb3, 0xbf, 0xd6,
-	0xce, 0x9a, 0x71, 0xde, 0x26, 0xad, 0x59, 0xce, 0xc9, 0x2f, 0x25, 0x68, 0xee, 0xa2, 0x9a, 0xf8,
-	0x21, 0x92, 0x0f, 0x66, 0x79, 0x98, 0xf6, 0x09, 0x6d, 0x3f, 0x38, 0x23, 0x3b, 0x8b, 0xe9, 0x7d,
-	0x13, 0xd3, 0x2a, 0xb9, 0x3a, 0x2d, 0xa6, 0x28, 0x57, 0x39, 0x5c, 0x30, 0x39, 0xff, 0xf0, 0xaf,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xbf, 0x35, 0xa6, 0x9e, 0xac, 0x0b, 0x00, 0x00,
+type TrustedPeerRequest struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConnInterface
+func (m *TrustedPeerRequest) Reset()         { *m = TrustedPeerRequest{} }
+func (m *TrustedPeerRequest) String() string { return proto.CompactTextString(m) }
+func (*TrustedPeerRequest) ProtoMessage()    {}
+func (*TrustedPeerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{10}
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion6
+func (m *TrustedPeerRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TrustedPeerRequest.Unmarshal(m, b)
+}
+func (m *TrustedPeerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TrustedPeerRequest.Marshal(b, m, deterministic)
+}
+func (m *TrustedPeerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrustedPeerRequest.Merge(m, src)
+}
+func (m *TrustedPeerRequest) XXX_Size() int {
+	return xxx_messageInfo_TrustedPeerRequest.Size(m)
+}
+func (m *TrustedPeerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrustedPeerRequest.DiscardUnknown(m)
+}
 
-// DebugClient is the client API for Debug service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type DebugClient interface {
-	GetBeaconState(ctx context.Context, in *BeaconStateRequest, opts ...grpc.CallOption) (*SSZResponse, error)
-	GetBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*SSZResponse, error)
-	SetLoggingLevel(ctx context.Context, in *LoggingLevelRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	GetProtoArrayForkChoice(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ProtoArrayForkChoiceResponse, error)
-	ListPeers(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*DebugPeerResponses, error)
-	GetPeer(ctx context.Context, in *v1alpha1.PeerRequest, opts ...grpc.CallOption) (*DebugPeerResponse, error)
-	GetInclusionSlot(ctx context.Context, in *InclusionSlotRequest, opts ...grpc.CallOption) (*InclusionSlotResponse, error)
+var xxx_messageInfo_TrustedPeerRequest proto.InternalMessageInfo
+
+func (m *TrustedPeerRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-type debugClient struct {
-	cc grpc.ClientConnInterface
+type TrustedPeersResponse struct {
+	Addresses            []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func NewDebugClient(cc grpc.ClientConnInterface) DebugClient {
-	return &debugClient{cc}
+func (m *TrustedPeersResponse) Reset()         { *m = TrustedPeersResponse{} }
+func (m *TrustedPeersResponse) String() string { return proto.CompactTextString(m) }
+func (*TrustedPeersResponse) ProtoMessage()    {}
+func (*TrustedPeersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{11}
 }
 
-func (c *debugClient) GetBeaconState(ctx context.Context, in *BeaconStateRequest, opts ...grpc.CallOption) (*SSZResponse, error) {
-	out := new(SSZResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetBeaconState", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *TrustedPeersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TrustedPeersResponse.Unmarshal(m, b)
+}
+func (m *TrustedPeersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TrustedPeersResponse.Marshal(b, m, deterministic)
+}
+func (m *TrustedPeersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrustedPeersResponse.Merge(m, src)
+}
+func (m *TrustedPeersResponse) XXX_Size() int {
+	return xxx_messageInfo_TrustedPeersResponse.Size(m)
+}
+func (m *TrustedPeersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrustedPeersResponse.DiscardUnknown(m)
 }
 
-func (c *debugClient) GetBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*SSZResponse, error) {
-	out := new(SSZResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetBlock", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_TrustedPeersResponse proto.InternalMessageInfo
+
+func (m *TrustedPeersResponse) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
 	}
-	return out, nil
+	return nil
 }
 
-func (c *debugClient) SetLoggingLevel(ctx context.Context, in *LoggingLevelRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/SetLoggingLevel", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type BanPeerRequest struct {
+	PeerId               string   `protobuf:"bytes,1,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	Ip                   string   `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip,omitempty"`
+	DurationSeconds      uint64   `protobuf:"varint,3,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *debugClient) GetProtoArrayForkChoice(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ProtoArrayForkChoiceResponse, error) {
-	out := new(ProtoArrayForkChoiceResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetProtoArrayForkChoice", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *BanPeerRequest) Reset()         { *m = BanPeerRequest{} }
+func (m *BanPeerRequest) String() string { return proto.CompactTextString(m) }
+func (*BanPeerRequest) ProtoMessage()    {}
+func (*BanPeerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{12}
 }
 
-func (c *debugClient) ListPeers(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*DebugPeerResponses, error) {
-	out := new(DebugPeerResponses)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/ListPeers", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *BanPeerRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BanPeerRequest.Unmarshal(m, b)
+}
+func (m *BanPeerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BanPeerRequest.Marshal(b, m, deterministic)
+}
+func (m *BanPeerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BanPeerRequest.Merge(m, src)
+}
+func (m *BanPeerRequest) XXX_Size() int {
+	return xxx_messageInfo_BanPeerRequest.Size(m)
+}
+func (m *BanPeerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BanPeerRequest.DiscardUnknown(m)
 }
 
-func (c *debugClient) GetPeer(ctx context.Context, in *v1alpha1.PeerRequest, opts ...grpc.CallOption) (*DebugPeerResponse, error) {
-	out := new(DebugPeerResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetPeer", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BanPeerRequest proto.InternalMessageInfo
+
+func (m *BanPeerRequest) GetPeerId() string {
+	if m != nil {
+		return m.PeerId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *debugClient) GetInclusionSlot(ctx context.Context, in *InclusionSlotRequest, opts ...grpc.CallOption) (*InclusionSlotResponse, error) {
-	out := new(InclusionSlotResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetInclusionSlot", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BanPeerRequest) GetIp() string {
+	if m != nil {
+		return m.Ip
 	}
-	return out, nil
+	return ""
 }
 
-// DebugServer is the server API for Debug service.
-type DebugServer interface {
-	GetBeaconState(context.Context, *BeaconStateRequest) (*SSZResponse, error)
-	GetBlock(context.Context, *BlockRequest) (*SSZResponse, error)
-	SetLoggingLevel(context.Context, *LoggingLevelRequest) (*empty.Empty, error)
-	GetProtoArrayForkChoice(context.Context, *empty.Empty) (*ProtoArrayForkChoiceResponse, error)
-	ListPeers(context.Context, *empty.Empty) (*DebugPeerResponses, error)
-	GetPeer(context.Context, *v1alpha1.PeerRequest) (*DebugPeerResponse, error)
-	GetInclusionSlot(context.Context, *InclusionSlotRequest) (*InclusionSlotResponse, error)
+func (m *BanPeerRequest) GetDurationSeconds() uint64 {
+	if m != nil {
+		return m.DurationSeconds
+	}
+	return 0
 }
 
-// UnimplementedDebugServer can be embedded to have forward compatible implementations.
-type UnimplementedDebugServer struct {
+type PeerBan struct {
+	PeerId               string   `protobuf:"bytes,1,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	Ip                   string   `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip,omitempty"`
+	ExpiresAt            uint64   `protobuf:"varint,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (*UnimplementedDebugServer) GetBeaconState(ctx context.Context, req *BeaconStateRequest) (*SSZResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetBeaconState not implemented")
-}
-func (*UnimplementedDebugServer) GetBlock(ctx context.Context, req *BlockRequest) (*SSZResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetBlock not implemented")
+func (m *PeerBan) Reset()         { *m = PeerBan{} }
+func (m *PeerBan) String() string { return proto.CompactTextString(m) }
+func (*PeerBan) ProtoMessage()    {}
+func (*PeerBan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{13}
 }
-func (*UnimplementedDebugServer) SetLoggingLevel(ctx context.Context, req *LoggingLevelRequest) (*empty.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetLoggingLevel not implemented")
+
+func (m *PeerBan) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PeerBan.Unmarshal(m, b)
 }
-func (*UnimplementedDebugServer) GetProtoArrayForkChoice(ctx context.Context, req *empty.Empty) (*ProtoArrayForkChoiceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProtoArrayForkChoice not implemented")
+func (m *PeerBan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PeerBan.Marshal(b, m, deterministic)
 }
-func (*UnimplementedDebugServer) ListPeers(ctx context.Context, req *empty.Empty) (*DebugPeerResponses, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListPeers not implemented")
+func (m *PeerBan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerBan.Merge(m, src)
 }
-func (*UnimplementedDebugServer) GetPeer(ctx context.Context, req *v1alpha1.PeerRequest) (*DebugPeerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPeer not implemented")
+func (m *PeerBan) XXX_Size() int {
+	return xxx_messageInfo_PeerBan.Size(m)
 }
-func (*UnimplementedDebugServer) GetInclusionSlot(ctx context.Context, req *InclusionSlotRequest) (*InclusionSlotResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetInclusionSlot not implemented")
+func (m *PeerBan) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerBan.DiscardUnknown(m)
 }
 
-func RegisterDebugServer(s *grpc.Server, srv DebugServer) {
-	s.RegisterService(&_Debug_serviceDesc, srv)
-}
+var xxx_messageInfo_PeerBan proto.InternalMessageInfo
 
-func _Debug_GetBeaconState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BeaconStateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(DebugServer).GetBeaconState(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetBeaconState",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DebugServer).GetBeaconState(ctx, req.(*BeaconStateRequest))
+func (m *PeerBan) GetPeerId() string {
+	if m != nil {
+		return m.PeerId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Debug_GetBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BlockRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(DebugServer).GetBlock(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetBlock",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DebugServer).GetBlock(ctx, req.(*BlockRequest))
+func (m *PeerBan) GetIp() string {
+	if m != nil {
+		return m.Ip
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Debug_SetLoggingLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoggingLevelRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(DebugServer).SetLoggingLevel(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.Debug/SetLoggingLevel",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DebugServer).SetLoggingLevel(ctx, req.(*LoggingLevelRequest))
+func (m *PeerBan) GetExpiresAt() uint64 {
+	if m != nil {
+		return m.ExpiresAt
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Debug_GetProtoArrayForkChoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(empty.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(DebugServer).GetProtoArrayForkChoice(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetProtoArrayForkChoice",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DebugServer).GetProtoArrayForkChoice(ctx, req.(*empty.Empty))
-	}
-	return interceptor(ctx, in, info, handler)
+type PeerBansResponse struct {
+	Bans                 []*PeerBan `protobuf:"bytes,1,rep,name=bans,proto3" json:"bans,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
-func _Debug_ListPeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(empty.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *PeerBansResponse) Reset()         { *m = PeerBansResponse{} }
+func (m *PeerBansResponse) String() string { return proto.CompactTextString(m) }
+func (*PeerBansResponse) ProtoMessage()    {}
+func (*PeerBansResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{14}
+}
+
+func (m *PeerBansResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PeerBansResponse.Unmarshal(m, b)
+}
+func (m *PeerBansResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PeerBansResponse.Marshal(b, m, deterministic)
+}
+func (m *PeerBansResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerBansResponse.Merge(m, src)
+}
+func (m *PeerBansResponse) XXX_Size() int {
+	return xxx_messageInfo_PeerBansResponse.Size(m)
+}
+func (m *PeerBansResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerBansResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeerBansResponse proto.InternalMessageInfo
+
+func (m *PeerBansResponse) GetBans() []*PeerBan {
+	if m != nil {
+		return m.Bans
+	}
+	return nil
+}
+
+type DisconnectPeerRequest struct {
+	PeerId               string   `protobuf:"bytes,1,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	Reason               uint64   `protobuf:"varint,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DisconnectPeerRequest) Reset()         { *m = DisconnectPeerRequest{} }
+func (m *DisconnectPeerRequest) String() string { return proto.CompactTextString(m) }
+func (*DisconnectPeerRequest) ProtoMessage()    {}
+func (*DisconnectPeerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{15}
+}
+
+func (m *DisconnectPeerRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DisconnectPeerRequest.Unmarshal(m, b)
+}
+func (m *DisconnectPeerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DisconnectPeerRequest.Marshal(b, m, deterministic)
+}
+func (m *DisconnectPeerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisconnectPeerRequest.Merge(m, src)
+}
+func (m *DisconnectPeerRequest) XXX_Size() int {
+	return xxx_messageInfo_DisconnectPeerRequest.Size(m)
+}
+func (m *DisconnectPeerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisconnectPeerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DisconnectPeerRequest proto.InternalMessageInfo
+
+func (m *DisconnectPeerRequest) GetPeerId() string {
+	if m != nil {
+		return m.PeerId
+	}
+	return ""
+}
+
+func (m *DisconnectPeerRequest) GetReason() uint64 {
+	if m != nil {
+		return m.Reason
+	}
+	return 0
+}
+
+type RateLimitsRequest struct {
+	PeerId               string   `protobuf:"bytes,1,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RateLimitsRequest) Reset()         { *m = RateLimitsRequest{} }
+func (m *RateLimitsRequest) String() string { return proto.CompactTextString(m) }
+func (*RateLimitsRequest) ProtoMessage()    {}
+func (*RateLimitsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{16}
+}
+
+func (m *RateLimitsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RateLimitsRequest.Unmarshal(m, b)
+}
+func (m *RateLimitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RateLimitsRequest.Marshal(b, m, deterministic)
+}
+func (m *RateLimitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitsRequest.Merge(m, src)
+}
+func (m *RateLimitsRequest) XXX_Size() int {
+	return xxx_messageInfo_RateLimitsRequest.Size(m)
+}
+func (m *RateLimitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitsRequest proto.InternalMessageInfo
+
+func (m *RateLimitsRequest) GetPeerId() string {
+	if m != nil {
+		return m.PeerId
+	}
+	return ""
+}
+
+type RateLimitsResponse struct {
+	Peers                []*PeerRateLimits `protobuf:"bytes,1,rep,name=peers,proto3" json:"peers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *RateLimitsResponse) Reset()         { *m = RateLimitsResponse{} }
+func (m *RateLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*RateLimitsResponse) ProtoMessage()    {}
+func (*RateLimitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{17}
+}
+
+func (m *RateLimitsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RateLimitsResponse.Unmarshal(m, b)
+}
+func (m *RateLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RateLimitsResponse.Marshal(b, m, deterministic)
+}
+func (m *RateLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitsResponse.Merge(m, src)
+}
+func (m *RateLimitsResponse) XXX_Size() int {
+	return xxx_messageInfo_RateLimitsResponse.Size(m)
+}
+func (m *RateLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitsResponse proto.InternalMessageInfo
+
+func (m *RateLimitsResponse) GetPeers() []*PeerRateLimits {
+	if m != nil {
+		return m.Peers
+	}
+	return nil
+}
+
+type PeerRateLimits struct {
+	PeerId               string             `protobuf:"bytes,1,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	Buckets              []*RateLimitBucket `protobuf:"bytes,2,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *PeerRateLimits) Reset()         { *m = PeerRateLimits{} }
+func (m *PeerRateLimits) String() string { return proto.CompactTextString(m) }
+func (*PeerRateLimits) ProtoMessage()    {}
+func (*PeerRateLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{18}
+}
+
+func (m *PeerRateLimits) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PeerRateLimits.Unmarshal(m, b)
+}
+func (m *PeerRateLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PeerRateLimits.Marshal(b, m, deterministic)
+}
+func (m *PeerRateLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerRateLimits.Merge(m, src)
+}
+func (m *PeerRateLimits) XXX_Size() int {
+	return xxx_messageInfo_PeerRateLimits.Size(m)
+}
+func (m *PeerRateLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerRateLimits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeerRateLimits proto.InternalMessageInfo
+
+func (m *PeerRateLimits) GetPeerId() string {
+	if m != nil {
+		return m.PeerId
+	}
+	return ""
+}
+
+func (m *PeerRateLimits) GetBuckets() []*RateLimitBucket {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
+type RateLimitBucket struct {
+	Topic                string   `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	Outbound             bool     `protobuf:"varint,2,opt,name=outbound,proto3" json:"outbound,omitempty"`
+	Capacity             int64    `protobuf:"varint,3,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Remaining            int64    `protobuf:"varint,4,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	Rate                 float64  `protobuf:"fixed64,5,opt,name=rate,proto3" json:"rate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RateLimitBucket) Reset()         { *m = RateLimitBucket{} }
+func (m *RateLimitBucket) String() string { return proto.CompactTextString(m) }
+func (*RateLimitBucket) ProtoMessage()    {}
+func (*RateLimitBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{19}
+}
+
+func (m *RateLimitBucket) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RateLimitBucket.Unmarshal(m, b)
+}
+func (m *RateLimitBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RateLimitBucket.Marshal(b, m, deterministic)
+}
+func (m *RateLimitBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitBucket.Merge(m, src)
+}
+func (m *RateLimitBucket) XXX_Size() int {
+	return xxx_messageInfo_RateLimitBucket.Size(m)
+}
+func (m *RateLimitBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitBucket proto.InternalMessageInfo
+
+func (m *RateLimitBucket) GetTopic() string {
+	if m != nil {
+		return m.Topic
+	}
+	return ""
+}
+
+func (m *RateLimitBucket) GetOutbound() bool {
+	if m != nil {
+		return m.Outbound
+	}
+	return false
+}
+
+func (m *RateLimitBucket) GetCapacity() int64 {
+	if m != nil {
+		return m.Capacity
+	}
+	return 0
+}
+
+func (m *RateLimitBucket) GetRemaining() int64 {
+	if m != nil {
+		return m.Remaining
+	}
+	return 0
+}
+
+func (m *RateLimitBucket) GetRate() float64 {
+	if m != nil {
+		return m.Rate
+	}
+	return 0
+}
+
+type ChainEvent struct {
+	Type                 ChainEvent_Type  `protobuf:"varint,1,opt,name=type,proto3,enum=ethereum.beacon.rpc.v1.ChainEvent_Type" json:"type,omitempty"`
+	Head                 *HeadEvent       `protobuf:"bytes,2,opt,name=head,proto3" json:"head,omitempty"`
+	Reorg                *ReorgEvent      `protobuf:"bytes,3,opt,name=reorg,proto3" json:"reorg,omitempty"`
+	Checkpoint           *CheckpointEvent `protobuf:"bytes,4,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
+	SyncStatus           *SyncStatusEvent `protobuf:"bytes,5,opt,name=sync_status,json=syncStatus,proto3" json:"sync_status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ChainEvent) Reset()         { *m = ChainEvent{} }
+func (m *ChainEvent) String() string { return proto.CompactTextString(m) }
+func (*ChainEvent) ProtoMessage()    {}
+func (*ChainEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{20}
+}
+
+func (m *ChainEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChainEvent.Unmarshal(m, b)
+}
+func (m *ChainEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChainEvent.Marshal(b, m, deterministic)
+}
+func (m *ChainEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainEvent.Merge(m, src)
+}
+func (m *ChainEvent) XXX_Size() int {
+	return xxx_messageInfo_ChainEvent.Size(m)
+}
+func (m *ChainEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainEvent proto.InternalMessageInfo
+
+func (m *ChainEvent) GetType() ChainEvent_Type {
+	if m != nil {
+		return m.Type
+	}
+	return ChainEvent_UNKNOWN
+}
+
+func (m *ChainEvent) GetHead() *HeadEvent {
+	if m != nil {
+		return m.Head
+	}
+	return nil
+}
+
+func (m *ChainEvent) GetReorg() *ReorgEvent {
+	if m != nil {
+		return m.Reorg
+	}
+	return nil
+}
+
+func (m *ChainEvent) GetCheckpoint() *CheckpointEvent {
+	if m != nil {
+		return m.Checkpoint
+	}
+	return nil
+}
+
+func (m *ChainEvent) GetSyncStatus() *SyncStatusEvent {
+	if m != nil {
+		return m.SyncStatus
+	}
+	return nil
+}
+
+type HeadEvent struct {
+	Slot                 uint64   `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	BlockRoot            []byte   `protobuf:"bytes,2,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
+	PreviousBlockRoot    []byte   `protobuf:"bytes,3,opt,name=previous_block_root,json=previousBlockRoot,proto3" json:"previous_block_root,omitempty"`
+	EpochTransition      bool     `protobuf:"varint,4,opt,name=epoch_transition,json=epochTransition,proto3" json:"epoch_transition,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HeadEvent) Reset()         { *m = HeadEvent{} }
+func (m *HeadEvent) String() string { return proto.CompactTextString(m) }
+func (*HeadEvent) ProtoMessage()    {}
+func (*HeadEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{21}
+}
+
+func (m *HeadEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HeadEvent.Unmarshal(m, b)
+}
+func (m *HeadEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HeadEvent.Marshal(b, m, deterministic)
+}
+func (m *HeadEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeadEvent.Merge(m, src)
+}
+func (m *HeadEvent) XXX_Size() int {
+	return xxx_messageInfo_HeadEvent.Size(m)
+}
+func (m *HeadEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeadEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HeadEvent proto.InternalMessageInfo
+
+func (m *HeadEvent) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *HeadEvent) GetBlockRoot() []byte {
+	if m != nil {
+		return m.BlockRoot
+	}
+	return nil
+}
+
+func (m *HeadEvent) GetPreviousBlockRoot() []byte {
+	if m != nil {
+		return m.PreviousBlockRoot
+	}
+	return nil
+}
+
+func (m *HeadEvent) GetEpochTransition() bool {
+	if m != nil {
+		return m.EpochTransition
+	}
+	return false
+}
+
+type ReorgEvent struct {
+	OldHeadRoot          []byte   `protobuf:"bytes,1,opt,name=old_head_root,json=oldHeadRoot,proto3" json:"old_head_root,omitempty"`
+	OldHeadSlot          uint64   `protobuf:"varint,2,opt,name=old_head_slot,json=oldHeadSlot,proto3" json:"old_head_slot,omitempty"`
+	NewHeadRoot          []byte   `protobuf:"bytes,3,opt,name=new_head_root,json=newHeadRoot,proto3" json:"new_head_root,omitempty"`
+	NewHeadSlot          uint64   `protobuf:"varint,4,opt,name=new_head_slot,json=newHeadSlot,proto3" json:"new_head_slot,omitempty"`
+	CommonAncestorRoot   []byte   `protobuf:"bytes,5,opt,name=common_ancestor_root,json=commonAncestorRoot,proto3" json:"common_ancestor_root,omitempty"`
+	CommonAncestorSlot   uint64   `protobuf:"varint,6,opt,name=common_ancestor_slot,json=commonAncestorSlot,proto3" json:"common_ancestor_slot,omitempty"`
+	Depth                uint64   `protobuf:"varint,7,opt,name=depth,proto3" json:"depth,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReorgEvent) Reset()         { *m = ReorgEvent{} }
+func (m *ReorgEvent) String() string { return proto.CompactTextString(m) }
+func (*ReorgEvent) ProtoMessage()    {}
+func (*ReorgEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{22}
+}
+
+func (m *ReorgEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReorgEvent.Unmarshal(m, b)
+}
+func (m *ReorgEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReorgEvent.Marshal(b, m, deterministic)
+}
+func (m *ReorgEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReorgEvent.Merge(m, src)
+}
+func (m *ReorgEvent) XXX_Size() int {
+	return xxx_messageInfo_ReorgEvent.Size(m)
+}
+func (m *ReorgEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReorgEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReorgEvent proto.InternalMessageInfo
+
+func (m *ReorgEvent) GetOldHeadRoot() []byte {
+	if m != nil {
+		return m.OldHeadRoot
+	}
+	return nil
+}
+
+func (m *ReorgEvent) GetOldHeadSlot() uint64 {
+	if m != nil {
+		return m.OldHeadSlot
+	}
+	return 0
+}
+
+func (m *ReorgEvent) GetNewHeadRoot() []byte {
+	if m != nil {
+		return m.NewHeadRoot
+	}
+	return nil
+}
+
+func (m *ReorgEvent) GetNewHeadSlot() uint64 {
+	if m != nil {
+		return m.NewHeadSlot
+	}
+	return 0
+}
+
+func (m *ReorgEvent) GetCommonAncestorRoot() []byte {
+	if m != nil {
+		return m.CommonAncestorRoot
+	}
+	return nil
+}
+
+func (m *ReorgEvent) GetCommonAncestorSlot() uint64 {
+	if m != nil {
+		return m.CommonAncestorSlot
+	}
+	return 0
+}
+
+func (m *ReorgEvent) GetDepth() uint64 {
+	if m != nil {
+		return m.Depth
+	}
+	return 0
+}
+
+type CheckpointEvent struct {
+	Epoch                uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Root                 []byte   `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CheckpointEvent) Reset()         { *m = CheckpointEvent{} }
+func (m *CheckpointEvent) String() string { return proto.CompactTextString(m) }
+func (*CheckpointEvent) ProtoMessage()    {}
+func (*CheckpointEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{23}
+}
+
+func (m *CheckpointEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckpointEvent.Unmarshal(m, b)
+}
+func (m *CheckpointEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckpointEvent.Marshal(b, m, deterministic)
+}
+func (m *CheckpointEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckpointEvent.Merge(m, src)
+}
+func (m *CheckpointEvent) XXX_Size() int {
+	return xxx_messageInfo_CheckpointEvent.Size(m)
+}
+func (m *CheckpointEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckpointEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckpointEvent proto.InternalMessageInfo
+
+func (m *CheckpointEvent) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *CheckpointEvent) GetRoot() []byte {
+	if m != nil {
+		return m.Root
+	}
+	return nil
+}
+
+type SyncStatusEvent struct {
+	Syncing              bool     `protobuf:"varint,1,opt,name=syncing,proto3" json:"syncing,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SyncStatusEvent) Reset()         { *m = SyncStatusEvent{} }
+func (m *SyncStatusEvent) String() string { return proto.CompactTextString(m) }
+func (*SyncStatusEvent) ProtoMessage()    {}
+func (*SyncStatusEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{24}
+}
+
+func (m *SyncStatusEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SyncStatusEvent.Unmarshal(m, b)
+}
+func (m *SyncStatusEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SyncStatusEvent.Marshal(b, m, deterministic)
+}
+func (m *SyncStatusEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncStatusEvent.Merge(m, src)
+}
+func (m *SyncStatusEvent) XXX_Size() int {
+	return xxx_messageInfo_SyncStatusEvent.Size(m)
+}
+func (m *SyncStatusEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncStatusEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncStatusEvent proto.InternalMessageInfo
+
+func (m *SyncStatusEvent) GetSyncing() bool {
+	if m != nil {
+		return m.Syncing
+	}
+	return false
+}
+
+type Eth1ConnectionStatus struct {
+	ActiveEndpoint       string                `protobuf:"bytes,1,opt,name=active_endpoint,json=activeEndpoint,proto3" json:"active_endpoint,omitempty"`
+	Endpoints            []*Eth1EndpointStatus `protobuf:"bytes,2,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *Eth1ConnectionStatus) Reset()         { *m = Eth1ConnectionStatus{} }
+func (m *Eth1ConnectionStatus) String() string { return proto.CompactTextString(m) }
+func (*Eth1ConnectionStatus) ProtoMessage()    {}
+func (*Eth1ConnectionStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{25}
+}
+
+func (m *Eth1ConnectionStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Eth1ConnectionStatus.Unmarshal(m, b)
+}
+func (m *Eth1ConnectionStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Eth1ConnectionStatus.Marshal(b, m, deterministic)
+}
+func (m *Eth1ConnectionStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Eth1ConnectionStatus.Merge(m, src)
+}
+func (m *Eth1ConnectionStatus) XXX_Size() int {
+	return xxx_messageInfo_Eth1ConnectionStatus.Size(m)
+}
+func (m *Eth1ConnectionStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_Eth1ConnectionStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Eth1ConnectionStatus proto.InternalMessageInfo
+
+func (m *Eth1ConnectionStatus) GetActiveEndpoint() string {
+	if m != nil {
+		return m.ActiveEndpoint
+	}
+	return ""
+}
+
+func (m *Eth1ConnectionStatus) GetEndpoints() []*Eth1EndpointStatus {
+	if m != nil {
+		return m.Endpoints
+	}
+	return nil
+}
+
+type Eth1EndpointStatus struct {
+	Endpoint             string   `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	Active               bool     `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
+	Healthy              bool     `protobuf:"varint,3,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	HeadBlock            uint64   `protobuf:"varint,4,opt,name=head_block,json=headBlock,proto3" json:"head_block,omitempty"`
+	RejectionReason      string   `protobuf:"bytes,5,opt,name=rejection_reason,json=rejectionReason,proto3" json:"rejection_reason,omitempty"`
+	LastChecked          uint64   `protobuf:"varint,6,opt,name=last_checked,json=lastChecked,proto3" json:"last_checked,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Eth1EndpointStatus) Reset()         { *m = Eth1EndpointStatus{} }
+func (m *Eth1EndpointStatus) String() string { return proto.CompactTextString(m) }
+func (*Eth1EndpointStatus) ProtoMessage()    {}
+func (*Eth1EndpointStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{26}
+}
+
+func (m *Eth1EndpointStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Eth1EndpointStatus.Unmarshal(m, b)
+}
+func (m *Eth1EndpointStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Eth1EndpointStatus.Marshal(b, m, deterministic)
+}
+func (m *Eth1EndpointStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Eth1EndpointStatus.Merge(m, src)
+}
+func (m *Eth1EndpointStatus) XXX_Size() int {
+	return xxx_messageInfo_Eth1EndpointStatus.Size(m)
+}
+func (m *Eth1EndpointStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_Eth1EndpointStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Eth1EndpointStatus proto.InternalMessageInfo
+
+func (m *Eth1EndpointStatus) GetEndpoint() string {
+	if m != nil {
+		return m.Endpoint
+	}
+	return ""
+}
+
+func (m *Eth1EndpointStatus) GetActive() bool {
+	if m != nil {
+		return m.Active
+	}
+	return false
+}
+
+func (m *Eth1EndpointStatus) GetHealthy() bool {
+	if m != nil {
+		return m.Healthy
+	}
+	return false
+}
+
+func (m *Eth1EndpointStatus) GetHeadBlock() uint64 {
+	if m != nil {
+		return m.HeadBlock
+	}
+	return 0
+}
+
+func (m *Eth1EndpointStatus) GetRejectionReason() string {
+	if m != nil {
+		return m.RejectionReason
+	}
+	return ""
+}
+
+func (m *Eth1EndpointStatus) GetLastChecked() uint64 {
+	if m != nil {
+		return m.LastChecked
+	}
+	return 0
+}
+
+type DepositSnapshotResponse struct {
+	Snapshot             []byte   `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	DepositCount         uint64   `protobuf:"varint,2,opt,name=deposit_count,json=depositCount,proto3" json:"deposit_count,omitempty"`
+	DepositRoot          []byte   `protobuf:"bytes,3,opt,name=deposit_root,json=depositRoot,proto3" json:"deposit_root,omitempty"`
+	Eth1BlockHash        []byte   `protobuf:"bytes,4,opt,name=eth1_block_hash,json=eth1BlockHash,proto3" json:"eth1_block_hash,omitempty"`
+	Eth1BlockHeight      uint64   `protobuf:"varint,5,opt,name=eth1_block_height,json=eth1BlockHeight,proto3" json:"eth1_block_height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DepositSnapshotResponse) Reset()         { *m = DepositSnapshotResponse{} }
+func (m *DepositSnapshotResponse) String() string { return proto.CompactTextString(m) }
+func (*DepositSnapshotResponse) ProtoMessage()    {}
+func (*DepositSnapshotResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{27}
+}
+
+func (m *DepositSnapshotResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DepositSnapshotResponse.Unmarshal(m, b)
+}
+func (m *DepositSnapshotResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DepositSnapshotResponse.Marshal(b, m, deterministic)
+}
+func (m *DepositSnapshotResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositSnapshotResponse.Merge(m, src)
+}
+func (m *DepositSnapshotResponse) XXX_Size() int {
+	return xxx_messageInfo_DepositSnapshotResponse.Size(m)
+}
+func (m *DepositSnapshotResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositSnapshotResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DepositSnapshotResponse proto.InternalMessageInfo
+
+func (m *DepositSnapshotResponse) GetSnapshot() []byte {
+	if m != nil {
+		return m.Snapshot
+	}
+	return nil
+}
+
+func (m *DepositSnapshotResponse) GetDepositCount() uint64 {
+	if m != nil {
+		return m.DepositCount
+	}
+	return 0
+}
+
+func (m *DepositSnapshotResponse) GetDepositRoot() []byte {
+	if m != nil {
+		return m.DepositRoot
+	}
+	return nil
+}
+
+func (m *DepositSnapshotResponse) GetEth1BlockHash() []byte {
+	if m != nil {
+		return m.Eth1BlockHash
+	}
+	return nil
+}
+
+func (m *DepositSnapshotResponse) GetEth1BlockHeight() uint64 {
+	if m != nil {
+		return m.Eth1BlockHeight
+	}
+	return 0
+}
+
+type Eth1DataVotesResponse struct {
+	VotingPeriodStartSlot uint64                     `protobuf:"varint,1,opt,name=voting_period_start_slot,json=votingPeriodStartSlot,proto3" json:"voting_period_start_slot,omitempty"`
+	VotesRequired         uint64                     `protobuf:"varint,2,opt,name=votes_required,json=votesRequired,proto3" json:"votes_required,omitempty"`
+	EarliestValidTime     uint64                     `protobuf:"varint,3,opt,name=earliest_valid_time,json=earliestValidTime,proto3" json:"earliest_valid_time,omitempty"`
+	LatestValidTime       uint64                     `protobuf:"varint,4,opt,name=latest_valid_time,json=latestValidTime,proto3" json:"latest_valid_time,omitempty"`
+	FirstValidBlock       uint64                     `protobuf:"varint,5,opt,name=first_valid_block,json=firstValidBlock,proto3" json:"first_valid_block,omitempty"`
+	LastValidBlock        uint64                     `protobuf:"varint,6,opt,name=last_valid_block,json=lastValidBlock,proto3" json:"last_valid_block,omitempty"`
+	FollowDistanceBlock   *Eth1DataCandidate         `protobuf:"bytes,7,opt,name=follow_distance_block,json=followDistanceBlock,proto3" json:"follow_distance_block,omitempty"`
+	Candidates            []*Eth1DataCandidate       `protobuf:"bytes,8,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	CurrentEth1Data       *v1alpha1.Eth1Data         `protobuf:"bytes,9,opt,name=current_eth1_data,json=currentEth1Data,proto3" json:"current_eth1_data,omitempty"`
+	NextProposalSlot      uint64                     `protobuf:"varint,10,opt,name=next_proposal_slot,json=nextProposalSlot,proto3" json:"next_proposal_slot,omitempty"`
+	NextProposerIndex     uint64                     `protobuf:"varint,11,opt,name=next_proposer_index,json=nextProposerIndex,proto3" json:"next_proposer_index,omitempty"`
+	NextVote              *v1alpha1.Eth1Data         `protobuf:"bytes,12,opt,name=next_vote,json=nextVote,proto3" json:"next_vote,omitempty"`
+	PendingDeposits       []*PendingDepositInclusion `protobuf:"bytes,13,rep,name=pending_deposits,json=pendingDeposits,proto3" json:"pending_deposits,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}                   `json:"-"`
+	XXX_unrecognized      []byte                     `json:"-"`
+	XXX_sizecache         int32                      `json:"-"`
+}
+
+func (m *Eth1DataVotesResponse) Reset()         { *m = Eth1DataVotesResponse{} }
+func (m *Eth1DataVotesResponse) String() string { return proto.CompactTextString(m) }
+func (*Eth1DataVotesResponse) ProtoMessage()    {}
+func (*Eth1DataVotesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{28}
+}
+
+func (m *Eth1DataVotesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Eth1DataVotesResponse.Unmarshal(m, b)
+}
+func (m *Eth1DataVotesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Eth1DataVotesResponse.Marshal(b, m, deterministic)
+}
+func (m *Eth1DataVotesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Eth1DataVotesResponse.Merge(m, src)
+}
+func (m *Eth1DataVotesResponse) XXX_Size() int {
+	return xxx_messageInfo_Eth1DataVotesResponse.Size(m)
+}
+func (m *Eth1DataVotesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_Eth1DataVotesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Eth1DataVotesResponse proto.InternalMessageInfo
+
+func (m *Eth1DataVotesResponse) GetVotingPeriodStartSlot() uint64 {
+	if m != nil {
+		return m.VotingPeriodStartSlot
+	}
+	return 0
+}
+
+func (m *Eth1DataVotesResponse) GetVotesRequired() uint64 {
+	if m != nil {
+		return m.VotesRequired
+	}
+	return 0
+}
+
+func (m *Eth1DataVotesResponse) GetEarliestValidTime() uint64 {
+	if m != nil {
+		return m.EarliestValidTime
+	}
+	return 0
+}
+
+func (m *Eth1DataVotesResponse) GetLatestValidTime() uint64 {
+	if m != nil {
+		return m.LatestValidTime
+	}
+	return 0
+}
+
+func (m *Eth1DataVotesResponse) GetFirstValidBlock() uint64 {
+	if m != nil {
+		return m.FirstValidBlock
+	}
+	return 0
+}
+
+func (m *Eth1DataVotesResponse) GetLastValidBlock() uint64 {
+	if m != nil {
+		return m.LastValidBlock
+	}
+	return 0
+}
+
+func (m *Eth1DataVotesResponse) GetFollowDistanceBlock() *Eth1DataCandidate {
+	if m != nil {
+		return m.FollowDistanceBlock
+	}
+	return nil
+}
+
+func (m *Eth1DataVotesResponse) GetCandidates() []*Eth1DataCandidate {
+	if m != nil {
+		return m.Candidates
+	}
+	return nil
+}
+
+func (m *Eth1DataVotesResponse) GetCurrentEth1Data() *v1alpha1.Eth1Data {
+	if m != nil {
+		return m.CurrentEth1Data
+	}
+	return nil
+}
+
+func (m *Eth1DataVotesResponse) GetNextProposalSlot() uint64 {
+	if m != nil {
+		return m.NextProposalSlot
+	}
+	return 0
+}
+
+func (m *Eth1DataVotesResponse) GetNextProposerIndex() uint64 {
+	if m != nil {
+		return m.NextProposerIndex
+	}
+	return 0
+}
+
+func (m *Eth1DataVotesResponse) GetNextVote() *v1alpha1.Eth1Data {
+	if m != nil {
+		return m.NextVote
+	}
+	return nil
+}
+
+func (m *Eth1DataVotesResponse) GetPendingDeposits() []*PendingDepositInclusion {
+	if m != nil {
+		return m.PendingDeposits
+	}
+	return nil
+}
+
+type Eth1DataCandidate struct {
+	Eth1Data             *v1alpha1.Eth1Data `protobuf:"bytes,1,opt,name=eth1_data,json=eth1Data,proto3" json:"eth1_data,omitempty"`
+	Votes                uint64             `protobuf:"varint,2,opt,name=votes,proto3" json:"votes,omitempty"`
+	BlockHeight          uint64             `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Counted              bool               `protobuf:"varint,4,opt,name=counted,proto3" json:"counted,omitempty"`
+	RejectionReason      string             `protobuf:"bytes,5,opt,name=rejection_reason,json=rejectionReason,proto3" json:"rejection_reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *Eth1DataCandidate) Reset()         { *m = Eth1DataCandidate{} }
+func (m *Eth1DataCandidate) String() string { return proto.CompactTextString(m) }
+func (*Eth1DataCandidate) ProtoMessage()    {}
+func (*Eth1DataCandidate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{29}
+}
+
+func (m *Eth1DataCandidate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Eth1DataCandidate.Unmarshal(m, b)
+}
+func (m *Eth1DataCandidate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Eth1DataCandidate.Marshal(b, m, deterministic)
+}
+func (m *Eth1DataCandidate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Eth1DataCandidate.Merge(m, src)
+}
+func (m *Eth1DataCandidate) XXX_Size() int {
+	return xxx_messageInfo_Eth1DataCandidate.Size(m)
+}
+func (m *Eth1DataCandidate) XXX_DiscardUnknown() {
+	xxx_messageInfo_Eth1DataCandidate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Eth1DataCandidate proto.InternalMessageInfo
+
+func (m *Eth1DataCandidate) GetEth1Data() *v1alpha1.Eth1Data {
+	if m != nil {
+		return m.Eth1Data
+	}
+	return nil
+}
+
+func (m *Eth1DataCandidate) GetVotes() uint64 {
+	if m != nil {
+		return m.Votes
+	}
+	return 0
+}
+
+func (m *Eth1DataCandidate) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *Eth1DataCandidate) GetCounted() bool {
+	if m != nil {
+		return m.Counted
+	}
+	return false
+}
+
+func (m *Eth1DataCandidate) GetRejectionReason() string {
+	if m != nil {
+		return m.RejectionReason
+	}
+	return ""
+}
+
+type PendingDepositInclusion struct {
+	Index                uint64   `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	PublicKey            []byte   `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Eth1BlockHeight      uint64   `protobuf:"varint,3,opt,name=eth1_block_height,json=eth1BlockHeight,proto3" json:"eth1_block_height,omitempty"`
+	EstimatedSlot        uint64   `protobuf:"varint,4,opt,name=estimated_slot,json=estimatedSlot,proto3" json:"estimated_slot,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PendingDepositInclusion) Reset()         { *m = PendingDepositInclusion{} }
+func (m *PendingDepositInclusion) String() string { return proto.CompactTextString(m) }
+func (*PendingDepositInclusion) ProtoMessage()    {}
+func (*PendingDepositInclusion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{30}
+}
+
+func (m *PendingDepositInclusion) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingDepositInclusion.Unmarshal(m, b)
+}
+func (m *PendingDepositInclusion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingDepositInclusion.Marshal(b, m, deterministic)
+}
+func (m *PendingDepositInclusion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingDepositInclusion.Merge(m, src)
+}
+func (m *PendingDepositInclusion) XXX_Size() int {
+	return xxx_messageInfo_PendingDepositInclusion.Size(m)
+}
+func (m *PendingDepositInclusion) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingDepositInclusion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingDepositInclusion proto.InternalMessageInfo
+
+func (m *PendingDepositInclusion) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *PendingDepositInclusion) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *PendingDepositInclusion) GetEth1BlockHeight() uint64 {
+	if m != nil {
+		return m.Eth1BlockHeight
+	}
+	return 0
+}
+
+func (m *PendingDepositInclusion) GetEstimatedSlot() uint64 {
+	if m != nil {
+		return m.EstimatedSlot
+	}
+	return 0
+}
+
+type BackfillStatusResponse struct {
+	LowestSlot           uint64   `protobuf:"varint,1,opt,name=lowest_slot,json=lowestSlot,proto3" json:"lowest_slot,omitempty"`
+	Complete             bool     `protobuf:"varint,2,opt,name=complete,proto3" json:"complete,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BackfillStatusResponse) Reset()         { *m = BackfillStatusResponse{} }
+func (m *BackfillStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*BackfillStatusResponse) ProtoMessage()    {}
+func (*BackfillStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_851e5cb2de3d61dd, []int{31}
+}
+
+func (m *BackfillStatusResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BackfillStatusResponse.Unmarshal(m, b)
+}
+func (m *BackfillStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BackfillStatusResponse.Marshal(b, m, deterministic)
+}
+func (m *BackfillStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackfillStatusResponse.Merge(m, src)
+}
+func (m *BackfillStatusResponse) XXX_Size() int {
+	return xxx_messageInfo_BackfillStatusResponse.Size(m)
+}
+func (m *BackfillStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackfillStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackfillStatusResponse proto.InternalMessageInfo
+
+func (m *BackfillStatusResponse) GetLowestSlot() uint64 {
+	if m != nil {
+		return m.LowestSlot
+	}
+	return 0
+}
+
+func (m *BackfillStatusResponse) GetComplete() bool {
+	if m != nil {
+		return m.Complete
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterEnum("ethereum.beacon.rpc.v1.LoggingLevelRequest_Level", LoggingLevelRequest_Level_name, LoggingLevelRequest_Level_value)
+	proto.RegisterEnum("ethereum.beacon.rpc.v1.ChainEvent_Type", ChainEvent_Type_name, ChainEvent_Type_value)
+	proto.RegisterType((*InclusionSlotRequest)(nil), "ethereum.beacon.rpc.v1.InclusionSlotRequest")
+	proto.RegisterType((*InclusionSlotResponse)(nil), "ethereum.beacon.rpc.v1.InclusionSlotResponse")
+	proto.RegisterType((*BeaconStateRequest)(nil), "ethereum.beacon.rpc.v1.BeaconStateRequest")
+	proto.RegisterType((*BlockRequest)(nil), "ethereum.beacon.rpc.v1.BlockRequest")
+	proto.RegisterType((*SSZResponse)(nil), "ethereum.beacon.rpc.v1.SSZResponse")
+	proto.RegisterType((*LoggingLevelRequest)(nil), "ethereum.beacon.rpc.v1.LoggingLevelRequest")
+	proto.RegisterType((*ProtoArrayForkChoiceResponse)(nil), "ethereum.beacon.rpc.v1.ProtoArrayForkChoiceResponse")
+	proto.RegisterMapType((map[string]uint64)(nil), "ethereum.beacon.rpc.v1.ProtoArrayForkChoiceResponse.IndicesEntry")
+	proto.RegisterType((*ProtoArrayNode)(nil), "ethereum.beacon.rpc.v1.ProtoArrayNode")
+	proto.RegisterType((*DebugPeerResponses)(nil), "ethereum.beacon.rpc.v1.DebugPeerResponses")
+	proto.RegisterType((*DebugPeerResponse)(nil), "ethereum.beacon.rpc.v1.DebugPeerResponse")
+	proto.RegisterType((*DebugPeerResponse_PeerInfo)(nil), "ethereum.beacon.rpc.v1.DebugPeerResponse.PeerInfo")
+	proto.RegisterType((*TrustedPeerRequest)(nil), "ethereum.beacon.rpc.v1.TrustedPeerRequest")
+	proto.RegisterType((*TrustedPeersResponse)(nil), "ethereum.beacon.rpc.v1.TrustedPeersResponse")
+	proto.RegisterType((*BanPeerRequest)(nil), "ethereum.beacon.rpc.v1.BanPeerRequest")
+	proto.RegisterType((*PeerBan)(nil), "ethereum.beacon.rpc.v1.PeerBan")
+	proto.RegisterType((*PeerBansResponse)(nil), "ethereum.beacon.rpc.v1.PeerBansResponse")
+	proto.RegisterType((*DisconnectPeerRequest)(nil), "ethereum.beacon.rpc.v1.DisconnectPeerRequest")
+	proto.RegisterType((*RateLimitsRequest)(nil), "ethereum.beacon.rpc.v1.RateLimitsRequest")
+	proto.RegisterType((*RateLimitsResponse)(nil), "ethereum.beacon.rpc.v1.RateLimitsResponse")
+	proto.RegisterType((*PeerRateLimits)(nil), "ethereum.beacon.rpc.v1.PeerRateLimits")
+	proto.RegisterType((*RateLimitBucket)(nil), "ethereum.beacon.rpc.v1.RateLimitBucket")
+	proto.RegisterType((*ChainEvent)(nil), "ethereum.beacon.rpc.v1.ChainEvent")
+	proto.RegisterType((*HeadEvent)(nil), "ethereum.beacon.rpc.v1.HeadEvent")
+	proto.RegisterType((*ReorgEvent)(nil), "ethereum.beacon.rpc.v1.ReorgEvent")
+	proto.RegisterType((*CheckpointEvent)(nil), "ethereum.beacon.rpc.v1.CheckpointEvent")
+	proto.RegisterType((*SyncStatusEvent)(nil), "ethereum.beacon.rpc.v1.SyncStatusEvent")
+	proto.RegisterType((*Eth1ConnectionStatus)(nil), "ethereum.beacon.rpc.v1.Eth1ConnectionStatus")
+	proto.RegisterType((*Eth1EndpointStatus)(nil), "ethereum.beacon.rpc.v1.Eth1EndpointStatus")
+	proto.RegisterType((*DepositSnapshotResponse)(nil), "ethereum.beacon.rpc.v1.DepositSnapshotResponse")
+	proto.RegisterType((*Eth1DataVotesResponse)(nil), "ethereum.beacon.rpc.v1.Eth1DataVotesResponse")
+	proto.RegisterType((*Eth1DataCandidate)(nil), "ethereum.beacon.rpc.v1.Eth1DataCandidate")
+	proto.RegisterType((*PendingDepositInclusion)(nil), "ethereum.beacon.rpc.v1.PendingDepositInclusion")
+	proto.RegisterType((*BackfillStatusResponse)(nil), "ethereum.beacon.rpc.v1.BackfillStatusResponse")
+}
+
+func init() { proto.RegisterFile("proto/beacon/rpc/v1/debug.proto", fileDescriptor_851e5cb2de3d61dd) }
+
+var fileDescriptor_851e5cb2de3d61dd = []byte{
+	// 2883 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x39, 0xeb, 0x6e, 0x1b, 0xc7,
+	0xd5, 0xa6, 0x24, 0x5a, 0xe4, 0xa1, 0x4c, 0x52, 0x63, 0xd9, 0xe6, 0xa7, 0xd8, 0x91, 0xbd, 0xbe,
+	0xdf, 0xc8, 0x48, 0xc9, 0x87, 0x2f, 0x48, 0x02, 0x7c, 0xd0, 0xcd, 0x92, 0x6a, 0x57, 0x76, 0x96,
+	0x52, 0x8a, 0x26, 0x08, 0x16, 0xa3, 0xdd, 0x91, 0xb8, 0xd1, 0x72, 0x67, 0xb3, 0x33, 0xa4, 0xcd,
+	0xf4, 0x47, 0x91, 0xf4, 0x86, 0x02, 0x05, 0x8a, 0xa2, 0x68, 0xd0, 0xfe, 0x6b, 0x9f, 0xa1, 0x7f,
+	0xfb, 0x0e, 0x45, 0xd1, 0x57, 0xe8, 0x0b, 0xf4, 0x0d, 0x8a, 0x39, 0x33, 0xbb, 0x5c, 0x4a, 0x5c,
+	0x89, 0x29, 0xf2, 0x8f, 0x73, 0xee, 0x73, 0x6e, 0x7b, 0xe6, 0x10, 0x96, 0xa2, 0x98, 0x4b, 0xde,
+	0x3a, 0x60, 0xd4, 0xe5, 0x61, 0x2b, 0x8e, 0xdc, 0x56, 0x7f, 0xb9, 0xe5, 0xb1, 0x83, 0xde, 0x51,
+	0x13, 0x31, 0xe4, 0x2a, 0x93, 0x1d, 0x16, 0xb3, 0x5e, 0xb7, 0xa9, 0x69, 0x9a, 0x71, 0xe4, 0x36,
+	0xfb, 0xcb, 0x8b, 0x4b, 0x4c, 0x76, 0x5a, 0xfd, 0x65, 0x1a, 0x44, 0x1d, 0xba, 0x6c, 0xf8, 0x9d,
+	0x83, 0x80, 0xbb, 0xc7, 0x9a, 0x71, 0xf1, 0xda, 0x08, 0x41, 0xc8, 0x3d, 0x66, 0x10, 0xd6, 0x88,
+	0xca, 0x68, 0x25, 0x52, 0x2a, 0xbb, 0x4c, 0x08, 0x7a, 0xc4, 0x84, 0xa1, 0xb9, 0x7e, 0xc4, 0xf9,
+	0x51, 0xc0, 0x5a, 0x34, 0xf2, 0x5b, 0x34, 0x0c, 0xb9, 0xa4, 0xd2, 0xe7, 0x61, 0x82, 0x7d, 0xcb,
+	0x60, 0xf1, 0x74, 0xd0, 0x3b, 0x6c, 0xb1, 0x6e, 0x24, 0x07, 0x1a, 0x69, 0x7d, 0x00, 0x0b, 0x3b,
+	0xa1, 0x1b, 0xf4, 0x84, 0xcf, 0xc3, 0x76, 0xc0, 0xa5, 0xcd, 0xbe, 0xec, 0x31, 0x21, 0x49, 0x15,
+	0xa6, 0x7c, 0xaf, 0x51, 0xb8, 0x59, 0x78, 0x30, 0x63, 0x4f, 0xf9, 0x1e, 0x21, 0x30, 0x23, 0x02,
+	0x2e, 0x1b, 0x53, 0x08, 0xc1, 0xdf, 0xd6, 0x63, 0xb8, 0x72, 0x82, 0x57, 0x44, 0x3c, 0x14, 0x6c,
+	0x2c, 0xf1, 0x67, 0x40, 0xd6, 0xf0, 0x0e, 0x6d, 0x49, 0x25, 0x4b, 0xd4, 0x2c, 0x18, 0x4a, 0x54,
+	0xb4, 0x7d, 0x41, 0xd3, 0x92, 0x25, 0x00, 0xf4, 0x8d, 0x13, 0x73, 0x23, 0x65, 0x6e, 0xfb, 0x82,
+	0x5d, 0x46, 0x98, 0xcd, 0xb9, 0x5c, 0xab, 0xc2, 0xdc, 0x97, 0x3d, 0x16, 0x0f, 0x9c, 0x43, 0x3f,
+	0x90, 0x2c, 0xb6, 0x9e, 0xc2, 0xdc, 0x1a, 0x22, 0x8d, 0xd8, 0x1b, 0x23, 0x02, 0x94, 0xf0, 0xb9,
+	0x0c, 0xbb, 0x75, 0x1f, 0x2a, 0xed, 0xf6, 0xa7, 0xa9, 0xb9, 0x0d, 0x98, 0x65, 0xa1, 0xcb, 0x3d,
+	0xe6, 0x19, 0xd2, 0xe4, 0x68, 0xfd, 0xaa, 0x00, 0x97, 0x5f, 0xf0, 0xa3, 0x23, 0x3f, 0x3c, 0x7a,
+	0xc1, 0xfa, 0x2c, 0x48, 0xe4, 0x6f, 0x41, 0x31, 0x50, 0x67, 0xa4, 0xaf, 0xae, 0x2c, 0x37, 0xc7,
+	0x87, 0xbd, 0x39, 0x86, 0xb7, 0xa9, 0x0f, 0x9a, 0xdf, 0xba, 0x0f, 0x45, 0x3c, 0x93, 0x12, 0xcc,
+	0xec, 0xec, 0x3e, 0x7b, 0x59, 0xbf, 0x40, 0xca, 0x50, 0xdc, 0xd8, 0x5c, 0xdb, 0xdf, 0xaa, 0x17,
+	0xd4, 0xcf, 0x3d, 0x7b, 0x75, 0x7d, 0xb3, 0x3e, 0x65, 0xfd, 0x72, 0x1a, 0xae, 0xbf, 0x52, 0x11,
+	0x5b, 0x8d, 0x63, 0x3a, 0x78, 0xc6, 0xe3, 0xe3, 0xf5, 0x0e, 0xf7, 0x5d, 0x96, 0x5e, 0xe2, 0x3e,
+	0xd4, 0xa2, 0xb8, 0x17, 0x32, 0x47, 0x76, 0x62, 0x26, 0x3a, 0x3c, 0x48, 0xa2, 0x57, 0x45, 0xf0,
+	0x5e, 0x02, 0x55, 0x84, 0x5f, 0xf4, 0x84, 0xf4, 0x0f, 0x7d, 0xe6, 0x39, 0x2c, 0xe2, 0x6e, 0xc7,
+	0xc4, 0xa9, 0x9a, 0x82, 0x37, 0x15, 0x54, 0x11, 0x1e, 0xfa, 0x21, 0x0d, 0xfc, 0xaf, 0x52, 0xc2,
+	0x69, 0x4d, 0x98, 0x82, 0x35, 0xa1, 0x0d, 0xf3, 0x98, 0x4c, 0x0e, 0x55, 0xb6, 0x39, 0x2a, 0x79,
+	0x45, 0x63, 0xe6, 0xe6, 0xf4, 0x83, 0xca, 0xca, 0xbd, 0x3c, 0xcf, 0x0c, 0xef, 0xb2, 0xcb, 0x3d,
+	0x66, 0xd7, 0xa2, 0x91, 0xb3, 0x20, 0x9f, 0xc1, 0xac, 0x1f, 0x7a, 0xbe, 0xcb, 0x44, 0xa3, 0x88,
+	0x92, 0x56, 0xcf, 0x97, 0x74, 0xda, 0x2b, 0xcd, 0x1d, 0x2d, 0x63, 0x33, 0x94, 0xf1, 0xc0, 0x4e,
+	0x24, 0x2e, 0x7e, 0x00, 0x73, 0x59, 0x04, 0xa9, 0xc3, 0xf4, 0x31, 0x1b, 0xa0, 0xbf, 0xca, 0xb6,
+	0xfa, 0x49, 0x16, 0xa0, 0xd8, 0xa7, 0x41, 0x8f, 0x19, 0xd7, 0xe8, 0xc3, 0x07, 0x53, 0xef, 0x17,
+	0xac, 0x6f, 0xa6, 0xa0, 0x3a, 0x6a, 0x7c, 0x9a, 0xee, 0x85, 0x61, 0xba, 0x2b, 0xd8, 0x30, 0x79,
+	0x6d, 0xfc, 0x4d, 0xae, 0xc2, 0xc5, 0x88, 0xc6, 0x2c, 0x94, 0xc6, 0x8f, 0xe6, 0x34, 0x2e, 0x22,
+	0x33, 0x93, 0x46, 0xa4, 0x38, 0x36, 0x22, 0x57, 0xe1, 0xe2, 0x6b, 0xe6, 0x1f, 0x75, 0x64, 0xe3,
+	0xa2, 0xd6, 0xa4, 0x4f, 0x58, 0x17, 0x4c, 0x48, 0xc7, 0xed, 0xf8, 0x81, 0xd7, 0x98, 0x45, 0x5c,
+	0x59, 0x41, 0xd6, 0x15, 0x40, 0xc9, 0x47, 0xb4, 0xc7, 0x84, 0xcb, 0x42, 0x8f, 0x86, 0xb2, 0x51,
+	0xd2, 0xf2, 0x15, 0x78, 0x23, 0x85, 0x5a, 0x9f, 0x03, 0xd9, 0x50, 0x5d, 0xef, 0x15, 0x63, 0x71,
+	0xe2, 0x6b, 0x41, 0xb6, 0xa0, 0x1c, 0x27, 0x87, 0x46, 0x01, 0xa3, 0xf6, 0x30, 0x2f, 0x6a, 0xa7,
+	0xd8, 0xed, 0x21, 0xaf, 0xf5, 0xef, 0x22, 0xcc, 0x9f, 0x22, 0x20, 0x2d, 0xb8, 0x1c, 0xf8, 0x42,
+	0xb2, 0xd0, 0x0f, 0x8f, 0x1c, 0xea, 0x79, 0x31, 0x13, 0x89, 0xa2, 0xb2, 0x4d, 0x52, 0xd4, 0x6a,
+	0x82, 0x21, 0x6b, 0x50, 0xf6, 0xfc, 0x98, 0xb9, 0xaa, 0x19, 0x62, 0x20, 0xaa, 0x2b, 0x77, 0x86,
+	0xf6, 0x30, 0xd9, 0x69, 0x26, 0x0d, 0xb7, 0xa9, 0x14, 0x6d, 0x24, 0xb4, 0xf6, 0x90, 0x8d, 0x7c,
+	0x0c, 0x75, 0x97, 0x87, 0xa1, 0x3e, 0x39, 0x42, 0xf5, 0x2e, 0x8c, 0x5e, 0x35, 0x9b, 0xda, 0x23,
+	0xa2, 0xd6, 0x53, 0x72, 0xdd, 0xe9, 0x6a, 0xee, 0x28, 0x80, 0x5c, 0x83, 0xd9, 0x88, 0xb1, 0xd8,
+	0xf1, 0x3d, 0x0c, 0x73, 0xd9, 0xbe, 0xa8, 0x8e, 0x3b, 0x9e, 0x4a, 0x43, 0x16, 0xc6, 0x18, 0xd2,
+	0xb2, 0xad, 0x7e, 0x92, 0x97, 0x50, 0xd6, 0xa4, 0xe1, 0x21, 0xc7, 0x50, 0x56, 0x56, 0x56, 0x26,
+	0xf6, 0x28, 0x5e, 0x6a, 0x27, 0x3c, 0xe4, 0x76, 0x29, 0x32, 0xbf, 0xc8, 0xff, 0x43, 0x05, 0x05,
+	0xaa, 0x8b, 0xf4, 0x04, 0x66, 0x40, 0x65, 0xe5, 0xed, 0x53, 0x22, 0xa3, 0x95, 0x48, 0x89, 0x6c,
+	0x23, 0x95, 0x0d, 0x8a, 0x45, 0xff, 0x26, 0xb7, 0x60, 0x2e, 0xa0, 0x42, 0x3a, 0xbd, 0xc8, 0xa3,
+	0x92, 0x79, 0x26, 0x3f, 0x2a, 0x0a, 0xb6, 0xaf, 0x41, 0x8b, 0x7f, 0x9d, 0x82, 0x52, 0xa2, 0x9a,
+	0x7c, 0x04, 0xa5, 0x2e, 0x93, 0xd4, 0xa3, 0x92, 0x62, 0x7d, 0x54, 0x56, 0x6e, 0xe6, 0x69, 0xfb,
+	0x21, 0x93, 0x74, 0x83, 0x4a, 0x6a, 0xa7, 0x1c, 0xe4, 0x3a, 0x94, 0xb1, 0x31, 0xb8, 0x3c, 0x10,
+	0x8d, 0x29, 0x0c, 0xf4, 0x10, 0x40, 0x96, 0xa0, 0x72, 0x48, 0x7b, 0x81, 0x74, 0x5c, 0xde, 0x4b,
+	0x8b, 0x0a, 0x10, 0xb4, 0xae, 0x20, 0xe4, 0x21, 0xd4, 0x13, 0x6a, 0xa7, 0xcf, 0x62, 0xf5, 0x9d,
+	0x32, 0x2e, 0xaf, 0x25, 0xf0, 0x4f, 0x34, 0x98, 0xdc, 0x86, 0x4b, 0xf4, 0x88, 0x85, 0x32, 0xa5,
+	0xd3, 0x51, 0x98, 0x43, 0x60, 0x42, 0x74, 0x0b, 0xe6, 0xd0, 0x7b, 0x01, 0x95, 0x2c, 0x74, 0x07,
+	0xa6, 0xb8, 0xd0, 0xa3, 0x2f, 0x34, 0x48, 0x35, 0x0e, 0xe1, 0xf2, 0x98, 0xa1, 0x6b, 0x0b, 0xb6,
+	0x3e, 0x28, 0xc6, 0x23, 0x2e, 0x84, 0x1f, 0x39, 0x1a, 0x59, 0x42, 0x64, 0x45, 0xc3, 0xda, 0x0a,
+	0x64, 0x35, 0x81, 0xec, 0xc5, 0x3d, 0x21, 0x99, 0xa7, 0x63, 0xa8, 0x3f, 0x34, 0x0d, 0x98, 0x35,
+	0x99, 0x6e, 0xba, 0x53, 0x72, 0xb4, 0xde, 0x83, 0x85, 0x0c, 0xbd, 0x48, 0xab, 0xe4, 0x3a, 0x94,
+	0x4f, 0xd6, 0xc6, 0x10, 0x60, 0x79, 0x50, 0x5d, 0xa3, 0x61, 0x56, 0x43, 0x26, 0x1b, 0x0b, 0x23,
+	0xd9, 0xa8, 0x26, 0x80, 0x08, 0xcb, 0xa6, 0x6c, 0x4f, 0xf9, 0x91, 0x72, 0xa6, 0xd7, 0x8b, 0xa9,
+	0xae, 0x03, 0xe6, 0xf2, 0xd0, 0x13, 0xc6, 0xe5, 0xb5, 0x04, 0xde, 0xd6, 0x60, 0xeb, 0x63, 0x98,
+	0x55, 0x2a, 0xd6, 0x68, 0x38, 0xb9, 0xf8, 0x1b, 0x00, 0xec, 0x4d, 0xe4, 0xc7, 0x4c, 0x38, 0x34,
+	0x89, 0x65, 0xd9, 0x40, 0x56, 0xa5, 0xb5, 0x05, 0x75, 0x23, 0x72, 0x78, 0xd5, 0x77, 0x61, 0xe6,
+	0x80, 0x86, 0x49, 0xab, 0x59, 0xca, 0xfd, 0x40, 0x68, 0x3e, 0x1b, 0x89, 0xad, 0x6d, 0xb8, 0xb2,
+	0xe1, 0x0b, 0x53, 0x93, 0x13, 0x39, 0xe2, 0x2a, 0x5c, 0x8c, 0x19, 0x15, 0xa6, 0x87, 0xcc, 0xd8,
+	0xe6, 0x64, 0x3d, 0x81, 0x79, 0x9b, 0x4a, 0xf6, 0xc2, 0xef, 0xfa, 0x52, 0x9c, 0x27, 0xc5, 0xb2,
+	0x81, 0x64, 0xa9, 0xcd, 0x15, 0x3e, 0x82, 0xa2, 0xc2, 0x27, 0x77, 0xb8, 0x77, 0xd6, 0x1d, 0x32,
+	0xec, 0x9a, 0xc9, 0x0a, 0xa0, 0x3a, 0x8a, 0xc8, 0xbf, 0xc4, 0x2a, 0xcc, 0x1e, 0xf4, 0xdc, 0x63,
+	0x26, 0x75, 0x1d, 0x55, 0x56, 0xee, 0xe7, 0xa9, 0x4a, 0xa5, 0xad, 0x21, 0xbd, 0x9d, 0xf0, 0x59,
+	0xbf, 0x2b, 0x40, 0xed, 0x04, 0x52, 0xa5, 0xbb, 0xe4, 0x91, 0xef, 0x1a, 0x6d, 0xfa, 0x40, 0x16,
+	0xa1, 0xc4, 0x7b, 0xf2, 0x80, 0xf7, 0x42, 0x0f, 0x7d, 0x56, 0xb2, 0xd3, 0xb3, 0xc2, 0xb9, 0x34,
+	0xa2, 0xae, 0x2f, 0x07, 0x18, 0xe5, 0x69, 0x3b, 0x3d, 0xab, 0xdc, 0x8d, 0x59, 0x97, 0xfa, 0xaa,
+	0x8d, 0x63, 0xa1, 0x4e, 0xdb, 0x43, 0x00, 0x7e, 0x52, 0x55, 0xfb, 0x2d, 0x62, 0xf1, 0xe0, 0x6f,
+	0xeb, 0x6f, 0xd3, 0x00, 0xeb, 0x1d, 0xea, 0x87, 0x9b, 0x7d, 0xf5, 0x25, 0xfd, 0x10, 0x66, 0xe4,
+	0x20, 0x62, 0x66, 0x2c, 0xcb, 0xbd, 0xe2, 0x90, 0xa3, 0xb9, 0x37, 0x88, 0x98, 0x8d, 0x4c, 0xe4,
+	0x7f, 0x61, 0xa6, 0xc3, 0xa8, 0xb6, 0xb8, 0xb2, 0x72, 0x2b, 0x8f, 0x79, 0x9b, 0x51, 0x0f, 0x79,
+	0x6d, 0x24, 0x27, 0xef, 0x43, 0x31, 0x66, 0x3c, 0x3e, 0xc2, 0xdb, 0x54, 0x56, 0xac, 0x5c, 0xbf,
+	0x2a, 0x22, 0xcd, 0xa8, 0x19, 0xc8, 0x16, 0x80, 0xdb, 0x61, 0xee, 0x71, 0xc4, 0xfd, 0x50, 0xe2,
+	0x7d, 0x2b, 0x67, 0xd9, 0x9c, 0x50, 0x6a, 0x19, 0x19, 0x56, 0xb2, 0x0d, 0x15, 0x31, 0x08, 0xdd,
+	0xa4, 0xab, 0x17, 0xcf, 0x96, 0xd4, 0x1e, 0x84, 0xae, 0xee, 0xe6, 0x46, 0x92, 0x48, 0x01, 0x56,
+	0x17, 0x66, 0x94, 0x47, 0x48, 0x05, 0x66, 0xf7, 0x77, 0x9f, 0xef, 0xbe, 0xfc, 0xd1, 0x6e, 0xfd,
+	0x82, 0x9a, 0x4d, 0xb7, 0x37, 0x57, 0x37, 0xf4, 0x40, 0x6a, 0x6f, 0xbe, 0xb4, 0xb7, 0xea, 0x53,
+	0xa4, 0x01, 0x0b, 0xcf, 0x76, 0x76, 0x57, 0x5f, 0xec, 0x7c, 0xba, 0xb9, 0xe1, 0xac, 0x6f, 0x6f,
+	0xae, 0x3f, 0x7f, 0xf5, 0x72, 0x67, 0x77, 0xaf, 0x3e, 0xad, 0x30, 0x3f, 0xd8, 0x6f, 0xef, 0xed,
+	0x3c, 0xdb, 0x19, 0xc5, 0xcc, 0x90, 0x1a, 0x54, 0xda, 0x3f, 0xde, 0x5d, 0x77, 0xda, 0x7b, 0xab,
+	0x7b, 0xfb, 0xed, 0x7a, 0xd1, 0xfa, 0x53, 0x01, 0xca, 0xa9, 0x3f, 0xc7, 0xce, 0x51, 0x37, 0x4e,
+	0x3f, 0x05, 0x32, 0x93, 0x3c, 0x69, 0xc2, 0xe5, 0x28, 0x66, 0x7d, 0x9f, 0xf7, 0x84, 0x93, 0xa1,
+	0x9b, 0x46, 0xba, 0xf9, 0x04, 0xb5, 0x96, 0xd2, 0x3f, 0x84, 0x3a, 0xce, 0x4d, 0x8e, 0x8c, 0x69,
+	0x28, 0x7c, 0x99, 0x7c, 0x11, 0x4a, 0x76, 0x0d, 0xe1, 0x7b, 0x29, 0xd8, 0xfa, 0x76, 0x0a, 0x60,
+	0x18, 0x33, 0x62, 0xc1, 0x25, 0x1e, 0x78, 0x8e, 0x0a, 0x79, 0xf6, 0x55, 0x51, 0xe1, 0x81, 0xa7,
+	0x6e, 0x80, 0xd2, 0xb3, 0x34, 0x99, 0x07, 0x50, 0x42, 0xa3, 0xde, 0x48, 0x8a, 0x26, 0x64, 0xaf,
+	0x33, 0x72, 0xb4, 0xad, 0x95, 0x90, 0xbd, 0xce, 0xca, 0x49, 0x69, 0x50, 0x8e, 0x1e, 0x07, 0x13,
+	0x1a, 0x94, 0xf3, 0x0e, 0x2c, 0xb8, 0xbc, 0xdb, 0xe5, 0xa1, 0x43, 0x43, 0x97, 0x09, 0xc9, 0x63,
+	0x2d, 0xae, 0x88, 0xe2, 0x88, 0xc6, 0xad, 0x1a, 0x14, 0x4a, 0x1d, 0xc3, 0x81, 0xc2, 0xf5, 0x57,
+	0xec, 0x04, 0x07, 0xea, 0x58, 0x80, 0xa2, 0xc7, 0x22, 0xd9, 0x31, 0x93, 0xa2, 0x3e, 0x58, 0x1f,
+	0x42, 0xed, 0x44, 0x32, 0x2a, 0x42, 0x3d, 0x8e, 0xea, 0xd0, 0xe9, 0xc3, 0xb8, 0x19, 0xd8, 0x7a,
+	0x0c, 0xb5, 0x13, 0xf9, 0xa7, 0xbe, 0x71, 0x2a, 0x03, 0x55, 0xcd, 0x17, 0x30, 0x14, 0xc9, 0xd1,
+	0xfa, 0x75, 0x01, 0x16, 0x36, 0x65, 0x67, 0x79, 0x74, 0xa4, 0xea, 0x09, 0x35, 0xa8, 0x52, 0x57,
+	0xfa, 0x7d, 0xe6, 0xb0, 0xd0, 0xd3, 0xe5, 0xa3, 0x1b, 0x50, 0x55, 0x83, 0x37, 0x0d, 0x94, 0x6c,
+	0x43, 0x39, 0xa1, 0x48, 0x1a, 0xdf, 0xa3, 0xbc, 0xba, 0x50, 0x9a, 0x12, 0x46, 0x33, 0xf9, 0x0c,
+	0x99, 0xad, 0xbf, 0x17, 0x80, 0x9c, 0xa6, 0x50, 0xed, 0xec, 0x84, 0x09, 0xe9, 0x59, 0x7d, 0x38,
+	0xb4, 0x39, 0xa6, 0x09, 0x9a, 0x93, 0xba, 0x70, 0x87, 0xd1, 0x40, 0x76, 0x74, 0x07, 0x2c, 0xd9,
+	0xc9, 0x51, 0x65, 0x3b, 0x06, 0x1d, 0x53, 0xd9, 0x44, 0xbd, 0xac, 0x20, 0x98, 0xc1, 0x2a, 0x7b,
+	0x63, 0xf6, 0x85, 0x99, 0x45, 0xcd, 0x37, 0x49, 0xcf, 0x29, 0xb5, 0x14, 0x6e, 0x23, 0x38, 0x9d,
+	0xd3, 0xb0, 0x4b, 0x30, 0x2f, 0x19, 0x55, 0x14, 0x6c, 0x5d, 0x83, 0xac, 0x7f, 0x14, 0xe0, 0xda,
+	0x06, 0x8b, 0xb8, 0xf0, 0x65, 0x3b, 0xa4, 0x91, 0xe8, 0x64, 0x5e, 0xf0, 0x8b, 0x50, 0x12, 0x06,
+	0x66, 0x12, 0x3d, 0x3d, 0xab, 0x51, 0xc9, 0xd3, 0x6c, 0x66, 0xf0, 0xd2, 0x59, 0x3e, 0x67, 0x80,
+	0x7a, 0xf4, 0xba, 0x05, 0xc9, 0x79, 0x24, 0xcb, 0x0d, 0x0c, 0xf3, 0xf1, 0x1e, 0xd4, 0x98, 0xec,
+	0x2c, 0x9b, 0xba, 0xed, 0x50, 0xa1, 0x9f, 0x3d, 0x73, 0xf6, 0x25, 0x05, 0xc6, 0x1b, 0x6f, 0x53,
+	0xd1, 0x21, 0x8f, 0x60, 0x3e, 0x4b, 0xa7, 0xdf, 0x35, 0xfa, 0xdd, 0x53, 0x1b, 0x52, 0x22, 0xd8,
+	0xfa, 0xe3, 0x45, 0xb8, 0xa2, 0xa2, 0xa4, 0xe6, 0xc8, 0x4f, 0xb8, 0x64, 0xc3, 0x2f, 0xed, 0xff,
+	0x41, 0xa3, 0xcf, 0xa5, 0x7a, 0x3a, 0x44, 0x2c, 0xf6, 0xb9, 0xa7, 0x9a, 0x65, 0x2c, 0x9d, 0x4c,
+	0xc3, 0xb9, 0xa2, 0xf1, 0xaf, 0x10, 0xdd, 0x56, 0x58, 0x2c, 0x82, 0xbb, 0x50, 0xed, 0x2b, 0x49,
+	0x4e, 0xcc, 0xbe, 0xec, 0xf9, 0x31, 0xf3, 0xcc, 0x7d, 0x2f, 0xf5, 0xb5, 0x7c, 0x0d, 0x54, 0x9d,
+	0x88, 0xd1, 0x38, 0xf0, 0xd5, 0xfb, 0xa9, 0x4f, 0x03, 0xdf, 0x73, 0xa4, 0xdf, 0x65, 0x66, 0x90,
+	0x99, 0x4f, 0x50, 0x9f, 0x28, 0xcc, 0x9e, 0xdf, 0x65, 0xea, 0x56, 0x6a, 0x8c, 0x1c, 0xa5, 0xd6,
+	0x11, 0xaf, 0x69, 0xc4, 0x08, 0xed, 0xa1, 0x1f, 0xa7, 0xa4, 0x3a, 0x3b, 0x8c, 0x07, 0x10, 0x81,
+	0xa4, 0x3a, 0x47, 0x1e, 0x40, 0x1d, 0x03, 0x9f, 0x25, 0xd5, 0xc1, 0xaf, 0x2a, 0x78, 0x86, 0xf2,
+	0x73, 0xb8, 0x72, 0xc8, 0x83, 0x80, 0xbf, 0x76, 0x3c, 0x5f, 0x48, 0xd5, 0x13, 0x0c, 0xb9, 0x7e,
+	0x15, 0x3c, 0x3c, 0xab, 0x4e, 0x94, 0x7f, 0xd7, 0x69, 0xe8, 0xf9, 0x6a, 0xe4, 0xb7, 0x2f, 0x6b,
+	0x39, 0x1b, 0x46, 0x8c, 0x16, 0xbf, 0x03, 0xe0, 0x26, 0x14, 0xa2, 0x51, 0x3a, 0xfb, 0x39, 0x78,
+	0x5a, 0x66, 0x86, 0x99, 0x3c, 0x87, 0x79, 0xb7, 0x17, 0xab, 0xb7, 0xb2, 0x83, 0x99, 0x80, 0xaf,
+	0x89, 0x32, 0x5a, 0xb9, 0x94, 0xf3, 0x0a, 0x4b, 0x04, 0xda, 0x35, 0xc3, 0x99, 0x00, 0xc8, 0x13,
+	0x20, 0x21, 0x7b, 0x23, 0x9d, 0x28, 0xe6, 0x11, 0x17, 0x34, 0xd0, 0x29, 0x00, 0xe8, 0xa2, 0xba,
+	0xc2, 0xbc, 0x32, 0x08, 0x8c, 0x7e, 0x13, 0x2e, 0x67, 0xa8, 0xf1, 0x29, 0xe6, 0xb1, 0x37, 0x8d,
+	0x8a, 0x0e, 0xeb, 0x90, 0x5c, 0x3d, 0x77, 0x3c, 0xf6, 0x86, 0x7c, 0x04, 0x65, 0xa4, 0x57, 0xc9,
+	0xd1, 0x98, 0x9b, 0xcc, 0xc4, 0x92, 0xe2, 0x50, 0xd9, 0x4a, 0x3e, 0x85, 0x7a, 0xc4, 0x42, 0x4f,
+	0x65, 0xa9, 0xa9, 0x14, 0xd1, 0xb8, 0x84, 0x9e, 0x6b, 0xe5, 0x4f, 0x86, 0x48, 0x6f, 0x0a, 0x39,
+	0xdd, 0xc7, 0xd9, 0xb5, 0x68, 0x04, 0x81, 0x0d, 0x6c, 0xfe, 0x94, 0x9b, 0x95, 0xbd, 0x43, 0x97,
+	0x16, 0x26, 0xb4, 0x97, 0x25, 0xbe, 0x5c, 0x80, 0x22, 0x56, 0x41, 0xba, 0x26, 0x51, 0x07, 0x55,
+	0xfb, 0x23, 0xb5, 0xaa, 0x6b, 0xa0, 0x72, 0x30, 0xac, 0x53, 0xd5, 0x02, 0xb1, 0x77, 0x30, 0xcf,
+	0x7c, 0x7e, 0x93, 0xe3, 0x77, 0xe8, 0x71, 0xd6, 0x5f, 0x0a, 0x70, 0x2d, 0xe7, 0xfa, 0xca, 0x32,
+	0x1d, 0x29, 0xf3, 0x45, 0xc2, 0x83, 0xea, 0xaf, 0x51, 0xef, 0x20, 0xf0, 0x5d, 0xe7, 0x98, 0x0d,
+	0x92, 0x69, 0x42, 0x43, 0x9e, 0xb3, 0xc1, 0xf8, 0x4e, 0x33, 0x3d, 0xb6, 0xd3, 0xa8, 0xb6, 0xc0,
+	0x84, 0xf4, 0xbb, 0xea, 0xc9, 0x9b, 0xfd, 0x48, 0x5f, 0x4a, 0xa1, 0x2a, 0x7f, 0xac, 0x7d, 0xb8,
+	0xba, 0x46, 0xdd, 0xe3, 0x43, 0x3f, 0x08, 0xcc, 0x37, 0x25, 0x69, 0x48, 0x4b, 0x50, 0x09, 0xf8,
+	0x6b, 0xd5, 0x00, 0x32, 0x3d, 0x08, 0x34, 0x08, 0x53, 0x4f, 0x4d, 0xca, 0xbc, 0x1b, 0x05, 0x4c,
+	0x26, 0x1f, 0x90, 0xf4, 0xbc, 0xf2, 0xe7, 0x05, 0x28, 0xe2, 0x83, 0x9f, 0xfc, 0xbc, 0x00, 0xd5,
+	0x2d, 0x26, 0x33, 0xbb, 0x55, 0x92, 0xfb, 0x85, 0x3b, 0xbd, 0x80, 0x5d, 0xbc, 0x9d, 0x3b, 0x25,
+	0x0e, 0x17, 0xa4, 0xd6, 0xad, 0x6f, 0xfe, 0xf9, 0xaf, 0xdf, 0x4f, 0xbd, 0x45, 0xfe, 0xa7, 0x35,
+	0xb2, 0xa5, 0xc6, 0xc5, 0x77, 0x0b, 0x77, 0x22, 0xe4, 0x0d, 0x94, 0x94, 0x15, 0x58, 0xf9, 0x77,
+	0x72, 0xf5, 0x67, 0x76, 0xb4, 0xdf, 0x83, 0x66, 0x0c, 0x13, 0xf9, 0x09, 0xd4, 0xda, 0x4c, 0x66,
+	0x37, 0xad, 0xe4, 0xf1, 0x77, 0xd8, 0xc7, 0x2e, 0x5e, 0x6d, 0xea, 0xfd, 0x78, 0x33, 0xd9, 0x8f,
+	0x37, 0x37, 0xbb, 0x91, 0x1c, 0x58, 0xb7, 0x51, 0xf5, 0x0d, 0xeb, 0xad, 0x71, 0xaa, 0x03, 0x2d,
+	0x88, 0xfc, 0xb6, 0x00, 0xd7, 0xb6, 0x98, 0x1c, 0xb7, 0x83, 0x24, 0x39, 0x82, 0x17, 0xdf, 0xfb,
+	0x6f, 0x36, 0x99, 0xd6, 0x3d, 0x34, 0xe7, 0x26, 0x79, 0x7b, 0x9c, 0x39, 0x87, 0x3c, 0x3e, 0x76,
+	0xb5, 0xd6, 0x18, 0xca, 0x2f, 0x7c, 0x81, 0x2f, 0x5b, 0x91, 0x6b, 0xc2, 0xa3, 0x89, 0x97, 0x48,
+	0xe2, 0xec, 0x10, 0xe0, 0x3b, 0x94, 0x7c, 0x05, 0xb3, 0xca, 0x09, 0x8c, 0xc5, 0xc4, 0x3a, 0x63,
+	0xc1, 0x96, 0x78, 0x7c, 0xf2, 0xa5, 0xa0, 0x75, 0x13, 0x95, 0x2f, 0x92, 0x46, 0x9e, 0x72, 0xf2,
+	0x6d, 0x01, 0xea, 0x5b, 0x4c, 0x8e, 0xfc, 0x11, 0x41, 0x9e, 0xe4, 0x69, 0x18, 0xf7, 0x5f, 0xc7,
+	0xe2, 0xd3, 0x09, 0xa9, 0x8d, 0x4d, 0x77, 0xd1, 0xa6, 0x25, 0x72, 0x63, 0x9c, 0x4d, 0x7e, 0xda,
+	0x81, 0x7e, 0x0a, 0xd5, 0x55, 0xcf, 0xcb, 0xec, 0x68, 0xf2, 0xeb, 0xf2, 0xf4, 0xe2, 0x27, 0x37,
+	0x2b, 0x1f, 0xa2, 0xf2, 0xdb, 0xd6, 0xad, 0xdc, 0x68, 0xb4, 0xa4, 0x96, 0x46, 0xbe, 0x82, 0x79,
+	0x9b, 0x75, 0x79, 0x9f, 0x65, 0x6d, 0x98, 0x24, 0x3e, 0xe7, 0xe8, 0x7e, 0x34, 0x81, 0xee, 0x9f,
+	0x15, 0xa0, 0xae, 0xd2, 0x30, 0xbb, 0xa2, 0xca, 0xcd, 0xc6, 0x27, 0x13, 0xf8, 0x25, 0xed, 0x9b,
+	0x89, 0x15, 0x64, 0x02, 0x2b, 0x22, 0x98, 0x35, 0xdb, 0x2e, 0x92, 0xbb, 0x59, 0x19, 0x5d, 0x87,
+	0xe5, 0xde, 0xdd, 0x04, 0xdd, 0xba, 0x91, 0xaf, 0xf5, 0x80, 0x86, 0x44, 0x42, 0x79, 0x3f, 0x3c,
+	0xf8, 0x9e, 0x74, 0xde, 0x47, 0x9d, 0xb7, 0xac, 0xa5, 0x7c, 0x9d, 0x3d, 0xa5, 0x8c, 0xbc, 0x81,
+	0xb9, 0xa4, 0xe6, 0xd7, 0x68, 0x98, 0xef, 0xe8, 0x07, 0xe7, 0xac, 0xc8, 0xc4, 0x64, 0xdd, 0x26,
+	0xbd, 0xae, 0x20, 0xbf, 0x28, 0x40, 0x75, 0x74, 0x9d, 0x46, 0x72, 0xab, 0x69, 0xec, 0xda, 0x2d,
+	0xf7, 0xf2, 0x4f, 0xd0, 0x82, 0x7b, 0xd6, 0x9d, 0x7c, 0x0b, 0xbc, 0x54, 0x20, 0xf9, 0x43, 0x01,
+	0xaa, 0xca, 0x05, 0x99, 0x55, 0xd8, 0xc3, 0x73, 0x17, 0x5c, 0xc9, 0xd2, 0x2e, 0xbf, 0x1d, 0x9e,
+	0xde, 0xd8, 0x25, 0x76, 0x91, 0x33, 0xec, 0x8a, 0xa9, 0x64, 0x81, 0x36, 0xa2, 0x0d, 0xf3, 0x6d,
+	0x19, 0x33, 0xda, 0x1d, 0xae, 0x9c, 0xf2, 0xc3, 0x63, 0x9d, 0xbf, 0xaf, 0xb2, 0x2e, 0xbc, 0x53,
+	0x20, 0xbf, 0xd1, 0x1f, 0x9d, 0xb1, 0x2f, 0xe3, 0xef, 0x5c, 0x63, 0xe3, 0xa4, 0x58, 0x8f, 0xf1,
+	0x92, 0x77, 0xc9, 0xed, 0x71, 0x97, 0x54, 0x93, 0x50, 0x6b, 0xf8, 0xa7, 0x86, 0x32, 0x87, 0x6c,
+	0x31, 0x79, 0xe2, 0x29, 0x99, 0x6b, 0x49, 0x2b, 0xbf, 0xfb, 0x8f, 0x7d, 0x8b, 0x5a, 0x4f, 0xd1,
+	0x98, 0xfb, 0xe4, 0xee, 0x38, 0x63, 0x92, 0x31, 0xb9, 0x95, 0x3e, 0x4f, 0xbf, 0xd6, 0x1f, 0x84,
+	0x91, 0x57, 0x60, 0xae, 0x31, 0x4f, 0xcf, 0x7b, 0x90, 0x8c, 0x3c, 0x22, 0xcf, 0x2e, 0x0b, 0xf4,
+	0x8b, 0x9e, 0x80, 0xbf, 0x2e, 0xc0, 0xbc, 0x1a, 0x87, 0x46, 0x26, 0xbf, 0x5c, 0x23, 0x9a, 0xf9,
+	0x7d, 0x62, 0xdc, 0xe4, 0x68, 0xdd, 0x41, 0x2b, 0xde, 0x26, 0xd7, 0xc7, 0x0e, 0x45, 0x86, 0xe7,
+	0xe0, 0x22, 0x6a, 0x79, 0xf7, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xaf, 0xdf, 0x77, 0x72, 0xb4,
+	0x20, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// DebugClient is the client API for Debug service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DebugClient interface {
+	GetBeaconState(ctx context.Context, in *BeaconStateRequest, opts ...grpc.CallOption) (*SSZResponse, error)
+	GetBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*SSZResponse, error)
+	SetLoggingLevel(ctx context.Context, in *LoggingLevelRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	GetProtoArrayForkChoice(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ProtoArrayForkChoiceResponse, error)
+	ListPeers(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*DebugPeerResponses, error)
+	GetPeer(ctx context.Context, in *v1alpha1.PeerRequest, opts ...grpc.CallOption) (*DebugPeerResponse, error)
+	GetInclusionSlot(ctx context.Context, in *InclusionSlotRequest, opts ...grpc.CallOption) (*InclusionSlotResponse, error)
+	AddTrustedPeer(ctx context.Context, in *TrustedPeerRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	RemoveTrustedPeer(ctx context.Context, in *v1alpha1.PeerRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	ListTrustedPeers(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*TrustedPeersResponse, error)
+	BanPeer(ctx context.Context, in *BanPeerRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	UnbanPeer(ctx context.Context, in *BanPeerRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	ListPeerBans(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*PeerBansResponse, error)
+	DisconnectPeer(ctx context.Context, in *DisconnectPeerRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	ListRateLimits(ctx context.Context, in *RateLimitsRequest, opts ...grpc.CallOption) (*RateLimitsResponse, error)
+	StreamChainEvents(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (Debug_StreamChainEventsClient, error)
+	GetEth1ConnectionStatus(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*Eth1ConnectionStatus, error)
+	GetDepositSnapshot(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*DepositSnapshotResponse, error)
+	GetEth1DataVotes(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*Eth1DataVotesResponse, error)
+	GetBackfillStatus(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*BackfillStatusResponse, error)
+}
+
+type debugClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDebugClient(cc grpc.ClientConnInterface) DebugClient {
+	return &debugClient{cc}
+}
+
+func (c *debugClient) GetBeaconState(ctx context.Context, in *BeaconStateRequest, opts ...grpc.CallOption) (*SSZResponse, error) {
+	out := new(SSZResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetBeaconState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) GetBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*SSZResponse, error) {
+	out := new(SSZResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) SetLoggingLevel(ctx context.Context, in *LoggingLevelRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/SetLoggingLevel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) GetProtoArrayForkChoice(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ProtoArrayForkChoiceResponse, error) {
+	out := new(ProtoArrayForkChoiceResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetProtoArrayForkChoice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) ListPeers(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*DebugPeerResponses, error) {
+	out := new(DebugPeerResponses)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/ListPeers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) GetPeer(ctx context.Context, in *v1alpha1.PeerRequest, opts ...grpc.CallOption) (*DebugPeerResponse, error) {
+	out := new(DebugPeerResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetPeer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) GetInclusionSlot(ctx context.Context, in *InclusionSlotRequest, opts ...grpc.CallOption) (*InclusionSlotResponse, error) {
+	out := new(InclusionSlotResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetInclusionSlot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) AddTrustedPeer(ctx context.Context, in *TrustedPeerRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/AddTrustedPeer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) RemoveTrustedPeer(ctx context.Context, in *v1alpha1.PeerRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/RemoveTrustedPeer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) ListTrustedPeers(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*TrustedPeersResponse, error) {
+	out := new(TrustedPeersResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/ListTrustedPeers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) BanPeer(ctx context.Context, in *BanPeerRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/BanPeer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) UnbanPeer(ctx context.Context, in *BanPeerRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/UnbanPeer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) ListPeerBans(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*PeerBansResponse, error) {
+	out := new(PeerBansResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/ListPeerBans", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) DisconnectPeer(ctx context.Context, in *DisconnectPeerRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/DisconnectPeer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) ListRateLimits(ctx context.Context, in *RateLimitsRequest, opts ...grpc.CallOption) (*RateLimitsResponse, error) {
+	out := new(RateLimitsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/ListRateLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) StreamChainEvents(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (Debug_StreamChainEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Debug_serviceDesc.Streams[0], "/ethereum.beacon.rpc.v1.Debug/StreamChainEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &debugStreamChainEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Debug_StreamChainEventsClient interface {
+	Recv() (*ChainEvent, error)
+	grpc.ClientStream
+}
+
+type debugStreamChainEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *debugStreamChainEventsClient) Recv() (*ChainEvent, error) {
+	m := new(ChainEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *debugClient) GetEth1ConnectionStatus(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*Eth1ConnectionStatus, error) {
+	out := new(Eth1ConnectionStatus)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetEth1ConnectionStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) GetDepositSnapshot(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*DepositSnapshotResponse, error) {
+	out := new(DepositSnapshotResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetDepositSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) GetEth1DataVotes(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*Eth1DataVotesResponse, error) {
+	out := new(Eth1DataVotesResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetEth1DataVotes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) GetBackfillStatus(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*BackfillStatusResponse, error) {
+	out := new(BackfillStatusResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.Debug/GetBackfillStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DebugServer is the server API for Debug service.
+type DebugServer interface {
+	GetBeaconState(context.Context, *BeaconStateRequest) (*SSZResponse, error)
+	GetBlock(context.Context, *BlockRequest) (*SSZResponse, error)
+	SetLoggingLevel(context.Context, *LoggingLevelRequest) (*empty.Empty, error)
+	GetProtoArrayForkChoice(context.Context, *empty.Empty) (*ProtoArrayForkChoiceResponse, error)
+	ListPeers(context.Context, *empty.Empty) (*DebugPeerResponses, error)
+	GetPeer(context.Context, *v1alpha1.PeerRequest) (*DebugPeerResponse, error)
+	GetInclusionSlot(context.Context, *InclusionSlotRequest) (*InclusionSlotResponse, error)
+	AddTrustedPeer(context.Context, *TrustedPeerRequest) (*empty.Empty, error)
+	RemoveTrustedPeer(context.Context, *v1alpha1.PeerRequest) (*empty.Empty, error)
+	ListTrustedPeers(context.Context, *empty.Empty) (*TrustedPeersResponse, error)
+	BanPeer(context.Context, *BanPeerRequest) (*empty.Empty, error)
+	UnbanPeer(context.Context, *BanPeerRequest) (*empty.Empty, error)
+	ListPeerBans(context.Context, *empty.Empty) (*PeerBansResponse, error)
+	DisconnectPeer(context.Context, *DisconnectPeerRequest) (*empty.Empty, error)
+	ListRateLimits(context.Context, *RateLimitsRequest) (*RateLimitsResponse, error)
+	StreamChainEvents(*empty.Empty, Debug_StreamChainEventsServer) error
+	GetEth1ConnectionStatus(context.Context, *empty.Empty) (*Eth1ConnectionStatus, error)
+	GetDepositSnapshot(context.Context, *empty.Empty) (*DepositSnapshotResponse, error)
+	GetEth1DataVotes(context.Context, *empty.Empty) (*Eth1DataVotesResponse, error)
+	GetBackfillStatus(context.Context, *empty.Empty) (*BackfillStatusResponse, error)
+}
+
+// UnimplementedDebugServer can be embedded to have forward compatible implementations.
+type UnimplementedDebugServer struct {
+}
+
+func (*UnimplementedDebugServer) GetBeaconState(ctx context.Context, req *BeaconStateRequest) (*SSZResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBeaconState not implemented")
+}
+func (*UnimplementedDebugServer) GetBlock(ctx context.Context, req *BlockRequest) (*SSZResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlock not implemented")
+}
+func (*UnimplementedDebugServer) SetLoggingLevel(ctx context.Context, req *LoggingLevelRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLoggingLevel not implemented")
+}
+func (*UnimplementedDebugServer) GetProtoArrayForkChoice(ctx context.Context, req *empty.Empty) (*ProtoArrayForkChoiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoArrayForkChoice not implemented")
+}
+func (*UnimplementedDebugServer) ListPeers(ctx context.Context, req *empty.Empty) (*DebugPeerResponses, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPeers not implemented")
+}
+func (*UnimplementedDebugServer) GetPeer(ctx context.Context, req *v1alpha1.PeerRequest) (*DebugPeerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPeer not implemented")
+}
+func (*UnimplementedDebugServer) GetInclusionSlot(ctx context.Context, req *InclusionSlotRequest) (*InclusionSlotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInclusionSlot not implemented")
+}
+func (*UnimplementedDebugServer) AddTrustedPeer(ctx context.Context, req *TrustedPeerRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTrustedPeer not implemented")
+}
+func (*UnimplementedDebugServer) RemoveTrustedPeer(ctx context.Context, req *v1alpha1.PeerRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTrustedPeer not implemented")
+}
+func (*UnimplementedDebugServer) ListTrustedPeers(ctx context.Context, req *empty.Empty) (*TrustedPeersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrustedPeers not implemented")
+}
+func (*UnimplementedDebugServer) BanPeer(ctx context.Context, req *BanPeerRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BanPeer not implemented")
+}
+func (*UnimplementedDebugServer) UnbanPeer(ctx context.Context, req *BanPeerRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbanPeer not implemented")
+}
+func (*UnimplementedDebugServer) ListPeerBans(ctx context.Context, req *empty.Empty) (*PeerBansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPeerBans not implemented")
+}
+func (*UnimplementedDebugServer) DisconnectPeer(ctx context.Context, req *DisconnectPeerRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisconnectPeer not implemented")
+}
+func (*UnimplementedDebugServer) ListRateLimits(ctx context.Context, req *RateLimitsRequest) (*RateLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRateLimits not implemented")
+}
+func (*UnimplementedDebugServer) StreamChainEvents(req *empty.Empty, srv Debug_StreamChainEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamChainEvents not implemented")
+}
+func (*UnimplementedDebugServer) GetEth1ConnectionStatus(ctx context.Context, req *empty.Empty) (*Eth1ConnectionStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEth1ConnectionStatus not implemented")
+}
+func (*UnimplementedDebugServer) GetDepositSnapshot(ctx context.Context, req *empty.Empty) (*DepositSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDepositSnapshot not implemented")
+}
+func (*UnimplementedDebugServer) GetEth1DataVotes(ctx context.Context, req *empty.Empty) (*Eth1DataVotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEth1DataVotes not implemented")
+}
+func (*UnimplementedDebugServer) GetBackfillStatus(ctx context.Context, req *empty.Empty) (*BackfillStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBackfillStatus not implemented")
+}
+
+func RegisterDebugServer(s *grpc.Server, srv DebugServer) {
+	s.RegisterService(&_Debug_serviceDesc, srv)
+}
+
+func _Debug_GetBeaconState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BeaconStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).GetBeaconState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetBeaconState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).GetBeaconState(ctx, req.(*BeaconStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_GetBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).GetBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).GetBlock(ctx, req.(*BlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_SetLoggingLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoggingLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).SetLoggingLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/SetLoggingLevel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).SetLoggingLevel(ctx, req.(*LoggingLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_GetProtoArrayForkChoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).GetProtoArrayForkChoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetProtoArrayForkChoice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).GetProtoArrayForkChoice(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_ListPeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
 	if interceptor == nil {
 		return srv.(DebugServer).ListPeers(ctx, in)
@@ -1074,6 +2751,243 @@ func _Debug_GetInclusionSlot_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Debug_AddTrustedPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrustedPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).AddTrustedPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/AddTrustedPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).AddTrustedPeer(ctx, req.(*TrustedPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_RemoveTrustedPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.PeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).RemoveTrustedPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/RemoveTrustedPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).RemoveTrustedPeer(ctx, req.(*v1alpha1.PeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_ListTrustedPeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).ListTrustedPeers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/ListTrustedPeers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).ListTrustedPeers(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_BanPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BanPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).BanPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/BanPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).BanPeer(ctx, req.(*BanPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_UnbanPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BanPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).UnbanPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/UnbanPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).UnbanPeer(ctx, req.(*BanPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_ListPeerBans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).ListPeerBans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/ListPeerBans",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).ListPeerBans(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_DisconnectPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisconnectPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).DisconnectPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/DisconnectPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).DisconnectPeer(ctx, req.(*DisconnectPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_ListRateLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RateLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).ListRateLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/ListRateLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).ListRateLimits(ctx, req.(*RateLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_StreamChainEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(empty.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DebugServer).StreamChainEvents(m, &debugStreamChainEventsServer{stream})
+}
+
+type Debug_StreamChainEventsServer interface {
+	Send(*ChainEvent) error
+	grpc.ServerStream
+}
+
+type debugStreamChainEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *debugStreamChainEventsServer) Send(m *ChainEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Debug_GetEth1ConnectionStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).GetEth1ConnectionStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetEth1ConnectionStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).GetEth1ConnectionStatus(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_GetDepositSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).GetDepositSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetDepositSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).GetDepositSnapshot(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_GetEth1DataVotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).GetEth1DataVotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetEth1DataVotes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).GetEth1DataVotes(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_GetBackfillStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).GetBackfillStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.Debug/GetBackfillStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).GetBackfillStatus(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Debug_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethereum.beacon.rpc.v1.Debug",
 	HandlerType: (*DebugServer)(nil),
@@ -1106,7 +3020,61 @@ var _Debug_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetInclusionSlot",
 			Handler:    _Debug_GetInclusionSlot_Handler,
 		},
+		{
+			MethodName: "AddTrustedPeer",
+			Handler:    _Debug_AddTrustedPeer_Handler,
+		},
+		{
+			MethodName: "RemoveTrustedPeer",
+			Handler:    _Debug_RemoveTrustedPeer_Handler,
+		},
+		{
+			MethodName: "ListTrustedPeers",
+			Handler:    _Debug_ListTrustedPeers_Handler,
+		},
+		{
+			MethodName: "BanPeer",
+			Handler:    _Debug_BanPeer_Handler,
+		},
+		{
+			MethodName: "UnbanPeer",
+			Handler:    _Debug_UnbanPeer_Handler,
+		},
+		{
+			MethodName: "ListPeerBans",
+			Handler:    _Debug_ListPeerBans_Handler,
+		},
+		{
+			MethodName: "DisconnectPeer",
+			Handler:    _Debug_DisconnectPeer_Handler,
+		},
+		{
+			MethodName: "ListRateLimits",
+			Handler:    _Debug_ListRateLimits_Handler,
+		},
+		{
+			MethodName: "GetEth1ConnectionStatus",
+			Handler:    _Debug_GetEth1ConnectionStatus_Handler,
+		},
+		{
+			MethodName: "GetDepositSnapshot",
+			Handler:    _Debug_GetDepositSnapshot_Handler,
+		},
+		{
+			MethodName: "GetEth1DataVotes",
+			Handler:    _Debug_GetEth1DataVotes_Handler,
+		},
+		{
+			MethodName: "GetBackfillStatus",
+			Handler:    _Debug_GetBackfillStatus_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamChainEvents",
+			Handler:       _Debug_StreamChainEvents_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/beacon/rpc/v1/debug.proto",
 }
@@ -0,0 +1,566 @@
+// Package backup writes, validates, prunes and restores backups of bolt databases. A backup is
+// either full, holding a copy of every bucket of the database, or incremental, holding only the
+// buckets which changed since a parent backup. Every backup records a manifest with a checksum
+// of each bucket of the database at the time of the backup, which is used to find the buckets
+// changed since the parent backup and to validate a backup before it is restored.
+package backup
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	// DirectoryName is the name of the directory next to a database which its backups are
+	// written to.
+	DirectoryName = "backups"
+	// FileExtension is the extension of backup files.
+	FileExtension = ".backup"
+
+	timestampFormat = "20060102T150405Z"
+)
+
+var (
+	metadataBucket = []byte("prysm-backup-metadata")
+	manifestKey    = []byte("manifest")
+)
+
+var log = logrus.WithField("prefix", "backup")
+
+// manifest describes the content of a backup.
+type manifest struct {
+	// Parent is the file name of the backup an incremental backup builds upon. It is empty for
+	// full backups.
+	Parent string `json:"parent,omitempty"`
+	// Buckets maps the hex encoded name of every bucket of the database to the hex encoded
+	// checksum of its content.
+	Buckets map[string]string `json:"buckets"`
+}
+
+// FileName returns the name of a scheduled backup written at the given time.
+// Example: prysm_beacondb_20200916T134501Z.backup
+func FileName(prefix string, t time.Time) string {
+	return fmt.Sprintf("%s_%s%s", prefix, t.UTC().Format(timestampFormat), FileExtension)
+}
+
+// List returns the paths of the scheduled backups with the given prefix in dir, oldest first.
+func List(dir, prefix string) ([]string, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, prefix+"_[0-9]*Z"+FileExtension))
+	if err != nil {
+		return nil, err
+	}
+	// Timestamps are zero padded, so lexical order is chronological order.
+	sort.Strings(paths)
+	return paths, nil
+}
+
+// Write writes a backup of db to path. When parentPath is not empty, only the buckets which
+// changed since the backup at parentPath are written, and the parent backup must be kept in the
+// same directory in order to restore the new backup. The backup is written under a temporary
+// name first, so an interrupted backup never leaves a partial file behind.
+func Write(ctx context.Context, db *bolt.DB, path, parentPath string) error {
+	var parent *manifest
+	if parentPath != "" {
+		if filepath.Dir(parentPath) != filepath.Dir(path) {
+			return errors.New("parent backup must be in the same directory as the backup")
+		}
+		if filepath.Base(parentPath) == filepath.Base(path) {
+			return errors.New("backup cannot be its own parent")
+		}
+		m, err := readManifest(parentPath)
+		if err != nil {
+			return errors.Wrap(err, "could not read parent backup")
+		}
+		parent = m
+	}
+
+	tmpPath := path + ".tmp"
+	if err := os.Remove(tmpPath); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	copyDB, err := bolt.Open(tmpPath, params.BeaconIoConfig().ReadWritePermissions, &bolt.Options{Timeout: params.BeaconIoConfig().BoltTimeout})
+	if err != nil {
+		return err
+	}
+	err = db.View(func(tx *bolt.Tx) error {
+		return writeBackup(ctx, tx, copyDB, parent, parentPath)
+	})
+	if closeErr := copyDB.Close(); closeErr != nil && err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		if removeErr := os.Remove(tmpPath); removeErr != nil {
+			log.WithError(removeErr).Error("Failed to remove partial backup")
+		}
+		return err
+	}
+	return os.Rename(tmpPath, path)
+}
+
+// writeBackup copies the buckets of tx which differ from the parent backup, or every bucket if
+// there is no parent, to dst and records the manifest of the backup.
+func writeBackup(ctx context.Context, tx *bolt.Tx, dst *bolt.DB, parent *manifest, parentPath string) error {
+	m := &manifest{Buckets: make(map[string]string)}
+	if parent != nil {
+		m.Parent = filepath.Base(parentPath)
+	}
+	if err := tx.ForEach(func(name []byte, b *bolt.Bucket) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if string(name) == string(metadataBucket) {
+			return nil
+		}
+		key := hex.EncodeToString(name)
+		m.Buckets[key] = checksum(b)
+		if parent != nil && parent.Buckets[key] == m.Buckets[key] {
+			return nil
+		}
+		log.Debugf("Copying bucket %s", name)
+		return dst.Update(func(dstTx *bolt.Tx) error {
+			dstBucket, err := dstTx.CreateBucket(name)
+			if err != nil {
+				return err
+			}
+			return copyBucket(b, dstBucket)
+		})
+	}); err != nil {
+		return err
+	}
+	enc, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	return dst.Update(func(dstTx *bolt.Tx) error {
+		b, err := dstTx.CreateBucket(metadataBucket)
+		if err != nil {
+			return err
+		}
+		return b.Put(manifestKey, enc)
+	})
+}
+
+// Validate checks the integrity of the backup at path and of every backup it builds upon, and
+// returns the paths of the backups to apply in order to restore it, starting with a full backup.
+func Validate(path string) ([]string, error) {
+	var chain []string
+	seen := make(map[string]bool)
+	for p := path; ; {
+		if seen[p] {
+			return nil, fmt.Errorf("backup %s builds upon itself", p)
+		}
+		seen[p] = true
+		m, err := validateFile(p)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid backup %s", p)
+		}
+		chain = append([]string{p}, chain...)
+		if m.Parent == "" {
+			return chain, nil
+		}
+		p = filepath.Join(filepath.Dir(p), m.Parent)
+	}
+}
+
+// validateFile checks the bolt pages of a single backup file and the checksums of the buckets it
+// holds against its manifest.
+func validateFile(path string) (*manifest, error) {
+	if _, err := os.Stat(path); err != nil {
+		return nil, err
+	}
+	db, err := bolt.Open(path, params.BeaconIoConfig().ReadWritePermissions, &bolt.Options{Timeout: params.BeaconIoConfig().BoltTimeout, ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := db.Close(); err != nil {
+			log.WithError(err).Error("Failed to close backup")
+		}
+	}()
+
+	var m *manifest
+	err = db.View(func(tx *bolt.Tx) error {
+		// The check must be drained, as it keeps reading pages of the transaction.
+		var checkErr error
+		for err := range tx.Check() {
+			if checkErr == nil {
+				checkErr = err
+			}
+		}
+		if checkErr != nil {
+			return errors.Wrap(checkErr, "corrupted backup")
+		}
+		var err error
+		m, err = decodeManifest(tx)
+		if err != nil {
+			return err
+		}
+		found := make(map[string]bool)
+		if err := tx.ForEach(func(name []byte, b *bolt.Bucket) error {
+			if string(name) == string(metadataBucket) {
+				return nil
+			}
+			key := hex.EncodeToString(name)
+			found[key] = true
+			want, ok := m.Buckets[key]
+			if !ok {
+				return fmt.Errorf("bucket %s is missing from the manifest", name)
+			}
+			if checksum(b) != want {
+				return fmt.Errorf("bucket %s does not match its checksum", name)
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		if m.Parent != "" {
+			return nil
+		}
+		for key := range m.Buckets {
+			if !found[key] {
+				return fmt.Errorf("full backup is missing bucket %s", key)
+			}
+		}
+		return nil
+	})
+	return m, err
+}
+
+// Restore rebuilds the database backed up at path and swaps it in at dbPath. The backup is
+// validated first, and the rebuilt database is passed to check before it replaces the current
+// database. The rebuilt database is written in a restore directory next to dbPath, under the
+// same file name, and the replaced database is kept next to dbPath with a .pre-restore suffix.
+func Restore(ctx context.Context, path, dbPath string, check func(restoredPath string) error) error {
+	if err := ensureUnused(dbPath); err != nil {
+		return err
+	}
+	chain, err := Validate(path)
+	if err != nil {
+		return err
+	}
+	want, err := readManifest(path)
+	if err != nil {
+		return err
+	}
+
+	restoreDir := filepath.Join(filepath.Dir(dbPath), "restore")
+	if err := os.RemoveAll(restoreDir); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(restoreDir, params.BeaconIoConfig().ReadWriteExecutePermissions); err != nil {
+		return err
+	}
+	restoredPath := filepath.Join(restoreDir, filepath.Base(dbPath))
+	if err := rebuild(ctx, chain, want, restoredPath); err != nil {
+		return errors.Wrap(err, "could not rebuild database from backup")
+	}
+	if check != nil {
+		if err := check(restoredPath); err != nil {
+			return errors.Wrap(err, "restored database failed validation")
+		}
+	}
+
+	if _, err := os.Stat(dbPath); err == nil {
+		if err := os.Rename(dbPath, dbPath+".pre-restore"); err != nil {
+			return err
+		}
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+	if err := os.Rename(restoredPath, dbPath); err != nil {
+		return err
+	}
+	return os.RemoveAll(restoreDir)
+}
+
+// rebuild applies the chain of backups, starting with a full backup, to a new database at
+// restoredPath and checks the result against the manifest of the last backup.
+func rebuild(ctx context.Context, chain []string, want *manifest, restoredPath string) error {
+	restored, err := bolt.Open(restoredPath, params.BeaconIoConfig().ReadWritePermissions, &bolt.Options{Timeout: params.BeaconIoConfig().BoltTimeout})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := restored.Close(); err != nil {
+			log.WithError(err).Error("Failed to close restored database")
+		}
+	}()
+
+	for _, p := range chain {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		log.WithField("backup", p).Info("Applying backup")
+		if err := applyBackup(p, restored); err != nil {
+			return errors.Wrapf(err, "could not apply backup %s", p)
+		}
+	}
+	return restored.Update(func(tx *bolt.Tx) error {
+		// Drop the buckets which no longer existed when the last backup was written.
+		var stale [][]byte
+		if err := tx.ForEach(func(name []byte, b *bolt.Bucket) error {
+			sum, ok := want.Buckets[hex.EncodeToString(name)]
+			if !ok {
+				stale = append(stale, append([]byte{}, name...))
+				return nil
+			}
+			if checksum(b) != sum {
+				return fmt.Errorf("restored bucket %s does not match its checksum", name)
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		for _, name := range stale {
+			if err := tx.DeleteBucket(name); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// applyBackup replaces the buckets of dst with the buckets held by the backup at path.
+func applyBackup(path string, dst *bolt.DB) error {
+	src, err := bolt.Open(path, params.BeaconIoConfig().ReadWritePermissions, &bolt.Options{Timeout: params.BeaconIoConfig().BoltTimeout, ReadOnly: true})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := src.Close(); err != nil {
+			log.WithError(err).Error("Failed to close backup")
+		}
+	}()
+	return src.View(func(tx *bolt.Tx) error {
+		return tx.ForEach(func(name []byte, b *bolt.Bucket) error {
+			if string(name) == string(metadataBucket) {
+				return nil
+			}
+			return dst.Update(func(dstTx *bolt.Tx) error {
+				if dstTx.Bucket(name) != nil {
+					if err := dstTx.DeleteBucket(name); err != nil {
+						return err
+					}
+				}
+				dstBucket, err := dstTx.CreateBucket(name)
+				if err != nil {
+					return err
+				}
+				return copyBucket(b, dstBucket)
+			})
+		})
+	})
+}
+
+// Prune removes the scheduled backups with the given prefix in dir, except for the retain most
+// recent ones and the backups they build upon.
+func Prune(dir, prefix string, retain int) error {
+	paths, err := List(dir, prefix)
+	if err != nil {
+		return err
+	}
+	if retain < 1 {
+		retain = 1
+	}
+	if len(paths) <= retain {
+		return nil
+	}
+	keep := make(map[string]bool)
+	for _, p := range paths[len(paths)-retain:] {
+		for cur := p; cur != "" && !keep[cur]; {
+			keep[cur] = true
+			m, err := readManifest(cur)
+			if err != nil {
+				return errors.Wrapf(err, "could not read backup %s", cur)
+			}
+			cur = ""
+			if m.Parent != "" {
+				cur = filepath.Join(dir, m.Parent)
+			}
+		}
+	}
+	for _, p := range paths {
+		if keep[p] {
+			continue
+		}
+		if err := os.Remove(p); err != nil {
+			return err
+		}
+		log.WithField("backup", p).Debug("Removed old backup")
+	}
+	return nil
+}
+
+// chainLength returns the number of backups to apply in order to restore the backup at path.
+func chainLength(path string) (int, error) {
+	n := 0
+	for cur := path; cur != ""; n++ {
+		m, err := readManifest(cur)
+		if err != nil {
+			return 0, err
+		}
+		cur = ""
+		if m.Parent != "" {
+			cur = filepath.Join(filepath.Dir(path), m.Parent)
+		}
+	}
+	return n, nil
+}
+
+// CheckDatabase checks the consistency of the pages of the bolt database at path and that it holds
+// every bucket in buckets. It is used to validate databases rebuilt from a backup which have no
+// more specific consistency checks.
+func CheckDatabase(path string, buckets ...[]byte) error {
+	db, err := bolt.Open(path, params.BeaconIoConfig().ReadWritePermissions, &bolt.Options{Timeout: params.BeaconIoConfig().BoltTimeout, ReadOnly: true})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := db.Close(); err != nil {
+			log.WithError(err).Error("Failed to close database")
+		}
+	}()
+	return db.View(func(tx *bolt.Tx) error {
+		for err := range tx.Check() {
+			return errors.Wrap(err, "database is corrupted")
+		}
+		for _, name := range buckets {
+			if tx.Bucket(name) == nil {
+				return fmt.Errorf("database has no %s bucket", name)
+			}
+		}
+		return nil
+	})
+}
+
+// ensureUnused returns an error if the database at dbPath is opened by another process.
+func ensureUnused(dbPath string) error {
+	if _, err := os.Stat(dbPath); os.IsNotExist(err) {
+		return nil
+	}
+	db, err := bolt.Open(dbPath, params.BeaconIoConfig().ReadWritePermissions, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		if err == bolt.ErrTimeout {
+			return errors.New("cannot obtain database lock, database may be in use by another process")
+		}
+		return err
+	}
+	return db.Close()
+}
+
+func readManifest(path string) (*manifest, error) {
+	db, err := bolt.Open(path, params.BeaconIoConfig().ReadWritePermissions, &bolt.Options{Timeout: params.BeaconIoConfig().BoltTimeout, ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := db.Close(); err != nil {
+			log.WithError(err).Error("Failed to close backup")
+		}
+	}()
+	var m *manifest
+	err = db.View(func(tx *bolt.Tx) error {
+		m, err = decodeManifest(tx)
+		return err
+	})
+	return m, err
+}
+
+// decodeManifest reads the manifest of a backup. Backups written before manifests were recorded
+// are full backups, and their manifest is derived from their content.
+func decodeManifest(tx *bolt.Tx) (*manifest, error) {
+	b := tx.Bucket(metadataBucket)
+	if b == nil {
+		m := &manifest{Buckets: make(map[string]string)}
+		err := tx.ForEach(func(name []byte, b *bolt.Bucket) error {
+			m.Buckets[hex.EncodeToString(name)] = checksum(b)
+			return nil
+		})
+		return m, err
+	}
+	enc := b.Get(manifestKey)
+	if enc == nil {
+		return nil, errors.New("backup has no manifest")
+	}
+	m := &manifest{}
+	if err := json.Unmarshal(enc, m); err != nil {
+		return nil, errors.Wrap(err, "could not decode backup manifest")
+	}
+	if strings.ContainsRune(m.Parent, filepath.Separator) {
+		return nil, fmt.Errorf("invalid parent backup name %q", m.Parent)
+	}
+	return m, nil
+}
+
+// copyBucket copies the keys and nested buckets of src to dst.
+func copyBucket(src, dst *bolt.Bucket) error {
+	if err := dst.SetSequence(src.Sequence()); err != nil {
+		return err
+	}
+	return src.ForEach(func(k, v []byte) error {
+		if v == nil {
+			if nested := src.Bucket(k); nested != nil {
+				dstNested, err := dst.CreateBucket(k)
+				if err != nil {
+					return err
+				}
+				return copyBucket(nested, dstNested)
+			}
+		}
+		return dst.Put(k, v)
+	})
+}
+
+// checksum returns the hex encoded SHA256 checksum of the keys, values and nested buckets of b.
+func checksum(b *bolt.Bucket) string {
+	h := sha256.New()
+	hashBucket(h, b)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func hashBucket(h hash.Hash, b *bolt.Bucket) {
+	writeUint64(h, b.Sequence())
+	// The iteration function never fails, so neither does ForEach.
+	_ = b.ForEach(func(k, v []byte) error {
+		if v == nil {
+			if nested := b.Bucket(k); nested != nil {
+				h.Write([]byte{1})
+				writeField(h, k)
+				hashBucket(h, nested)
+				h.Write([]byte{2})
+				return nil
+			}
+		}
+		h.Write([]byte{0})
+		writeField(h, k)
+		writeField(h, v)
+		return nil
+	})
+}
+
+// writeField writes a length prefixed field, so that distinct entries never hash alike.
+func writeField(h hash.Hash, b []byte) {
+	writeUint64(h, uint64(len(b)))
+	h.Write(b)
+}
+
+func writeUint64(h hash.Hash, v uint64) {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], v)
+	h.Write(buf[:])
+}
@@ -0,0 +1,218 @@
+package backup
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	bolt "go.etcd.io/bbolt"
+)
+
+type testDB struct {
+	*bolt.DB
+}
+
+func (d *testDB) WriteBackup(ctx context.Context, path, parentPath string) error {
+	return Write(ctx, d.DB, path, parentPath)
+}
+
+func setupDB(t *testing.T) (*testDB, string) {
+	dir, err := ioutil.TempDir("", "backup")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, os.RemoveAll(dir))
+	})
+	db, err := bolt.Open(filepath.Join(dir, "test.db"), params.BeaconIoConfig().ReadWritePermissions, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+	require.NoError(t, db.Update(func(tx *bolt.Tx) error {
+		a, err := tx.CreateBucket([]byte("a"))
+		if err != nil {
+			return err
+		}
+		if err := a.Put([]byte("key"), []byte("value")); err != nil {
+			return err
+		}
+		b, err := tx.CreateBucket([]byte("b"))
+		if err != nil {
+			return err
+		}
+		nested, err := b.CreateBucket([]byte("nested"))
+		if err != nil {
+			return err
+		}
+		return nested.Put([]byte("key"), []byte("nested value"))
+	}))
+	return &testDB{db}, dir
+}
+
+func put(t *testing.T, db *bolt.DB, bucket, key, value string) {
+	require.NoError(t, db.Update(func(tx *bolt.Tx) error {
+		b, err := tx.CreateBucketIfNotExists([]byte(bucket))
+		if err != nil {
+			return err
+		}
+		return b.Put([]byte(key), []byte(value))
+	}))
+}
+
+func checksums(t *testing.T, db *bolt.DB) map[string]string {
+	sums := make(map[string]string)
+	require.NoError(t, db.View(func(tx *bolt.Tx) error {
+		return tx.ForEach(func(name []byte, b *bolt.Bucket) error {
+			sums[string(name)] = checksum(b)
+			return nil
+		})
+	}))
+	return sums
+}
+
+func TestWrite_IncrementalAndRestore(t *testing.T) {
+	ctx := context.Background()
+	db, dir := setupDB(t)
+	fullPath := filepath.Join(dir, "full"+FileExtension)
+	require.NoError(t, Write(ctx, db.DB, fullPath, ""))
+
+	put(t, db.DB, "a", "other key", "other value")
+	put(t, db.DB, "c", "key", "value")
+	incPath := filepath.Join(dir, "incremental"+FileExtension)
+	require.NoError(t, Write(ctx, db.DB, incPath, fullPath))
+
+	m, err := readManifest(incPath)
+	require.NoError(t, err)
+	assert.Equal(t, "full"+FileExtension, m.Parent)
+	assert.Equal(t, 3, len(m.Buckets))
+	inc, err := bolt.Open(incPath, params.BeaconIoConfig().ReadWritePermissions, &bolt.Options{ReadOnly: true})
+	require.NoError(t, err)
+	require.NoError(t, inc.View(func(tx *bolt.Tx) error {
+		assert.Equal(t, true, tx.Bucket([]byte("a")) != nil, "Changed bucket is missing from incremental backup")
+		assert.Equal(t, true, tx.Bucket([]byte("b")) == nil, "Unchanged bucket was written to incremental backup")
+		assert.Equal(t, true, tx.Bucket([]byte("c")) != nil, "New bucket is missing from incremental backup")
+		return nil
+	}))
+	require.NoError(t, inc.Close())
+
+	chain, err := Validate(incPath)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []string{fullPath, incPath}, chain)
+
+	restoredPath := filepath.Join(dir, "restored", "test.db")
+	checked := false
+	require.NoError(t, Restore(ctx, incPath, restoredPath, func(path string) error {
+		checked = true
+		return nil
+	}))
+	assert.Equal(t, true, checked, "Restored database was not checked")
+	restored, err := bolt.Open(restoredPath, params.BeaconIoConfig().ReadWritePermissions, nil)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, restored.Close())
+	}()
+	assert.DeepEqual(t, checksums(t, db.DB), checksums(t, restored))
+}
+
+func TestRestore_ReplacesDatabase(t *testing.T) {
+	ctx := context.Background()
+	db, dir := setupDB(t)
+	backupPath := filepath.Join(dir, "full"+FileExtension)
+	require.NoError(t, Write(ctx, db.DB, backupPath, ""))
+
+	dbPath := filepath.Join(dir, "current", "test.db")
+	require.NoError(t, os.MkdirAll(filepath.Dir(dbPath), params.BeaconIoConfig().ReadWriteExecutePermissions))
+	current, err := bolt.Open(dbPath, params.BeaconIoConfig().ReadWritePermissions, nil)
+	require.NoError(t, err)
+	put(t, current, "d", "key", "value")
+	require.ErrorContains(t, "database may be in use", Restore(ctx, backupPath, dbPath, nil))
+	require.NoError(t, current.Close())
+
+	require.ErrorContains(t, "failed validation", Restore(ctx, backupPath, dbPath, func(string) error {
+		return os.ErrInvalid
+	}))
+	require.NoError(t, Restore(ctx, backupPath, dbPath, nil))
+	_, err = os.Stat(dbPath + ".pre-restore")
+	require.NoError(t, err)
+	restored, err := bolt.Open(dbPath, params.BeaconIoConfig().ReadWritePermissions, nil)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, restored.Close())
+	}()
+	assert.DeepEqual(t, checksums(t, db.DB), checksums(t, restored))
+}
+
+func TestCheckDatabase_RequiresBuckets(t *testing.T) {
+	ctx := context.Background()
+	db, dir := setupDB(t)
+	backupPath := filepath.Join(dir, "full"+FileExtension)
+	require.NoError(t, Write(ctx, db.DB, backupPath, ""))
+
+	dbPath := filepath.Join(dir, "current", "test.db")
+	require.NoError(t, Restore(ctx, backupPath, dbPath, func(restoredPath string) error {
+		return CheckDatabase(restoredPath, []byte("a"), []byte("b"))
+	}))
+	require.ErrorContains(t, "database has no c bucket", Restore(ctx, backupPath, dbPath, func(restoredPath string) error {
+		return CheckDatabase(restoredPath, []byte("a"), []byte("c"))
+	}))
+}
+
+func TestValidate_RejectsBrokenBackups(t *testing.T) {
+	ctx := context.Background()
+	db, dir := setupDB(t)
+	fullPath := filepath.Join(dir, "full"+FileExtension)
+	require.NoError(t, Write(ctx, db.DB, fullPath, ""))
+	put(t, db.DB, "a", "other key", "other value")
+	incPath := filepath.Join(dir, "incremental"+FileExtension)
+	require.NoError(t, Write(ctx, db.DB, incPath, fullPath))
+
+	full, err := bolt.Open(fullPath, params.BeaconIoConfig().ReadWritePermissions, nil)
+	require.NoError(t, err)
+	put(t, full, "b", "key", "tampered")
+	require.NoError(t, full.Close())
+	_, err = Validate(incPath)
+	assert.ErrorContains(t, "bucket b does not match its checksum", err)
+
+	require.NoError(t, os.Remove(fullPath))
+	_, err = Validate(incPath)
+	assert.ErrorContains(t, "invalid backup", err)
+}
+
+func TestService_IncrementalsAndRetention(t *testing.T) {
+	db, dir := setupDB(t)
+	backupsDir := filepath.Join(dir, DirectoryName)
+	s := NewService(context.Background(), &Config{
+		Database:     db,
+		Dir:          backupsDir,
+		Prefix:       "prysm_testdb",
+		Retention:    1,
+		Incrementals: 2,
+	})
+	start := time.Unix(1600000000, 0)
+	for i := 0; i < 3; i++ {
+		put(t, db.DB, "a", "key", string(rune('a'+i)))
+		require.NoError(t, s.backup(start.Add(time.Duration(i)*time.Minute)))
+	}
+	// The latest incremental backup builds upon every backup written so far.
+	paths, err := List(backupsDir, "prysm_testdb")
+	require.NoError(t, err)
+	require.Equal(t, 3, len(paths))
+	n, err := chainLength(paths[2])
+	require.NoError(t, err)
+	assert.Equal(t, 3, n)
+
+	// The next backup is a full backup, so the older ones are pruned.
+	require.NoError(t, s.backup(start.Add(3*time.Minute)))
+	paths, err = List(backupsDir, "prysm_testdb")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(paths))
+	assert.Equal(t, filepath.Join(backupsDir, FileName("prysm_testdb", start.Add(3*time.Minute))), paths[0])
+	n, err = chainLength(paths[0])
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+}
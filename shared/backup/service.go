@@ -0,0 +1,135 @@
+package backup
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+)
+
+// Database is a database which can be backed up.
+type Database interface {
+	// WriteBackup writes a backup of the database to path. When parentPath is not empty, only the
+	// buckets which changed since the backup at parentPath are written.
+	WriteBackup(ctx context.Context, path, parentPath string) error
+}
+
+// Config for the backup service.
+type Config struct {
+	// Database to back up.
+	Database Database
+	// Dir is the directory the backups are written to.
+	Dir string
+	// Prefix of the backup file names, such as prysm_beacondb.
+	Prefix string
+	// Interval between two backups.
+	Interval time.Duration
+	// Retention is the number of most recent backups to keep. Older backups are removed, unless
+	// one of the kept backups builds upon them.
+	Retention int
+	// Incrementals is the number of incremental backups written after every full backup.
+	Incrementals int
+}
+
+// Service writes scheduled backups of a database and prunes the backups past retention.
+type Service struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	cfg    *Config
+	lock   sync.RWMutex
+	err    error
+}
+
+// NewService returns a service writing backups of the configured database.
+func NewService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		ctx:    ctx,
+		cancel: cancel,
+		cfg:    cfg,
+	}
+}
+
+// Start the backup schedule.
+func (s *Service) Start() {
+	log.WithFields(logrus.Fields{
+		"dir":          s.cfg.Dir,
+		"interval":     s.cfg.Interval,
+		"retention":    s.cfg.Retention,
+		"incrementals": s.cfg.Incrementals,
+	}).Info("Scheduling database backups")
+	go s.run()
+}
+
+// Stop the backup schedule.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status returns the error of the last backup, if it failed.
+func (s *Service) Status() error {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.err
+}
+
+func (s *Service) run() {
+	ticker := time.NewTicker(s.cfg.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case t := <-ticker.C:
+			err := s.backup(t)
+			if err != nil {
+				log.WithError(err).Error("Could not back up database")
+			}
+			s.lock.Lock()
+			s.err = err
+			s.lock.Unlock()
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// backup writes the backup for the given time, incremental to the latest backup unless the
+// configured number of incremental backups was already written since the last full backup,
+// and prunes the backups past retention.
+func (s *Service) backup(t time.Time) error {
+	if err := os.MkdirAll(s.cfg.Dir, params.BeaconIoConfig().ReadWriteExecutePermissions); err != nil {
+		return err
+	}
+	paths, err := List(s.cfg.Dir, s.cfg.Prefix)
+	if err != nil {
+		return err
+	}
+	parentPath := ""
+	if s.cfg.Incrementals > 0 && len(paths) > 0 {
+		latest := paths[len(paths)-1]
+		n, err := chainLength(latest)
+		if err != nil {
+			return errors.Wrapf(err, "could not read latest backup %s", latest)
+		}
+		if n <= s.cfg.Incrementals {
+			parentPath = latest
+		}
+	}
+
+	path := filepath.Join(s.cfg.Dir, FileName(s.cfg.Prefix, t))
+	start := time.Now()
+	if err := s.cfg.Database.WriteBackup(s.ctx, path, parentPath); err != nil {
+		return err
+	}
+	log.WithFields(logrus.Fields{
+		"backup":      path,
+		"incremental": parentPath != "",
+		"duration":    time.Since(start),
+	}).Info("Wrote database backup")
+	return Prune(s.cfg.Dir, s.cfg.Prefix, s.cfg.Retention)
+}
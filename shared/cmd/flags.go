@@ -82,7 +82,7 @@ var (
 	// BootstrapNode tells the beacon node which bootstrap node to connect to
 	BootstrapNode = &cli.StringSliceFlag{
 		Name:  "bootstrap-node",
-		Usage: "The address of bootstrap node. Beacon node will connect for peer discovery via DHT.  Multiple nodes can be passed by using the flag multiple times but not comma-separated. You can also pass YAML files containing multiple nodes.",
+		Usage: "The address of bootstrap node. Beacon node will connect for peer discovery via DHT.  Multiple nodes can be passed by using the flag multiple times but not comma-separated. You can also pass YAML files containing multiple nodes, or EIP-1459 DNS node lists as enrtree:// URLs.",
 		Value: cli.NewStringSlice("enr:-Ku4QMKVC_MowDsmEa20d5uGjrChI0h8_KsKXDmgVQbIbngZV0idV6_RL7fEtZGo-kTNZ5o7_EJI_vCPJ6scrhwX0Z4Bh2F0dG5ldHOIAAAAAAAAAACEZXRoMpD1pf1CAAAAAP__________gmlkgnY0gmlwhBLf22SJc2VjcDI1NmsxoQJxCnE6v_x2ekgY_uoE1rtwzvGy40mq9eD66XfHPBWgIIN1ZHCCD6A"),
 	}
 	// RelayNode tells the beacon node which relay node to connect to.
@@ -164,6 +164,29 @@ var (
 		Name:  "clear-db",
 		Usage: "Prompt for clearing any previously stored data at the data directory",
 	}
+	// BackupIntervalFlag defines the interval between scheduled database backups.
+	BackupIntervalFlag = &cli.DurationFlag{
+		Name:  "db-backup-interval",
+		Usage: "Interval between scheduled database backups, written to the backups directory next to the database. Scheduled backups are disabled when unset",
+	}
+	// BackupRetentionFlag defines the number of scheduled database backups to keep.
+	BackupRetentionFlag = &cli.IntFlag{
+		Name:  "db-backup-retention",
+		Usage: "Number of most recent scheduled database backups to keep, along with the backups they build upon",
+		Value: 5,
+	}
+	// BackupIncrementalsFlag defines the number of incremental backups written after every full
+	// scheduled backup.
+	BackupIncrementalsFlag = &cli.IntFlag{
+		Name:  "db-backup-incrementals",
+		Usage: "Number of incremental backups, holding only the buckets changed since the previous backup, written after every full scheduled backup",
+	}
+	// RestoreFromFlag defines the backup file `db restore` rebuilds the database from.
+	RestoreFromFlag = &cli.StringFlag{
+		Name:     "from",
+		Usage:    "Backup file to restore the database from, incremental backups are applied along with the backups they build upon",
+		Required: true,
+	}
 	// LogFormat specifies the log output format.
 	LogFormat = &cli.StringFlag{
 		Name:  "log-format",
@@ -58,7 +58,7 @@ var (
 	}
 	kafkaBootstrapServersFlag = &cli.StringFlag{
 		Name:  "kafka-url",
-		Usage: "Stream attestations and blocks to specified kafka servers. This field is used for bootstrap.servers kafka config field.",
+		Usage: "Stream chain events to specified kafka servers, see --export-file for the exported events. This field is used for bootstrap.servers kafka config field.",
 	}
 	disableInitSyncVerifyEverythingFlag = &cli.BoolFlag{
 		Name: "disable-initial-sync-verify-all-signatures",
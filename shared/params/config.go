@@ -100,6 +100,7 @@ type BeaconChainConfig struct {
 	DefaultPageSize           int           // DefaultPageSize defines the default page size for RPC server request.
 	MaxPeersToSync            int           // MaxPeersToSync describes the limit for number of peers in round robin sync.
 	SlotsPerArchivedPoint     uint64        // SlotsPerArchivedPoint defines the number of slots per one archived point.
+	SlotsPerArchiveSnapshot   uint64        // SlotsPerArchiveSnapshot defines the number of slots between archived points saved as full states, the others are saved as state diffs. Zero saves full states only.
 	GenesisCountdownInterval  time.Duration // How often to log the countdown until the genesis time is reached.
 	NetworkName               string        // NetworkName for allowing an easy human-readable way of knowing what chain is being used.
 
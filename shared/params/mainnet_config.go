@@ -125,6 +125,7 @@ var mainnetBeaconConfig = &BeaconChainConfig{
 	DefaultPageSize:           250,
 	MaxPeersToSync:            15,
 	SlotsPerArchivedPoint:     2048,
+	SlotsPerArchiveSnapshot:   0,
 	GenesisCountdownInterval:  time.Minute,
 	NetworkName:               "Mainnet",
 
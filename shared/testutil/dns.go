@@ -0,0 +1,19 @@
+package testutil
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// DNSResolver serves TXT records from memory, so that a dnsdisc client resolves DNS node lists
+// without querying DNS.
+type DNSResolver map[string]string
+
+// LookupTXT returns the TXT record of the name.
+func (r DNSResolver) LookupTXT(_ context.Context, name string) ([]string, error) {
+	if record, ok := r[name]; ok {
+		return []string{record}, nil
+	}
+	return nil, errors.Errorf("no TXT record for %s", name)
+}
@@ -2,9 +2,18 @@
 package timeutils
 
 import (
+	"sync/atomic"
 	"time"
 )
 
+// clock holds the function Now reads the time from, which is the wall clock unless overridden
+// with SetNow.
+var clock atomic.Value
+
+func init() {
+	clock.Store(time.Now)
+}
+
 // Since returns the duration since t.
 func Since(t time.Time) time.Duration {
 	return Now().Sub(t)
@@ -17,5 +26,15 @@ func Until(t time.Time) time.Duration {
 
 // Now returns the current local time.
 func Now() time.Time {
-	return time.Now()
+	return clock.Load().(func() time.Time)()
+}
+
+// SetNow overrides the clock read by Now, and returns a function which restores the previous
+// clock. It allows tools such as the gossip replay to run at a recorded point in time.
+func SetNow(now func() time.Time) (reset func()) {
+	prev := clock.Load()
+	clock.Store(now)
+	return func() {
+		clock.Store(prev)
+	}
 }
@@ -0,0 +1,21 @@
+package timeutils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+)
+
+func TestSetNow(t *testing.T) {
+	pinned := time.Unix(1606824023, 0)
+	reset := SetNow(func() time.Time {
+		return pinned
+	})
+	assert.Equal(t, pinned, Now())
+	assert.Equal(t, time.Minute, Since(pinned.Add(-time.Minute)))
+	assert.Equal(t, time.Minute, Until(pinned.Add(time.Minute)))
+
+	reset()
+	assert.Equal(t, true, Now().After(pinned), "Wall clock not restored")
+}
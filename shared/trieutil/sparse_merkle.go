@@ -42,6 +42,85 @@ func CreateTrieFromProto(trieObj *protodb.SparseMerkleTrie) *SparseMerkleTrie {
 	return trie
 }
 
+// CreateTrieFromSnapshot creates a Sparse Merkle Trie of count items from a snapshot of its
+// finalized branch, being the roots of the largest complete subtrees of the items from left to
+// right, as returned by FinalizedBranch. The trie takes and proves new items as usual, but it
+// cannot prove the items covered by the snapshot, which are only kept as placeholders.
+func CreateTrieFromSnapshot(finalized [][]byte, count uint64, depth int) (*SparseMerkleTrie, error) {
+	if count == 0 {
+		if len(finalized) != 0 {
+			return nil, errors.New("snapshot of an empty trie has a finalized branch")
+		}
+		return NewTrie(depth)
+	}
+	if depth >= 64 || count >= 1<<uint(depth) {
+		return nil, fmt.Errorf("snapshot of %d items does not fit in a trie of depth %d", count, depth)
+	}
+	frontier := make([][]byte, depth)
+	next := 0
+	for h := depth - 1; h >= 0; h-- {
+		if (count>>uint(h))&1 == 0 {
+			continue
+		}
+		if next >= len(finalized) {
+			return nil, fmt.Errorf("snapshot of %d items needs more than %d finalized roots", count, len(finalized))
+		}
+		if len(finalized[next]) != 32 {
+			return nil, fmt.Errorf("finalized root %d has %d bytes instead of 32", next, len(finalized[next]))
+		}
+		frontier[h] = finalized[next]
+		next++
+	}
+	if next != len(finalized) {
+		return nil, fmt.Errorf("snapshot of %d items needs %d finalized roots, got %d", count, next, len(finalized))
+	}
+
+	// Every layer holds placeholders for the complete subtrees left of the last one, which is
+	// needed to insert further items, followed by the subtree partially filled by the items.
+	layers := make([][][]byte, depth+1)
+	var partial []byte
+	for h := 0; h <= depth; h++ {
+		size := count >> uint(h)
+		layer := make([][]byte, size, size+1)
+		for i := range layer {
+			layer[i] = ZeroHashes[h][:]
+		}
+		if size&1 == 1 {
+			layer[size-1] = frontier[h]
+		}
+		if partial != nil {
+			layer = append(layer, partial)
+		}
+		layers[h] = layer
+		if h == depth {
+			break
+		}
+		if size&1 == 1 {
+			right := ZeroHashes[h][:]
+			if partial != nil {
+				right = partial
+			}
+			parent := hashutil.Hash(append(append([]byte{}, frontier[h]...), right...))
+			partial = parent[:]
+		} else if partial != nil {
+			parent := hashutil.Hash(append(append([]byte{}, partial...), ZeroHashes[h][:]...))
+			partial = parent[:]
+		}
+	}
+	items := make([][]byte, count)
+	for i := range items {
+		items[i] = ZeroHashes[0][:]
+	}
+	if count&1 == 1 {
+		items[count-1] = frontier[0]
+	}
+	return &SparseMerkleTrie{
+		branches:      layers,
+		originalItems: items,
+		depth:         uint(depth),
+	}, nil
+}
+
 // GenerateTrieFromItems constructs a Merkle trie from a sequence of byte slices.
 func GenerateTrieFromItems(items [][]byte, depth int) (*SparseMerkleTrie, error) {
 	if len(items) == 0 {
@@ -165,6 +244,28 @@ func (m *SparseMerkleTrie) HashTreeRoot() [32]byte {
 	return hashutil.Hash(newNode)
 }
 
+// FinalizedBranch returns the roots of the largest complete subtrees of the first count items of
+// the trie from left to right, from which CreateTrieFromSnapshot recreates a trie of these items.
+// The count cannot be lower than that of the snapshot the trie was created from, if any.
+func (m *SparseMerkleTrie) FinalizedBranch(count uint64) ([][]byte, error) {
+	if count > uint64(len(m.originalItems)) {
+		return nil, fmt.Errorf("trie has %d items, fewer than %d", len(m.originalItems), count)
+	}
+	finalized := make([][]byte, 0)
+	for h := int(m.depth) - 1; h >= 0; h-- {
+		size := count >> uint(h)
+		if size&1 == 0 {
+			continue
+		}
+		if size > uint64(len(m.branches[h])) {
+			return nil, fmt.Errorf("trie layer %d has %d nodes, fewer than %d", h, len(m.branches[h]), size)
+		}
+		root := bytesutil.ToBytes32(m.branches[h][size-1])
+		finalized = append(finalized, root[:])
+	}
+	return finalized, nil
+}
+
 // ToProto converts the underlying trie into its corresponding
 // proto object
 func (m *SparseMerkleTrie) ToProto() *protodb.SparseMerkleTrie {
@@ -257,3 +257,53 @@ func BenchmarkVerifyMerkleBranch(b *testing.B) {
 		}
 	}
 }
+
+func TestCreateTrieFromSnapshot(t *testing.T) {
+	depth := int(params.BeaconConfig().DepositContractTreeDepth)
+	items := make([][]byte, 19)
+	for i := range items {
+		items[i] = []byte(strconv.Itoa(i + 1))
+	}
+	full, err := GenerateTrieFromItems(items, depth)
+	require.NoError(t, err)
+	for count := 0; count <= len(items); count++ {
+		finalized, err := full.FinalizedBranch(uint64(count))
+		require.NoError(t, err)
+		trie, err := CreateTrieFromSnapshot(finalized, uint64(count), depth)
+		require.NoError(t, err)
+		if count > 0 {
+			want, err := GenerateTrieFromItems(items[:count], depth)
+			require.NoError(t, err)
+			require.Equal(t, want.HashTreeRoot(), trie.HashTreeRoot(), "Wrong root for snapshot of %d items", count)
+		}
+
+		// Items inserted after the snapshot are proven as in the full trie.
+		for i := count; i < len(items); i++ {
+			trie.Insert(items[i], i)
+		}
+		require.Equal(t, full.HashTreeRoot(), trie.HashTreeRoot(), "Wrong root after inserting into snapshot of %d items", count)
+		for i := count; i < len(items); i++ {
+			want, err := full.MerkleProof(i)
+			require.NoError(t, err)
+			proof, err := trie.MerkleProof(i)
+			require.NoError(t, err)
+			require.DeepEqual(t, want, proof)
+		}
+	}
+}
+
+func TestCreateTrieFromSnapshot_InvalidSnapshot(t *testing.T) {
+	depth := int(params.BeaconConfig().DepositContractTreeDepth)
+	root := hashutil.Hash([]byte("root"))
+
+	_, err := CreateTrieFromSnapshot([][]byte{root[:]}, 0, depth)
+	require.ErrorContains(t, "snapshot of an empty trie has a finalized branch", err)
+	_, err = CreateTrieFromSnapshot([][]byte{root[:]}, 3, depth)
+	require.ErrorContains(t, "snapshot of 3 items needs more than 1 finalized roots", err)
+	_, err = CreateTrieFromSnapshot([][]byte{root[:], root[:]}, 4, depth)
+	require.ErrorContains(t, "snapshot of 4 items needs 1 finalized roots, got 2", err)
+	_, err = CreateTrieFromSnapshot([][]byte{root[:16]}, 4, depth)
+	require.ErrorContains(t, "finalized root 0 has 16 bytes instead of 32", err)
+	_, err = CreateTrieFromSnapshot([][]byte{root[:]}, 4, 2)
+	require.ErrorContains(t, "snapshot of 4 items does not fit in a trie of depth 2", err)
+}
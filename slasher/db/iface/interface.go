@@ -102,6 +102,7 @@ type Database interface {
 	FullAccessDatabase
 	DatabasePath() string
 	ClearDB() error
+	WriteBackup(ctx context.Context, path, parentPath string) error
 }
 
 // EpochSpansStore represents a data access layer for marshaling and unmarshaling validator spans for each validator per epoch.
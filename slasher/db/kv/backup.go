@@ -0,0 +1,34 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/prysmaticlabs/prysm/shared/backup"
+	"go.opencensus.io/trace"
+)
+
+// WriteBackup writes a backup of the database to path. When parentPath is not empty, only the
+// buckets which changed since the backup at parentPath are written.
+func (db *Store) WriteBackup(ctx context.Context, path, parentPath string) error {
+	ctx, span := trace.StartSpan(ctx, "slasherDB.WriteBackup")
+	defer span.End()
+	return backup.Write(ctx, db.db, path, parentPath)
+}
+
+// VerifyRestored checks that the database rebuilt from a backup at path is consistent and holds
+// every bucket of the slasher schema.
+func VerifyRestored(path string) error {
+	return backup.CheckDatabase(
+		path,
+		indexedAttestationsBucket,
+		indexedAttestationsRootsByTargetBucket,
+		historicIndexedAttestationsBucket,
+		historicBlockHeadersBucket,
+		compressedIdxAttsBucket,
+		validatorsPublicKeysBucket,
+		validatorsMinMaxSpanBucket,
+		validatorsMinMaxSpanBucketNew,
+		slashingBucket,
+		chainDataBucket,
+	)
+}
@@ -14,7 +14,12 @@ import (
 	"go.opencensus.io/trace"
 )
 
-var databaseFileName = "slasher.db"
+const (
+	// DatabaseFileName is the name of the slasher database file.
+	DatabaseFileName = "slasher.db"
+	// SlasherDbDirName is the name of the directory containing the slasher database.
+	SlasherDbDirName = "slasherdata"
+)
 
 // Store defines an implementation of the slasher Database interface
 // using BoltDB as the underlying persistent kv-store for eth2.
@@ -87,7 +92,7 @@ func NewKVStore(dirPath string, cfg *Config) (*Store, error) {
 	if err := os.MkdirAll(dirPath, params.BeaconIoConfig().ReadWriteExecutePermissions); err != nil {
 		return nil, err
 	}
-	datafile := path.Join(dirPath, databaseFileName)
+	datafile := path.Join(dirPath, DatabaseFileName)
 	boltDB, err := bolt.Open(datafile, params.BeaconIoConfig().ReadWritePermissions, &bolt.Options{Timeout: params.BeaconIoConfig().BoltTimeout})
 	if err != nil {
 		if err == bolt.ErrTimeout {
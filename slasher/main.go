@@ -14,6 +14,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/logutil"
 	"github.com/prysmaticlabs/prysm/shared/version"
+	"github.com/prysmaticlabs/prysm/slasher/db"
 	"github.com/prysmaticlabs/prysm/slasher/flags"
 	"github.com/prysmaticlabs/prysm/slasher/node"
 	"github.com/sirupsen/logrus"
@@ -55,6 +56,9 @@ var appFlags = []cli.Flag{
 	cmd.LogFormat,
 	cmd.ClearDB,
 	cmd.ForceClearDB,
+	cmd.BackupIntervalFlag,
+	cmd.BackupRetentionFlag,
+	cmd.BackupIncrementalsFlag,
 	cmd.ConfigFileFlag,
 	debug.PProfFlag,
 	debug.PProfAddrFlag,
@@ -83,6 +87,9 @@ func main() {
 	app.Version = version.GetVersion()
 	app.Flags = appFlags
 	app.Action = startSlasher
+	app.Commands = []*cli.Command{
+		db.Commands,
+	}
 	app.Before = func(ctx *cli.Context) error {
 		// Load any flags from file, if specified.
 		if ctx.IsSet(cmd.ConfigFileFlag.Name) {
@@ -14,6 +14,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/shared/backup"
 	"github.com/prysmaticlabs/prysm/shared/cmd"
 	"github.com/prysmaticlabs/prysm/shared/debug"
 	"github.com/prysmaticlabs/prysm/shared/event"
@@ -34,8 +35,6 @@ import (
 
 var log = logrus.WithField("prefix", "node")
 
-const slasherDBName = "slasherdata"
-
 // SlasherNode defines a struct that handles the services running a slashing detector
 // for eth2. It handles the lifecycle of the entire system and registers
 // services to a service registry.
@@ -93,6 +92,10 @@ func NewSlasherNode(cliCtx *cli.Context) (*SlasherNode, error) {
 		return nil, err
 	}
 
+	if err := slasher.registerBackupService(); err != nil {
+		return nil, err
+	}
+
 	if err := slasher.registerBeaconClientService(); err != nil {
 		return nil, err
 	}
@@ -167,7 +170,7 @@ func (s *SlasherNode) startDB() error {
 	baseDir := s.cliCtx.String(cmd.DataDirFlag.Name)
 	clearDB := s.cliCtx.Bool(cmd.ClearDB.Name)
 	forceClearDB := s.cliCtx.Bool(cmd.ForceClearDB.Name)
-	dbPath := path.Join(baseDir, slasherDBName)
+	dbPath := path.Join(baseDir, kv.SlasherDbDirName)
 	spanCacheSize := s.cliCtx.Int(flags.SpanCacheSize.Name)
 	cfg := &kv.Config{SpanCacheSize: spanCacheSize}
 	log.Infof("Span cache size has been set to: %d", spanCacheSize)
@@ -200,6 +203,24 @@ func (s *SlasherNode) startDB() error {
 	return nil
 }
 
+// registerBackupService schedules backups of the database when a backup interval is set.
+func (s *SlasherNode) registerBackupService() error {
+	interval := s.cliCtx.Duration(cmd.BackupIntervalFlag.Name)
+	if interval <= 0 {
+		return nil
+	}
+	dbPath := path.Join(s.cliCtx.String(cmd.DataDirFlag.Name), kv.SlasherDbDirName)
+	svc := backup.NewService(s.ctx, &backup.Config{
+		Database:     s.db,
+		Dir:          path.Join(dbPath, backup.DirectoryName),
+		Prefix:       "prysm_slasherdb",
+		Interval:     interval,
+		Retention:    s.cliCtx.Int(cmd.BackupRetentionFlag.Name),
+		Incrementals: s.cliCtx.Int(cmd.BackupIncrementalsFlag.Name),
+	})
+	return s.services.RegisterService(svc)
+}
+
 func (s *SlasherNode) registerBeaconClientService() error {
 	beaconCert := s.cliCtx.String(flags.BeaconCertFlag.Name)
 	beaconProvider := s.cliCtx.String(flags.BeaconRPCProviderFlag.Name)
@@ -59,6 +59,9 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.LogFileName,
 			cmd.ForceClearDB,
 			cmd.ClearDB,
+			cmd.BackupIntervalFlag,
+			cmd.BackupRetentionFlag,
+			cmd.BackupIncrementalsFlag,
 			cmd.ConfigFileFlag,
 		},
 	},
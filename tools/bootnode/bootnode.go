@@ -20,11 +20,13 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/btcsuite/btcd/btcec"
 	gethlog "github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/p2p/discover"
+	"github.com/ethereum/go-ethereum/p2p/dnsdisc"
 	"github.com/ethereum/go-ethereum/p2p/enode"
 	"github.com/ethereum/go-ethereum/p2p/enr"
 	"github.com/libp2p/go-libp2p-core/crypto"
@@ -53,6 +55,7 @@ var (
 	externalIP           = flag.String("external-ip", "", "External IP for the bootnode")
 	forkVersion          = flag.String("fork-version", "", "Fork Version that the bootnode uses")
 	genesisValidatorRoot = flag.String("genesis-root", "", "Genesis Validator Root the beacon node uses")
+	dnsNodeLists         = flag.String("dns-node-lists", "", "Comma separated enrtree:// DNS node lists to seed the discovery table with")
 	log                  = logrus.WithField("prefix", "bootnode")
 	discv5PeersCount     = promauto.NewGauge(prometheus.GaugeOpts{
 		Name: "bootstrap_node_discv5_peers",
@@ -92,6 +95,10 @@ func main() {
 	cfg := discover.Config{
 		PrivateKey: privKey,
 	}
+	if *dnsNodeLists != "" {
+		client := dnsdisc.NewClient(dnsdisc.Config{})
+		cfg.Bootnodes = resolveDNSNodeLists(client, strings.Split(*dnsNodeLists, ","))
+	}
 	ipAddr, err := iputils.ExternalIPv4()
 	if err != nil {
 		log.Fatal(err)
@@ -164,6 +171,22 @@ func (h *handler) httpHandler(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// resolveDNSNodeLists resolves the given EIP-1459 DNS node lists and returns their nodes. Every
+// tree is verified against the public key in its URL, lists which fail to resolve are skipped.
+func resolveDNSNodeLists(client *dnsdisc.Client, urls []string) []*enode.Node {
+	var nodes []*enode.Node
+	for _, url := range urls {
+		tree, err := client.SyncTree(url)
+		if err != nil {
+			log.WithError(err).Errorf("Could not resolve DNS node list %s", url)
+			continue
+		}
+		log.WithField("nodes", len(tree.Nodes())).Infof("Resolved DNS node list %s", url)
+		nodes = append(nodes, tree.Nodes()...)
+	}
+	return nodes
+}
+
 func createLocalNode(privKey *ecdsa.PrivateKey, ipAddr net.IP, port int) (*enode.LocalNode, error) {
 	db, err := enode.OpenDB("")
 	if err != nil {
@@ -5,16 +5,19 @@ import (
 	"crypto/rand"
 	"fmt"
 	"io/ioutil"
+	"net"
 	"os"
 	"testing"
 	"time"
 
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/ethereum/go-ethereum/p2p/discover"
+	"github.com/ethereum/go-ethereum/p2p/dnsdisc"
 	"github.com/ethereum/go-ethereum/p2p/enode"
 	"github.com/libp2p/go-libp2p-core/crypto"
 	"github.com/prysmaticlabs/prysm/shared/iputils"
 	_ "github.com/prysmaticlabs/prysm/shared/maxprocs"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 	"github.com/sirupsen/logrus"
@@ -78,3 +81,24 @@ func TestPrivateKey_ParsesCorrectly(t *testing.T) {
 	assert.Equal(t, true, isVerified, "Unmarshalled key is not the same as the key that was given to the function")
 	*privateKey = ""
 }
+
+func TestResolveDNSNodeLists(t *testing.T) {
+	nodes := make([]*enode.Node, 2)
+	for i := range nodes {
+		localNode, err := createLocalNode(extractPrivateKey(), net.IPv4(10, 0, 0, byte(i+1)), 4000)
+		require.NoError(t, err)
+		nodes[i] = localNode.Node()
+	}
+	tree, err := dnsdisc.MakeTree(1, nodes, nil)
+	require.NoError(t, err)
+	url, err := tree.Sign(extractPrivateKey(), "nodes.example.org")
+	require.NoError(t, err)
+	client := dnsdisc.NewClient(dnsdisc.Config{Resolver: testutil.DNSResolver(tree.ToTXT("nodes.example.org"))})
+
+	resolved := resolveDNSNodeLists(client, []string{url, "enrtree://invalid@nodes.example.org"})
+	require.Equal(t, len(nodes), len(resolved))
+	ids := map[enode.ID]bool{resolved[0].ID(): true, resolved[1].ID(): true}
+	for _, node := range nodes {
+		assert.Equal(t, true, ids[node.ID()], "Node %s not resolved", node.ID())
+	}
+}
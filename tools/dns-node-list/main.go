@@ -0,0 +1,207 @@
+// This binary crawls the discv5 network for eth2 nodes and publishes the nodes found as a signed
+// EIP-1459 DNS node list. The TXT records of the tree are written as a JSON object mapping each
+// record name to its content, which can then be deployed to the DNS zone of the domain.
+//
+// Usage: bazel run //tools/dns-node-list -- --private $KEY --domain nodes.example.org --bootstrap-node $ENR
+package main
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	gethCrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/p2p/discover"
+	"github.com/ethereum/go-ethereum/p2p/dnsdisc"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/pkg/errors"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	_ "github.com/prysmaticlabs/prysm/shared/maxprocs"
+	log "github.com/sirupsen/logrus"
+)
+
+var (
+	privateKey    = flag.String("private", "", "Hex encoded private key to sign the node list with")
+	domain        = flag.String("domain", "", "Domain the node list is published under")
+	bootnodes     = flag.String("bootstrap-node", "", "Comma separated ENRs of the nodes to start crawling from")
+	crawlDuration = flag.Duration("crawl-duration", time.Minute, "How long to crawl the network for nodes")
+	forkDigest    = flag.String("fork-digest", "", "Hex encoded fork digest, only nodes on this fork are published if set")
+	links         = flag.String("links", "", "Comma separated enrtree:// links to other node lists to include")
+	seq           = flag.Uint("seq", 0, "Sequence number of the tree, the current unix time is used if zero")
+	outfile       = flag.String("out", "", "Filepath to write the TXT records to, instead of stdout")
+)
+
+func main() {
+	flag.Parse()
+
+	if *privateKey == "" {
+		log.Fatal("No private key given")
+	}
+	if *domain == "" {
+		log.Fatal("No domain given")
+	}
+	key, err := decodePrivateKey(*privateKey)
+	if err != nil {
+		log.WithError(err).Fatal("Could not decode private key")
+	}
+	var digest []byte
+	if *forkDigest != "" {
+		digest, err = hex.DecodeString(strings.TrimPrefix(*forkDigest, "0x"))
+		if err != nil {
+			log.WithError(err).Fatal("Could not decode fork digest")
+		}
+	}
+	var bootNodes []*enode.Node
+	for _, addr := range splitList(*bootnodes) {
+		node, err := enode.Parse(enode.ValidSchemes, addr)
+		if err != nil {
+			log.WithError(err).Fatalf("Invalid bootstrap node %s", addr)
+		}
+		bootNodes = append(bootNodes, node)
+	}
+	treeSeq := *seq
+	if treeSeq == 0 {
+		treeSeq = uint(time.Now().Unix())
+	}
+
+	nodes, err := crawl(bootNodes, *crawlDuration)
+	if err != nil {
+		log.WithError(err).Fatal("Could not crawl the network")
+	}
+	nodes = filterNodes(nodes, digest)
+	log.WithField("nodes", len(nodes)).Info("Crawled eth2 nodes")
+
+	url, records, err := makeNodeList(nodes, splitList(*links), treeSeq, key, *domain)
+	if err != nil {
+		log.WithError(err).Fatal("Could not make node list")
+	}
+	out, err := json.MarshalIndent(records, "", "  ")
+	if err != nil {
+		log.WithError(err).Fatal("Could not encode TXT records")
+	}
+	if *outfile != "" {
+		if err := ioutil.WriteFile(*outfile, out, 0644); err != nil {
+			log.WithError(err).Fatal("Could not write TXT records")
+		}
+	} else {
+		fmt.Println(string(out))
+	}
+	log.WithField("url", url).Info("Node list signed")
+}
+
+// crawl walks the discv5 network from the given bootnodes for the given duration and returns the
+// most recent record of every node found.
+func crawl(bootNodes []*enode.Node, duration time.Duration) ([]*enode.Node, error) {
+	key, err := gethCrypto.GenerateKey()
+	if err != nil {
+		return nil, err
+	}
+	conn, err := net.ListenUDP("udp4", &net.UDPAddr{IP: net.IPv4zero})
+	if err != nil {
+		return nil, err
+	}
+	db, err := enode.OpenDB("")
+	if err != nil {
+		return nil, err
+	}
+	localNode := enode.NewLocalNode(db, key)
+	listener, err := discover.ListenV5(conn, localNode, discover.Config{
+		PrivateKey: key,
+		Bootnodes:  bootNodes,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer listener.Close()
+
+	iterator := listener.RandomNodes()
+	time.AfterFunc(duration, iterator.Close)
+	found := make(map[enode.ID]*enode.Node)
+	for iterator.Next() {
+		node := iterator.Node()
+		if prev, ok := found[node.ID()]; !ok || prev.Seq() < node.Seq() {
+			found[node.ID()] = node
+		}
+	}
+	nodes := make([]*enode.Node, 0, len(found))
+	for _, node := range found {
+		nodes = append(nodes, node)
+	}
+	return nodes, nil
+}
+
+// filterNodes returns the nodes which advertise an eth2 fork entry and can be dialed over TCP. If
+// a fork digest is given, only the nodes on that fork are returned.
+func filterNodes(nodes []*enode.Node, digest []byte) []*enode.Node {
+	filtered := make([]*enode.Node, 0, len(nodes))
+	for _, node := range nodes {
+		if node.IP() == nil || node.TCP() == 0 {
+			continue
+		}
+		entry := make([]byte, 0)
+		if err := node.Record().Load(enr.WithEntry("eth2", &entry)); err != nil {
+			continue
+		}
+		forkID := &pb.ENRForkID{}
+		if err := forkID.UnmarshalSSZ(entry); err != nil {
+			continue
+		}
+		if digest != nil && !bytes.Equal(forkID.CurrentForkDigest, digest) {
+			continue
+		}
+		filtered = append(filtered, node)
+	}
+	return filtered
+}
+
+// makeNodeList builds the tree of the given nodes and links, signs it and returns the URL of the
+// tree along with its TXT records.
+func makeNodeList(
+	nodes []*enode.Node,
+	links []string,
+	seq uint,
+	key *ecdsa.PrivateKey,
+	domain string,
+) (string, map[string]string, error) {
+	tree, err := dnsdisc.MakeTree(seq, nodes, links)
+	if err != nil {
+		return "", nil, errors.Wrap(err, "could not make tree")
+	}
+	url, err := tree.Sign(key, domain)
+	if err != nil {
+		return "", nil, errors.Wrap(err, "could not sign tree")
+	}
+	return url, tree.ToTXT(domain), nil
+}
+
+func decodePrivateKey(privKey string) (*ecdsa.PrivateKey, error) {
+	dst, err := hex.DecodeString(privKey)
+	if err != nil {
+		return nil, err
+	}
+	unmarshalledKey, err := crypto.UnmarshalSecp256k1PrivateKey(dst)
+	if err != nil {
+		return nil, err
+	}
+	return (*ecdsa.PrivateKey)((*btcec.PrivateKey)(unmarshalledKey.(*crypto.Secp256k1PrivateKey))), nil
+}
+
+func splitList(list string) []string {
+	var items []string
+	for _, item := range strings.Split(list, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
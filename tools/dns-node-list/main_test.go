@@ -0,0 +1,83 @@
+package main
+
+import (
+	"net"
+	"testing"
+
+	gethCrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/p2p/dnsdisc"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestFilterNodes(t *testing.T) {
+	digest := []byte{1, 2, 3, 4}
+	onFork := testNode(t, digest, 13000)
+	otherFork := testNode(t, []byte{4, 3, 2, 1}, 13000)
+	noTCP := testNode(t, digest, 0)
+	noEth2 := testNode(t, nil, 13000)
+	nodes := []*enode.Node{onFork, otherFork, noTCP, noEth2}
+
+	assert.DeepEqual(t, []*enode.Node{onFork}, filterNodes(nodes, digest))
+	assert.DeepEqual(t, []*enode.Node{onFork, otherFork}, filterNodes(nodes, nil))
+}
+
+func TestMakeNodeList_Resolve(t *testing.T) {
+	nodes := []*enode.Node{
+		testNode(t, []byte{1, 2, 3, 4}, 13000),
+		testNode(t, []byte{1, 2, 3, 4}, 13001),
+	}
+	key, err := gethCrypto.GenerateKey()
+	require.NoError(t, err)
+	url, records, err := makeNodeList(nodes, nil, 1, key, "nodes.example.org")
+	require.NoError(t, err)
+
+	client := dnsdisc.NewClient(dnsdisc.Config{Resolver: testutil.DNSResolver(records)})
+	tree, err := client.SyncTree(url)
+	require.NoError(t, err)
+	assert.Equal(t, uint(1), tree.Seq())
+	resolved := make(map[enode.ID]bool)
+	for _, node := range tree.Nodes() {
+		resolved[node.ID()] = true
+	}
+	require.Equal(t, len(nodes), len(resolved))
+	for _, node := range nodes {
+		assert.Equal(t, true, resolved[node.ID()], "Node %s not in resolved tree", node.ID())
+	}
+
+	// A tree signed with another key does not verify against the URL.
+	otherKey, err := gethCrypto.GenerateKey()
+	require.NoError(t, err)
+	_, otherRecords, err := makeNodeList(nodes, nil, 2, otherKey, "nodes.example.org")
+	require.NoError(t, err)
+	client = dnsdisc.NewClient(dnsdisc.Config{Resolver: testutil.DNSResolver(otherRecords)})
+	_, err = client.SyncTree(url)
+	assert.NotNil(t, err, "Tree with invalid signature was accepted")
+}
+
+func testNode(t *testing.T, digest []byte, tcpPort int) *enode.Node {
+	key, err := gethCrypto.GenerateKey()
+	require.NoError(t, err)
+	record := &enr.Record{}
+	record.Set(enr.IP(net.IPv4(10, 0, 0, 1)))
+	record.Set(enr.UDP(12000))
+	if tcpPort != 0 {
+		record.Set(enr.TCP(tcpPort))
+	}
+	if digest != nil {
+		entry, err := (&pb.ENRForkID{
+			CurrentForkDigest: digest,
+			NextForkVersion:   []byte{0, 0, 0, 0},
+		}).MarshalSSZ()
+		require.NoError(t, err)
+		record.Set(enr.WithEntry("eth2", entry))
+	}
+	require.NoError(t, enode.SignV4(record, key))
+	node, err := enode.New(enode.ValidSchemes, record)
+	require.NoError(t, err)
+	return node
+}
@@ -0,0 +1,99 @@
+// This binary replays a recording of the gossip traffic of a beacon node, as written with
+// --p2p-record-dir, through the sync service against a snapshot of the beacon node database. It
+// reports the messages which validated differently from when they were recorded, and exits with
+// a non-zero status if there are any, so that it can be bisected against.
+//
+// Usage: bazel run //tools/replay-gossip -- --recording /path/to/recording --db /path/to/beaconchain.db
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/recorder"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync/replay"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	_ "github.com/prysmaticlabs/prysm/shared/maxprocs"
+	log "github.com/sirupsen/logrus"
+)
+
+var (
+	recording = flag.String("recording", "", "Recording directory, or a single recording file, to replay")
+	dbPath    = flag.String("db", "", "Path to the beacon node database file to replay against, which is left untouched")
+	localPeer = flag.String("local-peer", "", "Peer ID of the recording node, its own messages are skipped")
+	verbose   = flag.Bool("verbose", false, "Print the result of every replayed message, not just the diverging ones")
+)
+
+func main() {
+	flag.Parse()
+
+	if *recording == "" {
+		log.Fatal("No recording given")
+	}
+	if *dbPath == "" {
+		log.Fatal("No database given")
+	}
+	featureconfig.Init(&featureconfig.Flags{})
+
+	entries, err := readRecording(*recording)
+	if err != nil {
+		log.WithError(err).Fatal("Could not read recording")
+	}
+	var pid peer.ID
+	if *localPeer != "" {
+		pid, err = peer.Decode(*localPeer)
+		if err != nil {
+			log.WithError(err).Fatal("Invalid local peer ID")
+		}
+	}
+	results, err := replay.Replay(context.Background(), &replay.Config{
+		DBPath:    *dbPath,
+		Entries:   entries,
+		LocalPeer: pid,
+	})
+	if err != nil {
+		log.WithError(err).Fatal("Could not replay recording")
+	}
+
+	for _, r := range results {
+		if *verbose || r.Diverged() || r.Err != nil {
+			fmt.Println(formatResult(r))
+		}
+	}
+	byResult, diverged, failed := replay.Summary(results)
+	log.WithFields(log.Fields{
+		"accepted": byResult[recorder.ResultAccept],
+		"rejected": byResult[recorder.ResultReject],
+		"ignored":  byResult[recorder.ResultIgnore],
+		"diverged": diverged,
+		"failed":   failed,
+	}).Info("Replayed gossip messages")
+	if diverged > 0 || failed > 0 {
+		os.Exit(1)
+	}
+}
+
+// readRecording reads the entries of a recording directory, or of a single recording file.
+func readRecording(name string) ([]*recorder.Entry, error) {
+	info, err := os.Stat(name)
+	if err != nil {
+		return nil, err
+	}
+	if info.IsDir() {
+		return recorder.ReadDir(name)
+	}
+	return recorder.ReadFile(name)
+}
+
+// formatResult describes the replay of a message on a single line.
+func formatResult(r *replay.Result) string {
+	line := fmt.Sprintf("%s %s from %s: recorded %s, replayed %s",
+		r.Entry.Time.UTC().Format("2006-01-02T15:04:05.000Z"), r.Entry.Topic, r.Entry.Peer, r.Entry.Result, r.Result)
+	if r.Err != nil {
+		line += fmt.Sprintf(", subscriber failed: %v", r.Err)
+	}
+	return line
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/recorder"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync/replay"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestReadRecording(t *testing.T) {
+	dir := path.Join(testutil.TempDir(), "replaygossiptest")
+	defer func() {
+		require.NoError(t, os.RemoveAll(dir))
+	}()
+	r, err := recorder.New(&recorder.Config{Dir: dir, MaxFileSize: 1 << 20})
+	require.NoError(t, err)
+	entry := &recorder.Entry{Kind: recorder.KindGossip, Topic: "/eth2/01020304/beacon_block/ssz_snappy"}
+	r.Record(entry)
+	require.NoError(t, r.Close())
+
+	fromDir, err := readRecording(dir)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []*recorder.Entry{entry}, fromDir)
+	files, err := recorder.Files(dir)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(files))
+	fromFile, err := readRecording(files[0])
+	require.NoError(t, err)
+	assert.DeepEqual(t, fromDir, fromFile)
+
+	_, err = readRecording(path.Join(dir, "missing"))
+	assert.NotNil(t, err)
+}
+
+func TestFormatResult(t *testing.T) {
+	pid, err := peer.Decode("16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR")
+	require.NoError(t, err)
+	entry := &recorder.Entry{
+		Time:   time.Unix(1606824023, 0),
+		Topic:  "/eth2/01020304/beacon_block/ssz_snappy",
+		Peer:   pid,
+		Result: recorder.ResultAccept,
+	}
+	assert.Equal(t,
+		"2020-12-01T12:00:23.000Z /eth2/01020304/beacon_block/ssz_snappy from 16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR: recorded accept, replayed reject",
+		formatResult(&replay.Result{Entry: entry, Result: recorder.ResultReject}))
+	assert.Equal(t,
+		"2020-12-01T12:00:23.000Z /eth2/01020304/beacon_block/ssz_snappy from 16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR: recorded accept, replayed accept, subscriber failed: boom",
+		formatResult(&replay.Result{Entry: entry, Result: recorder.ResultAccept, Err: errors.New("boom")}))
+}
@@ -0,0 +1,53 @@
+package db
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/prysmaticlabs/prysm/shared/backup"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+// Commands for restoring the validator slashing protection database.
+var Commands = &cli.Command{
+	Name:     "db",
+	Category: "db",
+	Usage:    "defines commands for interacting with the validator slashing protection database",
+	Subcommands: []*cli.Command{
+		{
+			Name: "restore",
+			Description: `restores the slashing protection database in the data directory from a full or incremental
+backup. The backup, and every backup it builds upon, is validated and the rebuilt database is checked
+before it replaces the current database, which is kept next to it with a .pre-restore suffix. When the
+validator keeps its database in the wallet accounts directory, pass that directory as --datadir.`,
+			Flags: []cli.Flag{
+				cmd.DataDirFlag,
+				cmd.RestoreFromFlag,
+			},
+			Action: func(cliCtx *cli.Context) error {
+				if err := restoreDB(cliCtx); err != nil {
+					logrus.WithField("prefix", "db").Fatalf("Could not restore database: %v", err)
+				}
+				return nil
+			},
+		},
+	},
+}
+
+// restoreDB rebuilds the slashing protection database from a backup and swaps it in once the
+// rebuilt database passed validation.
+func restoreDB(cliCtx *cli.Context) error {
+	from := cliCtx.String(cmd.RestoreFromFlag.Name)
+	dbPath := filepath.Join(cliCtx.String(cmd.DataDirFlag.Name), kv.ProtectionDbFileName)
+	if err := backup.Restore(context.Background(), from, dbPath, kv.VerifyRestored); err != nil {
+		return err
+	}
+	logrus.WithField("prefix", "db").WithFields(logrus.Fields{
+		"backup":   from,
+		"database": dbPath,
+	}).Info("Restored database from backup")
+	return nil
+}
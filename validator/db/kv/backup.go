@@ -0,0 +1,27 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/prysmaticlabs/prysm/shared/backup"
+	"go.opencensus.io/trace"
+)
+
+// WriteBackup writes a backup of the database to path. When parentPath is not empty, only the
+// buckets which changed since the backup at parentPath are written.
+func (store *Store) WriteBackup(ctx context.Context, path, parentPath string) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.WriteBackup")
+	defer span.End()
+	return backup.Write(ctx, store.db, path, parentPath)
+}
+
+// VerifyRestored checks that the database rebuilt from a backup at path is consistent and holds
+// every bucket of the slashing protection schema.
+func VerifyRestored(path string) error {
+	return backup.CheckDatabase(
+		path,
+		historicProposalsBucket,
+		historicAttestationsBucket,
+		newhistoricProposalsBucket,
+	)
+}
@@ -24,6 +24,7 @@ import (
 	v1 "github.com/prysmaticlabs/prysm/validator/accounts/v1"
 	v2 "github.com/prysmaticlabs/prysm/validator/accounts/v2"
 	"github.com/prysmaticlabs/prysm/validator/client"
+	"github.com/prysmaticlabs/prysm/validator/db"
 	"github.com/prysmaticlabs/prysm/validator/flags"
 	"github.com/prysmaticlabs/prysm/validator/node"
 	"github.com/sirupsen/logrus"
@@ -86,6 +87,9 @@ var appFlags = []cli.Flag{
 	cmd.DataDirFlag,
 	cmd.ClearDB,
 	cmd.ForceClearDB,
+	cmd.BackupIntervalFlag,
+	cmd.BackupRetentionFlag,
+	cmd.BackupIncrementalsFlag,
 	cmd.EnableTracingFlag,
 	cmd.TracingProcessNameFlag,
 	cmd.TracingEndpointFlag,
@@ -117,6 +121,7 @@ func main() {
 	app.Commands = []*cli.Command{
 		v2.WalletCommands,
 		v2.AccountCommands,
+		db.Commands,
 		{
 			Name:     "accounts",
 			Category: "accounts",
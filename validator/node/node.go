@@ -15,6 +15,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/shared/backup"
 	"github.com/prysmaticlabs/prysm/shared/cmd"
 	"github.com/prysmaticlabs/prysm/shared/debug"
 	"github.com/prysmaticlabs/prysm/shared/event"
@@ -220,6 +221,9 @@ func (s *ValidatorClient) initializeFromCLI(cliCtx *cli.Context) error {
 	if err := s.registerPrometheusService(); err != nil {
 		return err
 	}
+	if err := s.registerBackupService(cliCtx, dataDir); err != nil {
+		return err
+	}
 	if featureconfig.Get().SlasherProtection {
 		if err := s.registerSlasherClientService(); err != nil {
 			return err
@@ -311,6 +315,24 @@ func (s *ValidatorClient) registerPrometheusService() error {
 	return s.services.RegisterService(service)
 }
 
+// registerBackupService schedules backups of the slashing protection database when a backup
+// interval is set.
+func (s *ValidatorClient) registerBackupService(cliCtx *cli.Context, dataDir string) error {
+	interval := cliCtx.Duration(cmd.BackupIntervalFlag.Name)
+	if interval <= 0 {
+		return nil
+	}
+	svc := backup.NewService(cliCtx.Context, &backup.Config{
+		Database:     s.db,
+		Dir:          filepath.Join(dataDir, backup.DirectoryName),
+		Prefix:       "prysm_validatordb",
+		Interval:     interval,
+		Retention:    cliCtx.Int(cmd.BackupRetentionFlag.Name),
+		Incrementals: cliCtx.Int(cmd.BackupIncrementalsFlag.Name),
+	})
+	return s.services.RegisterService(svc)
+}
+
 func (s *ValidatorClient) registerClientService(
 	keyManager v1.KeyManager,
 	keyManagerV2 v2.IKeymanager,
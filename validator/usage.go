@@ -50,6 +50,9 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.DataDirFlag,
 			cmd.ClearDB,
 			cmd.ForceClearDB,
+			cmd.BackupIntervalFlag,
+			cmd.BackupRetentionFlag,
+			cmd.BackupIncrementalsFlag,
 			cmd.EnableTracingFlag,
 			cmd.TracingProcessNameFlag,
 			cmd.TracingEndpointFlag,